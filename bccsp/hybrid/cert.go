@@ -0,0 +1,78 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hybrid
+
+import (
+	"crypto/mldsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/pkg/errors"
+)
+
+// AltPublicKeyInfoOID is the OID of the extension which carries the
+// post-quantum public key of a hybrid certificate.
+var AltPublicKeyInfoOID = bccsp.HybridAltPublicKeyInfoOID
+
+// AltPublicKeyExtension returns the extension carrying pk in a certificate.
+// The extension is critical so that verifiers which do not support hybrid
+// keys reject the certificate, instead of only checking classical signatures.
+func AltPublicKeyExtension(pk *mldsa.PublicKey) (pkix.Extension, error) {
+	raw, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		return pkix.Extension{}, errors.Wrap(err, "failed marshalling ML-DSA public key")
+	}
+	return pkix.Extension{Id: AltPublicKeyInfoOID, Critical: true, Value: raw}, nil
+}
+
+// HasAltPublicKey returns true if cert carries a post-quantum public key.
+func HasAltPublicKey(cert *x509.Certificate) bool {
+	return altPublicKeyExtension(cert) != nil
+}
+
+// ParseAltPublicKey returns the post-quantum public key carried by cert.
+func ParseAltPublicKey(cert *x509.Certificate) (*mldsa.PublicKey, error) {
+	ext := altPublicKeyExtension(cert)
+	if ext == nil {
+		return nil, errors.New("certificate does not carry a hybrid public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(ext.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing the alternative public key of the certificate")
+	}
+	pk, ok := pub.(*mldsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("unsupported alternative public key of type %T, expected an ML-DSA key", pub)
+	}
+	return pk, nil
+}
+
+// HandleAltPublicKey returns a copy of cert in which the post-quantum public key
+// extension is not reported as an unhandled critical extension anymore, to be
+// used for chain verification by verifiers supporting hybrid keys.
+func HandleAltPublicKey(cert *x509.Certificate) *x509.Certificate {
+	handled := *cert
+	handled.UnhandledCriticalExtensions = nil
+	for _, oid := range cert.UnhandledCriticalExtensions {
+		if !oid.Equal(AltPublicKeyInfoOID) {
+			handled.UnhandledCriticalExtensions = append(handled.UnhandledCriticalExtensions, oid)
+		}
+	}
+	return &handled
+}
+
+func altPublicKeyExtension(cert *x509.Certificate) *pkix.Extension {
+	for i := range cert.Extensions {
+		if cert.Extensions[i].Id.Equal(AltPublicKeyInfoOID) {
+			return &cert.Extensions[i]
+		}
+	}
+	return nil
+}
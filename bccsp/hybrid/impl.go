@@ -0,0 +1,184 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hybrid
+
+import (
+	"crypto/mldsa"
+	"crypto/x509"
+	"encoding/asn1"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("bccsp_hybrid")
+
+// hybridSignature is the ASN.1 structure of the signatures of hybrid keys
+type hybridSignature struct {
+	Classical   []byte
+	PostQuantum []byte
+}
+
+// impl is a BCCSP supporting hybrid keys, which pair an ECDSA key managed
+// by an underlying BCCSP with an ML-DSA key. All other operations and key
+// types are delegated to the underlying BCCSP.
+type impl struct {
+	bccsp.BCCSP
+	ks *keyStore
+}
+
+// New returns a BCCSP supporting hybrid keys on top of csp, which manages
+// the classical keys. The ML-DSA private keys are stored in keyStorePath,
+// which is usually the key store path of csp. If keyStorePath is empty,
+// only temporary hybrid keys can be generated.
+func New(csp bccsp.BCCSP, keyStorePath string) (bccsp.BCCSP, error) {
+	if csp == nil {
+		return nil, errors.New("Invalid bccsp.BCCSP instance. It must be different from nil.")
+	}
+	return &impl{BCCSP: csp, ks: &keyStore{path: keyStorePath}}, nil
+}
+
+// KeyGen generates a key using opts.
+func (csp *impl) KeyGen(opts bccsp.KeyGenOpts) (bccsp.Key, error) {
+	hybridOpts, ok := opts.(*bccsp.HybridKeyGenOpts)
+	if !ok {
+		return csp.BCCSP.KeyGen(opts)
+	}
+
+	classical, err := csp.BCCSP.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: hybridOpts.Temporary})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed generating ECDSA key")
+	}
+	postQuantum, err := mldsa.GenerateKey(mldsa.MLDSA65())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generating ML-DSA key")
+	}
+	if !hybridOpts.Temporary {
+		if err := csp.ks.storeKey(classical.SKI(), postQuantum); err != nil {
+			return nil, err
+		}
+	}
+
+	return &privateKey{classical: classical, postQuantum: postQuantum}, nil
+}
+
+// KeyImport imports a key from its raw representation using opts. Certificates
+// carrying a hybrid public key are imported as hybrid keys, as well as ECDSA
+// private keys whose ML-DSA counterpart is in the key store.
+func (csp *impl) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	var temporary bool
+	switch importOpts := opts.(type) {
+	case *bccsp.ECDSAPrivateKeyImportOpts:
+		return csp.withPostQuantumKey(csp.BCCSP.KeyImport(raw, opts))
+	case *bccsp.HybridX509PublicKeyImportOpts:
+		temporary = importOpts.Temporary
+	case *bccsp.X509PublicKeyImportOpts:
+		cert, ok := raw.(*x509.Certificate)
+		if !ok || !HasAltPublicKey(cert) {
+			return csp.BCCSP.KeyImport(raw, opts)
+		}
+		temporary = importOpts.Temporary
+	default:
+		return csp.BCCSP.KeyImport(raw, opts)
+	}
+
+	cert, ok := raw.(*x509.Certificate)
+	if !ok {
+		return nil, errors.New("Invalid raw material. Expected *x509.Certificate.")
+	}
+	postQuantum, err := ParseAltPublicKey(cert)
+	if err != nil {
+		return nil, err
+	}
+	classical, err := csp.BCCSP.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: temporary})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed importing the classical public key of the certificate")
+	}
+	return &publicKey{classical: classical, postQuantum: postQuantum}, nil
+}
+
+// GetKey returns the key this CSP associates to the Subject Key Identifier
+// ski. Private keys with an ML-DSA counterpart are returned as hybrid keys.
+func (csp *impl) GetKey(ski []byte) (bccsp.Key, error) {
+	return csp.withPostQuantumKey(csp.BCCSP.GetKey(ski))
+}
+
+// withPostQuantumKey returns the hybrid key pairing the private key k with
+// its ML-DSA counterpart in the key store, if any.
+func (csp *impl) withPostQuantumKey(k bccsp.Key, err error) (bccsp.Key, error) {
+	if err != nil || !k.Private() {
+		return k, err
+	}
+	postQuantum, err := csp.ks.loadKey(k.SKI())
+	if err != nil {
+		return nil, err
+	}
+	if postQuantum == nil {
+		return k, nil
+	}
+	return &privateKey{classical: k, postQuantum: postQuantum}, nil
+}
+
+// Sign signs digest using key k. Hybrid keys sign digest with both
+// their ECDSA and ML-DSA keys.
+func (csp *impl) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	key, ok := k.(*privateKey)
+	if !ok {
+		return csp.BCCSP.Sign(k, digest, opts)
+	}
+
+	classical, err := csp.BCCSP.Sign(key.classical, digest, opts)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed computing ECDSA signature")
+	}
+	postQuantum, err := key.postQuantum.Sign(nil, digest, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed computing ML-DSA signature")
+	}
+	return asn1.Marshal(hybridSignature{Classical: classical, PostQuantum: postQuantum})
+}
+
+// Verify verifies signature against key k and digest. The signatures
+// of hybrid keys are valid only if both their ECDSA and ML-DSA
+// signatures are valid.
+func (csp *impl) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	var key *publicKey
+	switch hk := k.(type) {
+	case *publicKey:
+		key = hk
+	case *privateKey:
+		pub, err := hk.PublicKey()
+		if err != nil {
+			return false, err
+		}
+		key = pub.(*publicKey)
+	default:
+		return csp.BCCSP.Verify(k, signature, digest, opts)
+	}
+
+	sig := &hybridSignature{}
+	rest, err := asn1.Unmarshal(signature, sig)
+	if err != nil {
+		return false, errors.Wrap(err, "failed unmarshalling hybrid signature")
+	}
+	if len(rest) != 0 {
+		return false, errors.New("invalid hybrid signature, trailing data")
+	}
+
+	valid, err := csp.BCCSP.Verify(key.classical, sig.Classical, digest, opts)
+	if err != nil || !valid {
+		return valid, err
+	}
+	if err := mldsa.Verify(key.postQuantum, digest, sig.PostQuantum, nil); err != nil {
+		logger.Debugf("Invalid ML-DSA signature: %s", err)
+		return false, nil
+	}
+	return true, nil
+}
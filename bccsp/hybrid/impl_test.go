@@ -0,0 +1,258 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hybrid
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/mldsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCSP(t *testing.T, keyStorePath string) bccsp.BCCSP {
+	ks := sw.NewDummyKeyStore()
+	if keyStorePath != "" {
+		var err error
+		ks, err = sw.NewFileBasedKeyStore(nil, keyStorePath, false)
+		require.NoError(t, err)
+	}
+	inner, err := sw.NewWithParams(256, "SHA2", ks)
+	require.NoError(t, err)
+	csp, err := New(inner, keyStorePath)
+	require.NoError(t, err)
+	return csp
+}
+
+// newHybridCert returns a self signed certificate of the public key of k.
+func newHybridCert(t *testing.T, k bccsp.Key) *x509.Certificate {
+	pk, err := PostQuantumPublicKey(k)
+	require.NoError(t, err)
+	ext, err := AltPublicKeyExtension(pk)
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pub, err := k.PublicKey()
+	require.NoError(t, err)
+	raw, err := pub.Bytes()
+	require.NoError(t, err)
+	classical, err := x509.ParsePKIXPublicKey(raw)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: "hybrid"},
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{ext},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, classical, caKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, "")
+	assert.EqualError(t, err, "Invalid bccsp.BCCSP instance. It must be different from nil.")
+}
+
+func TestSignVerify(t *testing.T) {
+	csp := newTestCSP(t, "")
+
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	_, err = k.Bytes()
+	assert.Error(t, err)
+
+	digest := sha256.Sum256([]byte("hello world"))
+	sig, err := csp.Sign(k, digest[:], nil)
+	require.NoError(t, err)
+
+	pub, err := k.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, k.SKI(), pub.SKI())
+	valid, err := csp.Verify(pub, sig, digest[:], nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = csp.Verify(k, sig, digest[:], nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	other := sha256.Sum256([]byte("hello world!"))
+	valid, err = csp.Verify(pub, sig, other[:], nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = csp.Verify(pub, []byte("garbage"), digest[:], nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed unmarshalling hybrid signature")
+}
+
+func TestVerifyRequiresBothSignatures(t *testing.T) {
+	csp := newTestCSP(t, "")
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	key := k.(*privateKey)
+	digest := sha256.Sum256([]byte("hello world"))
+
+	classical, err := csp.Sign(key.classical, digest[:], nil)
+	require.NoError(t, err)
+	postQuantum, err := key.postQuantum.Sign(nil, digest[:], nil)
+	require.NoError(t, err)
+	otherKey, err := mldsa.GenerateKey(mldsa.MLDSA65())
+	require.NoError(t, err)
+	forged, err := otherKey.Sign(nil, digest[:], nil)
+	require.NoError(t, err)
+
+	sig, err := asn1.Marshal(hybridSignature{Classical: classical, PostQuantum: forged})
+	require.NoError(t, err)
+	valid, err := csp.Verify(k, sig, digest[:], nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	sig, err = asn1.Marshal(hybridSignature{Classical: classical[:len(classical)-1], PostQuantum: postQuantum})
+	require.NoError(t, err)
+	valid, _ = csp.Verify(k, sig, digest[:], nil)
+	assert.False(t, valid)
+}
+
+func TestKeyStore(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "hybrid")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	csp := newTestCSP(t, tempDir)
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(tempDir, (&keyStore{}).fileName(k.SKI())))
+	assert.NoError(t, err)
+
+	// a new instance retrieves the hybrid key from the key store
+	csp = newTestCSP(t, tempDir)
+	loaded, err := csp.GetKey(k.SKI())
+	require.NoError(t, err)
+	assert.IsType(t, &privateKey{}, loaded)
+
+	digest := sha256.Sum256([]byte("hello world"))
+	sig, err := csp.Sign(loaded, digest[:], nil)
+	require.NoError(t, err)
+	valid, err := csp.Verify(k, sig, digest[:], nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// the ECDSA key imported from its file is paired with its ML-DSA key
+	raw, err := ioutil.ReadFile(filepath.Join(tempDir, hex.EncodeToString(k.SKI())+"_sk"))
+	require.NoError(t, err)
+	block, _ := pem.Decode(raw)
+	imported, err := csp.KeyImport(block.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+	require.NoError(t, err)
+	assert.IsType(t, &privateKey{}, imported)
+
+	// classical keys are returned as they are
+	ecKey, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	require.NoError(t, err)
+	loaded, err = csp.GetKey(ecKey.SKI())
+	require.NoError(t, err)
+	_, err = PostQuantumPublicKey(loaded)
+	assert.Error(t, err)
+
+	// hybrid keys can only be temporary without a key store path
+	csp, err = New(csp.(*impl).BCCSP, "")
+	require.NoError(t, err)
+	_, err = csp.KeyGen(&bccsp.HybridKeyGenOpts{})
+	assert.EqualError(t, err, "no key store path configured, hybrid keys can only be temporary")
+}
+
+func TestKeyImport(t *testing.T) {
+	csp := newTestCSP(t, "")
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	cert := newHybridCert(t, k)
+
+	for _, opts := range []bccsp.KeyImportOpts{
+		&bccsp.X509PublicKeyImportOpts{Temporary: true},
+		&bccsp.HybridX509PublicKeyImportOpts{Temporary: true},
+	} {
+		pub, err := csp.KeyImport(cert, opts)
+		require.NoError(t, err)
+		assert.IsType(t, &publicKey{}, pub)
+		assert.Equal(t, k.SKI(), pub.SKI())
+
+		// hybrid keys can be used as ECDSA keys by the signer
+		s, err := signer.New(csp, k)
+		require.NoError(t, err)
+		digest := sha256.Sum256([]byte("hello world"))
+		sig, err := s.Sign(rand.Reader, digest[:], nil)
+		require.NoError(t, err)
+		valid, err := csp.Verify(pub, sig, digest[:], nil)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// certificates without hybrid keys
+	cert.Extensions = nil
+	_, err = csp.KeyImport(cert, &bccsp.HybridX509PublicKeyImportOpts{Temporary: true})
+	assert.EqualError(t, err, "certificate does not carry a hybrid public key")
+	pub, err := csp.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: true})
+	require.NoError(t, err)
+	_, err = PostQuantumPublicKey(pub)
+	assert.Error(t, err)
+	_, err = csp.KeyImport("not a certificate", &bccsp.HybridX509PublicKeyImportOpts{Temporary: true})
+	assert.EqualError(t, err, "Invalid raw material. Expected *x509.Certificate.")
+}
+
+func TestHandleAltPublicKey(t *testing.T) {
+	csp := newTestCSP(t, "")
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	cert := newHybridCert(t, k)
+
+	assert.True(t, HasAltPublicKey(cert))
+	assert.Contains(t, cert.UnhandledCriticalExtensions, AltPublicKeyInfoOID)
+	handled := HandleAltPublicKey(cert)
+	assert.Empty(t, handled.UnhandledCriticalExtensions)
+	assert.Contains(t, cert.UnhandledCriticalExtensions, AltPublicKeyInfoOID)
+
+	pk, err := ParseAltPublicKey(cert)
+	require.NoError(t, err)
+	expected, err := PostQuantumPublicKey(k)
+	require.NoError(t, err)
+	assert.True(t, expected.Equal(pk))
+
+	for i := range cert.Extensions {
+		if cert.Extensions[i].Id.Equal(AltPublicKeyInfoOID) {
+			cert.Extensions[i].Value = []byte("garbage")
+		}
+	}
+	_, err = ParseAltPublicKey(cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed parsing the alternative public key of the certificate")
+}
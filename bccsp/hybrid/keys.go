@@ -0,0 +1,113 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hybrid
+
+import (
+	"crypto"
+	"crypto/mldsa"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/pkg/errors"
+)
+
+// PublicKey is a hybrid public key as a crypto.PublicKey.
+type PublicKey struct {
+	// Classical is the ECDSA public key
+	Classical crypto.PublicKey
+	// PostQuantum is the ML-DSA public key
+	PostQuantum *mldsa.PublicKey
+}
+
+type privateKey struct {
+	classical   bccsp.Key
+	postQuantum *mldsa.PrivateKey
+}
+
+// Bytes converts this key to its byte representation,
+// if this operation is allowed.
+func (k *privateKey) Bytes() ([]byte, error) {
+	return nil, errors.New("Not supported.")
+}
+
+// SKI returns the subject key identifier of this key,
+// which is the one of the classical key.
+func (k *privateKey) SKI() []byte {
+	return k.classical.SKI()
+}
+
+// Symmetric returns true if this key is a symmetric key,
+// false if this key is asymmetric
+func (k *privateKey) Symmetric() bool {
+	return false
+}
+
+// Private returns true if this key is a private key,
+// false otherwise.
+func (k *privateKey) Private() bool {
+	return true
+}
+
+// PublicKey returns the corresponding public key part of an asymmetric public/private key pair.
+// This method returns an error in symmetric key schemes.
+func (k *privateKey) PublicKey() (bccsp.Key, error) {
+	classical, err := k.classical.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &publicKey{classical: classical, postQuantum: k.postQuantum.PublicKey()}, nil
+}
+
+type publicKey struct {
+	classical   bccsp.Key
+	postQuantum *mldsa.PublicKey
+}
+
+// Bytes converts this key to its byte representation. Only the classical key
+// is returned, in PKIX format, so that hybrid keys can be used wherever an
+// ECDSA key is expected.
+func (k *publicKey) Bytes() ([]byte, error) {
+	return k.classical.Bytes()
+}
+
+// SKI returns the subject key identifier of this key,
+// which is the one of the classical key.
+func (k *publicKey) SKI() []byte {
+	return k.classical.SKI()
+}
+
+// Symmetric returns true if this key is a symmetric key,
+// false if this key is asymmetric
+func (k *publicKey) Symmetric() bool {
+	return false
+}
+
+// Private returns true if this key is a private key,
+// false otherwise.
+func (k *publicKey) Private() bool {
+	return false
+}
+
+// PublicKey returns the corresponding public key part of an asymmetric public/private key pair.
+// This method returns an error in symmetric key schemes.
+func (k *publicKey) PublicKey() (bccsp.Key, error) {
+	return k, nil
+}
+
+// PostQuantumPublicKey returns the ML-DSA public key of a hybrid key,
+// which can be either private or public.
+func PostQuantumPublicKey(k bccsp.Key) (*mldsa.PublicKey, error) {
+	switch key := k.(type) {
+	case *privateKey:
+		return key.postQuantum.PublicKey(), nil
+	case *publicKey:
+		return key.postQuantum, nil
+	default:
+		return nil, errors.Errorf("key of type %T is not a hybrid key", k)
+	}
+}
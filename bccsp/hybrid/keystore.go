@@ -0,0 +1,80 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hybrid
+
+import (
+	"crypto/mldsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// postQuantumKeySuffix is the suffix of the files holding the ML-DSA
+// private keys, next to the files of the classical keys
+const postQuantumKeySuffix = "_mldsa"
+
+// keyStore stores the ML-DSA private keys of hybrid keys in PKCS#8 format,
+// in files named after the SKI of the hybrid key
+type keyStore struct {
+	path string
+}
+
+func (ks *keyStore) fileName(ski []byte) string {
+	return filepath.Join(ks.path, hex.EncodeToString(ski)+postQuantumKeySuffix)
+}
+
+func (ks *keyStore) storeKey(ski []byte, k *mldsa.PrivateKey) error {
+	if ks.path == "" {
+		return errors.New("no key store path configured, hybrid keys can only be temporary")
+	}
+	raw, err := x509.MarshalPKCS8PrivateKey(k)
+	if err != nil {
+		return errors.Wrap(err, "failed marshalling ML-DSA private key")
+	}
+	if err := os.MkdirAll(ks.path, 0755); err != nil {
+		return errors.Wrapf(err, "failed creating directory %s", ks.path)
+	}
+	if err := ioutil.WriteFile(ks.fileName(ski), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: raw}), 0600); err != nil {
+		return errors.Wrap(err, "failed storing ML-DSA private key")
+	}
+	return nil
+}
+
+// loadKey returns the ML-DSA private key of the hybrid key with the given
+// SKI, or nil if the key store has none.
+func (ks *keyStore) loadKey(ski []byte) (*mldsa.PrivateKey, error) {
+	if ks.path == "" {
+		return nil, nil
+	}
+	raw, err := ioutil.ReadFile(ks.fileName(ski))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading ML-DSA private key")
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.Errorf("failed decoding PEM of ML-DSA private key %s", ks.fileName(ski))
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing ML-DSA private key")
+	}
+	pqKey, ok := key.(*mldsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("key of type %T found instead of an ML-DSA private key", key)
+	}
+	return pqKey, nil
+}
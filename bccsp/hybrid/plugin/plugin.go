@@ -0,0 +1,80 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"strings"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultSecurityLevel = 256
+	defaultHashFamily    = "SHA2"
+)
+
+// New returns a software BCCSP supporting hybrid ECDSA and ML-DSA keys.
+// The config map accepts the following properties:
+//   - SecLevel, the security level of the software BCCSP (default 256)
+//   - HashFamily, the hash family of the software BCCSP (default SHA2)
+//   - KeyStore, the directory of the key store; keys are not persisted
+//     if it is missing
+func New(config map[string]interface{}) (bccsp.BCCSP, error) {
+	securityLevel := defaultSecurityLevel
+	hashFamily := defaultHashFamily
+	var keyStorePath string
+
+	// property names are case insensitive, as the configuration might
+	// come from viper which lowercases them
+	for name, value := range config {
+		switch strings.ToLower(name) {
+		case "seclevel":
+			switch v := value.(type) {
+			case int:
+				securityLevel = v
+			case float64:
+				securityLevel = int(v)
+			default:
+				return nil, errors.Errorf("invalid SecLevel %v, expected a number", value)
+			}
+		case "hashfamily":
+			v, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid HashFamily %v, expected a string", value)
+			}
+			hashFamily = v
+		case "keystore":
+			v, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid KeyStore %v, expected a string", value)
+			}
+			keyStorePath = v
+		}
+	}
+
+	ks := sw.NewDummyKeyStore()
+	if keyStorePath != "" {
+		var err error
+		ks, err = sw.NewFileBasedKeyStore(nil, keyStorePath, false)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed initializing key store")
+		}
+	}
+	csp, err := sw.NewWithParams(securityLevel, hashFamily, ks)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed initializing software BCCSP")
+	}
+	return hybrid.New(csp, keyStorePath)
+}
+
+func main() {
+}
@@ -0,0 +1,60 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "hybridplugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	csp, err := New(map[string]interface{}{"SecLevel": 256, "hashfamily": "SHA2", "KeyStore": tempDir})
+	require.NoError(t, err)
+	k, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{})
+	require.NoError(t, err)
+
+	// the key is retrieved by a new instance
+	csp, err = New(map[string]interface{}{"seclevel": float64(256), "keystore": tempDir})
+	require.NoError(t, err)
+	k, err = csp.GetKey(k.SKI())
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("hello world"))
+	sig, err := csp.Sign(k, digest[:], nil)
+	require.NoError(t, err)
+	valid, err := csp.Verify(k, sig, digest[:], nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	csp, err = New(nil)
+	require.NoError(t, err)
+	_, err = csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	assert.NoError(t, err)
+}
+
+func TestNewBadConfig(t *testing.T) {
+	_, err := New(map[string]interface{}{"SecLevel": "high"})
+	assert.EqualError(t, err, "invalid SecLevel high, expected a number")
+	_, err = New(map[string]interface{}{"HashFamily": 3})
+	assert.EqualError(t, err, "invalid HashFamily 3, expected a string")
+	_, err = New(map[string]interface{}{"KeyStore": true})
+	assert.EqualError(t, err, "invalid KeyStore true, expected a string")
+	_, err = New(map[string]interface{}{"SecLevel": 100})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed initializing software BCCSP")
+}
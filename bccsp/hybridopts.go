@@ -0,0 +1,49 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bccsp
+
+import "encoding/asn1"
+
+// HybridAltPublicKeyInfoOID is the OID of the subjectAltPublicKeyInfo extension
+// defined by ITU-T X.509 (10/2019), which carries the post-quantum public key
+// of a hybrid certificate, the classical key being the subject public key.
+var HybridAltPublicKeyInfoOID = asn1.ObjectIdentifier{2, 5, 29, 72}
+
+// HybridKeyGenOpts contains options for hybrid key generation.
+type HybridKeyGenOpts struct {
+	Temporary bool
+}
+
+// Algorithm returns the key generation algorithm identifier (to be used).
+func (opts *HybridKeyGenOpts) Algorithm() string {
+	return HYBRID
+}
+
+// Ephemeral returns true if the key to generate has to be ephemeral,
+// false otherwise.
+func (opts *HybridKeyGenOpts) Ephemeral() bool {
+	return opts.Temporary
+}
+
+// HybridX509PublicKeyImportOpts contains options for importing hybrid public
+// keys from an x509 certificate. Unlike X509PublicKeyImportOpts, the import
+// fails if the certificate does not carry a hybrid public key, or if the BCCSP
+// does not support hybrid keys.
+type HybridX509PublicKeyImportOpts struct {
+	Temporary bool
+}
+
+// Algorithm returns the key importation algorithm identifier (to be used).
+func (opts *HybridX509PublicKeyImportOpts) Algorithm() string {
+	return HYBRID
+}
+
+// Ephemeral returns true if the key to generate has to be ephemeral,
+// false otherwise.
+func (opts *HybridX509PublicKeyImportOpts) Ephemeral() bool {
+	return opts.Temporary
+}
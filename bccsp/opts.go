@@ -32,6 +32,16 @@ const (
 	// ECDSAReRand ECDSA key re-randomization
 	ECDSAReRand = "ECDSA_RERAND"
 
+	// ED25519 Edwards-curve Digital Signature Algorithm over Curve25519
+	// (key gen, import, sign, verify). Signatures are computed over the
+	// message itself, not over a digest of it.
+	ED25519 = "ED25519"
+
+	// HYBRID pairs an ECDSA key over the P-256 curve with an ML-DSA-65 key
+	// (key gen, import, sign, verify). Signatures carry a signature of each
+	// key, and both must be valid.
+	HYBRID = "HYBRID"
+
 	// RSA at the default security level.
 	// Each BCCSP may or may not support default security level. If not supported than
 	// an error will be returned.
@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignVerifyED25519(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	msg := []byte("hello world")
+	sigma, err := signED25519(priv, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := verifyED25519(pub, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifyED25519(pub, sigma, []byte("hello world!"), nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = verifyED25519(pub, sigma[1:], msg, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid ed25519 signature length [63]")
+
+	_, err = verifyED25519(pub[1:], sigma, msg, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid ed25519 public key length [31]")
+
+	_, err = signED25519(priv[1:], msg, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid ed25519 private key length [63]")
+}
+
+func TestED25519KeyGenSignVerify(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	assert.Len(t, k.SKI(), 32)
+	_, err = k.Bytes()
+	assert.Error(t, err)
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.False(t, pk.Private())
+	assert.Equal(t, k.SKI(), pk.SKI())
+
+	msg := []byte("Hello World")
+	signature, err := provider.Sign(k, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := provider.Verify(k, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// The key is retrievable from the keystore by its SKI
+	k2, err := provider.GetKey(k.SKI())
+	assert.NoError(t, err)
+	assert.True(t, k2.Private())
+	signature, err = provider.Sign(k2, msg, nil)
+	assert.NoError(t, err)
+	valid, err = provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestED25519KeyImport(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := utils.PublicKeyToDER(pub)
+	assert.NoError(t, err)
+	pk, err := provider.KeyImport(der, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	raw, err := pk.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, der, raw)
+
+	pk2, err := provider.KeyImport(pub, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), pk2.SKI())
+
+	skDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	sk, err := provider.KeyImport(skDER, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), sk.SKI())
+
+	msg := []byte("Hello World")
+	signature, err := provider.Sign(sk, msg, nil)
+	assert.NoError(t, err)
+	valid, err := provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	_, err = provider.KeyImport(pub, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected byte array.")
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ecdsaDER, err := utils.PublicKeyToDER(&ecdsaKey.PublicKey)
+	assert.NoError(t, err)
+	_, err = provider.KeyImport(ecdsaDER, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed casting to ED25519 public key. Invalid raw material.")
+}
+
+func TestKeyImportFromX509ED25519PublicKey(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: true})
+	assert.NoError(t, err)
+
+	cryptoSigner, err := signer.New(provider, k)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test.example.com"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, template, cryptoSigner.Public(), cryptoSigner)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+	assert.Equal(t, x509.PureEd25519, cert.SignatureAlgorithm)
+	assert.NoError(t, cert.CheckSignatureFrom(cert))
+
+	pk, err := provider.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, k.SKI(), pk.SKI())
+}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"encoding/asn1"
+	"encoding/pem"
+
+	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
+	"github.com/hyperledger/fabric/idemix"
+	"github.com/pkg/errors"
+)
+
+const (
+	publicKeyPEMType = "THRESHOLD PUBLIC KEY"
+	keySharePEMType  = "THRESHOLD KEY SHARE"
+)
+
+// keyShareASN1 is the ASN.1 structure of a key share
+type keyShareASN1 struct {
+	Index           int
+	Threshold       int
+	Secret          []byte
+	PublicKey       []byte
+	SharePublicKeys [][]byte
+}
+
+// PublicKeyToPEM encodes a public key in PEM format.
+func PublicKeyToPEM(pk *PublicKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: publicKeyPEMType, Bytes: pk.Bytes()})
+}
+
+// PEMtoPublicKey decodes a PEM encoded public key.
+func PEMtoPublicKey(raw []byte) (*PublicKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != publicKeyPEMType {
+		return nil, errors.Errorf("failed decoding PEM, expected a %s block", publicKeyPEMType)
+	}
+	return PublicKeyFromBytes(block.Bytes)
+}
+
+// KeyShareToPEM encodes a key share in PEM format.
+func KeyShareToPEM(s *KeyShare) ([]byte, error) {
+	share := keyShareASN1{
+		Index:           s.Index,
+		Threshold:       s.Threshold,
+		Secret:          idemix.BigToBytes(s.secret),
+		PublicKey:       s.PublicKey.Bytes(),
+		SharePublicKeys: make([][]byte, len(s.SharePublicKeys)),
+	}
+	for i, pk := range s.SharePublicKeys {
+		share.SharePublicKeys[i] = pk.Bytes()
+	}
+
+	der, err := asn1.Marshal(share)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed marshaling key share")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: keySharePEMType, Bytes: der}), nil
+}
+
+// PEMtoKeyShare decodes a PEM encoded key share.
+func PEMtoKeyShare(raw []byte) (*KeyShare, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != keySharePEMType {
+		return nil, errors.Errorf("failed decoding PEM, expected a %s block", keySharePEMType)
+	}
+
+	share := keyShareASN1{}
+	if rest, err := asn1.Unmarshal(block.Bytes, &share); err != nil || len(rest) != 0 {
+		return nil, errors.New("failed unmarshaling key share")
+	}
+	if share.Threshold < 1 || share.Threshold > len(share.SharePublicKeys) {
+		return nil, errors.Errorf("invalid threshold %d for %d shares", share.Threshold, len(share.SharePublicKeys))
+	}
+	if share.Index < 1 || share.Index > len(share.SharePublicKeys) {
+		return nil, errors.Errorf("invalid key share index %d", share.Index)
+	}
+	if len(share.Secret) != int(FP256BN.MODBYTES) {
+		return nil, errors.Errorf("invalid key share secret length %d", len(share.Secret))
+	}
+
+	pk, err := PublicKeyFromBytes(share.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	sharePublicKeys := make([]*PublicKey, len(share.SharePublicKeys))
+	for i, raw := range share.SharePublicKeys {
+		sharePublicKeys[i], err = PublicKeyFromBytes(raw)
+		if err != nil {
+			return nil, errors.WithMessage(err, "invalid key share public key")
+		}
+	}
+
+	keyShare := &KeyShare{
+		Index:           share.Index,
+		Threshold:       share.Threshold,
+		PublicKey:       pk,
+		SharePublicKeys: sharePublicKeys,
+		secret:          FP256BN.FromBytes(share.Secret),
+	}
+
+	// make sure that the secret matches the public key of the share
+	if !idemix.GenG2.Mul(keyShare.secret).Equals(sharePublicKeys[share.Index-1].point) {
+		return nil, errors.Errorf("the secret of key share %d does not match its public key", share.Index)
+	}
+
+	return keyShare, nil
+}
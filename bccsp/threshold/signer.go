@@ -0,0 +1,110 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"crypto"
+	"io"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("bccsp_threshold")
+
+// ShareCollector collects the partial signatures of a message
+// computed by the holders of the other key shares.
+type ShareCollector interface {
+	// CollectShares returns partial signatures of message. It should
+	// return once it gathered needed partial signatures, which are
+	// verified by the caller, or when no more can be gathered.
+	CollectShares(message []byte, needed int) ([]*PartialSignature, error)
+}
+
+// SignerOpts are the options of Signer.Sign.
+type SignerOpts struct {
+	// Collector collects the partial signatures of the other
+	// share holders. It is not needed when the threshold is 1.
+	Collector ShareCollector
+}
+
+// HashFunc returns 0, since the message is hashed by the signature scheme.
+func (*SignerOpts) HashFunc() crypto.Hash {
+	return 0
+}
+
+// Signer is a crypto.Signer that computes a threshold signature
+// from its own key share and the partial signatures of other share
+// holders.
+type Signer struct {
+	share *KeyShare
+}
+
+// NewSigner returns a new Signer for the given key share.
+func NewSigner(share *KeyShare) (*Signer, error) {
+	if share == nil {
+		return nil, errors.New("key share must be different from nil")
+	}
+	return &Signer{share: share}, nil
+}
+
+// Public returns the group public key as a *PublicKey.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.share.PublicKey
+}
+
+// Sign signs message with the key share and the partial signatures
+// gathered by the collector in opts, which must be a *SignerOpts.
+// Invalid partial signatures are discarded, and an error is returned
+// if fewer than the threshold of valid partial signatures are gathered.
+// The returned signature verifies against the group public key.
+func (s *Signer) Sign(_ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	partials := []*PartialSignature{s.share.Sign(message)}
+
+	if needed := s.share.Threshold - 1; needed > 0 {
+		signerOpts, ok := opts.(*SignerOpts)
+		if !ok || signerOpts.Collector == nil {
+			return nil, errors.New("a share collector is needed to sign")
+		}
+
+		collected, err := signerOpts.Collector.CollectShares(message, needed)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed collecting partial signatures")
+		}
+		for _, partial := range collected {
+			if len(partials) == s.share.Threshold {
+				break
+			}
+			if err := s.share.VerifyPartial(message, partial); err != nil {
+				logger.Warningf("Discarding partial signature: %s", err)
+				continue
+			}
+			if contains(partials, partial.Index) {
+				continue
+			}
+			partials = append(partials, partial)
+		}
+	}
+
+	signature, err := Combine(s.share.Threshold, partials)
+	if err != nil {
+		return nil, err
+	}
+	if err := Verify(s.share.PublicKey, message, signature); err != nil {
+		return nil, errors.WithMessage(err, "combined signature is invalid")
+	}
+	return signature, nil
+}
+
+func contains(partials []*PartialSignature, index int) bool {
+	for _, partial := range partials {
+		if partial.Index == index {
+			return true
+		}
+	}
+	return false
+}
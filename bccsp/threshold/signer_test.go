@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"crypto"
+	"crypto/rand"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type collector struct {
+	shares []*KeyShare
+	err    error
+}
+
+func (c *collector) CollectShares(message []byte, needed int) ([]*PartialSignature, error) {
+	var partials []*PartialSignature
+	for _, share := range c.shares {
+		partials = append(partials, share.Sign(message))
+	}
+	return partials, c.err
+}
+
+func TestSigner(t *testing.T) {
+	pk, shares := deal(t, 3, 4)
+	message := []byte("block")
+
+	_, err := NewSigner(nil)
+	assert.EqualError(t, err, "key share must be different from nil")
+
+	signer, err := NewSigner(shares[0])
+	assert.NoError(t, err)
+	assert.Equal(t, pk, signer.Public())
+
+	// the other share holders are needed
+	_, err = signer.Sign(rand.Reader, message, crypto.Hash(0))
+	assert.EqualError(t, err, "a share collector is needed to sign")
+	_, err = signer.Sign(rand.Reader, message, &SignerOpts{Collector: &collector{err: errors.New("timeout")}})
+	assert.EqualError(t, err, "failed collecting partial signatures: timeout")
+
+	// own and duplicate partial signatures are ignored
+	_, err = signer.Sign(rand.Reader, message, &SignerOpts{Collector: &collector{shares: []*KeyShare{shares[0], shares[1], shares[1]}}})
+	assert.EqualError(t, err, "2 partial signatures are not enough, 3 are needed")
+
+	signature, err := signer.Sign(rand.Reader, message, &SignerOpts{Collector: &collector{shares: shares[2:]}})
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(pk, message, signature))
+
+	// invalid partial signatures are discarded
+	forged := *shares[3]
+	forged.Index = 2
+	signature, err = signer.Sign(rand.Reader, message, &SignerOpts{Collector: &collector{shares: []*KeyShare{&forged, shares[2], shares[3]}}})
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(pk, message, signature))
+}
+
+func TestSignerWithoutCollector(t *testing.T) {
+	pk, shares := deal(t, 1, 2)
+	signer, err := NewSigner(shares[1])
+	assert.NoError(t, err)
+
+	signature, err := signer.Sign(rand.Reader, []byte("block"), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(pk, []byte("block"), signature))
+}
@@ -0,0 +1,237 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package threshold implements t-of-n threshold BLS signatures over the
+// FP256BN pairing curve. A dealer splits a group secret key into n key
+// shares with Shamir secret sharing. Each share holder computes a partial
+// signature of a message, and any t partial signatures are combined into
+// a signature that verifies against the group public key, while fewer
+// than t share holders cannot produce one.
+package threshold
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hyperledger/fabric-amcl/amcl"
+	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
+	"github.com/hyperledger/fabric/idemix"
+	"github.com/pkg/errors"
+)
+
+// SignatureLength is the length of signatures and partial signatures,
+// which are compressed points of G1.
+const SignatureLength = int(FP256BN.MODBYTES) + 1
+
+// publicKeyLength is the length of serialized public keys, which are points of G2.
+const publicKeyLength = 4 * int(FP256BN.MODBYTES)
+
+// PublicKey is a threshold public key, either the group public key
+// or the public key of a key share.
+type PublicKey struct {
+	point *FP256BN.ECP2
+}
+
+// Bytes returns the serialization of the public key.
+func (pk *PublicKey) Bytes() []byte {
+	raw := make([]byte, publicKeyLength)
+	pk.point.ToBytes(raw)
+	return raw
+}
+
+// PublicKeyFromBytes deserializes a public key.
+func PublicKeyFromBytes(raw []byte) (*PublicKey, error) {
+	if len(raw) != publicKeyLength {
+		return nil, errors.Errorf("invalid public key length %d, expected %d", len(raw), publicKeyLength)
+	}
+	point := FP256BN.ECP2_fromBytes(raw)
+	if point.Is_infinity() {
+		return nil, errors.New("invalid public key, not a point of G2")
+	}
+	// the twist has points outside of G2, whose order is not the group order
+	if !point.Mul(idemix.GroupOrder).Is_infinity() {
+		return nil, errors.New("invalid public key, not in the subgroup of G2")
+	}
+	return &PublicKey{point: point}, nil
+}
+
+// KeyShare is the key share of a share holder.
+type KeyShare struct {
+	// Index is the index of the share, from 1 to the number of shares
+	Index int
+	// Threshold is the number of partial signatures needed to sign
+	Threshold int
+	// PublicKey is the group public key
+	PublicKey *PublicKey
+	// SharePublicKeys are the public keys of all the shares,
+	// the public key of the share of index i is at i-1
+	SharePublicKeys []*PublicKey
+
+	secret *FP256BN.BIG
+}
+
+// PartialSignature is the signature of a message with a key share.
+type PartialSignature struct {
+	// Index is the index of the key share
+	Index int
+	// Signature is the partial signature
+	Signature []byte
+}
+
+// Deal generates a group key and splits it into n key shares,
+// any threshold of which can sign. The dealer, i.e. cryptogen thresholdkeys,
+// knows the group secret key while dealing: it must be run by a party that
+// all the share holders trust, which then hands each share to its holder
+// and erases the others, since whoever holds threshold of the shares can
+// sign on behalf of all of them.
+func Deal(threshold, n int, rng *amcl.RAND) (*PublicKey, []*KeyShare, error) {
+	if threshold < 1 || threshold > n {
+		return nil, nil, errors.Errorf("invalid threshold %d for %d shares", threshold, n)
+	}
+
+	// the secret key is the constant term of a random polynomial of degree threshold-1
+	coefficients := make([]*FP256BN.BIG, threshold)
+	for i := range coefficients {
+		coefficients[i] = idemix.RandModOrder(rng)
+	}
+
+	groupKey := &PublicKey{point: idemix.GenG2.Mul(coefficients[0])}
+	shares := make([]*KeyShare, n)
+	sharePublicKeys := make([]*PublicKey, n)
+	for i := range shares {
+		secret := evaluate(coefficients, i+1)
+		sharePublicKeys[i] = &PublicKey{point: idemix.GenG2.Mul(secret)}
+		shares[i] = &KeyShare{
+			Index:           i + 1,
+			Threshold:       threshold,
+			PublicKey:       groupKey,
+			SharePublicKeys: sharePublicKeys,
+			secret:          secret,
+		}
+	}
+
+	return groupKey, shares, nil
+}
+
+// evaluate evaluates the polynomial with the given coefficients at x
+func evaluate(coefficients []*FP256BN.BIG, x int) *FP256BN.BIG {
+	bx := FP256BN.NewBIGint(x)
+	result := FP256BN.NewBIGint(0)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = idemix.Modadd(FP256BN.Modmul(result, bx, idemix.GroupOrder), coefficients[i], idemix.GroupOrder)
+	}
+	return result
+}
+
+// hashToG1 hashes a message to a point of G1
+func hashToG1(message []byte) *FP256BN.ECP {
+	digest := sha256.Sum256(message)
+	return FP256BN.ECP_mapit(digest[:])
+}
+
+// Sign computes the partial signature of message with the key share.
+func (s *KeyShare) Sign(message []byte) *PartialSignature {
+	return &PartialSignature{
+		Index:     s.Index,
+		Signature: signatureToBytes(hashToG1(message).Mul(s.secret)),
+	}
+}
+
+// VerifyPartial verifies a partial signature of message
+// against the public key of the key share that computed it.
+func (s *KeyShare) VerifyPartial(message []byte, partial *PartialSignature) error {
+	if partial == nil {
+		return errors.New("nil partial signature")
+	}
+	if partial.Index < 1 || partial.Index > len(s.SharePublicKeys) {
+		return errors.Errorf("invalid key share index %d", partial.Index)
+	}
+	if err := Verify(s.SharePublicKeys[partial.Index-1], message, partial.Signature); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("invalid partial signature of key share %d", partial.Index))
+	}
+	return nil
+}
+
+// Combine combines the partial signatures of threshold distinct key shares
+// into a signature. The partial signatures are not verified.
+func Combine(threshold int, partials []*PartialSignature) ([]byte, error) {
+	if threshold < 1 || len(partials) < threshold {
+		return nil, errors.Errorf("%d partial signatures are not enough, %d are needed", len(partials), threshold)
+	}
+	partials = partials[:threshold]
+
+	points := make([]*FP256BN.ECP, threshold)
+	for i, partial := range partials {
+		if partial.Index < 1 {
+			return nil, errors.Errorf("invalid key share index %d", partial.Index)
+		}
+		for _, other := range partials[:i] {
+			if other.Index == partial.Index {
+				return nil, errors.Errorf("duplicate partial signature of key share %d", partial.Index)
+			}
+		}
+		point, err := signatureFromBytes(partial.Signature)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid partial signature of key share %d", partial.Index))
+		}
+		points[i] = point
+	}
+
+	// interpolate the partial signatures at 0 in the exponent
+	signature := FP256BN.NewECP()
+	for i, partial := range partials {
+		numerator := FP256BN.NewBIGint(1)
+		denominator := FP256BN.NewBIGint(1)
+		for _, other := range partials {
+			if other.Index == partial.Index {
+				continue
+			}
+			xj := FP256BN.NewBIGint(other.Index)
+			numerator = FP256BN.Modmul(numerator, xj, idemix.GroupOrder)
+			denominator = FP256BN.Modmul(denominator, idemix.Modsub(xj, FP256BN.NewBIGint(partial.Index), idemix.GroupOrder), idemix.GroupOrder)
+		}
+		denominator.Invmodp(idemix.GroupOrder)
+		signature.Add(points[i].Mul(FP256BN.Modmul(numerator, denominator, idemix.GroupOrder)))
+	}
+
+	return signatureToBytes(signature), nil
+}
+
+// Verify verifies a signature of message against a public key.
+func Verify(pk *PublicKey, message, signature []byte) error {
+	if pk == nil {
+		return errors.New("nil public key")
+	}
+	point, err := signatureFromBytes(signature)
+	if err != nil {
+		return err
+	}
+
+	// e(signature, g2) == e(H(message), pk)
+	left := FP256BN.Fexp(FP256BN.Ate(idemix.GenG2, point))
+	right := FP256BN.Fexp(FP256BN.Ate(pk.point, hashToG1(message)))
+	if !left.Equals(right) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+func signatureToBytes(point *FP256BN.ECP) []byte {
+	raw := make([]byte, SignatureLength)
+	point.ToBytes(raw, true)
+	return raw
+}
+
+func signatureFromBytes(raw []byte) (*FP256BN.ECP, error) {
+	if len(raw) != SignatureLength {
+		return nil, errors.Errorf("invalid signature length %d, expected %d", len(raw), SignatureLength)
+	}
+	point := FP256BN.ECP_fromBytes(raw)
+	if point.Is_infinity() {
+		return nil, errors.New("invalid signature, not a point of G1")
+	}
+	return point, nil
+}
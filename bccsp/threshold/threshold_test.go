@@ -0,0 +1,134 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
+	"github.com/hyperledger/fabric/idemix"
+	"github.com/stretchr/testify/assert"
+)
+
+func deal(t *testing.T, threshold, n int) (*PublicKey, []*KeyShare) {
+	rng, err := idemix.GetRand()
+	assert.NoError(t, err)
+	pk, shares, err := Deal(threshold, n, rng)
+	assert.NoError(t, err)
+	return pk, shares
+}
+
+func TestDeal(t *testing.T) {
+	rng, err := idemix.GetRand()
+	assert.NoError(t, err)
+
+	_, _, err = Deal(0, 4, rng)
+	assert.EqualError(t, err, "invalid threshold 0 for 4 shares")
+	_, _, err = Deal(5, 4, rng)
+	assert.EqualError(t, err, "invalid threshold 5 for 4 shares")
+
+	pk, shares, err := Deal(3, 4, rng)
+	assert.NoError(t, err)
+	assert.Len(t, shares, 4)
+	for i, share := range shares {
+		assert.Equal(t, i+1, share.Index)
+		assert.Equal(t, 3, share.Threshold)
+		assert.Equal(t, pk, share.PublicKey)
+		assert.Len(t, share.SharePublicKeys, 4)
+	}
+}
+
+func TestThresholdSignature(t *testing.T) {
+	pk, shares := deal(t, 3, 4)
+	message := []byte("block")
+
+	partials := make([]*PartialSignature, len(shares))
+	for i, share := range shares {
+		partials[i] = share.Sign(message)
+		assert.NoError(t, shares[0].VerifyPartial(message, partials[i]))
+		// a partial signature does not verify against the group key
+		assert.Error(t, Verify(pk, message, partials[i].Signature))
+	}
+
+	// any threshold of partial signatures yield the same signature
+	signature, err := Combine(3, partials[:3])
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(pk, message, signature))
+	other, err := Combine(3, []*PartialSignature{partials[3], partials[0], partials[2]})
+	assert.NoError(t, err)
+	assert.Equal(t, signature, other)
+
+	assert.EqualError(t, Verify(pk, []byte("other block"), signature), "signature verification failed")
+
+	// fewer than the threshold of partial signatures do not combine to a valid signature
+	_, err = Combine(3, partials[:2])
+	assert.EqualError(t, err, "2 partial signatures are not enough, 3 are needed")
+	signature, err = Combine(2, partials[:2])
+	assert.NoError(t, err)
+	assert.Error(t, Verify(pk, message, signature))
+
+	_, err = Combine(3, []*PartialSignature{partials[0], partials[1], partials[0]})
+	assert.EqualError(t, err, "duplicate partial signature of key share 1")
+	_, err = Combine(3, []*PartialSignature{partials[0], partials[1], {Index: 3, Signature: []byte{1}}})
+	assert.EqualError(t, err, "invalid partial signature of key share 3: invalid signature length 1, expected 33")
+}
+
+func TestVerifyPartial(t *testing.T) {
+	_, shares := deal(t, 2, 3)
+	message := []byte("block")
+
+	assert.EqualError(t, shares[0].VerifyPartial(message, nil), "nil partial signature")
+	assert.EqualError(t, shares[0].VerifyPartial(message, &PartialSignature{Index: 4}), "invalid key share index 4")
+
+	partial := shares[1].Sign(message)
+	partial.Index = 3
+	assert.EqualError(t, shares[0].VerifyPartial(message, partial), "invalid partial signature of key share 3: signature verification failed")
+}
+
+func TestEncoding(t *testing.T) {
+	pk, shares := deal(t, 2, 3)
+
+	decodedPK, err := PEMtoPublicKey(PublicKeyToPEM(pk))
+	assert.NoError(t, err)
+	assert.Equal(t, pk.Bytes(), decodedPK.Bytes())
+
+	raw, err := KeyShareToPEM(shares[1])
+	assert.NoError(t, err)
+	share, err := PEMtoKeyShare(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, share.Index)
+	assert.Equal(t, 2, share.Threshold)
+	assert.Equal(t, pk.Bytes(), share.PublicKey.Bytes())
+
+	// the decoded share signs like the original one
+	message := []byte("block")
+	signature, err := Combine(2, []*PartialSignature{shares[0].Sign(message), share.Sign(message)})
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(pk, message, signature))
+
+	_, err = PEMtoPublicKey(raw)
+	assert.EqualError(t, err, "failed decoding PEM, expected a THRESHOLD PUBLIC KEY block")
+
+	// a point of the twist that is not in G2 is rejected
+	var point *FP256BN.ECP2
+	for i := 1; point == nil || point.Is_infinity(); i++ {
+		point = FP256BN.NewECP2fp2(FP256BN.NewFP2int(i))
+	}
+	rawPoint := make([]byte, publicKeyLength)
+	point.ToBytes(rawPoint)
+	_, err = PublicKeyFromBytes(rawPoint)
+	assert.EqualError(t, err, "invalid public key, not in the subgroup of G2")
+	_, err = PEMtoKeyShare(PublicKeyToPEM(pk))
+	assert.EqualError(t, err, "failed decoding PEM, expected a THRESHOLD KEY SHARE block")
+
+	// a share whose secret does not match its public key is rejected
+	shares[1].secret = shares[0].secret
+	raw, err = KeyShareToPEM(shares[1])
+	assert.NoError(t, err)
+	_, err = PEMtoKeyShare(raw)
+	assert.EqualError(t, err, "the secret of key share 2 does not match its public key")
+}
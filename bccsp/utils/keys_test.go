@@ -18,6 +18,7 @@ package utils
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -318,6 +319,41 @@ func TestECDSAKeys(t *testing.T) {
 	}
 }
 
+func TestED25519Keys(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	// Private Key PEM format
+	rawPEM, err := PrivateKeyToPEM(priv, nil)
+	assert.NoError(t, err)
+	pemBlock, _ := pem.Decode(rawPEM)
+	assert.Equal(t, "PRIVATE KEY", pemBlock.Type)
+	keyFromPEM, err := PEMtoPrivateKey(rawPEM, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, priv, keyFromPEM)
+
+	_, err = PrivateKeyToPEM(priv[:10], nil)
+	assert.EqualError(t, err, "Invalid ed25519 private key. It must have a valid length.")
+
+	// Public Key PEM and DER formats
+	rawPEM, err = PublicKeyToPEM(pub, nil)
+	assert.NoError(t, err)
+	pemBlock, _ = pem.Decode(rawPEM)
+	assert.Equal(t, "PUBLIC KEY", pemBlock.Type)
+	keyFromPEM, err = PEMtoPublicKey(rawPEM, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pub, keyFromPEM)
+
+	der, err := PublicKeyToDER(pub)
+	assert.NoError(t, err)
+	keyFromDER, err := DERToPublicKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, pub, keyFromDER)
+
+	_, err = PublicKeyToDER(pub[:10])
+	assert.EqualError(t, err, "Invalid ed25519 public key. It must have a valid length.")
+}
+
 func TestAESKey(t *testing.T) {
 	k := []byte{0, 1, 2, 3, 4, 5}
 	pem := AEStoPEM(k)
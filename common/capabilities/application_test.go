@@ -56,6 +56,27 @@ func TestApplicationV13(t *testing.T) {
 	assert.True(t, ap.PrivateChannelData())
 }
 
+func TestApplicationV14(t *testing.T) {
+	ap := NewApplicationProvider(map[string]*cb.Capability{
+		ApplicationV1_4: {},
+	})
+	assert.NoError(t, ap.Supported())
+	assert.True(t, ap.ForbidDuplicateTXIdInBlock())
+	assert.True(t, ap.V1_1Validation())
+	assert.True(t, ap.V1_2Validation())
+	assert.True(t, ap.V1_3Validation())
+	assert.True(t, ap.V1_4Validation())
+	assert.True(t, ap.KeyLevelEndorsement())
+	assert.True(t, ap.ACLs())
+	assert.True(t, ap.CollectionUpgrade())
+	assert.True(t, ap.PrivateChannelData())
+
+	ap = NewApplicationProvider(map[string]*cb.Capability{
+		ApplicationV1_3: {},
+	})
+	assert.False(t, ap.V1_4Validation())
+}
+
 func TestApplicationPvtDataExperimental(t *testing.T) {
 	ap := NewApplicationProvider(map[string]*cb.Capability{
 		ApplicationPvtDataExperimental: {},
@@ -68,6 +89,7 @@ func TestHasCapability(t *testing.T) {
 	assert.True(t, ap.HasCapability(ApplicationV1_1))
 	assert.True(t, ap.HasCapability(ApplicationV1_2))
 	assert.True(t, ap.HasCapability(ApplicationV1_3))
+	assert.True(t, ap.HasCapability(ApplicationV1_4))
 	assert.True(t, ap.HasCapability(ApplicationPvtDataExperimental))
 	assert.True(t, ap.HasCapability(ApplicationResourcesTreeExperimental))
 	assert.False(t, ap.HasCapability("default"))
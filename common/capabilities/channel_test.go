@@ -19,6 +19,7 @@ func TestChannelV10(t *testing.T) {
 	op := NewChannelProvider(map[string]*cb.Capability{})
 	assert.NoError(t, op.Supported())
 	assert.True(t, op.MSPVersion() == msp.MSPv1_0)
+	assert.False(t, op.ThresholdSignaturePolicy())
 }
 
 func TestChannelV11(t *testing.T) {
@@ -36,4 +37,15 @@ func TestChannelV13(t *testing.T) {
 	})
 	assert.NoError(t, op.Supported())
 	assert.True(t, op.MSPVersion() == msp.MSPv1_3)
+	assert.False(t, op.ThresholdSignaturePolicy())
+}
+
+func TestChannelV14(t *testing.T) {
+	op := NewChannelProvider(map[string]*cb.Capability{
+		ChannelV1_3: {},
+		ChannelV1_4: {},
+	})
+	assert.NoError(t, op.Supported())
+	assert.True(t, op.MSPVersion() == msp.MSPv1_4)
+	assert.True(t, op.ThresholdSignaturePolicy())
 }
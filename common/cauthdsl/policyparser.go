@@ -38,19 +38,24 @@ const (
 
 // Role values for principals
 const (
-	RoleAdmin  = "admin"
-	RoleMember = "member"
-	RoleClient = "client"
-	RolePeer   = "peer"
-	// RoleOrderer = "orderer" TODO
+	RoleAdmin   = "admin"
+	RoleMember  = "member"
+	RoleClient  = "client"
+	RolePeer    = "peer"
+	RoleOrderer = "orderer"
 )
 
+// AttributesKeyword introduces the attributes that the
+// identities matching a principal must have
+const AttributesKeyword = "with attr"
+
 var (
 	regex = regexp.MustCompile(
-		fmt.Sprintf("^([[:alnum:].-]+)([.])(%s|%s|%s|%s)$",
-			RoleAdmin, RoleMember, RoleClient, RolePeer),
+		fmt.Sprintf("^([[:alnum:].-]+)([.])(%s|%s|%s|%s|%s)(?: %s (.+))?$",
+			RoleAdmin, RoleMember, RoleClient, RolePeer, RoleOrderer, AttributesKeyword),
 	)
-	regexErr = regexp.MustCompile("^No parameter '([^']+)' found[.]$")
+	regexAttr = regexp.MustCompile("^([[:alnum:]._-]+)=([^,]+)$")
+	regexErr  = regexp.MustCompile("^No parameter '([^']+)' found[.]$")
 )
 
 // a stub function - it returns the same string as it's passed.
@@ -160,12 +165,14 @@ func secondPass(args ...interface{}) (interface{}, error) {
 	for _, principal := range args[2:] {
 		switch t := principal.(type) {
 		/* if it's a string, we expect it to be formed as
-		   <MSP_ID> . <ROLE>, where MSP_ID is the MSP identifier
-		   and ROLE is either a member, an admin, a client, a peer or an orderer*/
+		   <MSP_ID> . <ROLE> [with attr <NAME>=<VALUE>[,<NAME>=<VALUE>]],
+		   where MSP_ID is the MSP identifier, ROLE is either a member,
+		   an admin, a client, a peer or an orderer, and NAME and VALUE
+		   are the name and value of a certificate attribute*/
 		case string:
 			/* split the string */
 			subm := regex.FindAllStringSubmatch(t, -1)
-			if subm == nil || len(subm) != 1 || len(subm[0]) != 4 {
+			if subm == nil || len(subm) != 1 || len(subm[0]) != 5 {
 				return nil, fmt.Errorf("Error parsing principal %s", t)
 			}
 
@@ -180,6 +187,11 @@ func secondPass(args ...interface{}) (interface{}, error) {
 				r = msp.MSPRole_CLIENT
 			case RolePeer:
 				r = msp.MSPRole_PEER
+			case RoleOrderer:
+				if !ctx.v14 {
+					return nil, fmt.Errorf("Error parsing principal %s: the orderer role requires the V1_4 channel capability", t)
+				}
+				r = msp.MSPRole_ORDERER
 			default:
 				return nil, fmt.Errorf("Error parsing role %s", t)
 			}
@@ -188,6 +200,18 @@ func secondPass(args ...interface{}) (interface{}, error) {
 			p := &msp.MSPPrincipal{
 				PrincipalClassification: msp.MSPPrincipal_ROLE,
 				Principal:               utils.MarshalOrPanic(&msp.MSPRole{MspIdentifier: subm[0][1], Role: r})}
+
+			/* the attributes are combined with the role */
+			if subm[0][4] != "" {
+				if !ctx.v14 {
+					return nil, fmt.Errorf("Error parsing principal %s: attributes require the V1_4 channel capability", t)
+				}
+				var err error
+				p, err = withAttributes(p, subm[0][1], subm[0][4])
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing principal %s: %s", t, err)
+				}
+			}
 			ctx.principals = append(ctx.principals, p)
 
 			/* create a SignaturePolicy that requires a signature from
@@ -214,13 +238,37 @@ func secondPass(args ...interface{}) (interface{}, error) {
 	return NOutOf(int32(t), policies), nil
 }
 
+// withAttributes returns a combined principal requiring the identities
+// that satisfy principal to have the given attributes, in the form
+// NAME=VALUE[,NAME=VALUE]
+func withAttributes(principal *msp.MSPPrincipal, mspID, attributes string) (*msp.MSPPrincipal, error) {
+	principals := []*msp.MSPPrincipal{principal}
+	for _, attribute := range strings.Split(attributes, ",") {
+		subm := regexAttr.FindStringSubmatch(attribute)
+		if subm == nil {
+			return nil, fmt.Errorf("invalid attribute '%s', expected NAME=VALUE", attribute)
+		}
+		principals = append(principals, &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+			Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: mspID, Name: subm[1], Value: subm[2]}),
+		})
+	}
+	return &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_COMBINED,
+		Principal:               utils.MarshalOrPanic(&msp.CombinedPrincipal{Principals: principals}),
+	}, nil
+}
+
 type context struct {
 	IDNum      int
 	principals []*msp.MSPPrincipal
+	// v14 is true if principals that require
+	// the V1_4 channel capability are allowed
+	v14 bool
 }
 
-func newContext() *context {
-	return &context{IDNum: 0, principals: make([]*msp.MSPPrincipal, 0)}
+func newContext(v14 bool) *context {
+	return &context{IDNum: 0, principals: make([]*msp.MSPPrincipal, 0), v14: v14}
 }
 
 // FromString takes a string representation of the policy,
@@ -235,13 +283,29 @@ func newContext() *context {
 //
 // A principal is defined as:
 //
-// ORG.ROLE
+// ORG.ROLE [with attr NAME=VALUE[,NAME=VALUE]]
 //
 // where:
 //	- ORG is a string (representing the MSP identifier)
 //	- ROLE takes the value of any of the RoleXXX constants representing
 //    the required role
+//	- NAME=VALUE is a certificate attribute that the identity must have,
+//    as issued by the Fabric CA
+//
+// Principals with attributes or the orderer role can only be evaluated by the
+// MSPs of channels with the V1_4 channel capability, so they are rejected;
+// FromStringV14 accepts them.
 func FromString(policy string) (*common.SignaturePolicyEnvelope, error) {
+	return fromString(policy, false)
+}
+
+// FromStringV14 parses the given policy like FromString, but also accepts
+// the principals that require the V1_4 channel capability.
+func FromStringV14(policy string) (*common.SignaturePolicyEnvelope, error) {
+	return fromString(policy, true)
+}
+
+func fromString(policy string, v14 bool) (*common.SignaturePolicyEnvelope, error) {
 	// first we translate the and/or business into outof gates
 	intermediate, err := govaluate.NewEvaluableExpressionWithFunctions(
 		policy, map[string]govaluate.ExpressionFunction{
@@ -305,7 +369,7 @@ func FromString(policy string) (*common.SignaturePolicyEnvelope, error) {
 		return nil, fmt.Errorf("invalid policy string '%s'", policy)
 	}
 
-	ctx := newContext()
+	ctx := newContext(v14)
 	parameters := make(map[string]interface{}, 1)
 	parameters["ID"] = ctx
 
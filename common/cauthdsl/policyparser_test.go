@@ -86,7 +86,10 @@ func TestAnd(t *testing.T) {
 }
 
 func TestAndClientPeerOrderer(t *testing.T) {
-	p1, err := FromString("AND('A.client', 'B.peer')")
+	_, err := FromString("AND('A.client', 'B.peer', 'C.orderer')")
+	assert.EqualError(t, err, "Error parsing principal C.orderer: the orderer role requires the V1_4 channel capability")
+
+	p1, err := FromStringV14("AND('A.client', 'B.peer', 'C.orderer')")
 	assert.NoError(t, err)
 
 	principals := make([]*msp.MSPPrincipal, 0)
@@ -99,9 +102,13 @@ func TestAndClientPeerOrderer(t *testing.T) {
 		PrincipalClassification: msp.MSPPrincipal_ROLE,
 		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_PEER, MspIdentifier: "B"})})
 
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_ORDERER, MspIdentifier: "C"})})
+
 	p2 := &common.SignaturePolicyEnvelope{
 		Version:    0,
-		Rule:       And(SignedBy(0), SignedBy(1)),
+		Rule:       NOutOf(3, []*common.SignaturePolicy{SignedBy(0), SignedBy(1), SignedBy(2)}),
 		Identities: principals,
 	}
 
@@ -217,6 +224,58 @@ func TestMSPIDWIthSpecialChars(t *testing.T) {
 	assert.Equal(t, p1, p2)
 }
 
+func TestAttributes(t *testing.T) {
+	p1, err := FromStringV14("AND('A.member with attr role=auditor', OR('B.peer', 'B.client with attr hf.Affiliation=org1.dept1,dept=sales team'))")
+	assert.NoError(t, err)
+
+	attribute := func(mspID, name, value string) *msp.MSPPrincipal {
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+			Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: mspID, Name: name, Value: value})}
+	}
+
+	principals := make([]*msp.MSPPrincipal, 0)
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_PEER, MspIdentifier: "B"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_COMBINED,
+		Principal: utils.MarshalOrPanic(&msp.CombinedPrincipal{Principals: []*msp.MSPPrincipal{
+			{
+				PrincipalClassification: msp.MSPPrincipal_ROLE,
+				Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_CLIENT, MspIdentifier: "B"})},
+			attribute("B", "hf.Affiliation", "org1.dept1"),
+			attribute("B", "dept", "sales team"),
+		}})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_COMBINED,
+		Principal: utils.MarshalOrPanic(&msp.CombinedPrincipal{Principals: []*msp.MSPPrincipal{
+			{
+				PrincipalClassification: msp.MSPPrincipal_ROLE,
+				Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_MEMBER, MspIdentifier: "A"})},
+			attribute("A", "role", "auditor"),
+		}})})
+
+	p2 := &common.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       And(SignedBy(2), Or(SignedBy(0), SignedBy(1))),
+		Identities: principals,
+	}
+
+	assert.Equal(t, p1, p2)
+
+	_, err = FromStringV14("AND('A.member with attr role')")
+	assert.EqualError(t, err, "Error parsing principal A.member with attr role: invalid attribute 'role', expected NAME=VALUE")
+	_, err = FromStringV14("AND('A.member with attr role=,dept=sales')")
+	assert.EqualError(t, err, "Error parsing principal A.member with attr role=,dept=sales: invalid attribute 'role=', expected NAME=VALUE")
+
+	_, err = FromString("AND('A.member with attr role=auditor')")
+	assert.EqualError(t, err, "Error parsing principal A.member with attr role=auditor: attributes require the V1_4 channel capability")
+}
+
 func TestBadStringsNoPanic(t *testing.T) {
 	_, err := FromString("OR('A.member', Bmember)") // error after 1st Evaluate()
 	assert.EqualError(t, err, "unrecognized token 'Bmember' in policy string")
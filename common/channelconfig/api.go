@@ -115,6 +115,10 @@ type ChannelCapabilities interface {
 	// MSPVersion specifies the version of the MSP this channel must understand, including the MSP types
 	// and MSP principal types.
 	MSPVersion() msp.MSPVersion
+
+	// ThresholdSignaturePolicy returns true if policies of type THRESHOLD_SIGNATURE
+	// may be used in the channel config.
+	ThresholdSignaturePolicy() bool
 }
 
 // ApplicationCapabilities defines the capabilities for the application portion of a channel
@@ -152,6 +156,14 @@ type ApplicationCapabilities interface {
 	//  - new chaincode lifecycle, as described in FAB-11237
 	V1_3Validation() bool
 
+	// V1_4Validation returns true if this channel supports transaction validation
+	// as introduced in v1.4. This includes:
+	//  - updates of collection definitions without chaincode upgrade
+	//  - per-collection endorsement policies
+	//  - purging of private data
+	//  - per-chaincode resource limits
+	V1_4Validation() bool
+
 	// MetadataLifecycle indicates whether the peer should use the deprecated and problematic
 	// v1.0/v1.1 lifecycle, or whether it should use the newer per channel peer local chaincode
 	// metadata package approach planned for release with Fabric v1.2
@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/fabric/common/configtx"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/policies/threshold"
 	"github.com/hyperledger/fabric/msp"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -194,6 +195,12 @@ func NewBundle(channelID string, config *cb.Config) (*Bundle, error) {
 			policyProviderMap[pType] = cauthdsl.NewPolicyProvider(channelConfig.MSPManager())
 		case cb.Policy_MSP:
 			// Add hook for MSP Handler here
+		case cb.Policy_THRESHOLD_SIGNATURE:
+			// Policies of this type are rejected by older binaries,
+			// so they are only accepted once all of them are upgraded
+			if channelConfig.Capabilities().ThresholdSignaturePolicy() {
+				policyProviderMap[pType] = threshold.NewPolicyProvider()
+			}
 		}
 	}
 
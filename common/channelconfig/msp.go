@@ -11,7 +11,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/msp"
-	"github.com/hyperledger/fabric/msp/cache"
+	"github.com/hyperledger/fabric/msp/mgmt"
 	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 )
@@ -41,14 +41,15 @@ func (bh *MSPConfigHandler) ProposeMSP(mspConfig *mspprotos.MSPConfig) (msp.MSP,
 
 	switch mspConfig.Type {
 	case int32(msp.FABRIC):
-		// create the bccsp msp instance
+		// create the bccsp msp instance. Channel MSPs never check revocation online,
+		// as all the peers must reach the same validation outcome
 		mspInst, err := msp.New(&msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: bh.version}})
 		if err != nil {
 			return nil, errors.WithMessage(err, "creating the MSP manager failed")
 		}
 
 		// add a cache layer on top
-		theMsp, err = cache.New(mspInst)
+		theMsp, err = mgmt.NewCachedMSP(mspInst)
 		if err != nil {
 			return nil, errors.WithMessage(err, "creating the MSP cache failed")
 		}
@@ -282,6 +282,14 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 
 		logger.Debugf("[channel: %s] Delivering block for (%p) for %s", chdr.ChannelId, seekInfo, addr)
 
+		if seekInfo.ContentType == ab.SeekInfo_HEADER_WITH_SIG {
+			// Only the header and the metadata that carries the signatures are delivered
+			block = &cb.Block{
+				Header:   block.Header,
+				Metadata: block.Metadata,
+			}
+		}
+
 		if err := srv.SendBlockResponse(block); err != nil {
 			logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
 			return err
@@ -328,6 +328,29 @@ var _ = Describe("Deliver", func() {
 			})
 		})
 
+		Context("when seek info requests only the headers of the blocks", func() {
+			BeforeEach(func() {
+				seekInfo = &ab.SeekInfo{Start: &ab.SeekPosition{}, Stop: seekOldest, ContentType: ab.SeekInfo_HEADER_WITH_SIG}
+				fakeBlockIterator.NextReturns(&cb.Block{
+					Header:   &cb.BlockHeader{Number: 100},
+					Data:     &cb.BlockData{Data: [][]byte{[]byte("tx")}},
+					Metadata: &cb.BlockMetadata{Metadata: [][]byte{[]byte("signatures")}},
+				}, cb.Status_SUCCESS)
+			})
+
+			It("sends the blocks without their data", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+				b := fakeResponseSender.SendBlockResponseArgsForCall(0)
+				Expect(b).To(Equal(&cb.Block{
+					Header:   &cb.BlockHeader{Number: 100},
+					Metadata: &cb.BlockMetadata{Metadata: [][]byte{[]byte("signatures")}},
+				}))
+			})
+		})
+
 		Context("when sending the block fails", func() {
 			BeforeEach(func() {
 				fakeResponseSender.SendBlockResponseReturns(errors.New("send-fails"))
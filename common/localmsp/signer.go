@@ -20,11 +20,15 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	cb "github.com/hyperledger/fabric/protos/common"
 )
 
 type mspSigner struct {
+	// identity is the signing identity the signer is bound to, if any.
+	// If nil, the default signing identity of the local msp is used.
+	identity msp.SigningIdentity
 }
 
 // NewSigner returns a new instance of the msp-based LocalSigner.
@@ -34,9 +38,23 @@ func NewSigner() crypto.LocalSigner {
 	return &mspSigner{}
 }
 
+// NewSignerWithIdentity returns a new instance of the msp-based LocalSigner
+// that keeps signing with the given signing identity, even if the local msp
+// is reloaded afterwards.
+func NewSignerWithIdentity(identity msp.SigningIdentity) crypto.LocalSigner {
+	return &mspSigner{identity: identity}
+}
+
+func (s *mspSigner) signingIdentity() (msp.SigningIdentity, error) {
+	if s.identity != nil {
+		return s.identity, nil
+	}
+	return mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+}
+
 // NewSignatureHeader creates a SignatureHeader with the correct signing identity and a valid nonce
 func (s *mspSigner) NewSignatureHeader() (*cb.SignatureHeader, error) {
-	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	signer, err := s.signingIdentity()
 	if err != nil {
 		return nil, fmt.Errorf("Failed getting MSP-based signer [%s]", err)
 	}
@@ -60,7 +78,7 @@ func (s *mspSigner) NewSignatureHeader() (*cb.SignatureHeader, error) {
 
 // Sign a message which should embed a signature header created by NewSignatureHeader
 func (s *mspSigner) Sign(message []byte) ([]byte, error) {
-	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	signer, err := s.signingIdentity()
 	if err != nil {
 		return nil, fmt.Errorf("Failed getting MSP-based signer [%s]", err)
 	}
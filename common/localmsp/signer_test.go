@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/core/config/configtest"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	"github.com/stretchr/testify/assert"
@@ -62,3 +63,31 @@ func TestMspSigner_Sign(t *testing.T) {
 	err = mspIdentity.Verify(msg, sigma)
 	assert.NoError(t, err, "Failed verifiing signature")
 }
+
+func TestMspSigner_WithIdentity(t *testing.T) {
+	identity, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	assert.NoError(t, err, "Failed getting default MSP Identity")
+	signer := NewSignerWithIdentity(identity)
+
+	// Reload the local MSP, the signer should keep signing with the identity it was given
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	err = mspmgmt.ReloadLocalMsp(mspDir, nil, "SampleOrg")
+	assert.NoError(t, err, "Failed reloading the local MSP")
+
+	sh, err := signer.NewSignatureHeader()
+	assert.NoError(t, err, "Failed creating signature header")
+	identityRaw, err := identity.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, identityRaw, sh.Creator, "Creator must be the identity the signer was created with")
+
+	msg := []byte("Hello World")
+	sigma, err := signer.Sign(msg)
+	assert.NoError(t, err, "Failed generating signature")
+	assert.NoError(t, identity.Verify(msg, sigma), "Failed verifying signature")
+
+	// A signer of the local MSP signs with the reloaded default signing identity
+	sigma, err = NewSigner().Sign(msg)
+	assert.NoError(t, err, "Failed generating signature")
+	assert.NoError(t, mspmgmt.GetLocalSigningIdentityOrPanic().Verify(msg, sigma), "Failed verifying signature")
+}
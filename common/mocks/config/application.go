@@ -46,6 +46,7 @@ type MockApplicationCapabilities struct {
 	MetadataLifecycleRv          bool
 	KeyLevelEndorsementRv        bool
 	V1_3ValidationRv             bool
+	V1_4ValidationRv             bool
 }
 
 func (mac *MockApplicationCapabilities) Supported() error {
@@ -87,3 +88,7 @@ func (mac *MockApplicationCapabilities) KeyLevelEndorsement() bool {
 func (mac *MockApplicationCapabilities) V1_3Validation() bool {
 	return mac.V1_3ValidationRv
 }
+
+func (mac *MockApplicationCapabilities) V1_4Validation() bool {
+	return mac.V1_4ValidationRv
+}
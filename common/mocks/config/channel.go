@@ -68,6 +68,9 @@ type ChannelCapabilities struct {
 
 	// MSPVersionVal is returned by MSPVersion()
 	MSPVersionVal msp.MSPVersion
+
+	// ThresholdSignaturePolicyVal is returned by ThresholdSignaturePolicy()
+	ThresholdSignaturePolicyVal bool
 }
 
 // Supported returns SupportedErr
@@ -79,3 +82,8 @@ func (cc *ChannelCapabilities) Supported() error {
 func (cc *ChannelCapabilities) MSPVersion() msp.MSPVersion {
 	return cc.MSPVersionVal
 }
+
+// ThresholdSignaturePolicy returns ThresholdSignaturePolicyVal
+func (cc *ChannelCapabilities) ThresholdSignaturePolicy() bool {
+	return cc.ThresholdSignaturePolicyVal
+}
@@ -0,0 +1,61 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/threshold"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("policies.threshold")
+
+type provider struct{}
+
+// NewPolicyProvider provides a policy generator for threshold signature policies
+func NewPolicyProvider() policies.Provider {
+	return &provider{}
+}
+
+// NewPolicy creates a new policy based on the policy bytes
+func (pr *provider) NewPolicy(data []byte) (policies.Policy, proto.Message, error) {
+	definition := &cb.ThresholdSignaturePolicy{}
+	if err := proto.Unmarshal(data, definition); err != nil {
+		return nil, nil, errors.Wrap(err, "error unmarshaling to ThresholdSignaturePolicy")
+	}
+
+	pk, err := threshold.PublicKeyFromBytes(definition.PublicKey)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "invalid threshold signature policy")
+	}
+
+	return &policy{publicKey: pk}, definition, nil
+}
+
+type policy struct {
+	publicKey *threshold.PublicKey
+}
+
+// Evaluate takes a set of SignedData and evaluates whether one of the
+// signatures is a threshold signature of its data. The identities of
+// the signers are not considered.
+func (p *policy) Evaluate(signatureSet []*cb.SignedData) error {
+	for _, signedData := range signatureSet {
+		if len(signedData.Signature) != threshold.SignatureLength {
+			continue
+		}
+		if err := threshold.Verify(p.publicKey, signedData.Data, signedData.Signature); err != nil {
+			logger.Debugf("Signature is not a valid threshold signature: %s", err)
+			continue
+		}
+		return nil
+	}
+	return errors.New("no valid threshold signature found")
+}
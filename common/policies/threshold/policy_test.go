@@ -0,0 +1,58 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package threshold
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/threshold"
+	"github.com/hyperledger/fabric/idemix"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThresholdSignaturePolicy(t *testing.T) {
+	rng, err := idemix.GetRand()
+	assert.NoError(t, err)
+	pk, shares, err := threshold.Deal(2, 3, rng)
+	assert.NoError(t, err)
+
+	p, msg, err := NewPolicyProvider().NewPolicy(utils.MarshalOrPanic(&cb.ThresholdSignaturePolicy{PublicKey: pk.Bytes()}))
+	assert.NoError(t, err)
+	assert.Equal(t, pk.Bytes(), msg.(*cb.ThresholdSignaturePolicy).PublicKey)
+
+	data := []byte("block")
+	signature, err := threshold.Combine(2, []*threshold.PartialSignature{shares[0].Sign(data), shares[2].Sign(data)})
+	assert.NoError(t, err)
+
+	// the policy is satisfied by the threshold signature, among other signatures
+	assert.NoError(t, p.Evaluate([]*cb.SignedData{
+		{Identity: []byte("orderer1"), Data: data, Signature: []byte("ecdsa signature")},
+		{Identity: []byte("orderer2"), Data: data, Signature: signature},
+	}))
+
+	// a partial signature does not satisfy the policy
+	err = p.Evaluate([]*cb.SignedData{{Data: data, Signature: shares[0].Sign(data).Signature}})
+	assert.EqualError(t, err, "no valid threshold signature found")
+
+	// neither does a signature of other data
+	err = p.Evaluate([]*cb.SignedData{{Data: []byte("other block"), Signature: signature}})
+	assert.EqualError(t, err, "no valid threshold signature found")
+
+	err = p.Evaluate(nil)
+	assert.EqualError(t, err, "no valid threshold signature found")
+}
+
+func TestNewPolicyErrors(t *testing.T) {
+	_, _, err := NewPolicyProvider().NewPolicy([]byte{0})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error unmarshaling to ThresholdSignaturePolicy")
+
+	_, _, err = NewPolicyProvider().NewPolicy(utils.MarshalOrPanic(&cb.ThresholdSignaturePolicy{PublicKey: []byte("key")}))
+	assert.EqualError(t, err, "invalid threshold signature policy: invalid public key length 3, expected 128")
+}
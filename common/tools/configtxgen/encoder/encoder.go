@@ -7,6 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package encoder
 
 import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hyperledger/fabric/bccsp/threshold"
 	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
@@ -60,6 +64,10 @@ const (
 
 	// ImplicitMetaPolicyType is the 'Type' string for implicit meta policies
 	ImplicitMetaPolicyType = "ImplicitMeta"
+
+	// ThresholdSignaturePolicyType is the 'Type' string for threshold signature policies,
+	// whose 'Rule' is the path of the PEM encoded threshold public key
+	ThresholdSignaturePolicyType = "ThresholdSignature"
 )
 
 func addValue(cg *cb.ConfigGroup, value channelconfig.ConfigValue, modPolicy string) {
@@ -103,6 +111,22 @@ func addPolicies(cg *cb.ConfigGroup, policyMap map[string]*genesisconfig.Policy,
 					Value: utils.MarshalOrPanic(sp),
 				},
 			}
+		case ThresholdSignaturePolicyType:
+			pemBytes, err := ioutil.ReadFile(policy.Rule)
+			if err != nil {
+				return errors.Wrapf(err, "could not read threshold public key '%s'", policy.Rule)
+			}
+			pk, err := threshold.PEMtoPublicKey(pemBytes)
+			if err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("invalid threshold public key '%s'", policy.Rule))
+			}
+			cg.Policies[policyName] = &cb.ConfigPolicy{
+				ModPolicy: modPolicy,
+				Policy: &cb.Policy{
+					Type:  int32(cb.Policy_THRESHOLD_SIGNATURE),
+					Value: utils.MarshalOrPanic(&cb.ThresholdSignaturePolicy{PublicKey: pk.Bytes()}),
+				},
+			}
 		default:
 			return errors.Errorf("unknown policy type: %s", policy.Type)
 		}
@@ -200,9 +224,11 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 			return nil, errors.Wrapf(err, "error adding policies to orderer group")
 		}
 	}
-	ordererGroup.Policies[BlockValidationPolicyKey] = &cb.ConfigPolicy{
-		Policy:    policies.ImplicitMetaAnyPolicy(channelconfig.WritersPolicyKey).Value(),
-		ModPolicy: channelconfig.AdminsPolicyKey,
+	if _, ok := ordererGroup.Policies[BlockValidationPolicyKey]; !ok {
+		ordererGroup.Policies[BlockValidationPolicyKey] = &cb.ConfigPolicy{
+			Policy:    policies.ImplicitMetaAnyPolicy(channelconfig.WritersPolicyKey).Value(),
+			ModPolicy: channelconfig.AdminsPolicyKey,
+		}
 	}
 	addValue(ordererGroup, channelconfig.BatchSizeValue(
 		conf.BatchSize.MaxMessageCount,
@@ -7,16 +7,21 @@ SPDX-License-Identifier: Apache-2.0
 package encoder
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 
+	"github.com/hyperledger/fabric/bccsp/threshold"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/configtx"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/idemix"
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -257,6 +262,47 @@ func TestNewOrdererGroup(t *testing.T) {
 		assert.Nil(t, group)
 	})
 
+	t.Run("Threshold signature block validation policy", func(t *testing.T) {
+		rng, err := idemix.GetRand()
+		require.NoError(t, err)
+		pk, _, err := threshold.Deal(2, 3, rng)
+		require.NoError(t, err)
+		dir, err := ioutil.TempDir("", "threshold")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		pkFile := filepath.Join(dir, "public_key.pem")
+		require.NoError(t, ioutil.WriteFile(pkFile, threshold.PublicKeyToPEM(pk), 0644))
+
+		config := configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)
+		config.Orderer.Policies[BlockValidationPolicyKey] = &genesisconfig.Policy{Type: ThresholdSignaturePolicyType, Rule: pkFile}
+		group, err := NewOrdererGroup(config.Orderer)
+		require.NoError(t, err)
+		policy := group.Policies[BlockValidationPolicyKey]
+		assert.Equal(t, int32(cb.Policy_THRESHOLD_SIGNATURE), policy.Policy.Type)
+		assert.Equal(t, channelconfig.AdminsPolicyKey, policy.ModPolicy)
+		tsp := &cb.ThresholdSignaturePolicy{}
+		require.NoError(t, proto.Unmarshal(policy.Policy.Value, tsp))
+		assert.Equal(t, pk.Bytes(), tsp.PublicKey)
+
+		// the policy is only accepted with the V1_4 channel capability
+		config.Capabilities = map[string]bool{"V1_3": true}
+		channelGroup, err := NewChannelGroup(config)
+		require.NoError(t, err)
+		_, err = channelconfig.NewBundle("test", &cb.Config{ChannelGroup: channelGroup})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown policy type")
+		config.Capabilities["V1_4"] = true
+		channelGroup, err = NewChannelGroup(config)
+		require.NoError(t, err)
+		_, err = channelconfig.NewBundle("test", &cb.Config{ChannelGroup: channelGroup})
+		assert.NoError(t, err)
+
+		config.Orderer.Policies[BlockValidationPolicyKey].Rule = filepath.Join(dir, "missing.pem")
+		_, err = NewOrdererGroup(config.Orderer)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "could not read threshold public key")
+	})
+
 	t.Run("etcd/raft-based Orderer", func(t *testing.T) {
 		config := configtxgentest.Load(genesisconfig.SampleDevModeEtcdRaftProfile)
 		group, _ := NewOrdererGroup(config.Orderer)
@@ -114,6 +114,10 @@ type Profile struct {
 	Policies     map[string]*Policy     `yaml:"Policies"`
 }
 
+// thresholdSignaturePolicyType is the policy type whose rule is a file path,
+// it must match encoder.ThresholdSignaturePolicyType
+const thresholdSignaturePolicyType = "ThresholdSignature"
+
 // Policy encodes a channel config policy
 type Policy struct {
 	Type string `yaml:"Type"`
@@ -430,6 +434,13 @@ loop:
 			c.ServerTlsCert = []byte(serverCertPath)
 		}
 	}
+
+	// The rule of a threshold signature policy is the path of the group public key
+	for _, policy := range ord.Policies {
+		if policy != nil && policy.Type == thresholdSignaturePolicyType {
+			cf.TranslatePathInPlace(configDir, &policy.Rule)
+		}
+	}
 }
 
 func translatePaths(configDir string, org *Organization) {
@@ -7,7 +7,6 @@ package ca
 
 import (
 	"crypto"
-	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -20,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/bccsp/utils"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 )
@@ -32,25 +32,41 @@ type CA struct {
 	OrganizationalUnit string
 	StreetAddress      string
 	PostalCode         string
+	// KeyAlgorithm is the algorithm of the CA key, and of
+	// the keys of the signing identities issued by the CA
+	KeyAlgorithm string
+	// BCCSPOpts describes the BCCSP that holds the CA key, and
+	// the keys of the signing identities issued by the CA.
+	// Nil selects software keys stored in files
+	BCCSPOpts *factory.FactoryOpts
 	//SignKey  *ecdsa.PrivateKey
 	Signer   crypto.Signer
 	SignCert *x509.Certificate
 }
 
 // NewCA creates an instance of CA and saves the signing key pair in
-// baseDir/name
-func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode string) (*CA, error) {
+// baseDir/name. The key pair is generated with keyAlgorithm,
+// an empty keyAlgorithm selects ECDSA. The CA of hybrid identities has
+// an ECDSA key, since certificates are signed classically. When bccspOpts selects an HSM,
+// the private key is generated inside the HSM and only its handle
+// is saved in baseDir.
+func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode, keyAlgorithm string, bccspOpts *factory.FactoryOpts) (*CA, error) {
 
 	var response error
 	var ca *CA
 
+	caKeyAlgorithm := keyAlgorithm
+	if strings.ToUpper(keyAlgorithm) == csp.HYBRID {
+		caKeyAlgorithm = csp.ECDSA
+	}
+
 	err := os.MkdirAll(baseDir, 0755)
 	if err == nil {
-		priv, signer, err := csp.GeneratePrivateKey(baseDir)
+		priv, signer, err := csp.GeneratePrivateKeyWithBCCSP(baseDir, caKeyAlgorithm, bccspOpts)
 		response = err
 		if err == nil {
 			// get public signing certificate
-			ecPubKey, err := csp.GetECPublicKey(priv)
+			pubKey, err := csp.GetPublicKey(priv)
 			response = err
 			if err == nil {
 				template := x509Template()
@@ -69,8 +85,8 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 				template.Subject = subject
 				template.SubjectKeyId = priv.SKI()
 
-				x509Cert, err := genCertificateECDSA(baseDir, name, &template, &template,
-					ecPubKey, signer)
+				x509Cert, err := genCertificate(baseDir, name, &template, &template,
+					pubKey, signer)
 				response = err
 				if err == nil {
 					ca = &CA{
@@ -83,6 +99,8 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 						OrganizationalUnit: orgUnit,
 						StreetAddress:      streetAddress,
 						PostalCode:         postalCode,
+						KeyAlgorithm:       keyAlgorithm,
+						BCCSPOpts:          bccspOpts,
 					}
 				}
 			}
@@ -93,7 +111,7 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 
 // SignCertificate creates a signed certificate based on a built-in template
 // and saves it in baseDir/name
-func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecdsa.PublicKey,
+func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub crypto.PublicKey,
 	ku x509.KeyUsage, eku []x509.ExtKeyUsage) (*x509.Certificate, error) {
 
 	template := x509Template()
@@ -117,7 +135,7 @@ func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecd
 		}
 	}
 
-	cert, err := genCertificateECDSA(baseDir, name, &template, ca.SignCert,
+	cert, err := genCertificate(baseDir, name, &template, ca.SignCert,
 		pub, ca.Signer)
 
 	if err != nil {
@@ -184,10 +202,16 @@ func x509Template() x509.Certificate {
 
 }
 
-// generate a signed X509 certificate using ECDSA
-func genCertificateECDSA(baseDir, name string, template, parent *x509.Certificate, pub *ecdsa.PublicKey,
+// generate a signed X509 certificate for an ECDSA, Ed25519 or hybrid public key.
+// The ML-DSA key of a hybrid key is carried by an extension of the certificate
+func genCertificate(baseDir, name string, template, parent *x509.Certificate, pub crypto.PublicKey,
 	priv interface{}) (*x509.Certificate, error) {
 
+	template, parent, pub, err := withAltPublicKey(template, parent, pub)
+	if err != nil {
+		return nil, err
+	}
+
 	//create the x509 public cert
 	certBytes, err := x509.CreateCertificate(rand.Reader, template, parent, pub, priv)
 	if err != nil {
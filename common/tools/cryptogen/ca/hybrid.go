@@ -0,0 +1,37 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package ca
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+)
+
+// withAltPublicKey returns the template and parent of the certificate of pub,
+// and its subject public key. The ML-DSA key of a hybrid public key is carried
+// by an extension of the certificate, whose subject public key is the ECDSA key.
+func withAltPublicKey(template, parent *x509.Certificate, pub crypto.PublicKey) (*x509.Certificate, *x509.Certificate, crypto.PublicKey, error) {
+	hybridPub, isHybrid := pub.(*hybrid.PublicKey)
+	if !isHybrid {
+		return template, parent, pub, nil
+	}
+
+	ext, err := hybrid.AltPublicKeyExtension(hybridPub.PostQuantum)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	withExt := *template
+	withExt.ExtraExtensions = append(append([]pkix.Extension{}, template.ExtraExtensions...), ext)
+	if parent == template {
+		parent = &withExt
+	}
+	return &withExt, parent, hybridPub.Classical, nil
+}
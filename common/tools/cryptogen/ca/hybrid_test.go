@@ -0,0 +1,47 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package ca_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCAHybrid(t *testing.T) {
+
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.HYBRID, nil)
+	assert.NoError(t, err, "Error generating CA")
+	assert.Equal(t, csp.HYBRID, rootCA.KeyAlgorithm)
+	// the CA itself has an ECDSA key
+	assert.IsType(t, &ecdsa.PublicKey{}, rootCA.SignCert.PublicKey, "Failed to generate an ECDSA CA")
+	assert.False(t, hybrid.HasAltPublicKey(rootCA.SignCert))
+
+	priv, _, err := csp.GeneratePrivateKeyWithAlgorithm(certDir, csp.HYBRID)
+	assert.NoError(t, err, "Failed to generate private key")
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key")
+
+	cert, err := rootCA.SignCertificate(certDir, testName, nil, nil, pubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+	assert.NoError(t, err, "Failed to generate signed certificate")
+	assert.Equal(t, pubKey.(*hybrid.PublicKey).Classical, cert.PublicKey)
+	pqKey, err := hybrid.ParseAltPublicKey(cert)
+	assert.NoError(t, err)
+	assert.True(t, pubKey.(*hybrid.PublicKey).PostQuantum.Equal(pqKey))
+	assert.NoError(t, cert.CheckSignatureFrom(rootCA.SignCert))
+	cleanup(testDir)
+}
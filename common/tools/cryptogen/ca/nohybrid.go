@@ -0,0 +1,19 @@
+// +build !go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package ca
+
+import (
+	"crypto"
+	"crypto/x509"
+)
+
+// withAltPublicKey returns the template, parent and pub unchanged,
+// as there are no hybrid keys without ML-DSA support.
+func withAltPublicKey(template, parent *x509.Certificate, pub crypto.PublicKey) (*x509.Certificate, *x509.Certificate, crypto.PublicKey, error) {
+	return template, parent, pub, nil
+}
@@ -6,44 +6,183 @@ SPDX-License-Identifier: Apache-2.0
 package csp
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/hyperledger/fabric/common/viperutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 )
 
+const (
+	// ECDSA selects ECDSA keys over the P-256 curve. This is the default.
+	ECDSA = "ECDSA"
+	// ED25519 selects Ed25519 keys.
+	ED25519 = "ED25519"
+	// HYBRID selects hybrid keys, pairing an ECDSA key over the P-256 curve
+	// with an ML-DSA key. Hybrid keys are only supported as software keys.
+	HYBRID = "HYBRID"
+
+	// keyHandleSuffix is the suffix of the files that hold the SKI of a key
+	// which lives inside an HSM instead of the key itself.
+	keyHandleSuffix = "_hsm"
+)
+
+var (
+	// bccsps caches the BCCSP instances created for non software providers,
+	// so that an HSM session is opened once per configuration.
+	bccsps     = map[*factory.FactoryOpts]bccsp.BCCSP{}
+	bccspsLock sync.Mutex
+)
+
+// ParseBCCSPConfig parses the BCCSP section of a YAML configuration. The
+// section has the same format as the peer.BCCSP section of core.yaml. If the
+// configuration has no BCCSP section, nil is returned, which selects software
+// keys stored in files.
+func ParseBCCSPConfig(data []byte) (*factory.FactoryOpts, error) {
+	config := struct {
+		BCCSP map[string]interface{} `yaml:"BCCSP"`
+	}{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, errors.Wrap(err, "failed reading BCCSP configuration")
+	}
+	if config.BCCSP == nil {
+		return nil, nil
+	}
+
+	section, err := yaml.Marshal(config.BCCSP)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading BCCSP configuration")
+	}
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(section)); err != nil {
+		return nil, errors.Wrap(err, "failed reading BCCSP configuration")
+	}
+
+	opts := &factory.FactoryOpts{}
+	if err := viperutil.EnhancedExactUnmarshal(v, opts); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling BCCSP configuration")
+	}
+	if opts.ProviderName == "" {
+		return nil, errors.New("the BCCSP configuration does not select a provider")
+	}
+
+	return opts, nil
+}
+
+// KeyGenOpts returns the BCCSP key generation options for the given key
+// algorithm. An empty key algorithm selects ECDSA.
+func KeyGenOpts(keyAlgorithm string, temporary bool) (bccsp.KeyGenOpts, error) {
+	switch strings.ToUpper(keyAlgorithm) {
+	case "", ECDSA:
+		return &bccsp.ECDSAP256KeyGenOpts{Temporary: temporary}, nil
+	case ED25519:
+		return &bccsp.ED25519KeyGenOpts{Temporary: temporary}, nil
+	case HYBRID:
+		return &bccsp.HybridKeyGenOpts{Temporary: temporary}, nil
+	default:
+		return nil, errors.Errorf("unsupported key algorithm [%s], must be one of [%s, %s, %s]", keyAlgorithm, ECDSA, ED25519, HYBRID)
+	}
+}
+
+// getBCCSP returns the BCCSP described by bccspOpts. Software providers, as
+// well as a nil bccspOpts, store their keys in files in keystorePath and
+// support hybrid keys. Other providers keep the keys inside the HSM.
+func getBCCSP(keystorePath string, bccspOpts *factory.FactoryOpts) (bccsp.BCCSP, error) {
+	if bccspOpts == nil || bccspOpts.ProviderName == "SW" {
+		opts := &factory.FactoryOpts{
+			ProviderName: "SW",
+			SwOpts: &factory.SwOpts{
+				HashFamily: "SHA2",
+				SecLevel:   256,
+			},
+		}
+		if bccspOpts != nil && bccspOpts.SwOpts != nil && bccspOpts.SwOpts.HashFamily != "" {
+			opts.SwOpts.HashFamily = bccspOpts.SwOpts.HashFamily
+			opts.SwOpts.SecLevel = bccspOpts.SwOpts.SecLevel
+		}
+		opts.SwOpts.FileKeystore = &factory.FileKeystoreOpts{KeyStorePath: keystorePath}
+		csp, err := factory.GetBCCSPFromOpts(opts)
+		if err != nil {
+			return nil, err
+		}
+		return WithHybridKeys(csp, keystorePath)
+	}
+
+	bccspsLock.Lock()
+	defer bccspsLock.Unlock()
+
+	if csp, exists := bccsps[bccspOpts]; exists {
+		return csp, nil
+	}
+	csp, err := factory.GetBCCSPFromOpts(bccspOpts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed initializing %s BCCSP", bccspOpts.ProviderName)
+	}
+	bccsps[bccspOpts] = csp
+	return csp, nil
+}
+
+// isHSM returns whether the keys of the BCCSP described by bccspOpts live
+// inside an HSM.
+func isHSM(bccspOpts *factory.FactoryOpts) bool {
+	return bccspOpts != nil && bccspOpts.ProviderName != "SW"
+}
+
 // LoadPrivateKey loads a private key from file in keystorePath
 func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
+	return LoadPrivateKeyWithBCCSP(keystorePath, nil)
+}
+
+// LoadPrivateKeyWithBCCSP loads a private key from keystorePath using the
+// BCCSP described by bccspOpts. For keys that live inside an HSM, the key
+// handle file in keystorePath is used to look the key up.
+func LoadPrivateKeyWithBCCSP(keystorePath string, bccspOpts *factory.FactoryOpts) (bccsp.Key, crypto.Signer, error) {
 	var err error
 	var priv bccsp.Key
 	var s crypto.Signer
 
-	opts := &factory.FactoryOpts{
-		ProviderName: "SW",
-		SwOpts: &factory.SwOpts{
-			HashFamily: "SHA2",
-			SecLevel:   256,
-
-			FileKeystore: &factory.FileKeystoreOpts{
-				KeyStorePath: keystorePath,
-			},
-		},
-	}
-
-	csp, err := factory.GetBCCSPFromOpts(opts)
+	csp, err := getBCCSP(keystorePath, bccspOpts)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	walkFunc := func(path string, info os.FileInfo, err error) error {
+		if isHSM(bccspOpts) && strings.HasSuffix(path, keyHandleSuffix) {
+			handle, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			ski, err := hex.DecodeString(strings.TrimSpace(string(handle)))
+			if err != nil {
+				return errors.Wrapf(err, "invalid key handle in %s", path)
+			}
+
+			priv, err = csp.GetKey(ski)
+			if err != nil {
+				return errors.Wrapf(err, "failed getting key %x from the %s BCCSP", ski, bccspOpts.ProviderName)
+			}
+
+			s, err = signer.New(csp, priv)
+			return err
+		}
+
 		if strings.HasSuffix(path, "_sk") {
 			rawKey, err := ioutil.ReadFile(path)
 			if err != nil {
@@ -51,7 +190,13 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 			}
 
 			block, _ := pem.Decode(rawKey)
-			priv, err = csp.KeyImport(block.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+			var importOpts bccsp.KeyImportOpts = &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true}
+			if key, err := utils.DERToPrivateKey(block.Bytes); err == nil {
+				if _, isED25519 := key.(ed25519.PrivateKey); isED25519 {
+					importOpts = &bccsp.ED25519PrivateKeyImportOpts{Temporary: true}
+				}
+			}
+			priv, err = csp.KeyImport(block.Bytes, importOpts)
 			if err != nil {
 				return err
 			}
@@ -74,29 +219,48 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 	return priv, s, err
 }
 
-// GeneratePrivateKey creates a private key and stores it in keystorePath
+// GeneratePrivateKey creates an ECDSA private key and stores it in keystorePath
 func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	crypto.Signer, error) {
+	return GeneratePrivateKeyWithAlgorithm(keystorePath, ECDSA)
+}
 
-	var err error
-	var priv bccsp.Key
-	var s crypto.Signer
+// GeneratePrivateKeyWithAlgorithm creates a private key for the given key
+// algorithm and stores it in keystorePath
+func GeneratePrivateKeyWithAlgorithm(keystorePath, keyAlgorithm string) (bccsp.Key,
+	crypto.Signer, error) {
+	return GeneratePrivateKeyWithBCCSP(keystorePath, keyAlgorithm, nil)
+}
 
-	opts := &factory.FactoryOpts{
-		ProviderName: "SW",
-		SwOpts: &factory.SwOpts{
-			HashFamily: "SHA2",
-			SecLevel:   256,
+// GeneratePrivateKeyWithBCCSP creates a private key for the given key
+// algorithm using the BCCSP described by bccspOpts. A nil bccspOpts stores
+// the key in keystorePath, while an HSM keeps the key and only a key handle
+// file is written to keystorePath.
+func GeneratePrivateKeyWithBCCSP(keystorePath, keyAlgorithm string, bccspOpts *factory.FactoryOpts) (bccsp.Key,
+	crypto.Signer, error) {
 
-			FileKeystore: &factory.FileKeystoreOpts{
-				KeyStorePath: keystorePath,
-			},
-		},
+	keyGenOpts, err := KeyGenOpts(keyAlgorithm, false)
+	if err != nil {
+		return nil, nil, err
 	}
-	csp, err := factory.GetBCCSPFromOpts(opts)
+	return GenerateKey(keystorePath, keyGenOpts, bccspOpts)
+}
+
+// GenerateKey creates a private key with keyGenOpts using the BCCSP described
+// by bccspOpts. See GeneratePrivateKeyWithBCCSP for where the key is kept.
+func GenerateKey(keystorePath string, keyGenOpts bccsp.KeyGenOpts, bccspOpts *factory.FactoryOpts) (bccsp.Key,
+	crypto.Signer, error) {
+
+	var priv bccsp.Key
+	var s crypto.Signer
+
+	csp, err := getBCCSP(keystorePath, bccspOpts)
 	if err == nil {
 		// generate a key
-		priv, err = csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+		priv, err = csp.KeyGen(keyGenOpts)
+		if err == nil && isHSM(bccspOpts) {
+			err = writeKeyHandle(keystorePath, priv)
+		}
 		if err == nil {
 			// create a crypto.Signer
 			s, err = signer.New(csp, priv)
@@ -105,6 +269,41 @@ func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	return priv, s, err
 }
 
+// writeKeyHandle writes the SKI of a key kept inside an HSM to keystorePath.
+func writeKeyHandle(keystorePath string, priv bccsp.Key) error {
+	if err := os.MkdirAll(keystorePath, 0755); err != nil {
+		return errors.Wrapf(err, "failed creating directory %s", keystorePath)
+	}
+	ski := hex.EncodeToString(priv.SKI())
+	path := filepath.Join(keystorePath, ski+keyHandleSuffix)
+	if err := ioutil.WriteFile(path, []byte(ski), 0600); err != nil {
+		return errors.Wrapf(err, "failed writing key handle %s", path)
+	}
+	return nil
+}
+
+// GetPublicKey returns the public key of priv as a crypto.PublicKey.
+// The public key of a hybrid key is returned as a *hybrid.PublicKey.
+func GetPublicKey(priv bccsp.Key) (crypto.PublicKey, error) {
+
+	// get the public key
+	pubKey, err := priv.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	// marshal to bytes
+	pubKeyBytes, err := pubKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	// unmarshal using pkix
+	classical, err := x509.ParsePKIXPublicKey(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return hybridPublicKey(priv, classical), nil
+}
+
 func GetECPublicKey(priv bccsp.Key) (*ecdsa.PublicKey, error) {
 
 	// get the public key
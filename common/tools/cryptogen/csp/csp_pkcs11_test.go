@@ -0,0 +1,71 @@
+// +build pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package csp_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/pkcs11"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
+	"github.com/stretchr/testify/assert"
+)
+
+func hsmOpts() *factory.FactoryOpts {
+	lib, pin, label := pkcs11.FindPKCS11Lib()
+	return &factory.FactoryOpts{
+		ProviderName: "PKCS11",
+		Pkcs11Opts: &pkcs11.PKCS11Opts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+			Library:    lib,
+			Pin:        pin,
+			Label:      label,
+		},
+	}
+}
+
+func TestGeneratePrivateKeyWithHSM(t *testing.T) {
+	defer cleanup(testDir)
+	bccspOpts := hsmOpts()
+
+	priv, signer, err := csp.GeneratePrivateKeyWithBCCSP(testDir, csp.ECDSA, bccspOpts)
+	assert.NoError(t, err, "Failed to generate private key")
+
+	// only the key handle is written
+	ski := hex.EncodeToString(priv.SKI())
+	assert.False(t, checkForFile(filepath.Join(testDir, ski+"_sk")))
+	handle, err := ioutil.ReadFile(filepath.Join(testDir, ski+"_hsm"))
+	assert.NoError(t, err, "Expected to find key handle file")
+	assert.Equal(t, ski, string(handle))
+
+	loadedPriv, loadedSigner, err := csp.LoadPrivateKeyWithBCCSP(testDir, bccspOpts)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+
+	digest := sha256.Sum256([]byte("hello"))
+	sig, err := loadedSigner.Sign(rand.Reader, digest[:], nil)
+	assert.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(signer.Public().(*ecdsa.PublicKey), digest[:], sig))
+}
+
+func TestGenerateKeyP384WithHSM(t *testing.T) {
+	defer cleanup(testDir)
+
+	priv, signer, err := csp.GenerateKey(testDir, &bccsp.ECDSAP384KeyGenOpts{}, hsmOpts())
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.True(t, checkForFile(filepath.Join(testDir, hex.EncodeToString(priv.SKI())+"_hsm")))
+	assert.Equal(t, 384, signer.Public().(*ecdsa.PublicKey).Curve.Params().BitSize)
+}
@@ -7,6 +7,7 @@ package csp_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"encoding/hex"
 	"errors"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,6 +75,114 @@ func TestGeneratePrivateKey(t *testing.T) {
 
 }
 
+func TestGeneratePrivateKeyWithAlgorithm(t *testing.T) {
+
+	priv, signer, err := csp.GeneratePrivateKeyWithAlgorithm(testDir, csp.ED25519)
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.Equal(t, true, priv.Private(), "Failed to return private key")
+	assert.IsType(t, ed25519.PublicKey{}, signer.Public(), "Failed to return an Ed25519 signer")
+
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key from private key")
+	assert.Equal(t, signer.Public(), pubKey)
+
+	// the key can be loaded back from the keystore
+	loadedPriv, loadedSigner, err := csp.LoadPrivateKey(testDir)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+	assert.Equal(t, signer.Public(), loadedSigner.Public())
+	cleanup(testDir)
+
+	_, _, err = csp.GeneratePrivateKeyWithAlgorithm(testDir, "DSA")
+	assert.EqualError(t, err, "unsupported key algorithm [DSA], must be one of [ECDSA, ED25519, HYBRID]")
+	cleanup(testDir)
+}
+
+func TestGeneratePrivateKeyWithBCCSP(t *testing.T) {
+	bccspOpts := &factory.FactoryOpts{
+		ProviderName: "SW",
+		SwOpts: &factory.SwOpts{
+			HashFamily: "SHA2",
+			SecLevel:   256,
+		},
+	}
+
+	// software keys are stored in keystorePath
+	priv, signer, err := csp.GeneratePrivateKeyWithBCCSP(testDir, csp.ECDSA, bccspOpts)
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.NotNil(t, signer, "Should have returned a crypto.Signer")
+	pkFile := filepath.Join(testDir, hex.EncodeToString(priv.SKI())+"_sk")
+	assert.Equal(t, true, checkForFile(pkFile),
+		"Expected to find private key file")
+
+	loadedPriv, loadedSigner, err := csp.LoadPrivateKeyWithBCCSP(testDir, bccspOpts)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+	assert.Equal(t, signer.Public(), loadedSigner.Public())
+	cleanup(testDir)
+
+	_, _, err = csp.GeneratePrivateKeyWithBCCSP(testDir, csp.ECDSA, &factory.FactoryOpts{ProviderName: "FOO"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed initializing FOO BCCSP")
+	cleanup(testDir)
+}
+
+func TestParseBCCSPConfig(t *testing.T) {
+	opts, err := csp.ParseBCCSPConfig([]byte("PeerOrgs:\n  - Name: Org1\n"))
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+
+	opts, err = csp.ParseBCCSPConfig([]byte(`
+PeerOrgs:
+  - Name: Org1
+BCCSP:
+  Default: SW
+  SW:
+    Hash: SHA3
+    Security: 384
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, &factory.FactoryOpts{
+		ProviderName: "SW",
+		SwOpts: &factory.SwOpts{
+			HashFamily: "SHA3",
+			SecLevel:   384,
+		},
+	}, opts)
+
+	_, err = csp.ParseBCCSPConfig([]byte("BCCSP:\n  SW:\n    Hash: SHA2\n"))
+	assert.EqualError(t, err, "the BCCSP configuration does not select a provider")
+
+	_, err = csp.ParseBCCSPConfig([]byte("BCCSP:\n  Default: SW\n  Unknown: true\n"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed unmarshaling BCCSP configuration")
+
+	_, err = csp.ParseBCCSPConfig([]byte("BCCSP: ["))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed reading BCCSP configuration")
+}
+
+func TestKeyGenOpts(t *testing.T) {
+	opts, err := csp.KeyGenOpts("", true)
+	assert.NoError(t, err)
+	assert.Equal(t, &bccsp.ECDSAP256KeyGenOpts{Temporary: true}, opts)
+
+	opts, err = csp.KeyGenOpts("ecdsa", false)
+	assert.NoError(t, err)
+	assert.Equal(t, &bccsp.ECDSAP256KeyGenOpts{Temporary: false}, opts)
+
+	opts, err = csp.KeyGenOpts("Ed25519", true)
+	assert.NoError(t, err)
+	assert.Equal(t, &bccsp.ED25519KeyGenOpts{Temporary: true}, opts)
+
+	opts, err = csp.KeyGenOpts("hybrid", false)
+	assert.NoError(t, err)
+	assert.Equal(t, &bccsp.HybridKeyGenOpts{Temporary: false}, opts)
+
+	_, err = csp.KeyGenOpts("RSA", true)
+	assert.Error(t, err)
+}
+
 func TestGetECPublicKey(t *testing.T) {
 
 	priv, _, err := csp.GeneratePrivateKey(testDir)
@@ -0,0 +1,31 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package csp
+
+import (
+	"crypto"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+)
+
+// WithHybridKeys returns a BCCSP supporting hybrid keys on top of csp,
+// which stores the ML-DSA keys in keystorePath.
+func WithHybridKeys(csp bccsp.BCCSP, keystorePath string) (bccsp.BCCSP, error) {
+	return hybrid.New(csp, keystorePath)
+}
+
+// hybridPublicKey returns the public key of priv as a *hybrid.PublicKey
+// if priv is a hybrid key, and classical otherwise.
+func hybridPublicKey(priv bccsp.Key, classical crypto.PublicKey) crypto.PublicKey {
+	postQuantum, err := hybrid.PostQuantumPublicKey(priv)
+	if err != nil {
+		return classical
+	}
+	return &hybrid.PublicKey{Classical: classical, PostQuantum: postQuantum}
+}
@@ -0,0 +1,40 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package csp_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratePrivateKeyHybrid(t *testing.T) {
+	defer cleanup(testDir)
+
+	priv, signer, err := csp.GeneratePrivateKeyWithAlgorithm(testDir, csp.HYBRID)
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.IsType(t, &ecdsa.PublicKey{}, signer.Public(), "Failed to return an ECDSA signer")
+
+	// the public key carries both the ECDSA and the ML-DSA keys
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key from private key")
+	hybridPubKey, ok := pubKey.(*hybrid.PublicKey)
+	assert.True(t, ok, "Failed to return a hybrid public key")
+	assert.Equal(t, signer.Public(), hybridPubKey.Classical)
+
+	// the key is loaded back from the keystore with its ML-DSA key
+	loadedPriv, _, err := csp.LoadPrivateKey(testDir)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+	loadedPubKey, err := csp.GetPublicKey(loadedPriv)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, loadedPubKey)
+}
@@ -0,0 +1,25 @@
+// +build !go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package csp
+
+import (
+	"crypto"
+
+	"github.com/hyperledger/fabric/bccsp"
+)
+
+// WithHybridKeys returns csp, as hybrid keys require ML-DSA,
+// which is not supported by this version of Go.
+func WithHybridKeys(csp bccsp.BCCSP, keystorePath string) (bccsp.BCCSP, error) {
+	return csp, nil
+}
+
+// hybridPublicKey returns classical, as there are no hybrid keys.
+func hybridPublicKey(priv bccsp.Key, classical crypto.PublicKey) crypto.PublicKey {
+	return classical
+}
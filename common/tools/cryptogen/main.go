@@ -7,17 +7,22 @@ package main
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"text/template"
 
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/threshold"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/metadata"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
+	"github.com/hyperledger/fabric/idemix"
 	"gopkg.in/alecthomas/kingpin.v2"
 	"gopkg.in/yaml.v2"
 )
@@ -65,18 +70,23 @@ type UsersSpec struct {
 }
 
 type OrgSpec struct {
-	Name          string       `yaml:"Name"`
-	Domain        string       `yaml:"Domain"`
-	EnableNodeOUs bool         `yaml:"EnableNodeOUs"`
-	CA            NodeSpec     `yaml:"CA"`
-	Template      NodeTemplate `yaml:"Template"`
-	Specs         []NodeSpec   `yaml:"Specs"`
-	Users         UsersSpec    `yaml:"Users"`
+	Name           string       `yaml:"Name"`
+	Domain         string       `yaml:"Domain"`
+	EnableNodeOUs  bool         `yaml:"EnableNodeOUs"`
+	EnableAdminOUs bool         `yaml:"EnableAdminOUs"`
+	KeyAlgorithm   string       `yaml:"KeyAlgorithm"`
+	CA             NodeSpec     `yaml:"CA"`
+	Template       NodeTemplate `yaml:"Template"`
+	Specs          []NodeSpec   `yaml:"Specs"`
+	Users          UsersSpec    `yaml:"Users"`
 }
 
 type Config struct {
 	OrdererOrgs []OrgSpec `yaml:"OrdererOrgs"`
 	PeerOrgs    []OrgSpec `yaml:"PeerOrgs"`
+	// BCCSP is parsed from the BCCSP section of the configuration,
+	// nil selects software keys stored in files
+	BCCSP *factory.FactoryOpts `yaml:"-"`
 }
 
 var defaultConfig = `
@@ -107,6 +117,27 @@ PeerOrgs:
     Domain: org1.example.com
     EnableNodeOUs: false
 
+    # ---------------------------------------------------------------------------
+    # "EnableAdminOUs"
+    # ---------------------------------------------------------------------------
+    # Tells apart admins and orderers by OU, in addition to clients and peers,
+    # and leaves the admincerts folders empty. Unlike EnableNodeOUs, it applies
+    # to orderer organizations too. The MSPs generated this way require the
+    # V1_4 channel capability, and local MSPs of version V1_4.
+    # ---------------------------------------------------------------------------
+    # EnableAdminOUs: false
+
+    # ---------------------------------------------------------------------------
+    # "KeyAlgorithm"
+    # ---------------------------------------------------------------------------
+    # The algorithm of the keys of the signing CA and of the identities it
+    # issues (nodes, admins and users). Either ECDSA (P-256, the default),
+    # ED25519 or HYBRID. HYBRID identities pair an ECDSA key with an ML-DSA
+    # key carried by a certificate extension, and are issued by an ECDSA CA.
+    # TLS keys are always ECDSA.
+    # ---------------------------------------------------------------------------
+    # KeyAlgorithm: ECDSA
+
     # ---------------------------------------------------------------------------
     # "CA"
     # ---------------------------------------------------------------------------
@@ -194,6 +225,25 @@ PeerOrgs:
       Count: 1
     Users:
       Count: 1
+
+# ---------------------------------------------------------------------------
+# "BCCSP"
+# ---------------------------------------------------------------------------
+# Uncomment this section to generate the keys of the CAs and of the signing
+# identities inside an HSM. Only the certificates and, in place of the private
+# keys, key handle files holding the SKIs of the keys are written. TLS keys
+# of nodes and users are always software keys stored in files. The section
+# has the same format as the peer.BCCSP section of core.yaml, and the PKCS11
+# provider requires cryptogen to be built with the pkcs11 build tag.
+# ---------------------------------------------------------------------------
+# BCCSP:
+#   Default: PKCS11
+#   PKCS11:
+#     Library: /usr/lib/softhsm/libsofthsm2.so
+#     Label: ForFabric
+#     Pin: 98765432
+#     Hash: SHA2
+#     Security: 256
 `
 
 //command line flags
@@ -210,6 +260,11 @@ var (
 	ext           = app.Command("extend", "Extend existing network")
 	inputDir      = ext.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
 	extConfigFile = ext.Flag("config", "The configuration template to use").File()
+
+	thresholdKeys   = app.Command("thresholdkeys", "Generate the key shares of a threshold signing key for orderers")
+	thresholdOutput = thresholdKeys.Flag("output", "The output directory in which to place the public key and key shares").Default("crypto-config/threshold").String()
+	thresholdShares = thresholdKeys.Flag("shares", "The number of key shares, one per orderer").Required().Int()
+	thresholdValue  = thresholdKeys.Flag("threshold", "The number of key shares needed to sign").Required().Int()
 )
 
 func main() {
@@ -223,6 +278,13 @@ func main() {
 	case ext.FullCommand():
 		extend()
 
+		// "thresholdkeys" command
+	case thresholdKeys.FullCommand():
+		if err := generateThresholdKeys(*thresholdOutput, *thresholdValue, *thresholdShares); err != nil {
+			fmt.Printf("Error generating threshold keys: %s\n", err)
+			os.Exit(-1)
+		}
+
 		// "showtemplate" command
 	case showtemplate.FullCommand():
 		fmt.Print(defaultConfig)
@@ -262,6 +324,11 @@ func getConfig() (*Config, error) {
 		return nil, fmt.Errorf("Error Unmarshaling YAML: %s", err)
 	}
 
+	config.BCCSP, err = csp.ParseBCCSPConfig([]byte(configData))
+	if err != nil {
+		return nil, fmt.Errorf("Error processing BCCSP configuration: %s", err)
+	}
+
 	return config, nil
 }
 
@@ -278,7 +345,7 @@ func extend() {
 			fmt.Printf("Error processing peer configuration: %s", err)
 			os.Exit(-1)
 		}
-		extendPeerOrg(orgSpec)
+		extendPeerOrg(orgSpec, config.BCCSP)
 	}
 
 	for _, orgSpec := range config.OrdererOrgs {
@@ -287,16 +354,16 @@ func extend() {
 			fmt.Printf("Error processing orderer configuration: %s", err)
 			os.Exit(-1)
 		}
-		extendOrdererOrg(orgSpec)
+		extendOrdererOrg(orgSpec, config.BCCSP)
 	}
 
 }
 
-func extendPeerOrg(orgSpec OrgSpec) {
+func extendPeerOrg(orgSpec OrgSpec, bccspOpts *factory.FactoryOpts) {
 	orgName := orgSpec.Domain
 	orgDir := filepath.Join(*inputDir, "peerOrganizations", orgName)
 	if _, err := os.Stat(orgDir); os.IsNotExist(err) {
-		generatePeerOrg(*inputDir, orgSpec)
+		generatePeerOrg(*inputDir, orgSpec, bccspOpts)
 		return
 	}
 
@@ -305,22 +372,26 @@ func extendPeerOrg(orgSpec OrgSpec) {
 	caDir := filepath.Join(orgDir, "ca")
 	tlscaDir := filepath.Join(orgDir, "tlsca")
 
-	signCA := getCA(caDir, orgSpec, orgSpec.CA.CommonName)
-	tlsCA := getCA(tlscaDir, orgSpec, "tls"+orgSpec.CA.CommonName)
+	signCA := getCA(caDir, orgSpec, orgSpec.CA.CommonName, bccspOpts)
+	tlsCA := getCA(tlscaDir, orgSpec, "tls"+orgSpec.CA.CommonName, bccspOpts)
 
-	generateNodes(peersDir, orgSpec.Specs, signCA, tlsCA, msp.PEER, orgSpec.EnableNodeOUs)
+	nodeOUs := nodeOUsMode(orgSpec, false)
+	generateNodes(peersDir, orgSpec.Specs, signCA, tlsCA, msp.PEER, nodeOUs)
 
 	adminUser := NodeSpec{
 		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
 	}
-	// copy the admin cert to each of the org's peer's MSP admincerts
-	for _, spec := range orgSpec.Specs {
-		err := copyAdminCert(usersDir,
-			filepath.Join(peersDir, spec.CommonName, "msp", "admincerts"), adminUser.CommonName)
-		if err != nil {
-			fmt.Printf("Error copying admin cert for org %s peer %s:\n%v\n",
-				orgName, spec.CommonName, err)
-			os.Exit(1)
+	// copy the admin cert to each of the org's peer's MSP admincerts,
+	// unless admins are told apart by OU
+	if nodeOUs != msp.AllNodeOUs {
+		for _, spec := range orgSpec.Specs {
+			err := copyAdminCert(usersDir,
+				filepath.Join(peersDir, spec.CommonName, "msp", "admincerts"), adminUser.CommonName)
+			if err != nil {
+				fmt.Printf("Error copying admin cert for org %s peer %s:\n%v\n",
+					orgName, spec.CommonName, err)
+				os.Exit(1)
+			}
 		}
 	}
 
@@ -334,10 +405,10 @@ func extendPeerOrg(orgSpec OrgSpec) {
 		users = append(users, user)
 	}
 
-	generateNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, orgSpec.EnableNodeOUs)
+	generateNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, nodeOUs)
 }
 
-func extendOrdererOrg(orgSpec OrgSpec) {
+func extendOrdererOrg(orgSpec OrgSpec, bccspOpts *factory.FactoryOpts) {
 	orgName := orgSpec.Domain
 
 	orgDir := filepath.Join(*inputDir, "ordererOrganizations", orgName)
@@ -346,19 +417,25 @@ func extendOrdererOrg(orgSpec OrgSpec) {
 	tlscaDir := filepath.Join(orgDir, "tlsca")
 	orderersDir := filepath.Join(orgDir, "orderers")
 	if _, err := os.Stat(orgDir); os.IsNotExist(err) {
-		generateOrdererOrg(*inputDir, orgSpec)
+		generateOrdererOrg(*inputDir, orgSpec, bccspOpts)
 		return
 	}
 
-	signCA := getCA(caDir, orgSpec, orgSpec.CA.CommonName)
-	tlsCA := getCA(tlscaDir, orgSpec, "tls"+orgSpec.CA.CommonName)
+	signCA := getCA(caDir, orgSpec, orgSpec.CA.CommonName, bccspOpts)
+	tlsCA := getCA(tlscaDir, orgSpec, "tls"+orgSpec.CA.CommonName, bccspOpts)
 
-	generateNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, false)
+	nodeOUs := nodeOUsMode(orgSpec, true)
+	generateNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, nodeOUs)
 
 	adminUser := NodeSpec{
 		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
 	}
 
+	// admins are told apart by OU, no admin cert to copy
+	if nodeOUs == msp.AllNodeOUs {
+		return
+	}
+
 	for _, spec := range orgSpec.Specs {
 		err := copyAdminCert(usersDir,
 			filepath.Join(orderersDir, spec.CommonName, "msp", "admincerts"), adminUser.CommonName)
@@ -384,7 +461,7 @@ func generate() {
 			fmt.Printf("Error processing peer configuration: %s", err)
 			os.Exit(-1)
 		}
-		generatePeerOrg(*outputDir, orgSpec)
+		generatePeerOrg(*outputDir, orgSpec, config.BCCSP)
 	}
 
 	for _, orgSpec := range config.OrdererOrgs {
@@ -393,7 +470,7 @@ func generate() {
 			fmt.Printf("Error processing orderer configuration: %s", err)
 			os.Exit(-1)
 		}
-		generateOrdererOrg(*outputDir, orgSpec)
+		generateOrdererOrg(*outputDir, orgSpec, config.BCCSP)
 	}
 }
 
@@ -488,6 +565,11 @@ func renderOrgSpec(orgSpec *OrgSpec, prefix string) error {
 		orgSpec.Specs[idx] = spec
 	}
 
+	// Make sure that the key algorithm is supported
+	if _, err := csp.KeyGenOpts(orgSpec.KeyAlgorithm, true); err != nil {
+		return err
+	}
+
 	// Process the CA node-spec in the same manner
 	if len(orgSpec.CA.Hostname) == 0 {
 		orgSpec.CA.Hostname = "ca"
@@ -500,7 +582,7 @@ func renderOrgSpec(orgSpec *OrgSpec, prefix string) error {
 	return nil
 }
 
-func generatePeerOrg(baseDir string, orgSpec OrgSpec) {
+func generatePeerOrg(baseDir string, orgSpec OrgSpec, bccspOpts *factory.FactoryOpts) {
 
 	orgName := orgSpec.Domain
 
@@ -514,25 +596,26 @@ func generatePeerOrg(baseDir string, orgSpec OrgSpec) {
 	usersDir := filepath.Join(orgDir, "users")
 	adminCertsDir := filepath.Join(mspDir, "admincerts")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm, bccspOpts)
 	if err != nil {
 		fmt.Printf("Error generating signCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, csp.ECDSA, bccspOpts)
 	if err != nil {
 		fmt.Printf("Error generating tlsCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 
-	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, orgSpec.EnableNodeOUs)
+	nodeOUs := nodeOUsMode(orgSpec, false)
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, nodeOUs)
 	if err != nil {
 		fmt.Printf("Error generating MSP for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 
-	generateNodes(peersDir, orgSpec.Specs, signCA, tlsCA, msp.PEER, orgSpec.EnableNodeOUs)
+	generateNodes(peersDir, orgSpec.Specs, signCA, tlsCA, msp.PEER, nodeOUs)
 
 	// TODO: add ability to specify usernames
 	users := []NodeSpec{}
@@ -543,13 +626,18 @@ func generatePeerOrg(baseDir string, orgSpec OrgSpec) {
 
 		users = append(users, user)
 	}
+	generateNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, nodeOUs)
+
 	// add an admin user
 	adminUser := NodeSpec{
 		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
 	}
+	generateNodes(usersDir, []NodeSpec{adminUser}, signCA, tlsCA, msp.ADMIN, nodeOUs)
 
-	users = append(users, adminUser)
-	generateNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, orgSpec.EnableNodeOUs)
+	// admins are told apart by OU, no admin cert to copy
+	if nodeOUs == msp.AllNodeOUs {
+		return
+	}
 
 	// copy the admin cert to the org's MSP admincerts
 	err = copyAdminCert(usersDir, adminCertsDir, adminUser.CommonName)
@@ -596,7 +684,20 @@ func copyAdminCert(usersDir, adminCertsDir, adminUserName string) error {
 
 }
 
-func generateNodes(baseDir string, nodes []NodeSpec, signCA *ca.CA, tlsCA *ca.CA, nodeType int, nodeOUs bool) {
+// nodeOUsMode returns how the MSPs of the organization tell apart their
+// identities by OU. EnableNodeOUs is ignored for orderer organizations.
+func nodeOUsMode(orgSpec OrgSpec, ordererOrg bool) msp.NodeOUsMode {
+	switch {
+	case orgSpec.EnableAdminOUs:
+		return msp.AllNodeOUs
+	case orgSpec.EnableNodeOUs && !ordererOrg:
+		return msp.ClientPeerNodeOUs
+	default:
+		return msp.NoNodeOUs
+	}
+}
+
+func generateNodes(baseDir string, nodes []NodeSpec, signCA *ca.CA, tlsCA *ca.CA, nodeType int, nodeOUs msp.NodeOUsMode) {
 
 	for _, node := range nodes {
 		nodeDir := filepath.Join(baseDir, node.CommonName)
@@ -610,7 +711,7 @@ func generateNodes(baseDir string, nodes []NodeSpec, signCA *ca.CA, tlsCA *ca.CA
 	}
 }
 
-func generateOrdererOrg(baseDir string, orgSpec OrgSpec) {
+func generateOrdererOrg(baseDir string, orgSpec OrgSpec, bccspOpts *factory.FactoryOpts) {
 
 	orgName := orgSpec.Domain
 
@@ -623,25 +724,26 @@ func generateOrdererOrg(baseDir string, orgSpec OrgSpec) {
 	usersDir := filepath.Join(orgDir, "users")
 	adminCertsDir := filepath.Join(mspDir, "admincerts")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm, bccspOpts)
 	if err != nil {
 		fmt.Printf("Error generating signCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, csp.ECDSA, bccspOpts)
 	if err != nil {
 		fmt.Printf("Error generating tlsCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 
-	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, false)
+	nodeOUs := nodeOUsMode(orgSpec, true)
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, nodeOUs)
 	if err != nil {
 		fmt.Printf("Error generating MSP for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 
-	generateNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, false)
+	generateNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, nodeOUs)
 
 	adminUser := NodeSpec{
 		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
@@ -651,7 +753,12 @@ func generateOrdererOrg(baseDir string, orgSpec OrgSpec) {
 	users := []NodeSpec{}
 	// add an admin user
 	users = append(users, adminUser)
-	generateNodes(usersDir, users, signCA, tlsCA, msp.CLIENT, false)
+	generateNodes(usersDir, users, signCA, tlsCA, msp.ADMIN, nodeOUs)
+
+	// admins are told apart by OU, no admin cert to copy
+	if nodeOUs == msp.AllNodeOUs {
+		return
+	}
 
 	// copy the admin cert to the org's MSP admincerts
 	err = copyAdminCert(usersDir, adminCertsDir, adminUser.CommonName)
@@ -697,10 +804,22 @@ func printVersion() {
 	fmt.Println(metadata.GetVersionInfo())
 }
 
-func getCA(caDir string, spec OrgSpec, name string) *ca.CA {
-	_, signer, _ := csp.LoadPrivateKey(caDir)
+func getCA(caDir string, spec OrgSpec, name string, bccspOpts *factory.FactoryOpts) *ca.CA {
+	_, signer, _ := csp.LoadPrivateKeyWithBCCSP(caDir, bccspOpts)
 	cert, _ := ca.LoadCertificateECDSA(caDir)
 
+	// keep issuing identities with the algorithm of the existing CA.
+	// Since the CA of hybrid identities has an ECDSA key, hybrid
+	// identities are issued as long as the configuration asks for them
+	keyAlgorithm := csp.ECDSA
+	if cert != nil {
+		if _, isED25519 := cert.PublicKey.(ed25519.PublicKey); isED25519 {
+			keyAlgorithm = csp.ED25519
+		} else if strings.ToUpper(spec.KeyAlgorithm) == csp.HYBRID {
+			keyAlgorithm = csp.HYBRID
+		}
+	}
+
 	return &ca.CA{
 		Name:               name,
 		Signer:             signer,
@@ -711,5 +830,38 @@ func getCA(caDir string, spec OrgSpec, name string) *ca.CA {
 		OrganizationalUnit: spec.CA.OrganizationalUnit,
 		StreetAddress:      spec.CA.StreetAddress,
 		PostalCode:         spec.CA.PostalCode,
+		KeyAlgorithm:       keyAlgorithm,
+		BCCSPOpts:          bccspOpts,
+	}
+}
+
+// generateThresholdKeys deals a threshold signing key in shares key shares,
+// threshold of which are needed to sign, and writes the group public key
+// and the key shares to outputDir
+func generateThresholdKeys(outputDir string, thresholdValue, shares int) error {
+	rng, err := idemix.GetRand()
+	if err != nil {
+		return err
+	}
+	pk, keyShares, err := threshold.Deal(thresholdValue, shares, rng)
+	if err != nil {
+		return err
 	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputDir, "public_key.pem"), threshold.PublicKeyToPEM(pk), 0644); err != nil {
+		return err
+	}
+	for _, share := range keyShares {
+		raw, err := threshold.KeyShareToPEM(share)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(outputDir, fmt.Sprintf("share-%d.pem", share.Index)), raw, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
 }
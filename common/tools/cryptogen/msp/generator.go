@@ -11,6 +11,7 @@ import (
 	"encoding/pem"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
@@ -25,20 +26,47 @@ const (
 	CLIENT = iota
 	ORDERER
 	PEER
+	ADMIN
 )
 
 const (
-	CLIENTOU = "client"
-	PEEROU   = "peer"
+	CLIENTOU  = "client"
+	PEEROU    = "peer"
+	ADMINOU   = "admin"
+	ORDEREROU = "orderer"
 )
 
 var nodeOUMap = map[int]string{
-	CLIENT: CLIENTOU,
-	PEER:   PEEROU,
+	CLIENT:  CLIENTOU,
+	PEER:    PEEROU,
+	ADMIN:   ADMINOU,
+	ORDERER: ORDEREROU,
+}
+
+// NodeOUsMode tells which identities of the generated MSPs are told apart by OU
+type NodeOUsMode int
+
+const (
+	// NoNodeOUs does not tell apart identities by OU
+	NoNodeOUs NodeOUsMode = iota
+	// ClientPeerNodeOUs tells apart clients and peers by OU.
+	// Admins are clients listed in admincerts
+	ClientPeerNodeOUs
+	// AllNodeOUs tells apart clients, peers, admins and orderers by OU,
+	// and leaves admincerts empty
+	AllNodeOUs
+)
+
+// nodeOU returns the OU of the identities of the given type
+func (mode NodeOUsMode) nodeOU(nodeType int) string {
+	if mode == ClientPeerNodeOUs && nodeType == ADMIN {
+		return CLIENTOU
+	}
+	return nodeOUMap[nodeType]
 }
 
 func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
-	tlsCA *ca.CA, nodeType int, nodeOUs bool) error {
+	tlsCA *ca.CA, nodeType int, nodeOUs NodeOUsMode) error {
 
 	// create folder structure
 	mspDir := filepath.Join(baseDir, "msp")
@@ -60,24 +88,24 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	// get keystore path
 	keystore := filepath.Join(mspDir, "keystore")
 
-	// generate private key
-	priv, _, err := csp.GeneratePrivateKey(keystore)
+	// generate private key, with the same algorithm and BCCSP as the signing CA
+	priv, _, err := csp.GeneratePrivateKeyWithBCCSP(keystore, signCA.KeyAlgorithm, signCA.BCCSPOpts)
 	if err != nil {
 		return err
 	}
 
 	// get public key
-	ecPubKey, err := csp.GetECPublicKey(priv)
+	pubKey, err := csp.GetPublicKey(priv)
 	if err != nil {
 		return err
 	}
 	// generate X509 certificate using signing CA
 	var ous []string
-	if nodeOUs {
-		ous = []string{nodeOUMap[nodeType]}
+	if nodeOUs != NoNodeOUs {
+		ous = []string{nodeOUs.nodeOU(nodeType)}
 	}
 	cert, err := signCA.SignCertificate(filepath.Join(mspDir, "signcerts"),
-		name, ous, nil, ecPubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+		name, ous, nil, pubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
 	if err != nil {
 		return err
 	}
@@ -96,27 +124,34 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	}
 
 	// generate config.yaml if required
-	if nodeOUs && nodeType == PEER {
-		exportConfig(mspDir, "cacerts/"+x509Filename(signCA.Name), true)
+	if !((nodeOUs == ClientPeerNodeOUs && nodeType == PEER) || nodeOUs == AllNodeOUs) {
+		nodeOUs = NoNodeOUs
+	}
+	if nodeOUs != NoNodeOUs || isHybrid(signCA) {
+		exportConfig(mspDir, "cacerts/"+x509Filename(signCA.Name), nodeOUs, isHybrid(signCA))
 	}
 
-	// the signing identity goes into admincerts.
+	// the signing identity goes into admincerts, unless
+	// admins are told apart by OU.
 	// This means that the signing identity
 	// of this MSP is also an admin of this MSP
 	// NOTE: the admincerts folder is going to be
 	// cleared up anyway by copyAdminCert, but
 	// we leave a valid admin for now for the sake
 	// of unit tests
-	err = x509Export(filepath.Join(mspDir, "admincerts", x509Filename(name)), cert)
-	if err != nil {
-		return err
+	if nodeOUs != AllNodeOUs {
+		err = x509Export(filepath.Join(mspDir, "admincerts", x509Filename(name)), cert)
+		if err != nil {
+			return err
+		}
 	}
 
 	/*
 		Generate the TLS artifacts in the TLS folder
 	*/
 
-	// generate private key
+	// generate private key. The TLS key is always a software key stored
+	// in a file, since the TLS stack of the nodes cannot use an HSM
 	tlsPrivKey, _, err := csp.GeneratePrivateKey(tlsDir)
 	if err != nil {
 		return err
@@ -140,7 +175,7 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 
 	// rename the generated TLS X509 cert
 	tlsFilePrefix := "server"
-	if nodeType == CLIENT {
+	if nodeType == CLIENT || nodeType == ADMIN {
 		tlsFilePrefix = "client"
 	}
 	err = os.Rename(filepath.Join(tlsDir, x509Filename(name)),
@@ -157,7 +192,7 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	return nil
 }
 
-func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs bool) error {
+func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs NodeOUsMode) error {
 
 	// create folder structure and write artifacts to proper locations
 	err := createFolderStructure(baseDir, false)
@@ -175,8 +210,13 @@ func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs b
 	}
 
 	// generate config.yaml if required
-	if nodeOUs {
-		exportConfig(baseDir, "cacerts/"+x509Filename(signCA.Name), true)
+	if nodeOUs != NoNodeOUs || isHybrid(signCA) {
+		exportConfig(baseDir, "cacerts/"+x509Filename(signCA.Name), nodeOUs, isHybrid(signCA))
+	}
+
+	// admincerts is left empty if admins are told apart by OU
+	if nodeOUs == AllNodeOUs {
+		return nil
 	}
 
 	// create a throwaway cert to act as an admin cert
@@ -185,14 +225,24 @@ func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs b
 	// we leave a valid admin for now for the sake
 	// of unit tests
 	factory.InitFactories(nil)
-	bcsp := factory.GetDefault()
-	priv, err := bcsp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: true})
-	ecPubKey, err := csp.GetECPublicKey(priv)
+	bcsp, err := csp.WithHybridKeys(factory.GetDefault(), "")
+	if err != nil {
+		return err
+	}
+	keyGenOpts, err := csp.KeyGenOpts(signCA.KeyAlgorithm, true)
+	if err != nil {
+		return err
+	}
+	priv, err := bcsp.KeyGen(keyGenOpts)
+	if err != nil {
+		return err
+	}
+	pubKey, err := csp.GetPublicKey(priv)
 	if err != nil {
 		return err
 	}
 	_, err = signCA.SignCertificate(filepath.Join(baseDir, "admincerts"), signCA.Name,
-		nil, nil, ecPubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+		nil, nil, pubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
 	if err != nil {
 		return err
 	}
@@ -249,10 +299,18 @@ func pemExport(path, pemType string, bytes []byte) error {
 	return pem.Encode(file, &pem.Block{Type: pemType, Bytes: bytes})
 }
 
-func exportConfig(mspDir, caFile string, enable bool) error {
+// isHybrid returns true if the identities issued by signCA have hybrid keys
+func isHybrid(signCA *ca.CA) bool {
+	return strings.ToUpper(signCA.KeyAlgorithm) == csp.HYBRID
+}
+
+func exportConfig(mspDir, caFile string, nodeOUs NodeOUsMode, hybridPublicKeys bool) error {
 	var config = &fabricmsp.Configuration{
-		NodeOUs: &fabricmsp.NodeOUs{
-			Enable: enable,
+		HybridPublicKeys: hybridPublicKeys,
+	}
+	if nodeOUs != NoNodeOUs {
+		config.NodeOUs = &fabricmsp.NodeOUs{
+			Enable: true,
 			ClientOUIdentifier: &fabricmsp.OrganizationalUnitIdentifiersConfiguration{
 				Certificate:                  caFile,
 				OrganizationalUnitIdentifier: CLIENTOU,
@@ -261,7 +319,17 @@ func exportConfig(mspDir, caFile string, enable bool) error {
 				Certificate:                  caFile,
 				OrganizationalUnitIdentifier: PEEROU,
 			},
-		},
+		}
+	}
+	if nodeOUs == AllNodeOUs {
+		config.NodeOUs.AdminOUIdentifier = &fabricmsp.OrganizationalUnitIdentifiersConfiguration{
+			Certificate:                  caFile,
+			OrganizationalUnitIdentifier: ADMINOU,
+		}
+		config.NodeOUs.OrdererOUIdentifier = &fabricmsp.OrganizationalUnitIdentifiersConfiguration{
+			Certificate:                  caFile,
+			OrganizationalUnitIdentifier: ORDEREROU,
+		}
 	}
 
 	configBytes, err := yaml.Marshal(config)
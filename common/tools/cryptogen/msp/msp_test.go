@@ -6,17 +6,21 @@ SPDX-License-Identifier: Apache-2.0
 package msp_test
 
 import (
+	"crypto/ed25519"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v2"
 
 	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
 	fabricmsp "github.com/hyperledger/fabric/msp"
+	pmsp "github.com/hyperledger/fabric/protos/msp"
 )
 
 const (
@@ -37,7 +41,7 @@ func TestGenerateLocalMSP(t *testing.T) {
 
 	cleanup(testDir)
 
-	err := msp.GenerateLocalMSP(testDir, testName, nil, &ca.CA{}, &ca.CA{}, msp.PEER, true)
+	err := msp.GenerateLocalMSP(testDir, testName, nil, &ca.CA{}, &ca.CA{}, msp.PEER, msp.ClientPeerNodeOUs)
 	assert.Error(t, err, "Empty CA should have failed")
 
 	caDir := filepath.Join(testDir, "ca")
@@ -46,10 +50,10 @@ func TestGenerateLocalMSP(t *testing.T) {
 	tlsDir := filepath.Join(testDir, "tls")
 
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
 	assert.NoError(t, err, "Error generating CA")
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
 	assert.NoError(t, err, "Error generating CA")
 
 	assert.NotEmpty(t, signCA.SignCert.Subject.Country, "country cannot be empty.")
@@ -66,7 +70,7 @@ func TestGenerateLocalMSP(t *testing.T) {
 	assert.Equal(t, testPostalCode, signCA.SignCert.Subject.PostalCode[0], "Failed to match postalCode")
 
 	// generate local MSP for nodeType=PEER
-	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER, true)
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER, msp.ClientPeerNodeOUs)
 	assert.NoError(t, err, "Failed to generate local MSP")
 
 	// check to see that the right files were generated/saved
@@ -94,7 +98,7 @@ func TestGenerateLocalMSP(t *testing.T) {
 	}
 
 	// generate local MSP for nodeType=CLIENT
-	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.CLIENT, true)
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.CLIENT, msp.ClientPeerNodeOUs)
 	assert.NoError(t, err, "Failed to generate local MSP")
 	//only need to check for the TLS certs
 	tlsFiles = []string{
@@ -117,10 +121,10 @@ func TestGenerateLocalMSP(t *testing.T) {
 	assert.NoError(t, err, "Error setting up local MSP")
 
 	tlsCA.Name = "test/fail"
-	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.CLIENT, true)
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.CLIENT, msp.ClientPeerNodeOUs)
 	assert.Error(t, err, "Should have failed with CA name 'test/fail'")
 	signCA.Name = "test/fail"
-	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.ORDERER, true)
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.ORDERER, msp.ClientPeerNodeOUs)
 	assert.Error(t, err, "Should have failed with CA name 'test/fail'")
 	t.Log(err)
 	cleanup(testDir)
@@ -133,13 +137,13 @@ func TestGenerateVerifyingMSP(t *testing.T) {
 	tlsCADir := filepath.Join(testDir, "tlsca")
 	mspDir := filepath.Join(testDir, "msp")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
 	assert.NoError(t, err, "Error generating CA")
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
 	assert.NoError(t, err, "Error generating CA")
 
-	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, true)
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, msp.ClientPeerNodeOUs)
 	assert.NoError(t, err, "Failed to generate verifying MSP")
 
 	// check to see that the right files were generated/saved
@@ -163,15 +167,76 @@ func TestGenerateVerifyingMSP(t *testing.T) {
 	assert.NoError(t, err, "Error setting up verifying MSP")
 
 	tlsCA.Name = "test/fail"
-	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, true)
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, msp.ClientPeerNodeOUs)
 	assert.Error(t, err, "Should have failed with CA name 'test/fail'")
 	signCA.Name = "test/fail"
-	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, true)
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, msp.ClientPeerNodeOUs)
 	assert.Error(t, err, "Should have failed with CA name 'test/fail'")
 	t.Log(err)
 	cleanup(testDir)
 }
 
+func TestGenerateMSPED25519(t *testing.T) {
+
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	mspDir := filepath.Join(testDir, "msp")
+	verifyingMSPDir := filepath.Join(testDir, "verifyingmsp")
+
+	// generate an Ed25519 signing CA and an ECDSA TLS CA
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ED25519, nil)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA, nil)
+	assert.NoError(t, err, "Error generating CA")
+
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER, msp.NoNodeOUs)
+	assert.NoError(t, err, "Failed to generate local MSP")
+
+	// the local MSP signs with an Ed25519 identity
+	testMSPConfig, err := fabricmsp.GetLocalMspConfig(mspDir, nil, testName)
+	assert.NoError(t, err, "Error parsing local MSP config")
+	localMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = localMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up local MSP")
+
+	id, err := localMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	msg := []byte("hello world")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.Len(t, sig, ed25519.SignatureSize)
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.Error(t, id.Verify([]byte("hello world!"), sig))
+
+	// the verifying MSP validates the identity and its signatures
+	err = msp.GenerateVerifyingMSP(verifyingMSPDir, signCA, tlsCA, msp.NoNodeOUs)
+	assert.NoError(t, err, "Failed to generate verifying MSP")
+	testMSPConfig, err = fabricmsp.GetVerifyingMspConfig(verifyingMSPDir, testName, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+	assert.NoError(t, err, "Error parsing verifying MSP config")
+	verifyingMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = verifyingMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up verifying MSP")
+
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+	remoteID, err := verifyingMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.NoError(t, verifyingMSP.Validate(remoteID))
+	assert.NoError(t, remoteID.Verify(msg, sig))
+
+	// MSPs before version 1.4 reject Ed25519 certificates
+	oldMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_3}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = oldMSP.Setup(testMSPConfig)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Ed25519 certificates require MSP version 1.4 or later")
+}
+
 func TestExportConfig(t *testing.T) {
 	path := filepath.Join(testDir, "export-test")
 	configFile := filepath.Join(path, "config.yaml")
@@ -182,7 +247,7 @@ func TestExportConfig(t *testing.T) {
 		t.Fatalf("failed to create test directory: [%s]", err)
 	}
 
-	err = msp.ExportConfig(path, caFile, true)
+	err = msp.ExportConfig(path, caFile, msp.ClientPeerNodeOUs, false)
 	assert.NoError(t, err)
 
 	configBytes, err := ioutil.ReadFile(configFile)
@@ -200,6 +265,7 @@ func TestExportConfig(t *testing.T) {
 	assert.Equal(t, msp.CLIENTOU, config.NodeOUs.ClientOUIdentifier.OrganizationalUnitIdentifier)
 	assert.Equal(t, caFile, config.NodeOUs.PeerOUIdentifier.Certificate)
 	assert.Equal(t, msp.PEEROU, config.NodeOUs.PeerOUIdentifier.OrganizationalUnitIdentifier)
+	assert.False(t, config.HybridPublicKeys)
 }
 
 func cleanup(dir string) {
@@ -212,3 +278,89 @@ func checkForFile(file string) bool {
 	}
 	return true
 }
+
+func TestGenerateMSPAdminOUs(t *testing.T) {
+
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	verifyingMSPDir := filepath.Join(testDir, "verifyingmsp")
+
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, "", nil)
+	assert.NoError(t, err, "Error generating CA")
+
+	// the admincerts of the verifying MSP are left empty
+	err = msp.GenerateVerifyingMSP(verifyingMSPDir, signCA, tlsCA, msp.AllNodeOUs)
+	assert.NoError(t, err, "Failed to generate verifying MSP")
+	admincerts, err := ioutil.ReadDir(filepath.Join(verifyingMSPDir, "admincerts"))
+	assert.NoError(t, err)
+	assert.Empty(t, admincerts)
+
+	testMSPConfig, err := fabricmsp.GetVerifyingMspConfig(verifyingMSPDir, testName, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+	assert.NoError(t, err, "Error parsing verifying MSP config")
+	verifyingMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = verifyingMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up verifying MSP")
+
+	// admins and orderers are told apart by OU
+	for nodeType, role := range map[int]pmsp.MSPRole_MSPRoleType{
+		msp.ADMIN:   pmsp.MSPRole_ADMIN,
+		msp.ORDERER: pmsp.MSPRole_ORDERER,
+		msp.PEER:    pmsp.MSPRole_PEER,
+		msp.CLIENT:  pmsp.MSPRole_CLIENT,
+	} {
+		nodeDir := filepath.Join(testDir, "node", role.String())
+		err = msp.GenerateLocalMSP(nodeDir, testName, nil, signCA, tlsCA, nodeType, msp.AllNodeOUs)
+		assert.NoError(t, err, "Failed to generate local MSP")
+		assert.True(t, checkForFile(filepath.Join(nodeDir, "msp", "config.yaml")))
+		admincerts, err = ioutil.ReadDir(filepath.Join(nodeDir, "msp", "admincerts"))
+		assert.NoError(t, err)
+		assert.Empty(t, admincerts)
+
+		_, err = fabricmsp.GetLocalMspConfig(filepath.Join(nodeDir, "msp"), nil, testName)
+		assert.NoError(t, err, "Error parsing local MSP config")
+		nodeMSPConfig, err := fabricmsp.GetVerifyingMspConfig(filepath.Join(nodeDir, "msp"), testName, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+		assert.NoError(t, err, "Error parsing node MSP config")
+		nodeMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4}})
+		assert.NoError(t, err, "Error creating new BCCSP MSP")
+		err = nodeMSP.Setup(nodeMSPConfig)
+		assert.NoError(t, err, "Error setting up node MSP")
+
+		cert, err := ioutil.ReadFile(filepath.Join(nodeDir, "msp", "signcerts", testName+"-cert.pem"))
+		assert.NoError(t, err)
+		serializedID, err := proto.Marshal(&pmsp.SerializedIdentity{Mspid: testName, IdBytes: cert})
+		assert.NoError(t, err)
+		remoteID, err := verifyingMSP.DeserializeIdentity(serializedID)
+		assert.NoError(t, err)
+		assert.NoError(t, remoteID.Validate())
+		principalBytes, err := proto.Marshal(&pmsp.MSPRole{MspIdentifier: testName, Role: role})
+		assert.NoError(t, err)
+		principal := &pmsp.MSPPrincipal{PrincipalClassification: pmsp.MSPPrincipal_ROLE, Principal: principalBytes}
+		assert.NoError(t, remoteID.SatisfiesPrincipal(principal), "Expected a %s", role)
+	}
+}
+
+func TestExportConfigAdminOUs(t *testing.T) {
+	path := filepath.Join(testDir, "export-test")
+	defer cleanup(testDir)
+	err := os.MkdirAll(path, 0755)
+	assert.NoError(t, err)
+
+	err = msp.ExportConfig(path, "ca.pem", msp.AllNodeOUs, false)
+	assert.NoError(t, err)
+	configBytes, err := ioutil.ReadFile(filepath.Join(path, "config.yaml"))
+	assert.NoError(t, err)
+	config := &fabricmsp.Configuration{}
+	err = yaml.Unmarshal(configBytes, config)
+	assert.NoError(t, err)
+	assert.True(t, config.NodeOUs.Enable)
+	assert.Equal(t, msp.ADMINOU, config.NodeOUs.AdminOUIdentifier.OrganizationalUnitIdentifier)
+	assert.Equal(t, "ca.pem", config.NodeOUs.AdminOUIdentifier.Certificate)
+	assert.Equal(t, msp.ORDEREROU, config.NodeOUs.OrdererOUIdentifier.OrganizationalUnitIdentifier)
+	assert.Equal(t, "ca.pem", config.NodeOUs.OrdererOUIdentifier.Certificate)
+}
@@ -0,0 +1,70 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package msp_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
+	fabricmsp "github.com/hyperledger/fabric/msp"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestGenerateMSPHybrid(t *testing.T) {
+
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	mspDir := filepath.Join(testDir, "msp")
+	verifyingMSPDir := filepath.Join(testDir, "verifyingmsp")
+
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.HYBRID, nil)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA, nil)
+	assert.NoError(t, err, "Error generating CA")
+
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER, msp.NoNodeOUs)
+	assert.NoError(t, err, "Failed to generate local MSP")
+	err = msp.GenerateVerifyingMSP(verifyingMSPDir, signCA, tlsCA, msp.NoNodeOUs)
+	assert.NoError(t, err, "Failed to generate verifying MSP")
+
+	// the signing identity and the admin carry hybrid public keys
+	for _, certDir := range []string{
+		filepath.Join(mspDir, "signcerts"),
+		filepath.Join(verifyingMSPDir, "admincerts"),
+	} {
+		cert, err := ca.LoadCertificateECDSA(certDir)
+		assert.NoError(t, err)
+		assert.True(t, hybrid.HasAltPublicKey(cert), "Expected a hybrid certificate in %s", certDir)
+		assert.NoError(t, cert.CheckSignatureFrom(signCA.SignCert))
+	}
+
+	// both MSPs accept hybrid public keys
+	for _, dir := range []string{mspDir, verifyingMSPDir} {
+		configBytes, err := ioutil.ReadFile(filepath.Join(dir, "config.yaml"))
+		assert.NoError(t, err)
+		config := &fabricmsp.Configuration{}
+		assert.NoError(t, yaml.Unmarshal(configBytes, config))
+		assert.True(t, config.HybridPublicKeys)
+		assert.Nil(t, config.NodeOUs)
+	}
+
+	// the private key is loaded with its ML-DSA key
+	priv, _, err := csp.LoadPrivateKey(filepath.Join(mspDir, "keystore"))
+	assert.NoError(t, err)
+	_, err = hybrid.PostQuantumPublicKey(priv)
+	assert.NoError(t, err)
+}
@@ -7,7 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package idemixca
 
 import (
-	"crypto/ecdsa"
+	"crypto"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
@@ -42,7 +42,7 @@ func GenerateIssuerKey() ([]byte, []byte, error) {
 // GenerateSignerConfig creates a new signer config.
 // It generates a fresh user secret and issues a credential
 // with four attributes (described above) using the CA's key pair.
-func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey *ecdsa.PrivateKey) ([]byte, error) {
+func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey crypto.Signer) ([]byte, error) {
 	attrs := make([]*FP256BN.BIG, 4)
 
 	if ouString == "" {
@@ -75,7 +75,8 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 		return nil, errors.WithMessage(err, "failed to marshal credential")
 	}
 
-	// NOTE currently, idemixca creates CRI's with "ALG_NO_REVOCATION"
+	// The signer config starts out with a CRI for epoch 0 that uses "ALG_NO_REVOCATION",
+	// CRIs for later epochs are created with GenerateCRI
 	cri, err := idemix.CreateCRI(revKey, []*FP256BN.BIG{FP256BN.NewBIGint(revocationHandle)}, 0, idemix.ALG_NO_REVOCATION, rng)
 	if err != nil {
 		return nil, err
@@ -96,3 +97,27 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 
 	return proto.Marshal(signer)
 }
+
+// GenerateCRI creates the credential revocation information (CRI) of an epoch.
+// When using idemix.ALG_ACCUMULATOR, only the signers whose revocation handle
+// is in unrevokedHandles are able to prove that they are not revoked in this epoch.
+func GenerateCRI(unrevokedHandles []int, epoch int, alg idemix.RevocationAlgorithm, revKey crypto.Signer) ([]byte, error) {
+	if epoch < 0 {
+		return nil, errors.Errorf("invalid epoch %d", epoch)
+	}
+	rng, err := idemix.GetRand()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Error getting PRNG")
+	}
+
+	handles := make([]*FP256BN.BIG, len(unrevokedHandles))
+	for i, rh := range unrevokedHandles {
+		handles[i] = FP256BN.NewBIGint(rh)
+	}
+	cri, err := idemix.CreateCRI(revKey, handles, epoch, alg, rng)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create CRI")
+	}
+
+	return proto.Marshal(cri)
+}
@@ -59,6 +59,21 @@ func TestIdemixCa(t *testing.T) {
 	assert.NoError(t, writeSignerToFile(conf))
 	assert.NoError(t, setupMSP())
 
+	// Publish a CRI in which the signer is not revoked
+	cri, err := GenerateCRI([]int{1, 1234}, 1, idemix.ALG_ACCUMULATOR, revocationkey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeCRIToFile(cri))
+	assert.NoError(t, setupMSP())
+
+	// Publish a CRI in which the signer is revoked
+	cri, err = GenerateCRI([]int{1}, 2, idemix.ALG_ACCUMULATOR, revocationkey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeCRIToFile(cri))
+	assert.Error(t, setupMSP())
+
+	_, err = GenerateCRI([]int{1}, -1, idemix.ALG_ACCUMULATOR, revocationkey)
+	assert.EqualError(t, err, "invalid epoch -1")
+
 	// Without the verifier dir present, setup should give an error
 	cleanupVerifier()
 	assert.Error(t, setupMSP())
@@ -108,6 +123,10 @@ func writeSignerToFile(signerBytes []byte) error {
 	return ioutil.WriteFile(filepath.Join(testDir, m.IdemixConfigDirUser, m.IdemixConfigFileSigner), signerBytes, 0644)
 }
 
+func writeCRIToFile(criBytes []byte) error {
+	return ioutil.WriteFile(filepath.Join(testDir, m.IdemixConfigDirMsp, m.IdemixConfigFileCredentialRevocationInformation), criBytes, 0644)
+}
+
 // setupMSP tests whether we can successfully setup an idemix msp
 // with the generated config bytes
 func setupMSP() error {
@@ -18,11 +18,14 @@ import (
 	"os"
 	"path/filepath"
 
-	"crypto/ecdsa"
+	"crypto"
 
 	"encoding/pem"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	"github.com/hyperledger/fabric/common/tools/idemixgen/idemixca"
 	"github.com/hyperledger/fabric/common/tools/idemixgen/metadata"
 	"github.com/hyperledger/fabric/idemix"
@@ -42,6 +45,7 @@ var (
 	app = kingpin.New("idemixgen", "Utility for generating key material to be used with the Identity Mixer MSP in Hyperledger Fabric")
 
 	outputDir = app.Flag("output", "The output directory in which to place artifacts").Default("idemix-config").String()
+	bccspFile = app.Flag("bccsp", "A YAML file whose BCCSP section, in the format of core.yaml, selects the HSM that holds the revocation key").File()
 
 	genIssuerKey            = app.Command("ca-keygen", "Generate CA key material")
 	genSignerConfig         = app.Command("signerconfig", "Generate a default signer for this Idemix MSP")
@@ -49,6 +53,10 @@ var (
 	genCredIsAdmin          = genSignerConfig.Flag("admin", "Make the default signer admin").Short('a').Bool()
 	genCredEnrollmentId     = genSignerConfig.Flag("enrollmentId", "The enrollment id of the default signer").Short('e').String()
 	genCredRevocationHandle = genSignerConfig.Flag("revocationHandle", "The handle used to revoke this signer").Short('r').Int()
+	genCRI                  = app.Command("cri", "Publish the credential revocation information of a new epoch")
+	genCRIEpoch             = genCRI.Flag("epoch", "The epoch the credential revocation information is valid in").Short('e').Required().Int()
+	genCRIUnrevokedHandles  = genCRI.Flag("unrevoked", "The revocation handle of a signer that is not revoked in this epoch (can be repeated)").Short('r').Ints()
+	genCRIAlgorithm         = genCRI.Flag("algorithm", "The revocation algorithm (accumulator or none)").Default("accumulator").Enum("accumulator", "none")
 
 	version = app.Command("version", "Show version information")
 )
@@ -59,19 +67,10 @@ func main() {
 	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
 
 	case genIssuerKey.FullCommand():
+		bccspOpts := readBCCSPConfig()
 		isk, ipk, err := idemixca.GenerateIssuerKey()
 		handleError(err)
 
-		revocationKey, err := idemix.GenerateLongTermRevocationKey()
-		handleError(err)
-		encodedRevocationSK, err := x509.MarshalECPrivateKey(revocationKey)
-		handleError(err)
-		pemEncodedRevocationSK := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedRevocationSK})
-		handleError(err)
-		encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationKey.Public())
-		handleError(err)
-		pemEncodedRevocationPK := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
-
 		// Prevent overwriting the existing key
 		path := filepath.Join(*outputDir, IdemixDirIssuer)
 		checkDirectoryNotExists(path, fmt.Sprintf("Directory %s already exists", path))
@@ -83,8 +82,8 @@ func main() {
 		handleError(os.MkdirAll(filepath.Join(*outputDir, IdemixDirIssuer), 0770))
 		handleError(os.MkdirAll(filepath.Join(*outputDir, msp.IdemixConfigDirMsp), 0770))
 		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigIssuerSecretKey), isk)
-		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigRevocationKey), pemEncodedRevocationSK)
 		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, msp.IdemixConfigFileIssuerPublicKey), ipk)
+		pemEncodedRevocationPK := generateRevocationKey(bccspOpts)
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileRevocationPublicKey), pemEncodedRevocationPK)
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileIssuerPublicKey), ipk)
 
@@ -95,7 +94,7 @@ func main() {
 		} else {
 			roleMask = msp.GetRoleMaskFromIdemixRole(msp.MEMBER)
 		}
-		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(), readRevocationKey())
+		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(), readRevocationKey(readBCCSPConfig()))
 		handleError(err)
 
 		path := filepath.Join(*outputDir, msp.IdemixConfigDirUser)
@@ -105,6 +104,17 @@ func main() {
 		handleError(os.Mkdir(filepath.Join(*outputDir, msp.IdemixConfigDirUser), 0770))
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirUser, msp.IdemixConfigFileSigner), config)
 
+	case genCRI.FullCommand():
+		alg := idemix.ALG_ACCUMULATOR
+		if *genCRIAlgorithm == "none" {
+			alg = idemix.ALG_NO_REVOCATION
+		}
+		cri, err := idemixca.GenerateCRI(*genCRIUnrevokedHandles, *genCRIEpoch, alg, readRevocationKey(readBCCSPConfig()))
+		handleError(err)
+
+		// The CRI of the new epoch replaces the one of the previous epoch
+		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileCredentialRevocationInformation), cri)
+
 	case version.FullCommand():
 		printVersion()
 	}
@@ -138,8 +148,65 @@ func readIssuerKey() *idemix.IssuerKey {
 	return key
 }
 
-func readRevocationKey() *ecdsa.PrivateKey {
-	path := filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigRevocationKey)
+// readBCCSPConfig reads the BCCSP configuration given with --bccsp. Without
+// it, nil is returned and the revocation key is a software key stored in a file.
+func readBCCSPConfig() *factory.FactoryOpts {
+	if *bccspFile == nil {
+		return nil
+	}
+	data, err := ioutil.ReadAll(*bccspFile)
+	if err != nil {
+		handleError(errors.Wrap(err, "failed to read BCCSP configuration"))
+	}
+	opts, err := csp.ParseBCCSPConfig(data)
+	handleError(err)
+	if opts == nil {
+		handleError(errors.Errorf("%s has no BCCSP section", (*bccspFile).Name()))
+	}
+	return opts
+}
+
+// generateRevocationKey generates the long term revocation key in the issuer
+// directory and returns the PEM encoded public key. With an HSM, the key is
+// generated inside the HSM and only a key handle file is written.
+// Note that the issuer secret key cannot be kept in an HSM,
+// since PKCS#11 has no support for the pairing based keys of idemix.
+func generateRevocationKey(bccspOpts *factory.FactoryOpts) []byte {
+	var revocationPK interface{}
+	if bccspOpts != nil {
+		priv, _, err := csp.GenerateKey(filepath.Join(*outputDir, IdemixDirIssuer), &bccsp.ECDSAP384KeyGenOpts{}, bccspOpts)
+		handleError(err)
+		revocationPK, err = csp.GetPublicKey(priv)
+		handleError(err)
+	} else {
+		revocationKey, err := idemix.GenerateLongTermRevocationKey()
+		handleError(err)
+		encodedRevocationSK, err := x509.MarshalECPrivateKey(revocationKey)
+		handleError(err)
+		pemEncodedRevocationSK := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedRevocationSK})
+		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigRevocationKey), pemEncodedRevocationSK)
+		revocationPK = revocationKey.Public()
+	}
+
+	encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationPK)
+	handleError(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
+}
+
+// readRevocationKey reads the long term revocation key, from the HSM
+// described by bccspOpts if it is not nil
+func readRevocationKey(bccspOpts *factory.FactoryOpts) crypto.Signer {
+	caDir := filepath.Join(*outputDir, IdemixDirIssuer)
+	if bccspOpts != nil {
+		_, signer, err := csp.LoadPrivateKeyWithBCCSP(caDir, bccspOpts)
+		handleError(err)
+		if signer == nil {
+			handleError(errors.Errorf("no revocation key handle found in %s", caDir))
+		}
+		return signer
+	}
+
+	path := filepath.Join(caDir, IdemixConfigRevocationKey)
 	keyBytes, err := ioutil.ReadFile(path)
 	if err != nil {
 		handleError(errors.Wrapf(err, "failed to open revocation secret key file: %s", path))
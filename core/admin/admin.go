@@ -32,13 +32,28 @@ type AccessControlEvaluator interface {
 	Evaluate(signatureSet []*common.SignedData) error
 }
 
+// PvtDataReconciliationStatusProvider returns the progress of the
+// reconciliation of the missing private data of the given channel
+type PvtDataReconciliationStatusProvider func(channelID string) (*pb.PvtDataReconciliationStatus, error)
+
+// GossipStatusProvider returns the gossip membership the peer sees, and the state
+// of the given channel, or of all channels the peer has joined if channelID is empty
+type GossipStatusProvider func(channelID string) (*pb.GossipStatus, error)
+
+// IdentityReloader reloads the local MSP and the TLS key pairs of the peer
+// from the files they were loaded from at startup
+type IdentityReloader func() error
+
 // NewAdminServer creates and returns a Admin service instance.
-func NewAdminServer(ace AccessControlEvaluator) *ServerAdmin {
+func NewAdminServer(ace AccessControlEvaluator, reconciliationStatus PvtDataReconciliationStatusProvider, gossipStatus GossipStatusProvider, reloadIdentities IdentityReloader) *ServerAdmin {
 	s := &ServerAdmin{
 		v: &validator{
 			ace: ace,
 		},
-		levelsAtStartup: flogging.GetModuleLevels(),
+		levelsAtStartup:      flogging.GetModuleLevels(),
+		reconciliationStatus: reconciliationStatus,
+		gossipStatus:         gossipStatus,
+		reloadIdentities:     reloadIdentities,
 	}
 	return s
 }
@@ -47,7 +62,10 @@ func NewAdminServer(ace AccessControlEvaluator) *ServerAdmin {
 type ServerAdmin struct {
 	v requestValidator
 
-	levelsAtStartup map[string]zapcore.Level
+	levelsAtStartup      map[string]zapcore.Level
+	reconciliationStatus PvtDataReconciliationStatusProvider
+	gossipStatus         GossipStatusProvider
+	reloadIdentities     IdentityReloader
 }
 
 func (s *ServerAdmin) GetStatus(ctx context.Context, env *common.Envelope) (*pb.ServerStatus, error) {
@@ -103,3 +121,49 @@ func (s *ServerAdmin) RevertLogLevels(ctx context.Context, env *common.Envelope)
 	flogging.RestoreLevels(s.levelsAtStartup)
 	return &empty.Empty{}, nil
 }
+
+func (s *ServerAdmin) GetPvtDataReconciliationStatus(ctx context.Context, env *common.Envelope) (*pb.PvtDataReconciliationStatus, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetReconciliationStatusReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	if request.ChannelId == "" {
+		return nil, errors.New("channel ID is empty")
+	}
+	if s.reconciliationStatus == nil {
+		return nil, errors.New("private data reconciliation status is not available")
+	}
+	return s.reconciliationStatus(request.ChannelId)
+}
+
+func (s *ServerAdmin) GetGossipStatus(ctx context.Context, env *common.Envelope) (*pb.GossipStatus, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetGossipStatusReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	if s.gossipStatus == nil {
+		return nil, errors.New("gossip status is not available")
+	}
+	return s.gossipStatus(request.ChannelId)
+}
+
+func (s *ServerAdmin) ReloadIdentities(ctx context.Context, env *common.Envelope) (*empty.Empty, error) {
+	if _, err := s.v.validate(ctx, env); err != nil {
+		return nil, err
+	}
+	if s.reloadIdentities == nil {
+		return nil, errors.New("reloading identities is not available")
+	}
+	if err := s.reloadIdentities(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
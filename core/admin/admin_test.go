@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/fabric/core/testutil"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,7 +36,7 @@ func (v *mockValidator) validate(ctx context.Context, env *common.Envelope) (*pb
 }
 
 func TestGetStatus(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -45,7 +46,7 @@ func TestGetStatus(t *testing.T) {
 }
 
 func TestStartServer(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -55,7 +56,7 @@ func TestStartServer(t *testing.T) {
 }
 
 func TestForbidden(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, accessDenied).Times(5)
@@ -81,7 +82,7 @@ func TestForbidden(t *testing.T) {
 }
 
 func TestLoggingCalls(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	flogging.MustGetLogger("test")
@@ -130,3 +131,165 @@ func TestLoggingCalls(t *testing.T) {
 	assert.Equal(t, flogging.DefaultLevel(), logResponse.LogLevel, "logger level should have been the default")
 	assert.Nil(t, err, "Error should have been nil")
 }
+
+func TestGetPvtDataReconciliationStatus(t *testing.T) {
+	wrapStatusRequest := func(channelID string) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_ReconciliationStatusReq{
+				ReconciliationStatusReq: &pb.PvtDataReconciliationStatusRequest{ChannelId: channelID},
+			},
+		}
+	}
+
+	var requestedChannel string
+	adminServer := NewAdminServer(nil, func(channelID string) (*pb.PvtDataReconciliationStatus, error) {
+		requestedChannel = channelID
+		if channelID == "nonexistent" {
+			return nil, errors.New("channel nonexistent not found")
+		}
+		return &pb.PvtDataReconciliationStatus{
+			ChannelId:             channelID,
+			ReconciliationEnabled: true,
+			Missing:               3,
+			Ineligible:            2,
+			Reconciled:            1,
+		}, nil
+	}, nil, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	mv.On("validate").Return(wrapStatusRequest("mychannel"), nil).Once()
+	status, err := adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "mychannel", requestedChannel)
+	assert.Equal(t, &pb.PvtDataReconciliationStatus{
+		ChannelId:             "mychannel",
+		ReconciliationEnabled: true,
+		Missing:               3,
+		Ineligible:            2,
+		Reconciled:            1,
+	}, status)
+
+	mv.On("validate").Return(wrapStatusRequest("nonexistent"), nil).Once()
+	status, err = adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "channel nonexistent not found")
+
+	mv.On("validate").Return(wrapStatusRequest(""), nil).Once()
+	status, err = adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "channel ID is empty")
+
+	mv.On("validate").Return(&pb.AdminOperation{}, nil).Once()
+	status, err = adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "request is nil")
+
+	mv.On("validate").Return(nil, accessDenied).Once()
+	status, err = adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.Equal(t, accessDenied, err)
+
+	adminServer = NewAdminServer(nil, nil, nil, nil)
+	adminServer.v = mv
+	mv.On("validate").Return(wrapStatusRequest("mychannel"), nil).Once()
+	status, err = adminServer.GetPvtDataReconciliationStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "private data reconciliation status is not available")
+}
+
+func TestGetGossipStatus(t *testing.T) {
+	wrapStatusRequest := func(channelID string) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_GossipStatusReq{
+				GossipStatusReq: &pb.GossipStatusRequest{ChannelId: channelID},
+			},
+		}
+	}
+
+	var requestedChannel string
+	adminServer := NewAdminServer(nil, nil, func(channelID string) (*pb.GossipStatus, error) {
+		requestedChannel = channelID
+		if channelID == "nonexistent" {
+			return nil, errors.New("channel nonexistent not found")
+		}
+		return &pb.GossipStatus{
+			Self:  &pb.GossipMember{Endpoint: "p0:7051"},
+			Alive: []*pb.GossipMember{{Endpoint: "p1:7051"}},
+			Dead:  []*pb.GossipMember{{Endpoint: "p2:7051"}},
+		}, nil
+	}, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	// An empty channel ID asks for the status of all channels
+	mv.On("validate").Return(wrapStatusRequest(""), nil).Once()
+	status, err := adminServer.GetGossipStatus(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", requestedChannel)
+	assert.Equal(t, &pb.GossipStatus{
+		Self:  &pb.GossipMember{Endpoint: "p0:7051"},
+		Alive: []*pb.GossipMember{{Endpoint: "p1:7051"}},
+		Dead:  []*pb.GossipMember{{Endpoint: "p2:7051"}},
+	}, status)
+
+	mv.On("validate").Return(wrapStatusRequest("mychannel"), nil).Once()
+	_, err = adminServer.GetGossipStatus(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "mychannel", requestedChannel)
+
+	mv.On("validate").Return(wrapStatusRequest("nonexistent"), nil).Once()
+	status, err = adminServer.GetGossipStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "channel nonexistent not found")
+
+	mv.On("validate").Return(&pb.AdminOperation{}, nil).Once()
+	status, err = adminServer.GetGossipStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "request is nil")
+
+	mv.On("validate").Return(nil, accessDenied).Once()
+	status, err = adminServer.GetGossipStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.Equal(t, accessDenied, err)
+
+	adminServer = NewAdminServer(nil, nil, nil, nil)
+	adminServer.v = mv
+	mv.On("validate").Return(wrapStatusRequest("mychannel"), nil).Once()
+	status, err = adminServer.GetGossipStatus(context.Background(), nil)
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "gossip status is not available")
+}
+
+func TestReloadIdentities(t *testing.T) {
+	var reloads int
+	var reloadErr error
+	adminServer := NewAdminServer(nil, nil, nil, func() error {
+		reloads++
+		return reloadErr
+	})
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	mv.On("validate").Return(nil, nil).Once()
+	_, err := adminServer.ReloadIdentities(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reloads)
+
+	reloadErr = errors.New("failed reloading local MSP")
+	mv.On("validate").Return(nil, nil).Once()
+	_, err = adminServer.ReloadIdentities(context.Background(), nil)
+	assert.EqualError(t, err, "failed reloading local MSP")
+	assert.Equal(t, 2, reloads)
+
+	mv.On("validate").Return(nil, accessDenied).Once()
+	_, err = adminServer.ReloadIdentities(context.Background(), nil)
+	assert.Equal(t, accessDenied, err)
+	assert.Equal(t, 2, reloads)
+
+	adminServer = NewAdminServer(nil, nil, nil, nil)
+	adminServer.v = mv
+	mv.On("validate").Return(nil, nil).Once()
+	_, err = adminServer.ReloadIdentities(context.Background(), nil)
+	assert.EqualError(t, err, "reloading identities is not available")
+}
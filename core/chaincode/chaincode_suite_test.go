@@ -140,3 +140,8 @@ type registry interface {
 type applicationConfigRetriever interface {
 	chaincode.ApplicationConfigRetriever
 }
+
+//go:generate counterfeiter -o fake/collection_store.go --fake-name CollectionStore . collectionStore
+type collectionStore interface {
+	chaincode.CollectionStore
+}
@@ -14,10 +14,13 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/chaincode/platforms"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/msp"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 )
@@ -53,6 +56,7 @@ type ChaincodeSupport struct {
 	Launcher         Launcher
 	SystemCCProvider sysccprovider.SystemChaincodeProvider
 	Lifecycle        Lifecycle
+	CollectionStore  CollectionStore
 	appConfig        ApplicationConfigRetriever
 }
 
@@ -79,6 +83,7 @@ func NewChaincodeSupport(
 		ACLProvider:      aclProvider,
 		SystemCCProvider: SystemCCProvider,
 		Lifecycle:        lifecycle,
+		CollectionStore:  privdata.NewSimpleCollectionStore(&collectionSupport{}),
 		appConfig:        appConfig,
 	}
 
@@ -119,6 +124,7 @@ func (cs *ChaincodeSupport) LaunchInit(ccci *ccprovider.ChaincodeContainerInfo)
 		return nil
 	}
 
+	cs.HandlerRegistry.SetResourceLimits(cname, ccci.ResourceLimits)
 	return cs.Launcher.Launch(ccci)
 }
 
@@ -143,6 +149,7 @@ func (cs *ChaincodeSupport) Launch(chainID, chaincodeName, chaincodeVersion stri
 		return nil, errors.Wrapf(err, "[channel %s] failed to get chaincode container info for %s", chainID, cname)
 	}
 
+	cs.HandlerRegistry.SetResourceLimits(cname, ccci.ResourceLimits)
 	if err := cs.Launcher.Launch(ccci); err != nil {
 		return nil, errors.Wrapf(err, "[channel %s] could not launch chaincode %s", chainID, cname)
 	}
@@ -177,6 +184,7 @@ func (cs *ChaincodeSupport) HandleChaincodeStream(stream ccintf.ChaincodeStream)
 		UUIDGenerator:              UUIDGeneratorFunc(util.GenerateUUID),
 		LedgerGetter:               peer.Default,
 		AppConfig:                  cs.appConfig,
+		CollectionStore:            cs.CollectionStore,
 	}
 
 	return handler.ProcessStream(stream)
@@ -206,7 +214,7 @@ func createCCMessage(messageType pb.ChaincodeMessage_Type, cid string, txid stri
 // is entirely deprecated.  Ideally one release after the introduction of the new lifecycle.
 // It does not attempt to start the chaincode based on the information from lifecycle, but instead
 // accepts the container information directly in the form of a ChaincodeDeploymentSpec.
-func (cs *ChaincodeSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (cs *ChaincodeSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	ccci := ccprovider.DeploymentSpecToChaincodeContainerInfo(spec)
 	ccci.Version = cccid.Version
 
@@ -226,12 +234,12 @@ func (cs *ChaincodeSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionPa
 }
 
 // Execute invokes chaincode and returns the original response.
-func (cs *ChaincodeSupport) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (cs *ChaincodeSupport) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, input *pb.ChaincodeInput) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	resp, err := cs.Invoke(txParams, cccid, input)
 	return processChaincodeExecutionResult(txParams.TxID, cccid.Name, resp, err)
 }
 
-func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMessage, err error) (*pb.Response, *pb.ChaincodeEvent, error) {
+func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMessage, err error) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to execute transaction %s", txid)
 	}
@@ -239,9 +247,14 @@ func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMess
 		return nil, nil, errors.Errorf("nil response from transaction %s", txid)
 	}
 
-	if resp.ChaincodeEvent != nil {
-		resp.ChaincodeEvent.ChaincodeId = ccName
-		resp.ChaincodeEvent.TxId = txid
+	events := resp.ChaincodeEvents
+	if len(events) == 0 && resp.ChaincodeEvent != nil {
+		// the chaincode predates support for multiple events
+		events = []*pb.ChaincodeEvent{resp.ChaincodeEvent}
+	}
+	for _, event := range events {
+		event.ChaincodeId = ccName
+		event.TxId = txid
 	}
 
 	switch resp.Type {
@@ -251,10 +264,10 @@ func processChaincodeExecutionResult(txid, ccName string, resp *pb.ChaincodeMess
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to unmarshal response for transaction %s", txid)
 		}
-		return res, resp.ChaincodeEvent, nil
+		return res, events, nil
 
 	case pb.ChaincodeMessage_ERROR:
-		return nil, resp.ChaincodeEvent, errors.Errorf("transaction returned with failure: %s", resp.Payload)
+		return nil, events, errors.Errorf("transaction returned with failure: %s", resp.Payload)
 
 	default:
 		return nil, nil, errors.Errorf("unexpected response type %d for transaction %s", resp.Type, txid)
@@ -308,3 +321,19 @@ func (cs *ChaincodeSupport) execute(cctyp pb.ChaincodeMessage_Type, txParams *cc
 
 	return ccresp, nil
 }
+
+// collectionSupport provides the collection store of the chaincode support
+// with access to the ledgers and the identity deserializers of the peer.
+type collectionSupport struct{}
+
+func (*collectionSupport) GetQueryExecutorForLedger(cid string) (ledger.QueryExecutor, error) {
+	l := peer.GetLedger(cid)
+	if l == nil {
+		return nil, errors.Errorf("ledger for channel %s not found", cid)
+	}
+	return l.NewQueryExecutor()
+}
+
+func (*collectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDeserializer {
+	return mspmgmt.GetManagerForChain(chainID)
+}
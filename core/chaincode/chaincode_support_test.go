@@ -1423,3 +1423,55 @@ func TestCCFramework(t *testing.T) {
 
 	ccSide.Quit()
 }
+
+func TestProcessChaincodeExecutionResultEvents(t *testing.T) {
+	payload := putils.MarshalOrPanic(&pb.Response{Status: shim.OK})
+
+	// chaincode emitting multiple events
+	resp := &pb.ChaincodeMessage{
+		Type:           pb.ChaincodeMessage_COMPLETED,
+		Payload:        payload,
+		ChaincodeEvent: &pb.ChaincodeEvent{EventName: "e1"},
+		ChaincodeEvents: []*pb.ChaincodeEvent{
+			{EventName: "e1"},
+			{EventName: "e2"},
+		},
+	}
+	res, events, err := processChaincodeExecutionResult("txid", "mycc", resp, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(shim.OK), res.Status)
+	assert.Len(t, events, 2)
+	for i, name := range []string{"e1", "e2"} {
+		assert.Equal(t, name, events[i].EventName)
+		assert.Equal(t, "mycc", events[i].ChaincodeId)
+		assert.Equal(t, "txid", events[i].TxId)
+	}
+
+	// chaincode only setting the single event
+	resp = &pb.ChaincodeMessage{
+		Type:           pb.ChaincodeMessage_COMPLETED,
+		Payload:        payload,
+		ChaincodeEvent: &pb.ChaincodeEvent{EventName: "e1"},
+	}
+	_, events, err = processChaincodeExecutionResult("txid", "mycc", resp, nil)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "e1", events[0].EventName)
+	assert.Equal(t, "mycc", events[0].ChaincodeId)
+
+	// no events
+	resp = &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Payload: payload}
+	_, events, err = processChaincodeExecutionResult("txid", "mycc", resp, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	// events are returned along with errors
+	resp = &pb.ChaincodeMessage{
+		Type:            pb.ChaincodeMessage_ERROR,
+		Payload:         []byte("boom"),
+		ChaincodeEvents: []*pb.ChaincodeEvent{{EventName: "e1"}},
+	}
+	_, events, err = processChaincodeExecutionResult("txid", "mycc", resp, nil)
+	assert.EqualError(t, err, "transaction returned with failure: boom")
+	assert.Len(t, events, 1)
+}
@@ -72,6 +72,7 @@ func (c *ContainerRuntime) Start(ccci *ccprovider.ChaincodeContainerInfo, codePa
 			Name:    ccci.Name,
 			Version: ccci.Version,
 		},
+		ResourceLimits: ccci.ResourceLimits,
 	}
 
 	if err := c.Processor.Process(ccci.ContainerType, scr); err != nil {
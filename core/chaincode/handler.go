@@ -19,10 +19,12 @@ import (
 	commonledger "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 )
@@ -113,6 +115,13 @@ type ApplicationConfigRetriever interface {
 	GetApplicationConfig(cid string) (channelconfig.Application, bool)
 }
 
+// CollectionStore is used to determine whether the creator of a transaction
+// is allowed to read or write the private data of a collection.
+type CollectionStore interface {
+	RetrieveReadWritePermission(cc common.CollectionCriteria, signedProposal *pb.SignedProposal) (bool, bool, error)
+	RetrieveCollectionConfigPackage(cc common.CollectionCriteria) (*common.CollectionConfigPackage, error)
+}
+
 // Handler implements the peer side of the chaincode stream.
 type Handler struct {
 	// Keepalive specifies the interval at which keep-alive messages are sent.
@@ -145,6 +154,9 @@ type Handler struct {
 	UUIDGenerator UUIDGenerator
 	// AppConfig is used to retrieve the application config for a channel
 	AppConfig ApplicationConfigRetriever
+	// CollectionStore is used to check the read and write access of
+	// transaction creators to the collections of the chaincode
+	CollectionStore CollectionStore
 
 	// state holds the current handler state. It will be created, established, or
 	// ready.
@@ -162,6 +174,67 @@ type Handler struct {
 	chatStream ccintf.ChaincodeStream
 	// errChan is used to communicate errors from the async send to the receive loop
 	errChan chan error
+
+	// executeTimeout, when set, replaces the peer wide execution timeout for
+	// the transactions of this chaincode.
+	executeTimeout time.Duration
+	// concurrency, when set, bounds the number of transactions executing
+	// concurrently in the chaincode.
+	concurrency chan struct{}
+	// slotHolders counts, per transaction ID, the executions sharing the
+	// concurrency slot taken by the outermost execution of the transaction.
+	slotHolders      map[string]int
+	slotHoldersMutex sync.Mutex
+}
+
+// setResourceLimits applies the execution limits of the chaincode to the
+// handler. It must be called before the handler starts executing transactions.
+func (h *Handler) setResourceLimits(limits *pb.ChaincodeResourceLimits) {
+	h.executeTimeout = time.Duration(limits.GetExecuteTimeoutMs()) * time.Millisecond
+	h.concurrency = nil
+	if limits.GetMaxConcurrency() != 0 {
+		h.concurrency = make(chan struct{}, limits.GetMaxConcurrency())
+		h.slotHolders = map[string]int{}
+	}
+}
+
+// acquireConcurrencySlot waits, at most until the timer fires, for the
+// chaincode to have room for one more transaction. An invocation nested in a
+// transaction that is already executing in the chaincode, such as a chaincode
+// calling itself, shares the slot of that transaction instead of waiting for
+// one, as the outer execution cannot complete before the nested one does.
+func (h *Handler) acquireConcurrencySlot(txID string, timer *time.Timer) error {
+	h.slotHoldersMutex.Lock()
+	if h.slotHolders[txID] != 0 {
+		h.slotHolders[txID]++
+		h.slotHoldersMutex.Unlock()
+		return nil
+	}
+	h.slotHoldersMutex.Unlock()
+
+	select {
+	case h.concurrency <- struct{}{}:
+	case <-timer.C:
+		return errors.Errorf("timeout expired while waiting for one of the %d concurrent transactions of the chaincode to complete", cap(h.concurrency))
+	}
+
+	h.slotHoldersMutex.Lock()
+	h.slotHolders[txID]++
+	h.slotHoldersMutex.Unlock()
+	return nil
+}
+
+// releaseConcurrencySlot frees the slot of the transaction once its outermost
+// execution completes.
+func (h *Handler) releaseConcurrencySlot(txID string) {
+	h.slotHoldersMutex.Lock()
+	defer h.slotHoldersMutex.Unlock()
+
+	h.slotHolders[txID]--
+	if h.slotHolders[txID] == 0 {
+		delete(h.slotHolders, txID)
+		<-h.concurrency
+	}
 }
 
 // handleMessage is called by ProcessStream to dispatch messages.
@@ -221,6 +294,8 @@ func (h *Handler) handleMessageReadyState(msg *pb.ChaincodeMessage) error {
 		go h.HandleTransaction(msg, h.HandleGetStateMetadata)
 	case pb.ChaincodeMessage_PUT_STATE_METADATA:
 		go h.HandleTransaction(msg, h.HandlePutStateMetadata)
+	case pb.ChaincodeMessage_PURGE_PRIVATE_DATA:
+		go h.HandleTransaction(msg, h.HandlePurgePrivateData)
 	default:
 		return fmt.Errorf("[%s] Fabric side handler cannot handle message (%s) while in ready state", msg.Txid, msg.Type)
 	}
@@ -558,6 +633,18 @@ func (h *Handler) checkMetadataCap(msg *pb.ChaincodeMessage) error {
 	return nil
 }
 
+func (h *Handler) checkPurgeCap(msg *pb.ChaincodeMessage) error {
+	ac, exists := h.AppConfig.GetApplicationConfig(msg.ChannelId)
+	if !exists {
+		return errors.Errorf("application config does not exist for %s", msg.ChannelId)
+	}
+
+	if !ac.Capabilities().V1_4Validation() {
+		return errors.New("private data purge is not enabled")
+	}
+	return nil
+}
+
 // Handles query to ledger to get state
 func (h *Handler) HandleGetState(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	key := string(msg.Payload)
@@ -572,6 +659,9 @@ func (h *Handler) HandleGetState(msg *pb.ChaincodeMessage, txContext *Transactio
 
 	var res []byte
 	if isCollectionSet(getState.Collection) {
+		if err := h.errorIfCreatorHasNoReadAccess(chaincodeName, getState.Collection, txContext); err != nil {
+			return nil, err
+		}
 		res, err = txContext.TXSimulator.GetPrivateData(chaincodeName, getState.Collection, getState.Key)
 	} else {
 		res, err = txContext.TXSimulator.GetState(chaincodeName, getState.Key)
@@ -605,6 +695,9 @@ func (h *Handler) HandleGetStateMetadata(msg *pb.ChaincodeMessage, txContext *Tr
 
 	var metadata map[string][]byte
 	if isCollectionSet(getStateMetadata.Collection) {
+		if err := h.errorIfCreatorHasNoReadAccess(chaincodeName, getStateMetadata.Collection, txContext); err != nil {
+			return nil, err
+		}
 		metadata, err = txContext.TXSimulator.GetPrivateDataMetadata(chaincodeName, getStateMetadata.Collection, getStateMetadata.Key)
 	} else {
 		metadata, err = txContext.TXSimulator.GetStateMetadata(chaincodeName, getStateMetadata.Key)
@@ -650,6 +743,9 @@ func (h *Handler) HandleGetStateByRange(msg *pb.ChaincodeMessage, txContext *Tra
 	isPaginated := false
 
 	if isCollectionSet(getStateByRange.Collection) {
+		if err := h.errorIfCreatorHasNoReadAccess(chaincodeName, getStateByRange.Collection, txContext); err != nil {
+			return nil, err
+		}
 		rangeIter, err = txContext.TXSimulator.GetPrivateDataRangeScanIterator(chaincodeName, getStateByRange.Collection,
 			getStateByRange.StartKey, getStateByRange.EndKey)
 	} else if isMetadataSetForPagination(metadata) {
@@ -767,6 +863,9 @@ func (h *Handler) HandleGetQueryResult(msg *pb.ChaincodeMessage, txContext *Tran
 	var paginationInfo map[string]interface{}
 
 	if isCollectionSet(getQueryResult.Collection) {
+		if err := h.errorIfCreatorHasNoReadAccess(chaincodeName, getQueryResult.Collection, txContext); err != nil {
+			return nil, err
+		}
 		executeIter, err = txContext.TXSimulator.ExecuteQueryOnPrivateData(chaincodeName, getQueryResult.Collection, getQueryResult.Query)
 	} else if isMetadataSetForPagination(metadata) {
 		paginationInfo, err = createPaginationInfoFromMetadata(metadata, totalReturnLimit, pb.ChaincodeMessage_GET_QUERY_RESULT)
@@ -841,6 +940,88 @@ func isCollectionSet(collection string) bool {
 	return collection != ""
 }
 
+func (h *Handler) errorIfCreatorHasNoReadAccess(chaincodeName, collection string, txContext *TransactionContext) error {
+	perm, err := h.getReadWritePermission(chaincodeName, collection, txContext)
+	if err != nil {
+		return err
+	}
+	if !perm.read {
+		return errors.Errorf("tx creator does not have read access permission on privatedata in chaincodeName:%s collectionName: %s",
+			chaincodeName, collection)
+	}
+	return nil
+}
+
+func (h *Handler) errorIfCreatorHasNoWriteAccess(chaincodeName, collection string, txContext *TransactionContext) error {
+	perm, err := h.getReadWritePermission(chaincodeName, collection, txContext)
+	if err != nil {
+		return err
+	}
+	if !perm.write {
+		return errors.Errorf("tx creator does not have write access permission on privatedata in chaincodeName:%s collectionName: %s",
+			chaincodeName, collection)
+	}
+	return nil
+}
+
+// getReadWritePermission returns the access of the transaction creator to the
+// collection, which is retrieved once per transaction and collection.
+// The collection configuration is read outside of the transaction simulation,
+// so that it does not become part of the read set of the transaction.
+func (h *Handler) getReadWritePermission(chaincodeName, collection string, txContext *TransactionContext) (readWritePermission, error) {
+	if perm, ok := txContext.collectionPermission(collection); ok {
+		return perm, nil
+	}
+
+	ac, exists := h.AppConfig.GetApplicationConfig(txContext.ChainID)
+	if !exists {
+		return readWritePermission{}, errors.Errorf("application config does not exist for %s", txContext.ChainID)
+	}
+
+	cc := common.CollectionCriteria{
+		Channel:    txContext.ChainID,
+		Namespace:  chaincodeName,
+		Collection: collection,
+	}
+	if !ac.Capabilities().V1_4Validation() {
+		// implicit collections and the member only read and write
+		// flags are honored as of v1.4
+		if err := h.checkExplicitCollection(cc); err != nil {
+			return readWritePermission{}, err
+		}
+		perm := readWritePermission{read: true, write: true}
+		txContext.setCollectionPermission(collection, perm)
+		return perm, nil
+	}
+
+	read, write, err := h.CollectionStore.RetrieveReadWritePermission(cc, txContext.SignedProp)
+	if err != nil {
+		return readWritePermission{}, errors.WithMessage(err, fmt.Sprintf("failed to check access to collection %s", collection))
+	}
+
+	perm := readWritePermission{read: read, write: write}
+	txContext.setCollectionPermission(collection, perm)
+	return perm, nil
+}
+
+// checkExplicitCollection returns an error if the given collection has the
+// name of an implicit collection but is not defined by the chaincode.
+func (h *Handler) checkExplicitCollection(cc common.CollectionCriteria) error {
+	if isImplicit, _ := privdata.MspIDIfImplicitCollection(cc.Collection); !isImplicit {
+		return nil
+	}
+	collections, err := h.CollectionStore.RetrieveCollectionConfigPackage(cc)
+	if _, notFound := err.(privdata.NoSuchCollectionError); err != nil && !notFound {
+		return errors.WithMessage(err, fmt.Sprintf("failed to check access to collection %s", cc.Collection))
+	}
+	for _, config := range collections.GetConfig() {
+		if config.GetStaticCollectionConfig().GetName() == cc.Collection {
+			return nil
+		}
+	}
+	return errors.Errorf("implicit collection %s requires the V1_4 application capability", cc.Collection)
+}
+
 func isMetadataSetForPagination(metadata *pb.QueryMetadata) bool {
 	if metadata == nil {
 		return false
@@ -936,6 +1117,9 @@ func (h *Handler) HandlePutState(msg *pb.ChaincodeMessage, txContext *Transactio
 
 	chaincodeName := h.ChaincodeName()
 	if isCollectionSet(putState.Collection) {
+		if err := h.errorIfCreatorHasNoWriteAccess(chaincodeName, putState.Collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.SetPrivateData(chaincodeName, putState.Collection, putState.Key, putState.Value)
 	} else {
 		err = txContext.TXSimulator.SetState(chaincodeName, putState.Key, putState.Value)
@@ -964,6 +1148,9 @@ func (h *Handler) HandlePutStateMetadata(msg *pb.ChaincodeMessage, txContext *Tr
 
 	chaincodeName := h.ChaincodeName()
 	if isCollectionSet(putStateMetadata.Collection) {
+		if err := h.errorIfCreatorHasNoWriteAccess(chaincodeName, putStateMetadata.Collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.SetPrivateDataMetadata(chaincodeName, putStateMetadata.Collection, putStateMetadata.Key, metadata)
 	} else {
 		err = txContext.TXSimulator.SetStateMetadata(chaincodeName, putStateMetadata.Key, metadata)
@@ -975,6 +1162,34 @@ func (h *Handler) HandlePutStateMetadata(msg *pb.ChaincodeMessage, txContext *Tr
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+// HandlePurgePrivateData deletes a private data key and records the purge of
+// all its previous versions in the private write set of the transaction.
+func (h *Handler) HandlePurgePrivateData(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
+	err := h.checkPurgeCap(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	delState := &pb.DelState{}
+	err = proto.Unmarshal(msg.Payload, delState)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+	if !isCollectionSet(delState.Collection) {
+		return nil, errors.New("only private data can be purged")
+	}
+
+	chaincodeName := h.ChaincodeName()
+	if err := h.errorIfCreatorHasNoWriteAccess(chaincodeName, delState.Collection, txContext); err != nil {
+		return nil, err
+	}
+	if err := txContext.TXSimulator.PurgePrivateData(chaincodeName, delState.Collection, delState.Key); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
+}
+
 func (h *Handler) HandleDelState(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	delState := &pb.DelState{}
 	err := proto.Unmarshal(msg.Payload, delState)
@@ -984,6 +1199,9 @@ func (h *Handler) HandleDelState(msg *pb.ChaincodeMessage, txContext *Transactio
 
 	chaincodeName := h.ChaincodeName()
 	if isCollectionSet(delState.Collection) {
+		if err := h.errorIfCreatorHasNoWriteAccess(chaincodeName, delState.Collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.DeletePrivateData(chaincodeName, delState.Collection, delState.Key)
 	} else {
 		err = txContext.TXSimulator.DeleteState(chaincodeName, delState.Key)
@@ -1104,10 +1322,27 @@ func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *Tra
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: res, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+// Execute sends a transaction to the chaincode and waits for its response. The
+// execution timeout of the chaincode, when it has one, takes precedence over
+// the timeout passed in.
 func (h *Handler) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, msg *pb.ChaincodeMessage, timeout time.Duration) (*pb.ChaincodeMessage, error) {
 	chaincodeLogger.Debugf("Entry")
 	defer chaincodeLogger.Debugf("Exit")
 
+	if h.executeTimeout != 0 {
+		timeout = h.executeTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	// the time spent waiting for a slot counts against the execution timeout
+	if h.concurrency != nil {
+		if err := h.acquireConcurrencySlot(txParams.TxID, timer); err != nil {
+			return nil, err
+		}
+		defer h.releaseConcurrencySlot(txParams.TxID)
+	}
+
 	txctx, err := h.TXContexts.Create(txParams)
 	if err != nil {
 		return nil, err
@@ -1125,7 +1360,7 @@ func (h *Handler) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovi
 	case ccresp = <-txctx.ResponseNotifier:
 		// response is sent to user or calling chaincode. ChaincodeMessage_ERROR
 		// are typically treated as error
-	case <-time.After(timeout):
+	case <-timer.C:
 		err = errors.New("timeout expired while executing transaction")
 	}
 
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode
 
 import (
+	"time"
+
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	pb "github.com/hyperledger/fabric/protos/peer"
@@ -25,3 +27,11 @@ func SetHandlerChatStream(h *Handler, chatStream ccintf.ChaincodeStream) {
 func SetHandlerCCInstance(h *Handler, ccInstance *sysccprovider.ChaincodeInstance) {
 	h.ccInstance = ccInstance
 }
+
+func SetHandlerResourceLimits(h *Handler, limits *pb.ChaincodeResourceLimits) {
+	h.setResourceLimits(limits)
+}
+
+func HandlerResourceLimits(h *Handler) (executeTimeout time.Duration, maxConcurrency int) {
+	return h.executeTimeout, cap(h.concurrency)
+}
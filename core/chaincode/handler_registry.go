@@ -9,6 +9,7 @@ package chaincode
 import (
 	"sync"
 
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 )
 
@@ -16,9 +17,10 @@ import (
 type HandlerRegistry struct {
 	allowUnsolicitedRegistration bool // from cs.userRunsCC
 
-	mutex     sync.Mutex              // lock covering handlers and launching
-	handlers  map[string]*Handler     // chaincode cname to associated handler
-	launching map[string]*LaunchState // launching chaincodes to LaunchState
+	mutex          sync.Mutex                             // lock covering handlers, launching and resourceLimits
+	handlers       map[string]*Handler                    // chaincode cname to associated handler
+	launching      map[string]*LaunchState                // launching chaincodes to LaunchState
+	resourceLimits map[string]*pb.ChaincodeResourceLimits // chaincode cname to the limits of its handler
 }
 
 type LaunchState struct {
@@ -60,6 +62,7 @@ func NewHandlerRegistry(allowUnsolicitedRegistration bool) *HandlerRegistry {
 	return &HandlerRegistry{
 		handlers:                     map[string]*Handler{},
 		launching:                    map[string]*LaunchState{},
+		resourceLimits:               map[string]*pb.ChaincodeResourceLimits{},
 		allowUnsolicitedRegistration: allowUnsolicitedRegistration,
 	}
 }
@@ -90,6 +93,20 @@ func (r *HandlerRegistry) Launching(cname string) (*LaunchState, bool) {
 	return launchState, false
 }
 
+// SetResourceLimits records the resource limits to apply to the handler of a
+// chaincode when it registers. Limits do not affect a handler that is already
+// registered.
+func (r *HandlerRegistry) SetResourceLimits(cname string, limits *pb.ChaincodeResourceLimits) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if limits == nil {
+		delete(r.resourceLimits, cname)
+		return
+	}
+	r.resourceLimits[cname] = limits
+}
+
 // Ready indicates that the chaincode registration has completed and the
 // READY response has been sent to the chaincode.
 func (r *HandlerRegistry) Ready(cname string) {
@@ -141,6 +158,7 @@ func (r *HandlerRegistry) Register(h *Handler) error {
 		return errors.Errorf("peer will not accept external chaincode connection %v (except in dev mode)", h.chaincodeID.Name)
 	}
 
+	h.setResourceLimits(r.resourceLimits[key])
 	r.handlers[key] = h
 
 	chaincodeLogger.Debugf("registered handler complete for chaincode %s", key)
@@ -157,6 +175,7 @@ func (r *HandlerRegistry) Deregister(cname string) error {
 	handler := r.handlers[cname]
 	delete(r.handlers, cname)
 	delete(r.launching, cname)
+	delete(r.resourceLimits, cname)
 	r.mutex.Unlock()
 
 	if handler == nil {
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package chaincode_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -57,6 +59,31 @@ var _ = Describe("HandlerRegistry", func() {
 			})
 		})
 
+		Context("when resource limits were set for the chaincode", func() {
+			BeforeEach(func() {
+				hr.SetResourceLimits("chaincode-name", &pb.ChaincodeResourceLimits{ExecuteTimeoutMs: 1500, MaxConcurrency: 4})
+			})
+
+			It("applies them to the handler", func() {
+				err := hr.Register(handler)
+				Expect(err).NotTo(HaveOccurred())
+
+				executeTimeout, maxConcurrency := chaincode.HandlerResourceLimits(handler)
+				Expect(executeTimeout).To(Equal(1500 * time.Millisecond))
+				Expect(maxConcurrency).To(Equal(4))
+			})
+
+			It("forgets them when they are cleared", func() {
+				hr.SetResourceLimits("chaincode-name", nil)
+				err := hr.Register(handler)
+				Expect(err).NotTo(HaveOccurred())
+
+				executeTimeout, maxConcurrency := chaincode.HandlerResourceLimits(handler)
+				Expect(executeTimeout).To(BeZero())
+				Expect(maxConcurrency).To(BeZero())
+			})
+		})
+
 		Context("when a handler has already been registered", func() {
 			BeforeEach(func() {
 				err := hr.Register(handler)
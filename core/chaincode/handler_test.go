@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/mock"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -42,6 +43,7 @@ var _ = Describe("Handler", func() {
 		fakeLedgerGetter               *mock.LedgerGetter
 		fakeHandlerRegistry            *fake.Registry
 		fakeApplicationConfigRetriever *fake.ApplicationConfigRetriever
+		fakeCollectionStore            *fake.CollectionStore
 
 		responseNotifier chan *pb.ChaincodeMessage
 		txContext        *chaincode.TransactionContext
@@ -80,10 +82,13 @@ var _ = Describe("Handler", func() {
 
 		fakeApplicationConfigRetriever = &fake.ApplicationConfigRetriever{}
 		applicationCapability := &config.MockApplication{
-			CapabilitiesRv: &config.MockApplicationCapabilities{KeyLevelEndorsementRv: true},
+			CapabilitiesRv: &config.MockApplicationCapabilities{KeyLevelEndorsementRv: true, V1_4ValidationRv: true},
 		}
 		fakeApplicationConfigRetriever.GetApplicationConfigReturns(applicationCapability, true)
 
+		fakeCollectionStore = &fake.CollectionStore{}
+		fakeCollectionStore.RetrieveReadWritePermissionReturns(true, true, nil)
+
 		handler = &chaincode.Handler{
 			ACLProvider:                fakeACLProvider,
 			ActiveTransactions:         fakeTransactionRegistry,
@@ -99,7 +104,8 @@ var _ = Describe("Handler", func() {
 			UUIDGenerator: chaincode.UUIDGeneratorFunc(func() string {
 				return "generated-query-id"
 			}),
-			AppConfig: fakeApplicationConfigRetriever,
+			AppConfig:       fakeApplicationConfigRetriever,
+			CollectionStore: fakeCollectionStore,
 		}
 		chaincode.SetHandlerChatStream(handler, fakeChatStream)
 		chaincode.SetHandlerChaincodeID(handler, &pb.ChaincodeID{Name: "test-handler-name"})
@@ -476,6 +482,109 @@ var _ = Describe("Handler", func() {
 				Expect(value).To(Equal([]byte("put-state-value")))
 			})
 
+			It("checks the write access of the creator only once per collection", func() {
+				_, err := handler.HandlePutState(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = handler.HandlePutState(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeCollectionStore.RetrieveReadWritePermissionCallCount()).To(Equal(1))
+				cc, _ := fakeCollectionStore.RetrieveReadWritePermissionArgsForCall(0)
+				Expect(cc).To(Equal(common.CollectionCriteria{
+					Channel:    "channel-id",
+					Namespace:  "cc-instance-name",
+					Collection: "collection-name",
+				}))
+			})
+
+			Context("when the V1_4 validation capability is not enabled", func() {
+				BeforeEach(func() {
+					applicationCapability := &config.MockApplication{
+						CapabilitiesRv: &config.MockApplicationCapabilities{KeyLevelEndorsementRv: true},
+					}
+					fakeApplicationConfigRetriever.GetApplicationConfigReturns(applicationCapability, true)
+					fakeCollectionStore.RetrieveReadWritePermissionReturns(false, false, nil)
+				})
+
+				It("does not check the access of the creator", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCollectionStore.RetrieveReadWritePermissionCallCount()).To(Equal(0))
+					Expect(fakeCollectionStore.RetrieveCollectionConfigPackageCallCount()).To(Equal(0))
+					Expect(fakeTxSimulator.SetPrivateDataCallCount()).To(Equal(1))
+				})
+
+				Context("when the collection has the name of an implicit collection", func() {
+					BeforeEach(func() {
+						request.Collection = "_implicit_org_Org1MSP"
+						payload, err := proto.Marshal(request)
+						Expect(err).NotTo(HaveOccurred())
+						incomingMessage.Payload = payload
+					})
+
+					It("returns an error if the collection is not defined", func() {
+						_, err := handler.HandlePutState(incomingMessage, txContext)
+						Expect(err).To(MatchError("implicit collection _implicit_org_Org1MSP requires the V1_4 application capability"))
+						Expect(fakeTxSimulator.SetPrivateDataCallCount()).To(Equal(0))
+					})
+
+					It("accepts a collection that is defined explicitly", func() {
+						fakeCollectionStore.RetrieveCollectionConfigPackageReturns(&common.CollectionConfigPackage{
+							Config: []*common.CollectionConfig{{
+								Payload: &common.CollectionConfig_StaticCollectionConfig{
+									StaticCollectionConfig: &common.StaticCollectionConfig{Name: "_implicit_org_Org1MSP"},
+								},
+							}},
+						}, nil)
+
+						_, err := handler.HandlePutState(incomingMessage, txContext)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(fakeTxSimulator.SetPrivateDataCallCount()).To(Equal(1))
+					})
+
+					It("returns an error if the collections cannot be retrieved", func() {
+						fakeCollectionStore.RetrieveCollectionConfigPackageReturns(nil, errors.New("mothra"))
+
+						_, err := handler.HandlePutState(incomingMessage, txContext)
+						Expect(err).To(MatchError("failed to check access to collection _implicit_org_Org1MSP: mothra"))
+					})
+				})
+			})
+
+			Context("when the application config cannot be retrieved", func() {
+				BeforeEach(func() {
+					fakeApplicationConfigRetriever.GetApplicationConfigReturns(nil, false)
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("application config does not exist for channel-id"))
+				})
+			})
+
+			Context("when the creator does not have write access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.RetrieveReadWritePermissionReturns(true, false, nil)
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+					Expect(fakeTxSimulator.SetPrivateDataCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the access of the creator cannot be retrieved", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.RetrieveReadWritePermissionReturns(false, false, errors.New("mothra"))
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("failed to check access to collection collection-name: mothra"))
+				})
+			})
+
 			Context("when SetPrivateData fails", func() {
 				BeforeEach(func() {
 					fakeTxSimulator.SetPrivateDataReturns(errors.New("godzilla"))
@@ -628,6 +737,111 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Describe("HandlePurgePrivateData", func() {
+		var incomingMessage *pb.ChaincodeMessage
+		var request *pb.DelState
+
+		BeforeEach(func() {
+			applicationCapability := &config.MockApplication{
+				CapabilitiesRv: &config.MockApplicationCapabilities{V1_3ValidationRv: true, V1_4ValidationRv: true},
+			}
+			fakeApplicationConfigRetriever.GetApplicationConfigReturns(applicationCapability, true)
+
+			request = &pb.DelState{
+				Key:        "purge-key",
+				Collection: "collection-name",
+			}
+			payload, err := proto.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			incomingMessage = &pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_PURGE_PRIVATE_DATA,
+				Payload:   payload,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}
+		})
+
+		It("calls PurgePrivateData on the transaction simulator", func() {
+			resp, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(Equal(&pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_RESPONSE,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}))
+
+			Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(1))
+			ccname, collection, key := fakeTxSimulator.PurgePrivateDataArgsForCall(0)
+			Expect(ccname).To(Equal("cc-instance-name"))
+			Expect(collection).To(Equal("collection-name"))
+			Expect(key).To(Equal("purge-key"))
+		})
+
+		Context("when the V1_4 validation capability is not enabled", func() {
+			BeforeEach(func() {
+				applicationCapability := &config.MockApplication{
+					CapabilitiesRv: &config.MockApplicationCapabilities{V1_3ValidationRv: true},
+				}
+				fakeApplicationConfigRetriever.GetApplicationConfigReturns(applicationCapability, true)
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("private data purge is not enabled"))
+				Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when unmarshalling the request fails", func() {
+			BeforeEach(func() {
+				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+			})
+		})
+
+		Context("when the collection is not set", func() {
+			BeforeEach(func() {
+				request.Collection = ""
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("only private data can be purged"))
+			})
+		})
+
+		Context("when the creator does not have write access", func() {
+			BeforeEach(func() {
+				fakeCollectionStore.RetrieveReadWritePermissionReturns(true, false, nil)
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+				Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when PurgePrivateData fails", func() {
+			BeforeEach(func() {
+				fakeTxSimulator.PurgePrivateDataReturns(errors.New("rodan"))
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("rodan"))
+			})
+		})
+	})
+
 	Describe("HandleDelState", func() {
 		var incomingMessage *pb.ChaincodeMessage
 		var request *pb.DelState
@@ -784,6 +998,18 @@ var _ = Describe("Handler", func() {
 				Expect(key).To(Equal("get-state-key"))
 			})
 
+			Context("and the creator does not have read access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.RetrieveReadWritePermissionReturns(false, true, nil)
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetState(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have read access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+					Expect(fakeTxSimulator.GetPrivateDataCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("and GetPrivateData fails", func() {
 				BeforeEach(func() {
 					fakeTxSimulator.GetPrivateDataReturns(nil, errors.New("french fries"))
@@ -2202,6 +2428,60 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the chaincode limits concurrent transactions", func() {
+			BeforeEach(func() {
+				chaincode.SetHandlerResourceLimits(handler, &pb.ChaincodeResourceLimits{MaxConcurrency: 1})
+			})
+
+			It("waits for a running transaction to complete", func() {
+				doneCh := make(chan struct{})
+				go func() {
+					handler.Execute(txParams, cccid, incomingMessage, time.Second)
+					close(doneCh)
+				}()
+				Eventually(fakeChatStream.SendCallCount).Should(Equal(1))
+
+				otherTxParams := *txParams
+				otherTxParams.TxID = "other-tx-id"
+				_, err := handler.Execute(&otherTxParams, cccid, incomingMessage, 10*time.Millisecond)
+				Expect(err).To(MatchError("timeout expired while waiting for one of the 1 concurrent transactions of the chaincode to complete"))
+				Expect(fakeChatStream.SendCallCount()).To(Equal(1))
+
+				Eventually(responseNotifier).Should(BeSent(&pb.ChaincodeMessage{}))
+				Eventually(doneCh).Should(BeClosed())
+
+				close(responseNotifier)
+				_, err = handler.Execute(&otherTxParams, cccid, incomingMessage, time.Second)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(fakeChatStream.SendCallCount).Should(Equal(2))
+			})
+
+			It("does not make a nested invocation of the same transaction wait", func() {
+				doneCh := make(chan struct{})
+				go func() {
+					handler.Execute(txParams, cccid, incomingMessage, time.Second)
+					close(doneCh)
+				}()
+				Eventually(fakeChatStream.SendCallCount).Should(Equal(1))
+
+				nestedDoneCh := make(chan struct{})
+				go func() {
+					handler.Execute(txParams, cccid, incomingMessage, time.Second)
+					close(nestedDoneCh)
+				}()
+				Eventually(fakeChatStream.SendCallCount).Should(Equal(2))
+
+				close(responseNotifier)
+				Eventually(nestedDoneCh).Should(BeClosed())
+				Eventually(doneCh).Should(BeClosed())
+
+				otherTxParams := *txParams
+				otherTxParams.TxID = "other-tx-id"
+				_, err := handler.Execute(&otherTxParams, cccid, incomingMessage, 10*time.Millisecond)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when execute times out", func() {
 			It("returns an error", func() {
 				errCh := make(chan error, 1)
@@ -2212,6 +2492,21 @@ var _ = Describe("Handler", func() {
 				Eventually(errCh).Should(Receive(MatchError("timeout expired while executing transaction")))
 			})
 
+			Context("and the chaincode has its own execution timeout", func() {
+				BeforeEach(func() {
+					chaincode.SetHandlerResourceLimits(handler, &pb.ChaincodeResourceLimits{ExecuteTimeoutMs: 1})
+				})
+
+				It("uses the timeout of the chaincode", func() {
+					errCh := make(chan error, 1)
+					go func() {
+						_, err := handler.Execute(txParams, cccid, incomingMessage, time.Hour)
+						errCh <- err
+					}()
+					Eventually(errCh).Should(Receive(MatchError("timeout expired while executing transaction")))
+				})
+			})
+
 			It("deletes the transaction context", func() {
 				handler.Execute(txParams, cccid, incomingMessage, time.Millisecond)
 
@@ -0,0 +1,329 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package harness runs chaincode in-process against a real peer ledger.
+//
+// Unlike shim.MockStub, which reimplements state semantics in memory, the
+// harness simulates transactions with the lockbased transaction manager on
+// top of a goleveldb (or, optionally, CouchDB) state database. Transactions
+// are then ordered into blocks and validated the way a committing peer would
+// validate them: endorsement policies (including key-level policies set
+// through ext/statebased) are evaluated by the key-level validator used by
+// the builtin VSCC, and read-write sets are subject to MVCC validation.
+package harness
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr/lockbasedtxmgr"
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+var logger = flogging.MustGetLogger("chaincode.harness")
+
+const lsccNamespace = "lscc"
+
+// Config contains the settings used to construct a Harness.
+type Config struct {
+	// ChannelID is the ID of the channel the chaincodes are deployed on.
+	ChannelID string
+	// RootDir is the directory holding the ledger files. If empty, a
+	// temporary directory is created and removed by Close.
+	RootDir string
+	// CouchDBAddress, if set, selects CouchDB as the state database, which
+	// enables rich queries. goleveldb is used otherwise.
+	CouchDBAddress string
+}
+
+// Chaincode describes a chaincode deployed on the harness.
+type Chaincode struct {
+	// Name is the name of the chaincode and the namespace of its state.
+	Name string
+	// Version is the version recorded in the transactions.
+	Version string
+	// Chaincode is the implementation that is invoked in-process.
+	Chaincode shim.Chaincode
+	// EndorsementPolicy is the chaincode-level endorsement policy.
+	EndorsementPolicy *common.SignaturePolicyEnvelope
+	// Collections is the private data collection configuration.
+	Collections *common.CollectionConfigPackage
+}
+
+// Harness runs chaincodes against a ledger of a single channel.
+// Proposals may be endorsed concurrently, however blocks must be
+// committed by a single goroutine.
+type Harness struct {
+	channelID    string
+	rootDir      string
+	removeRoot   bool
+	bookkeeper   bookkeeping.Provider
+	dbProvider   privacyenabledstate.DBProvider
+	txmgr        txmgr.TxMgr
+	blockNum     uint64
+	previousHash []byte
+
+	mutex      sync.RWMutex
+	chaincodes map[string]*chaincodeDefinition
+}
+
+type chaincodeDefinition struct {
+	name        string
+	version     string
+	chaincode   shim.Chaincode
+	collections *common.CollectionConfigPackage
+	policyBytes []byte
+}
+
+// New creates a Harness backed by an empty ledger. The ledger location and
+// state database are configured through viper, therefore only one Harness
+// should be active in a process at any given time.
+func New(config Config) (*Harness, error) {
+	if config.ChannelID == "" {
+		return nil, errors.New("channel ID must be set")
+	}
+
+	rootDir, removeRoot := config.RootDir, false
+	if rootDir == "" {
+		dir, err := ioutil.TempDir("", "chaincode-harness")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create ledger directory")
+		}
+		rootDir, removeRoot = dir, true
+	}
+
+	viper.Set("peer.fileSystemPath", rootDir)
+	if config.CouchDBAddress != "" {
+		viper.Set("ledger.state.stateDatabase", "CouchDB")
+		viper.Set("ledger.state.couchDBConfig.couchDBAddress", config.CouchDBAddress)
+		viper.Set("ledger.state.couchDBConfig.maxRetries", 3)
+		viper.Set("ledger.state.couchDBConfig.maxRetriesOnStartup", 10)
+		viper.Set("ledger.state.couchDBConfig.requestTimeout", 35*time.Second)
+	} else {
+		viper.Set("ledger.state.stateDatabase", "goleveldb")
+	}
+
+	h := &Harness{
+		channelID:  config.ChannelID,
+		rootDir:    rootDir,
+		removeRoot: removeRoot,
+		blockNum:   1,
+		chaincodes: map[string]*chaincodeDefinition{},
+	}
+
+	h.bookkeeper = bookkeeping.NewProvider()
+	dbProvider, err := privacyenabledstate.NewCommonStorageDBProvider(h.bookkeeper)
+	if err != nil {
+		h.Close()
+		return nil, errors.WithMessage(err, "failed to create state database provider")
+	}
+	h.dbProvider = dbProvider
+
+	db, err := dbProvider.GetDBHandle(config.ChannelID)
+	if err != nil {
+		h.Close()
+		return nil, errors.WithMessage(err, "failed to open state database")
+	}
+
+	h.txmgr, err = lockbasedtxmgr.NewLockBasedTxMgr(config.ChannelID, db, nil, &btlPolicy{harness: h}, h.bookkeeper)
+	if err != nil {
+		h.Close()
+		return nil, errors.WithMessage(err, "failed to create transaction manager")
+	}
+
+	return h, nil
+}
+
+// Close releases the ledger resources held by the harness.
+func (h *Harness) Close() {
+	if h.txmgr != nil {
+		h.txmgr.Shutdown()
+	}
+	if h.dbProvider != nil {
+		h.dbProvider.Close()
+	}
+	if h.bookkeeper != nil {
+		h.bookkeeper.Close()
+	}
+	if h.removeRoot {
+		os.RemoveAll(h.rootDir)
+	}
+}
+
+// ChannelID returns the ID of the channel simulated by the harness.
+func (h *Harness) ChannelID() string {
+	return h.channelID
+}
+
+// Height returns the number of the next block to be committed.
+func (h *Harness) Height() uint64 {
+	return h.blockNum
+}
+
+// Deploy makes the chaincode available for invocation. The collection
+// configuration, if any, is committed to the ledger in a block of its own
+// so that the ledger can validate private data written by the chaincode.
+// Deploy does not invoke Init; submit a Proposal with IsInit set instead.
+func (h *Harness) Deploy(cc *Chaincode) error {
+	if cc.Name == "" {
+		return errors.New("chaincode name must be set")
+	}
+	if cc.Chaincode == nil {
+		return errors.Errorf("chaincode %s has no implementation", cc.Name)
+	}
+	if cc.EndorsementPolicy == nil {
+		return errors.Errorf("chaincode %s has no endorsement policy", cc.Name)
+	}
+	policyBytes, err := proto.Marshal(cc.EndorsementPolicy)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal endorsement policy of chaincode %s", cc.Name)
+	}
+
+	h.mutex.Lock()
+	h.chaincodes[cc.Name] = &chaincodeDefinition{
+		name:        cc.Name,
+		version:     cc.Version,
+		chaincode:   cc.Chaincode,
+		collections: cc.Collections,
+		policyBytes: policyBytes,
+	}
+	h.mutex.Unlock()
+
+	if cc.Collections == nil {
+		return nil
+	}
+
+	collBytes, err := proto.Marshal(cc.Collections)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal collections of chaincode %s", cc.Name)
+	}
+	txid := newTxID()
+	sim, err := h.txmgr.NewTxSimulator(txid)
+	if err != nil {
+		return errors.WithMessage(err, "failed to create transaction simulator")
+	}
+	err = sim.SetState(lsccNamespace, privdata.BuildCollectionKVSKey(cc.Name), collBytes)
+	if err != nil {
+		sim.Done()
+		return errors.WithMessage(err, "failed to record collection configuration")
+	}
+	results, err := sim.GetTxSimulationResults()
+	sim.Done()
+	if err != nil {
+		return errors.WithMessage(err, "failed to obtain simulation results")
+	}
+
+	codes, err := h.Commit(&Transaction{
+		TxID:              txid,
+		Chaincode:         lsccNamespace,
+		Response:          shim.Success(nil),
+		SimulationResults: results,
+	})
+	if err != nil {
+		return err
+	}
+	if codes[0] != pb.TxValidationCode_VALID {
+		return errors.Errorf("collection configuration of chaincode %s was invalidated: %s", cc.Name, codes[0])
+	}
+	return nil
+}
+
+// Invoke endorses the proposal and commits the resulting transaction in a
+// block of its own.
+func (h *Harness) Invoke(p *Proposal) (*Transaction, pb.TxValidationCode, error) {
+	tx, err := h.Endorse(p)
+	if err != nil {
+		return nil, pb.TxValidationCode_INVALID_OTHER_REASON, err
+	}
+	if tx.Response.Status >= shim.ERRORTHRESHOLD {
+		return tx, pb.TxValidationCode_INVALID_OTHER_REASON, errors.Errorf("chaincode %s returned error: %s", p.Chaincode, tx.Response.Message)
+	}
+	codes, err := h.Commit(tx)
+	if err != nil {
+		return tx, pb.TxValidationCode_INVALID_OTHER_REASON, err
+	}
+	return tx, codes[0], nil
+}
+
+// GetState returns the committed value of a key in the namespace of the
+// given chaincode.
+func (h *Harness) GetState(chaincode, key string) ([]byte, error) {
+	qe, err := h.txmgr.NewQueryExecutor(newTxID())
+	if err != nil {
+		return nil, err
+	}
+	defer qe.Done()
+	return qe.GetState(chaincode, key)
+}
+
+// GetPrivateData returns the committed value of a key in a collection of
+// the given chaincode.
+func (h *Harness) GetPrivateData(chaincode, collection, key string) ([]byte, error) {
+	qe, err := h.txmgr.NewQueryExecutor(newTxID())
+	if err != nil {
+		return nil, err
+	}
+	defer qe.Done()
+	return qe.GetPrivateData(chaincode, collection, key)
+}
+
+func (h *Harness) chaincode(name string) (*chaincodeDefinition, bool) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	cc, ok := h.chaincodes[name]
+	return cc, ok
+}
+
+// btlPolicy resolves the block-to-live of collections from the
+// configuration of the deployed chaincodes.
+type btlPolicy struct {
+	harness *Harness
+}
+
+// GetBTL implements the function from the pvtdatapolicy.BTLPolicy interface.
+func (p *btlPolicy) GetBTL(ns string, coll string) (uint64, error) {
+	cc, ok := p.harness.chaincode(ns)
+	if !ok || cc.collections == nil {
+		return 0, privdata.NoSuchCollectionError{Channel: p.harness.channelID, Namespace: ns, Collection: coll}
+	}
+	for _, config := range cc.collections.Config {
+		staticConfig := config.GetStaticCollectionConfig()
+		if staticConfig == nil || staticConfig.Name != coll {
+			continue
+		}
+		if staticConfig.BlockToLive == 0 {
+			return math.MaxUint64, nil
+		}
+		return staticConfig.BlockToLive, nil
+	}
+	return 0, privdata.NoSuchCollectionError{Channel: p.harness.channelID, Namespace: ns, Collection: coll}
+}
+
+// GetExpiringBlock implements the function from the pvtdatapolicy.BTLPolicy interface.
+func (p *btlPolicy) GetExpiringBlock(ns string, coll string, committingBlock uint64) (uint64, error) {
+	btl, err := p.GetBTL(ns, coll)
+	if err != nil {
+		return 0, err
+	}
+	expiryBlk := committingBlock + btl + uint64(1)
+	if expiryBlk <= committingBlock {
+		expiryBlk = math.MaxUint64
+	}
+	return expiryBlk, nil
+}
@@ -0,0 +1,396 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/chaincode/shim/ext/statebased"
+	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChaincode exercises the parts of the stub the harness provides.
+type testChaincode struct{}
+
+func (cc *testChaincode) Init(stub shim.ChaincodeStubInterface) pb.Response {
+	if err := stub.PutState("initialized", []byte("true")); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(nil)
+}
+
+func (cc *testChaincode) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
+	fn, args := stub.GetFunctionAndParameters()
+	switch fn {
+	case "put":
+		if err := stub.PutState(args[0], []byte(args[1])); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	case "get":
+		val, err := stub.GetState(args[0])
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(val)
+	case "incr":
+		val, err := stub.GetState(args[0])
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		n, _ := strconv.Atoi(string(val))
+		if err := stub.PutState(args[0], []byte(strconv.Itoa(n+1))); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	case "setep":
+		ep, err := statebased.NewStateEP(nil)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if err := ep.AddOrgs(statebased.RoleTypePeer, args[1:]...); err != nil {
+			return shim.Error(err.Error())
+		}
+		policy, err := ep.Policy()
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if err := stub.SetStateValidationParameter(args[0], policy); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	case "putpvt":
+		if err := stub.PutPrivateData(args[0], args[1], []byte(args[2])); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	case "getpvt":
+		val, err := stub.GetPrivateData(args[0], args[1])
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(val)
+	case "page":
+		pageSize, _ := strconv.Atoi(args[0])
+		bookmark := ""
+		if len(args) > 1 {
+			bookmark = args[1]
+		}
+		iter, metadata, err := stub.GetStateByRangeWithPagination("key", "key~", int32(pageSize), bookmark)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		defer iter.Close()
+		result := ""
+		for iter.HasNext() {
+			kv, err := iter.Next()
+			if err != nil {
+				return shim.Error(err.Error())
+			}
+			result += kv.Key + ","
+		}
+		return shim.Success([]byte(result + metadata.Bookmark))
+	case "event":
+		if err := stub.SetEvent(args[0], []byte(args[1])); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	case "events":
+		for _, name := range args {
+			if err := stub.AppendEvent(name, []byte(name)); err != nil {
+				return shim.Error(err.Error())
+			}
+		}
+		return shim.Success(nil)
+	case "call":
+		return stub.InvokeChaincode(args[0], [][]byte{[]byte(args[1]), []byte(args[2]), []byte(args[3])}, "")
+	case "putcall":
+		if err := stub.PutState(args[0], []byte(args[1])); err != nil {
+			return shim.Error(err.Error())
+		}
+		return stub.InvokeChaincode(args[2], [][]byte{[]byte(args[3]), []byte(args[4]), []byte(args[5])}, "")
+	default:
+		return shim.Error("unknown function " + fn)
+	}
+}
+
+func newTestHarness(t *testing.T) *Harness {
+	h, err := New(Config{ChannelID: "testchannel"})
+	require.NoError(t, err)
+
+	err = h.Deploy(&Chaincode{
+		Name:              "cc",
+		Version:           "1.0",
+		Chaincode:         &testChaincode{},
+		EndorsementPolicy: cauthdsl.SignedByAnyMember([]string{"Org1MSP", "Org2MSP"}),
+		Collections: &common.CollectionConfigPackage{
+			Config: []*common.CollectionConfig{{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name:        "coll",
+						BlockToLive: 2,
+					},
+				},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	return h
+}
+
+func args(a ...string) [][]byte {
+	result := make([][]byte, len(a))
+	for i, arg := range a {
+		result[i] = []byte(arg)
+	}
+	return result
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{})
+	assert.EqualError(t, err, "channel ID must be set")
+}
+
+func TestDeploy(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	err := h.Deploy(&Chaincode{Name: "nopolicy", Chaincode: &testChaincode{}})
+	assert.EqualError(t, err, "chaincode nopolicy has no endorsement policy")
+
+	err = h.Deploy(&Chaincode{Name: "noimpl"})
+	assert.EqualError(t, err, "chaincode noimpl has no implementation")
+
+	_, err = h.Endorse(&Proposal{Chaincode: "missing"})
+	assert.EqualError(t, err, "chaincode missing is not deployed")
+}
+
+func TestInvokeAndQuery(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	_, code, err := h.Invoke(&Proposal{Chaincode: "cc", IsInit: true, Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	tx, code, err := h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "1"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+	assert.Equal(t, int32(shim.OK), tx.Response.Status)
+
+	val, err := h.GetState("cc", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), val)
+	val, err = h.GetState("cc", "initialized")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("true"), val)
+
+	tx, err = h.Endorse(&Proposal{Chaincode: "cc", Args: args("get", "a")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), tx.Response.Payload)
+
+	tx, _, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("bogus")})
+	assert.EqualError(t, err, "chaincode cc returned error: unknown function bogus")
+	_, err = h.Commit(tx)
+	assert.EqualError(t, err, fmt.Sprintf("transaction %s was not endorsed: chaincode returned error: unknown function bogus", tx.TxID))
+}
+
+func TestMVCCConflict(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	tx1, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("incr", "counter"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	tx2, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("incr", "counter"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+
+	codes, err := h.Commit(tx1, tx2)
+	require.NoError(t, err)
+	assert.Equal(t, []pb.TxValidationCode{pb.TxValidationCode_VALID, pb.TxValidationCode_MVCC_READ_CONFLICT}, codes)
+
+	val, err := h.GetState("cc", "counter")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), val)
+}
+
+func TestEndorsementPolicy(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	_, code, err := h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "1"), Endorsers: []string{"Org3MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, code)
+
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "1")})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, code)
+
+	val, err := h.GetState("cc", "a")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
+
+func TestKeyLevelEndorsement(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	// validation parameters are only recorded for existing keys
+	_, code, err := h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "0"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("setep", "a", "Org2MSP"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "1"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, code)
+
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "a", "1"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	// a transaction changing the validation parameter invalidates
+	// later transactions in the same block that write the key
+	tx1, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("setep", "a", "Org1MSP"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+	tx2, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("put", "a", "2"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+	codes, err := h.Commit(tx1, tx2)
+	require.NoError(t, err)
+	assert.Equal(t, []pb.TxValidationCode{pb.TxValidationCode_VALID, pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE}, codes)
+}
+
+func TestKeyLevelEndorsementInvalidTransaction(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	err := h.Deploy(&Chaincode{
+		Name:              "other",
+		Chaincode:         &testChaincode{},
+		EndorsementPolicy: cauthdsl.SignedByMspMember("Org2MSP"),
+	})
+	require.NoError(t, err)
+
+	_, code, err := h.Invoke(&Proposal{Chaincode: "other", Args: args("put", "a", "0"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	// the first transaction fails the policy of its own namespace, so the
+	// validation parameter it sets in the namespace of the called
+	// chaincode must be released for the second transaction
+	tx1, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("putcall", "b", "1", "other", "setep", "a", "Org1MSP"), Endorsers: []string{"Org3MSP"}})
+	require.NoError(t, err)
+	tx2, err := h.Endorse(&Proposal{Chaincode: "other", Args: args("put", "a", "1"), Endorsers: []string{"Org2MSP"}})
+	require.NoError(t, err)
+	codes, err := h.Commit(tx1, tx2)
+	require.NoError(t, err)
+	assert.Equal(t, []pb.TxValidationCode{pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, pb.TxValidationCode_VALID}, codes)
+}
+
+func TestPrivateData(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	_, code, err := h.Invoke(&Proposal{Chaincode: "cc", Args: args("putpvt", "coll", "a", "secret"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	val, err := h.GetPrivateData("cc", "coll", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), val)
+
+	tx, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("getpvt", "coll", "a")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), tx.Response.Payload)
+
+	tx, err = h.Endorse(&Proposal{Chaincode: "cc", Args: args("putpvt", "missing", "a", "secret")})
+	require.NoError(t, err)
+	assert.Equal(t, int32(shim.ERROR), tx.Response.Status)
+	assert.Contains(t, tx.Response.Message, "collection [missing] not defined")
+
+	// the data expires after the block-to-live of the collection
+	for i := 0; i < 3; i++ {
+		_, _, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("put", "b", "1"), Endorsers: []string{"Org1MSP"}})
+		require.NoError(t, err)
+	}
+	val, err = h.GetPrivateData("cc", "coll", "a")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
+
+func TestPagination(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	var txs []*Transaction
+	for i := 0; i < 5; i++ {
+		tx, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("put", fmt.Sprintf("key%d", i), "v"), Endorsers: []string{"Org1MSP"}})
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+	_, err := h.Commit(txs...)
+	require.NoError(t, err)
+
+	tx, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("page", "2")})
+	require.NoError(t, err)
+	assert.Equal(t, "key0,key1,key2", string(tx.Response.Payload))
+
+	tx, err = h.Endorse(&Proposal{Chaincode: "cc", Args: args("page", "3", "key2")})
+	require.NoError(t, err)
+	assert.Equal(t, "key2,key3,key4,", string(tx.Response.Payload))
+}
+
+func TestEventsAndChaincodeToChaincode(t *testing.T) {
+	h := newTestHarness(t)
+	defer h.Close()
+
+	err := h.Deploy(&Chaincode{
+		Name:              "other",
+		Chaincode:         &testChaincode{},
+		EndorsementPolicy: cauthdsl.SignedByMspMember("Org2MSP"),
+	})
+	require.NoError(t, err)
+
+	tx, err := h.Endorse(&Proposal{Chaincode: "cc", Args: args("event", "created", "payload")})
+	require.NoError(t, err)
+	require.Len(t, tx.Events, 1)
+	assert.Equal(t, "cc", tx.Events[0].ChaincodeId)
+	assert.Equal(t, tx.TxID, tx.Events[0].TxId)
+	assert.Equal(t, "created", tx.Events[0].EventName)
+	assert.Equal(t, []byte("payload"), tx.Events[0].Payload)
+
+	tx, code, err := h.Invoke(&Proposal{Chaincode: "cc", Args: args("events", "created", "transferred")})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+	require.Len(t, tx.Events, 2)
+	assert.Equal(t, "created", tx.Events[0].EventName)
+	assert.Equal(t, "transferred", tx.Events[1].EventName)
+	assert.Equal(t, tx.TxID, tx.Events[1].TxId)
+
+	// writes to the namespace of the called chaincode are validated
+	// against its own endorsement policy
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("call", "other", "put", "a", "1"), Endorsers: []string{"Org1MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, code)
+
+	_, code, err = h.Invoke(&Proposal{Chaincode: "cc", Args: args("call", "other", "put", "a", "1"), Endorsers: []string{"Org1MSP", "Org2MSP"}})
+	require.NoError(t, err)
+	assert.Equal(t, pb.TxValidationCode_VALID, code)
+
+	val, err := h.GetState("other", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), val)
+}
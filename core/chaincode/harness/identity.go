@@ -0,0 +1,91 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/msp"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// endorserDeserializer deserializes the endorser identities produced by
+// Transaction.assemble so that endorsement policies can be evaluated by
+// cauthdsl without certificates.
+type endorserDeserializer struct{}
+
+func (d *endorserDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	sID := &mspproto.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sID); err != nil {
+		return nil, errors.Wrap(err, "could not deserialize endorser identity")
+	}
+	return &endorserIdentity{mspID: sID.Mspid, serialized: serializedIdentity}, nil
+}
+
+func (d *endorserDeserializer) IsWellFormed(identity *mspproto.SerializedIdentity) error {
+	return nil
+}
+
+// endorserIdentity is the identity of a peer of the organization with the
+// given MSP ID. It satisfies the member and peer roles of that organization.
+type endorserIdentity struct {
+	mspID      string
+	serialized []byte
+}
+
+func (id *endorserIdentity) ExpiresAt() time.Time {
+	return time.Time{}
+}
+
+func (id *endorserIdentity) GetIdentifier() *msp.IdentityIdentifier {
+	return &msp.IdentityIdentifier{Mspid: id.mspID, Id: id.mspID}
+}
+
+func (id *endorserIdentity) GetMSPIdentifier() string {
+	return id.mspID
+}
+
+func (id *endorserIdentity) Validate() error {
+	return nil
+}
+
+func (id *endorserIdentity) GetOrganizationalUnits() []*msp.OUIdentifier {
+	return nil
+}
+
+func (id *endorserIdentity) Anonymous() bool {
+	return false
+}
+
+func (id *endorserIdentity) Verify(msg []byte, sig []byte) error {
+	return nil
+}
+
+func (id *endorserIdentity) Serialize() ([]byte, error) {
+	return id.serialized, nil
+}
+
+func (id *endorserIdentity) SatisfiesPrincipal(principal *mspproto.MSPPrincipal) error {
+	if principal.PrincipalClassification != mspproto.MSPPrincipal_ROLE {
+		return errors.Errorf("principal classification %s is not supported by the harness", principal.PrincipalClassification)
+	}
+	role := &mspproto.MSPRole{}
+	if err := proto.Unmarshal(principal.Principal, role); err != nil {
+		return errors.Wrap(err, "could not unmarshal MSPRole")
+	}
+	if role.MspIdentifier != id.mspID {
+		return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", role.MspIdentifier, id.mspID)
+	}
+	switch role.Role {
+	case mspproto.MSPRole_MEMBER, mspproto.MSPRole_PEER:
+		return nil
+	default:
+		return errors.Errorf("endorser of %s does not have role %s", id.mspID, role.Role)
+	}
+}
@@ -0,0 +1,534 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	commonledger "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	minUnicodeRuneValue   = 0            //U+0000
+	maxUnicodeRuneValue   = utf8.MaxRune //U+10FFFF - maximum (and unallocated) code point
+	compositeKeyNamespace = "\x00"
+	emptyKeySubstitute    = "\x01"
+)
+
+var validationParameterMetakey = pb.MetaDataKeys_VALIDATION_PARAMETER.String()
+
+// stub implements shim.ChaincodeStubInterface on top of a transaction
+// simulator, performing the same ledger calls the peer's chaincode
+// Handler performs on behalf of a chaincode.
+type stub struct {
+	harness        *Harness
+	sim            ledger.TxSimulator
+	namespace      string
+	txid           string
+	args           [][]byte
+	transient      map[string][]byte
+	proposal       *pb.Proposal
+	signedProposal *pb.SignedProposal
+	creator        []byte
+	binding        []byte
+	timestamp      *timestamp.Timestamp
+	events         []*pb.ChaincodeEvent
+}
+
+func newStub(h *Harness, sim ledger.TxSimulator, namespace, txid string, args [][]byte, transient map[string][]byte, prop *pb.Proposal) (*stub, error) {
+	hdr, err := putils.GetHeader(prop.Header)
+	if err != nil {
+		return nil, err
+	}
+	chdr, err := putils.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+	shdr, err := putils.GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := putils.ComputeProposalBinding(prop)
+	if err != nil {
+		return nil, err
+	}
+	propBytes, err := proto.Marshal(prop)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal proposal")
+	}
+
+	return &stub{
+		harness:        h,
+		sim:            sim,
+		namespace:      namespace,
+		txid:           txid,
+		args:           args,
+		transient:      transient,
+		proposal:       prop,
+		signedProposal: &pb.SignedProposal{ProposalBytes: propBytes},
+		creator:        shdr.Creator,
+		binding:        binding,
+		timestamp:      chdr.Timestamp,
+	}, nil
+}
+
+func (s *stub) GetArgs() [][]byte {
+	return s.args
+}
+
+func (s *stub) GetStringArgs() []string {
+	strargs := make([]string, 0, len(s.args))
+	for _, barg := range s.args {
+		strargs = append(strargs, string(barg))
+	}
+	return strargs
+}
+
+func (s *stub) GetFunctionAndParameters() (function string, params []string) {
+	allargs := s.GetStringArgs()
+	function = ""
+	params = []string{}
+	if len(allargs) >= 1 {
+		function = allargs[0]
+		params = allargs[1:]
+	}
+	return
+}
+
+func (s *stub) GetArgsSlice() ([]byte, error) {
+	res := []byte{}
+	for _, barg := range s.args {
+		res = append(res, barg...)
+	}
+	return res, nil
+}
+
+func (s *stub) GetTxID() string {
+	return s.txid
+}
+
+func (s *stub) GetChannelID() string {
+	return s.harness.channelID
+}
+
+// InvokeChaincode runs the target chaincode on the same transaction
+// simulator, as the peer does for chaincodes on the same channel.
+func (s *stub) InvokeChaincode(chaincodeName string, args [][]byte, channel string) pb.Response {
+	if channel != "" && channel != s.harness.channelID {
+		return shim.Error("channel " + channel + " is not available in the harness")
+	}
+	cc, ok := s.harness.chaincode(chaincodeName)
+	if !ok {
+		return shim.Error("chaincode " + chaincodeName + " is not deployed")
+	}
+	target := *s
+	target.namespace = cc.name
+	target.args = args
+	target.events = nil
+	return cc.chaincode.Invoke(&target)
+}
+
+func (s *stub) GetState(key string) ([]byte, error) {
+	return s.sim.GetState(s.namespace, key)
+}
+
+func (s *stub) PutState(key string, value []byte) error {
+	if key == "" {
+		return errors.New("key must not be an empty string")
+	}
+	return s.sim.SetState(s.namespace, key, value)
+}
+
+func (s *stub) DelState(key string) error {
+	return s.sim.DeleteState(s.namespace, key)
+}
+
+func (s *stub) SetStateValidationParameter(key string, ep []byte) error {
+	return s.sim.SetStateMetadata(s.namespace, key, map[string][]byte{validationParameterMetakey: ep})
+}
+
+func (s *stub) GetStateValidationParameter(key string) ([]byte, error) {
+	md, err := s.sim.GetStateMetadata(s.namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	return md[validationParameterMetakey], nil
+}
+
+func (s *stub) GetStateByRange(startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	if startKey == "" {
+		startKey = emptyKeySubstitute
+	}
+	if err := validateSimpleKeys(startKey, endKey); err != nil {
+		return nil, err
+	}
+	return s.rangeQuery("", startKey, endKey)
+}
+
+func (s *stub) GetStateByRangeWithPagination(startKey, endKey string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if startKey == "" {
+		startKey = emptyKeySubstitute
+	}
+	if err := validateSimpleKeys(startKey, endKey); err != nil {
+		return nil, nil, err
+	}
+	return s.paginatedRangeQuery(startKey, endKey, pageSize, bookmark)
+}
+
+func (s *stub) GetStateByPartialCompositeKey(objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	startKey, endKey, err := rangeKeysForPartialCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	return s.rangeQuery("", startKey, endKey)
+}
+
+func (s *stub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	startKey, endKey, err := rangeKeysForPartialCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.paginatedRangeQuery(startKey, endKey, pageSize, bookmark)
+}
+
+func (s *stub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
+	return createCompositeKey(objectType, attributes)
+}
+
+func (s *stub) SplitCompositeKey(compositeKey string) (string, []string, error) {
+	componentIndex := 1
+	components := []string{}
+	for i := 1; i < len(compositeKey); i++ {
+		if compositeKey[i] == minUnicodeRuneValue {
+			components = append(components, compositeKey[componentIndex:i])
+			componentIndex = i + 1
+		}
+	}
+	if len(components) == 0 {
+		return "", nil, errors.Errorf("%q is not a composite key", compositeKey)
+	}
+	return components[0], components[1:], nil
+}
+
+func (s *stub) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	iter, err := s.sim.ExecuteQuery(s.namespace, query)
+	if err != nil {
+		return nil, err
+	}
+	return &queryIterator{iter: iter}, nil
+}
+
+func (s *stub) GetQueryResultWithPagination(query string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	iter, err := s.sim.ExecuteQueryWithMetadata(s.namespace, query, map[string]interface{}{
+		"bookmark": bookmark,
+		"limit":    totalReturnLimit(pageSize),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return readPage(iter)
+}
+
+func (s *stub) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
+	return nil, errors.New("the history database is not available in the harness")
+}
+
+func (s *stub) GetPrivateData(collection, key string) ([]byte, error) {
+	if collection == "" {
+		return nil, errors.New("collection must not be an empty string")
+	}
+	return s.sim.GetPrivateData(s.namespace, collection, key)
+}
+
+func (s *stub) PutPrivateData(collection string, key string, value []byte) error {
+	if collection == "" {
+		return errors.New("collection must not be an empty string")
+	}
+	if key == "" {
+		return errors.New("key must not be an empty string")
+	}
+	return s.sim.SetPrivateData(s.namespace, collection, key, value)
+}
+
+func (s *stub) DelPrivateData(collection, key string) error {
+	if collection == "" {
+		return errors.New("collection must not be an empty string")
+	}
+	return s.sim.DeletePrivateData(s.namespace, collection, key)
+}
+
+func (s *stub) PurgePrivateData(collection, key string) error {
+	if collection == "" {
+		return errors.New("collection must not be an empty string")
+	}
+	if key == "" {
+		return errors.New("key must not be an empty string")
+	}
+	return s.sim.PurgePrivateData(s.namespace, collection, key)
+}
+
+func (s *stub) SetPrivateDataValidationParameter(collection, key string, ep []byte) error {
+	return s.sim.SetPrivateDataMetadata(s.namespace, collection, key, map[string][]byte{validationParameterMetakey: ep})
+}
+
+func (s *stub) GetPrivateDataValidationParameter(collection, key string) ([]byte, error) {
+	md, err := s.sim.GetPrivateDataMetadata(s.namespace, collection, key)
+	if err != nil {
+		return nil, err
+	}
+	return md[validationParameterMetakey], nil
+}
+
+func (s *stub) GetPrivateDataByRange(collection, startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	if collection == "" {
+		return nil, errors.New("collection must not be an empty string")
+	}
+	if startKey == "" {
+		startKey = emptyKeySubstitute
+	}
+	if err := validateSimpleKeys(startKey, endKey); err != nil {
+		return nil, err
+	}
+	return s.rangeQuery(collection, startKey, endKey)
+}
+
+func (s *stub) GetPrivateDataByPartialCompositeKey(collection, objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	if collection == "" {
+		return nil, errors.New("collection must not be an empty string")
+	}
+	startKey, endKey, err := rangeKeysForPartialCompositeKey(objectType, keys)
+	if err != nil {
+		return nil, err
+	}
+	return s.rangeQuery(collection, startKey, endKey)
+}
+
+func (s *stub) GetPrivateDataQueryResult(collection, query string) (shim.StateQueryIteratorInterface, error) {
+	if collection == "" {
+		return nil, errors.New("collection must not be an empty string")
+	}
+	iter, err := s.sim.ExecuteQueryOnPrivateData(s.namespace, collection, query)
+	if err != nil {
+		return nil, err
+	}
+	return &queryIterator{iter: iter}, nil
+}
+
+func (s *stub) GetCreator() ([]byte, error) {
+	return s.creator, nil
+}
+
+func (s *stub) GetTransient() (map[string][]byte, error) {
+	return s.transient, nil
+}
+
+func (s *stub) GetBinding() ([]byte, error) {
+	return s.binding, nil
+}
+
+func (s *stub) GetDecorations() map[string][]byte {
+	return nil
+}
+
+func (s *stub) GetSignedProposal() (*pb.SignedProposal, error) {
+	return s.signedProposal, nil
+}
+
+func (s *stub) GetTxTimestamp() (*timestamp.Timestamp, error) {
+	return s.timestamp, nil
+}
+
+func (s *stub) SetEvent(name string, payload []byte) error {
+	if name == "" {
+		return errors.New("event name can not be nil string")
+	}
+	s.events = []*pb.ChaincodeEvent{{ChaincodeId: s.namespace, TxId: s.txid, EventName: name, Payload: payload}}
+	return nil
+}
+
+func (s *stub) AppendEvent(name string, payload []byte) error {
+	if name == "" {
+		return errors.New("event name can not be nil string")
+	}
+	s.events = append(s.events, &pb.ChaincodeEvent{ChaincodeId: s.namespace, TxId: s.txid, EventName: name, Payload: payload})
+	return nil
+}
+
+func (s *stub) rangeQuery(collection, startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	var iter commonledger.ResultsIterator
+	var err error
+	if collection == "" {
+		iter, err = s.sim.GetStateRangeScanIterator(s.namespace, startKey, endKey)
+	} else {
+		iter, err = s.sim.GetPrivateDataRangeScanIterator(s.namespace, collection, startKey, endKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &queryIterator{iter: iter}, nil
+}
+
+func (s *stub) paginatedRangeQuery(startKey, endKey string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if bookmark != "" {
+		startKey = bookmark
+	}
+	iter, err := s.sim.GetStateRangeScanIteratorWithMetadata(s.namespace, startKey, endKey, map[string]interface{}{
+		"limit": totalReturnLimit(pageSize),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return readPage(iter)
+}
+
+// totalReturnLimit caps the page size to the total query limit of the
+// ledger, as the chaincode Handler does.
+func totalReturnLimit(pageSize int32) int32 {
+	limit := int32(ledgerconfig.GetTotalQueryLimit())
+	if pageSize > 0 && pageSize < limit {
+		limit = pageSize
+	}
+	return limit
+}
+
+// readPage drains a paginated iterator so that the bookmark for the next
+// page can be returned along with the results.
+func readPage(iter ledger.QueryResultsIterator) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	var results []*queryresult.KV
+	for {
+		qr, err := iter.Next()
+		if err != nil {
+			iter.Close()
+			return nil, nil, err
+		}
+		if qr == nil {
+			break
+		}
+		results = append(results, qr.(*queryresult.KV))
+	}
+	metadata := &pb.QueryResponseMetadata{
+		FetchedRecordsCount: int32(len(results)),
+		Bookmark:            iter.GetBookmarkAndClose(),
+	}
+	return &sliceIterator{results: results}, metadata, nil
+}
+
+// queryIterator adapts a ledger iterator to shim.StateQueryIteratorInterface.
+type queryIterator struct {
+	iter commonledger.ResultsIterator
+	next *queryresult.KV
+	err  error
+	done bool
+}
+
+func (it *queryIterator) HasNext() bool {
+	if it.next != nil || it.err != nil {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	qr, err := it.iter.Next()
+	switch {
+	case err != nil:
+		it.err = err
+	case qr == nil:
+		it.done = true
+	default:
+		it.next = qr.(*queryresult.KV)
+	}
+	return !it.done
+}
+
+func (it *queryIterator) Next() (*queryresult.KV, error) {
+	if !it.HasNext() {
+		return nil, errors.New("no such key")
+	}
+	next, err := it.next, it.err
+	it.next, it.err = nil, nil
+	return next, err
+}
+
+func (it *queryIterator) Close() error {
+	it.iter.Close()
+	return nil
+}
+
+// sliceIterator iterates over the results of a page that has already been read.
+type sliceIterator struct {
+	results []*queryresult.KV
+}
+
+func (it *sliceIterator) HasNext() bool {
+	return len(it.results) > 0
+}
+
+func (it *sliceIterator) Next() (*queryresult.KV, error) {
+	if len(it.results) == 0 {
+		return nil, errors.New("no such key")
+	}
+	next := it.results[0]
+	it.results = it.results[1:]
+	return next, nil
+}
+
+func (it *sliceIterator) Close() error {
+	return nil
+}
+
+func rangeKeysForPartialCompositeKey(objectType string, attributes []string) (string, string, error) {
+	partialCompositeKey, err := createCompositeKey(objectType, attributes)
+	if err != nil {
+		return "", "", err
+	}
+	return partialCompositeKey, partialCompositeKey + string(rune(maxUnicodeRuneValue)), nil
+}
+
+func createCompositeKey(objectType string, attributes []string) (string, error) {
+	if err := validateCompositeKeyAttribute(objectType); err != nil {
+		return "", err
+	}
+	ck := compositeKeyNamespace + objectType + string(rune(minUnicodeRuneValue))
+	for _, att := range attributes {
+		if err := validateCompositeKeyAttribute(att); err != nil {
+			return "", err
+		}
+		ck += att + string(rune(minUnicodeRuneValue))
+	}
+	return ck, nil
+}
+
+func validateCompositeKeyAttribute(str string) error {
+	if !utf8.ValidString(str) {
+		return errors.Errorf("not a valid utf8 string: [%x]", str)
+	}
+	for index, runeValue := range str {
+		if runeValue == minUnicodeRuneValue || runeValue == maxUnicodeRuneValue {
+			return errors.Errorf(`input contain unicode %#U starting at position [%d]. %#U and %#U are not allowed in the input attribute of a composite key`,
+				runeValue, index, minUnicodeRuneValue, maxUnicodeRuneValue)
+		}
+	}
+	return nil
+}
+
+func validateSimpleKeys(simpleKeys ...string) error {
+	for _, key := range simpleKeys {
+		if len(key) > 0 && key[0] == compositeKeyNamespace[0] {
+			return errors.Errorf(`first character of the key [%s] contains a null character which is not allowed`, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/capabilities"
+	commonerrors "github.com/hyperledger/fabric/common/errors"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/committer/txvalidator"
+	"github.com/hyperledger/fabric/core/common/validation/statebased"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	lutils "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// channelCapabilities are the application capabilities of the channel the
+// harness emulates, with all the validation features of this release enabled.
+var channelCapabilities = capabilities.NewApplicationProvider(map[string]*common.Capability{
+	capabilities.ApplicationV1_4: {},
+})
+
+// Proposal describes a chaincode invocation to be endorsed.
+type Proposal struct {
+	// TxID is the transaction ID; a random one is generated if empty.
+	TxID string
+	// Chaincode is the name of the chaincode to invoke.
+	Chaincode string
+	// Args are the invocation arguments.
+	Args [][]byte
+	// Transient is the transient map passed to the chaincode.
+	Transient map[string][]byte
+	// Creator is the serialized identity of the client.
+	Creator []byte
+	// Endorsers are the MSP IDs of the organizations whose peers endorse
+	// the proposal. Every endorsement satisfies the member and peer roles
+	// of its organization.
+	Endorsers []string
+	// IsInit selects the Init function of the chaincode instead of Invoke.
+	IsInit bool
+}
+
+// Transaction is the result of an endorsed proposal.
+type Transaction struct {
+	// TxID is the ID of the transaction.
+	TxID string
+	// Chaincode is the name of the invoked chaincode.
+	Chaincode string
+	// Response is the response returned by the chaincode.
+	Response pb.Response
+	// Events are the chaincode events emitted during the invocation.
+	Events []*pb.ChaincodeEvent
+	// SimulationResults are the read-write sets produced by the simulation.
+	SimulationResults *ledger.TxSimulationResults
+
+	proposal          *pb.Proposal
+	envelope          *common.Envelope
+	responsePayload   []byte
+	endorsements      []*pb.Endorsement
+	readWriteSetBytes []byte
+}
+
+// Endorse simulates the proposal on the current state of the ledger. The
+// returned transaction is not committed; pass it to Commit, possibly along
+// with other transactions, to have it ordered in a block and validated.
+func (h *Harness) Endorse(p *Proposal) (*Transaction, error) {
+	cc, ok := h.chaincode(p.Chaincode)
+	if !ok {
+		return nil, errors.Errorf("chaincode %s is not deployed", p.Chaincode)
+	}
+
+	txid := p.TxID
+	if txid == "" {
+		txid = newTxID()
+	}
+	ccid := &pb.ChaincodeID{Name: cc.name, Version: cc.version}
+	cis := &pb.ChaincodeInvocationSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			Type:        pb.ChaincodeSpec_GOLANG,
+			ChaincodeId: ccid,
+			Input:       &pb.ChaincodeInput{Args: p.Args},
+		},
+	}
+	nonce, err := putils.CreateNonce()
+	if err != nil {
+		return nil, err
+	}
+	prop, _, err := putils.CreateChaincodeProposalWithTxIDNonceAndTransient(txid, common.HeaderType_ENDORSER_TRANSACTION, h.channelID, cis, nonce, p.Creator, p.Transient)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create proposal")
+	}
+
+	sim, err := h.txmgr.NewTxSimulator(txid)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create transaction simulator")
+	}
+	defer sim.Done()
+
+	s, err := newStub(h, sim, cc.name, txid, p.Args, p.Transient, prop)
+	if err != nil {
+		return nil, err
+	}
+	var response pb.Response
+	if p.IsInit {
+		response = cc.chaincode.Init(s)
+	} else {
+		response = cc.chaincode.Invoke(s)
+	}
+
+	tx := &Transaction{
+		TxID:      txid,
+		Chaincode: cc.name,
+		Response:  response,
+		Events:    s.events,
+		proposal:  prop,
+	}
+	if response.Status >= shim.ERRORTHRESHOLD {
+		return tx, nil
+	}
+
+	tx.SimulationResults, err = sim.GetTxSimulationResults()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to obtain simulation results")
+	}
+	if err := tx.assemble(h.channelID, ccid, p.Endorsers); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Commit orders the transactions into the next block, validates them and
+// commits the block to the ledger. It returns the validation code of each
+// transaction.
+func (h *Harness) Commit(txs ...*Transaction) ([]pb.TxValidationCode, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("at least one transaction is required")
+	}
+
+	block := common.NewBlock(h.blockNum, h.previousHash)
+	for _, tx := range txs {
+		if tx.Response.Status >= shim.ERRORTHRESHOLD {
+			return nil, errors.Errorf("transaction %s was not endorsed: chaincode returned error: %s", tx.TxID, tx.Response.Message)
+		}
+		if tx.envelope == nil {
+			if err := tx.assemble(h.channelID, &pb.ChaincodeID{Name: tx.Chaincode}, nil); err != nil {
+				return nil, err
+			}
+		}
+		envBytes, err := proto.Marshal(tx.envelope)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal transaction %s", tx.TxID)
+		}
+		block.Data.Data = append(block.Data.Data, envBytes)
+	}
+	block.Header.DataHash = block.Data.Hash()
+	putils.InitBlockMetadata(block)
+
+	flags := lutils.NewTxValidationFlagsSetValue(len(txs), pb.TxValidationCode_VALID)
+	stateFetcher := &txvalidator.StateFetcherImpl{QueryExecutorCreator: &queryExecutorCreator{harness: h}}
+	klv := statebased.NewKeyLevelValidator(
+		&txvalidator.PolicyEvaluator{IdentityDeserializer: &endorserDeserializer{}},
+		&statebased.KeyLevelValidationParameterManagerImpl{StateFetcher: stateFetcher},
+		&statebased.CollectionResourcesImpl{StateFetcher: stateFetcher, Capabilities: channelCapabilities},
+	)
+	pvtData := map[uint64]*ledger.TxPvtData{}
+	for i, tx := range txs {
+		code, err := h.validateEndorsements(klv, block, uint64(i), tx)
+		if err != nil {
+			return nil, err
+		}
+		flags.SetFlag(i, code)
+		if code != pb.TxValidationCode_VALID {
+			logger.Debugf("transaction %s in block %d invalidated with code %s", tx.TxID, block.Header.Number, code)
+			continue
+		}
+		if pvt := tx.SimulationResults.PvtSimulationResults; pvt != nil && len(pvt.NsPvtRwset) > 0 {
+			pvtData[uint64(i)] = &ledger.TxPvtData{SeqInBlock: uint64(i), WriteSet: pvt}
+		}
+	}
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = flags
+
+	err := h.txmgr.ValidateAndPrepare(&ledger.BlockAndPvtData{Block: block, BlockPvtData: pvtData}, true)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to validate block")
+	}
+	if err := h.txmgr.Commit(); err != nil {
+		return nil, errors.WithMessage(err, "failed to commit block")
+	}
+
+	flags = lutils.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	codes := make([]pb.TxValidationCode, len(txs))
+	for i := range txs {
+		codes[i] = flags.Flag(i)
+	}
+	h.blockNum++
+	h.previousHash = block.Header.Hash()
+	return codes, nil
+}
+
+// validateEndorsements checks the endorsements of the transaction against
+// the policies of every namespace the transaction writes to, the way the
+// builtin VSCC does.
+func (h *Harness) validateEndorsements(klv *statebased.KeyLevelValidator, block *common.Block, txNum uint64, tx *Transaction) (pb.TxValidationCode, error) {
+	klv.PreValidate(txNum, block)
+
+	if tx.Chaincode == lsccNamespace {
+		klv.PostValidate(tx.Chaincode, block.Header.Number, txNum, nil)
+		return pb.TxValidationCode_VALID, nil
+	}
+
+	txRWSet, err := rwsetutil.TxRwSetFromProtoMsg(tx.SimulationResults.PubSimulationResults)
+	if err != nil {
+		klv.PostValidate(tx.Chaincode, block.Header.Number, txNum, err)
+		return pb.TxValidationCode_BAD_RWSET, nil
+	}
+
+	code := pb.TxValidationCode_VALID
+	var failure, validationErr error
+	for _, nsRWSet := range txRWSet.NsRwSets {
+		if nsRWSet.NameSpace != tx.Chaincode && !hasWrites(nsRWSet) {
+			continue
+		}
+		// once the transaction is invalid, the remaining namespaces are
+		// not validated but their results must still be posted, or later
+		// transactions depending on their keys would wait forever
+		if failure != nil {
+			klv.PostValidate(nsRWSet.NameSpace, block.Header.Number, txNum, failure)
+			continue
+		}
+
+		cc, ok := h.chaincode(nsRWSet.NameSpace)
+		if !ok {
+			failure = errors.Errorf("transaction %s writes to namespace %s which is not deployed", tx.TxID, nsRWSet.NameSpace)
+			klv.PostValidate(nsRWSet.NameSpace, block.Header.Number, txNum, failure)
+			code = pb.TxValidationCode_ILLEGAL_WRITESET
+			continue
+		}
+
+		err := klv.Validate(cc.name, block.Header.Number, txNum, tx.readWriteSetBytes, tx.responsePayload, cc.policyBytes, tx.endorsements)
+		klv.PostValidate(cc.name, block.Header.Number, txNum, err)
+		switch err := err.(type) {
+		case nil:
+		case *commonerrors.VSCCEndorsementPolicyError:
+			logger.Debugf("endorsement policy of %s not satisfied by transaction %s: %s", cc.name, tx.TxID, err)
+			failure = err
+			code = pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE
+		default:
+			failure = err
+			code = pb.TxValidationCode_INVALID_OTHER_REASON
+			validationErr = errors.WithMessage(err, "failed to validate endorsements")
+		}
+	}
+
+	return code, validationErr
+}
+
+func hasWrites(nsRWSet *rwsetutil.NsRwSet) bool {
+	if len(nsRWSet.KvRwSet.Writes) > 0 || len(nsRWSet.KvRwSet.MetadataWrites) > 0 {
+		return true
+	}
+	for _, collRWSet := range nsRWSet.CollHashedRwSets {
+		if len(collRWSet.HashedRwSet.HashedWrites) > 0 || len(collRWSet.HashedRwSet.MetadataWrites) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// assemble builds the endorsed transaction envelope. Endorsements carry the
+// MSP ID of the endorsing organization and are not signed.
+func (tx *Transaction) assemble(channelID string, ccid *pb.ChaincodeID, endorsers []string) error {
+	var err error
+	if tx.proposal == nil {
+		nonce, err := putils.CreateNonce()
+		if err != nil {
+			return err
+		}
+		cis := &pb.ChaincodeInvocationSpec{ChaincodeSpec: &pb.ChaincodeSpec{ChaincodeId: ccid}}
+		tx.proposal, _, err = putils.CreateChaincodeProposalWithTxIDNonceAndTransient(tx.TxID, common.HeaderType_ENDORSER_TRANSACTION, channelID, cis, nonce, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx.readWriteSetBytes, err = tx.SimulationResults.GetPubSimulationBytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal read-write set")
+	}
+	hdr, err := putils.GetHeader(tx.proposal.Header)
+	if err != nil {
+		return err
+	}
+	proposalPayload, err := putils.GetChaincodeProposalPayload(tx.proposal.Payload)
+	if err != nil {
+		return err
+	}
+	proposalHash, err := putils.GetProposalHash1(hdr, tx.proposal.Payload, nil)
+	if err != nil {
+		return err
+	}
+	tx.responsePayload, err = putils.GetBytesProposalResponsePayloadWithEvents(proposalHash, &tx.Response, tx.readWriteSetBytes, tx.Events, ccid)
+	if err != nil {
+		return err
+	}
+
+	tx.endorsements = nil
+	for _, mspID := range endorsers {
+		endorser, err := proto.Marshal(&msp.SerializedIdentity{Mspid: mspID, IdBytes: []byte(mspID)})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal endorser identity")
+		}
+		tx.endorsements = append(tx.endorsements, &pb.Endorsement{Endorser: endorser})
+	}
+
+	proposalPayloadBytes, err := putils.GetBytesProposalPayloadForTx(proposalPayload, nil)
+	if err != nil {
+		return err
+	}
+	cap := &pb.ChaincodeActionPayload{
+		ChaincodeProposalPayload: proposalPayloadBytes,
+		Action: &pb.ChaincodeEndorsedAction{
+			ProposalResponsePayload: tx.responsePayload,
+			Endorsements:            tx.endorsements,
+		},
+	}
+	capBytes, err := putils.GetBytesChaincodeActionPayload(cap)
+	if err != nil {
+		return err
+	}
+	txBytes, err := putils.GetBytesTransaction(&pb.Transaction{
+		Actions: []*pb.TransactionAction{{Header: hdr.SignatureHeader, Payload: capBytes}},
+	})
+	if err != nil {
+		return err
+	}
+	payloadBytes, err := putils.GetBytesPayload(&common.Payload{Header: hdr, Data: txBytes})
+	if err != nil {
+		return err
+	}
+	tx.envelope = &common.Envelope{Payload: payloadBytes}
+	return nil
+}
+
+type queryExecutorCreator struct {
+	harness *Harness
+}
+
+func (q *queryExecutorCreator) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	return q.harness.txmgr.NewQueryExecutor(newTxID())
+}
+
+func newTxID() string {
+	return util.GenerateUUID()
+}
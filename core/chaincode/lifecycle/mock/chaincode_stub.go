@@ -317,6 +317,18 @@ type ChaincodeStub struct {
 	delPrivateDataReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PurgePrivateDataStub        func(collection, key string) error
+	purgePrivateDataMutex       sync.RWMutex
+	purgePrivateDataArgsForCall []struct {
+		collection string
+		key        string
+	}
+	purgePrivateDataReturns struct {
+		result1 error
+	}
+	purgePrivateDataReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPrivateDataValidationParameterStub        func(collection, key string, ep []byte) error
 	setPrivateDataValidationParameterMutex       sync.RWMutex
 	setPrivateDataValidationParameterArgsForCall []struct {
@@ -464,6 +476,18 @@ type ChaincodeStub struct {
 	setEventReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AppendEventStub        func(name string, payload []byte) error
+	appendEventMutex       sync.RWMutex
+	appendEventArgsForCall []struct {
+		name    string
+		payload []byte
+	}
+	appendEventReturns struct {
+		result1 error
+	}
+	appendEventReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1680,6 +1704,55 @@ func (fake *ChaincodeStub) DelPrivateDataReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *ChaincodeStub) PurgePrivateData(collection string, key string) error {
+	fake.purgePrivateDataMutex.Lock()
+	ret, specificReturn := fake.purgePrivateDataReturnsOnCall[len(fake.purgePrivateDataArgsForCall)]
+	fake.purgePrivateDataArgsForCall = append(fake.purgePrivateDataArgsForCall, struct {
+		collection string
+		key        string
+	}{collection, key})
+	fake.recordInvocation("PurgePrivateData", []interface{}{collection, key})
+	fake.purgePrivateDataMutex.Unlock()
+	if fake.PurgePrivateDataStub != nil {
+		return fake.PurgePrivateDataStub(collection, key)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.purgePrivateDataReturns.result1
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataCallCount() int {
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
+	return len(fake.purgePrivateDataArgsForCall)
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataArgsForCall(i int) (string, string) {
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
+	return fake.purgePrivateDataArgsForCall[i].collection, fake.purgePrivateDataArgsForCall[i].key
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataReturns(result1 error) {
+	fake.PurgePrivateDataStub = nil
+	fake.purgePrivateDataReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataReturnsOnCall(i int, result1 error) {
+	fake.PurgePrivateDataStub = nil
+	if fake.purgePrivateDataReturnsOnCall == nil {
+		fake.purgePrivateDataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.purgePrivateDataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *ChaincodeStub) SetPrivateDataValidationParameter(collection string, key string, ep []byte) error {
 	var epCopy []byte
 	if ep != nil {
@@ -2231,12 +2304,16 @@ func (fake *ChaincodeStub) SetEvent(name string, payload []byte) error {
 func (fake *ChaincodeStub) SetEventCallCount() int {
 	fake.setEventMutex.RLock()
 	defer fake.setEventMutex.RUnlock()
+	fake.appendEventMutex.RLock()
+	defer fake.appendEventMutex.RUnlock()
 	return len(fake.setEventArgsForCall)
 }
 
 func (fake *ChaincodeStub) SetEventArgsForCall(i int) (string, []byte) {
 	fake.setEventMutex.RLock()
 	defer fake.setEventMutex.RUnlock()
+	fake.appendEventMutex.RLock()
+	defer fake.appendEventMutex.RUnlock()
 	return fake.setEventArgsForCall[i].name, fake.setEventArgsForCall[i].payload
 }
 
@@ -2259,6 +2336,60 @@ func (fake *ChaincodeStub) SetEventReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *ChaincodeStub) AppendEvent(name string, payload []byte) error {
+	var payloadCopy []byte
+	if payload != nil {
+		payloadCopy = make([]byte, len(payload))
+		copy(payloadCopy, payload)
+	}
+	fake.appendEventMutex.Lock()
+	ret, specificReturn := fake.appendEventReturnsOnCall[len(fake.appendEventArgsForCall)]
+	fake.appendEventArgsForCall = append(fake.appendEventArgsForCall, struct {
+		name    string
+		payload []byte
+	}{name, payloadCopy})
+	fake.recordInvocation("AppendEvent", []interface{}{name, payloadCopy})
+	fake.appendEventMutex.Unlock()
+	if fake.AppendEventStub != nil {
+		return fake.AppendEventStub(name, payload)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appendEventReturns.result1
+}
+
+func (fake *ChaincodeStub) AppendEventCallCount() int {
+	fake.appendEventMutex.RLock()
+	defer fake.appendEventMutex.RUnlock()
+	return len(fake.appendEventArgsForCall)
+}
+
+func (fake *ChaincodeStub) AppendEventArgsForCall(i int) (string, []byte) {
+	fake.appendEventMutex.RLock()
+	defer fake.appendEventMutex.RUnlock()
+	return fake.appendEventArgsForCall[i].name, fake.appendEventArgsForCall[i].payload
+}
+
+func (fake *ChaincodeStub) AppendEventReturns(result1 error) {
+	fake.AppendEventStub = nil
+	fake.appendEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ChaincodeStub) AppendEventReturnsOnCall(i int, result1 error) {
+	fake.AppendEventStub = nil
+	if fake.appendEventReturnsOnCall == nil {
+		fake.appendEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *ChaincodeStub) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2310,6 +2441,8 @@ func (fake *ChaincodeStub) Invocations() map[string][][]interface{} {
 	defer fake.putPrivateDataMutex.RUnlock()
 	fake.delPrivateDataMutex.RLock()
 	defer fake.delPrivateDataMutex.RUnlock()
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
 	fake.setPrivateDataValidationParameterMutex.RLock()
 	defer fake.setPrivateDataValidationParameterMutex.RUnlock()
 	fake.getPrivateDataValidationParameterMutex.RLock()
@@ -2334,6 +2467,8 @@ func (fake *ChaincodeStub) Invocations() map[string][][]interface{} {
 	defer fake.getTxTimestampMutex.RUnlock()
 	fake.setEventMutex.RLock()
 	defer fake.setEventMutex.RUnlock()
+	fake.appendEventMutex.RLock()
+	defer fake.appendEventMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
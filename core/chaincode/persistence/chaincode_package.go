@@ -13,38 +13,85 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"strings"
+	"time"
 
+	"github.com/hyperledger/fabric/core/chaincode/platforms/ccmetadata"
 	"github.com/pkg/errors"
 )
 
-// The chaincode package is simply a .tar.gz file.  For the time being, we
-// assume that the package contains a Chaincode-Package-Metadata.json file
-// which contains a 'Type', and optionally a 'Path'.  In the future, it would
-// be nice if we moved to a more buildpack type system, rather than the below
-// presented JAR+manifest type system, but for expediency and incremental changes,
-// moving to a tar format over the proto format for a user-inspectable artifact
-// seems like a good step.
+// The chaincode package is simply a .tar.gz file.  Two layouts are
+// understood.  The legacy layout contains a Chaincode-Package-Metadata.json
+// file which contains a 'Type', and optionally a 'Path', next to exactly one
+// code package file.  The versioned layout contains a metadata.json file
+// which additionally records the package format version, a label, the
+// arguments to hand to the platform builder and the hash of the dependency
+// lock file, next to a code.tar.gz code package and, optionally, the
+// META-INF/statedb index files of the chaincode.  In the future, it would be
+// nice if we moved to a more buildpack type system, rather than the below
+// presented JAR+manifest type system, but for expediency and incremental
+// changes, moving to a tar format over the proto format for a
+// user-inspectable artifact seems like a good step.
 
 const (
 	ChaincodePackageMetadataFile = "Chaincode-Package-Metadata.json"
+
+	// MetadataFile is the name of the metadata file of versioned packages
+	MetadataFile = "metadata.json"
+
+	// CodePackageFile is the name of the code package of versioned packages
+	CodePackageFile = "code.tar.gz"
+
+	// PackageFormatVersion is the version of the package format written
+	// by this peer and the only versioned format it is able to parse
+	PackageFormatVersion = 1
+
+	dbArtifactsDir = "META-INF/"
 )
 
 // ChaincodePackage represents the un-tar-ed format of the chaincode package.
 type ChaincodePackage struct {
 	Metadata    *ChaincodePackageMetadata
 	CodePackage []byte
+	DBArtifacts []byte
 }
 
 // ChaincodePackageMetadata contains the information necessary to understand
 // the embedded code package.
 type ChaincodePackageMetadata struct {
-	Type string `json:"Type"`
-	Path string `json:"Path"`
+	FormatVersion int      `json:"FormatVersion,omitempty"`
+	Type          string   `json:"Type"`
+	Path          string   `json:"Path"`
+	Label         string   `json:"Label,omitempty"`
+	BuildArgs     []string `json:"BuildArgs,omitempty"`
+	LockHash      string   `json:"LockHash,omitempty"`
+}
+
+// Validate checks the metadata of a versioned chaincode package
+func (md *ChaincodePackageMetadata) Validate() error {
+	if md.FormatVersion != PackageFormatVersion {
+		return errors.Errorf("unsupported package format version %d", md.FormatVersion)
+	}
+	if err := ccmetadata.ValidateChaincodeType(md.Type); err != nil {
+		return err
+	}
+	if err := ccmetadata.ValidateLabel(md.Label); err != nil {
+		return err
+	}
+	if err := ccmetadata.ValidateBuildArgs(md.BuildArgs); err != nil {
+		return err
+	}
+	return ccmetadata.ValidateLockHash(md.LockHash)
 }
 
 // ChaincodePackageParser provides the ability to parse chaincode packages
 type ChaincodePackageParser struct{}
 
+type packageFile struct {
+	name string
+	data []byte
+}
+
 // Parse parses a set of bytes as a chaincode package
 // and returns the parsed package as a struct
 func (ccpp ChaincodePackageParser) Parse(source []byte) (*ChaincodePackage, error) {
@@ -55,8 +102,9 @@ func (ccpp ChaincodePackageParser) Parse(source []byte) (*ChaincodePackage, erro
 
 	tarReader := tar.NewReader(gzReader)
 
-	var codePackage []byte
+	var files []packageFile
 	var ccPackageMetadata *ChaincodePackageMetadata
+	var versioned bool
 	for {
 		header, err := tarReader.Next()
 		if err == io.EOF {
@@ -76,24 +124,32 @@ func (ccpp ChaincodePackageParser) Parse(source []byte) (*ChaincodePackage, erro
 			return nil, errors.Wrapf(err, "could not read %s from tar", header.Name)
 		}
 
-		if header.Name == ChaincodePackageMetadataFile {
+		if header.Name == ChaincodePackageMetadataFile || header.Name == MetadataFile {
+			if ccPackageMetadata != nil {
+				return nil, errors.Errorf("found more than one package metadata file in archive")
+			}
 			ccPackageMetadata = &ChaincodePackageMetadata{}
 			err := json.Unmarshal(fileBytes, ccPackageMetadata)
 			if err != nil {
-				return nil, errors.Wrapf(err, "could not unmarshal %s as json", ChaincodePackageMetadataFile)
+				return nil, errors.Wrapf(err, "could not unmarshal %s as json", header.Name)
 			}
+			versioned = header.Name == MetadataFile
 
 			continue
 		}
 
-		if codePackage != nil {
-			return nil, errors.Errorf("found too many files in archive, cannot identify which file is the code-package")
-		}
+		files = append(files, packageFile{name: header.Name, data: fileBytes})
+	}
 
-		codePackage = fileBytes
+	if versioned {
+		return parseVersionedPackage(ccPackageMetadata, files)
 	}
 
-	if codePackage == nil {
+	if len(files) > 1 {
+		return nil, errors.Errorf("found too many files in archive, cannot identify which file is the code-package")
+	}
+
+	if len(files) == 0 {
 		return nil, errors.Errorf("did not find a code package inside the package")
 	}
 
@@ -103,6 +159,196 @@ func (ccpp ChaincodePackageParser) Parse(source []byte) (*ChaincodePackage, erro
 
 	return &ChaincodePackage{
 		Metadata:    ccPackageMetadata,
+		CodePackage: files[0].data,
+	}, nil
+}
+
+func parseVersionedPackage(metadata *ChaincodePackageMetadata, files []packageFile) (*ChaincodePackage, error) {
+	if err := metadata.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid package metadata")
+	}
+
+	var codePackage []byte
+	dbArtifacts := bytes.NewBuffer(nil)
+	tw := tar.NewWriter(dbArtifacts)
+	for _, file := range files {
+		switch {
+		case file.name == CodePackageFile:
+			codePackage = file.data
+		case strings.HasPrefix(file.name, dbArtifactsDir):
+			if err := ccmetadata.ValidateMetadataFile(file.name, file.data); err != nil {
+				return nil, errors.WithMessage(err, "invalid database artifact")
+			}
+			if err := writePackageFile(tw, file.name, file.data); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("unexpected file %s in chaincode package", file.name)
+		}
+	}
+
+	if codePackage == nil {
+		return nil, errors.Errorf("did not find a code package inside the package (missing %s)", CodePackageFile)
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not collect database artifacts")
+	}
+
+	return &ChaincodePackage{
+		Metadata:    metadata,
 		CodePackage: codePackage,
+		DBArtifacts: dbArtifacts.Bytes(),
 	}, nil
 }
+
+// CodePackageWithDBArtifacts returns the code package with the database
+// artifacts of the package added to it, as the peer looks for the statedb
+// indexes of a chaincode installed as a ChaincodeDeploymentSpec in its code
+// package.  Artifacts the code package already contains are not duplicated.
+func (ccp *ChaincodePackage) CodePackageWithDBArtifacts() ([]byte, error) {
+	if len(ccp.DBArtifacts) == 0 {
+		return ccp.CodePackage, nil
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(ccp.CodePackage))
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading code package as gzip stream")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	names := map[string]struct{}{}
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read code package")
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, errors.Wrapf(err, "could not write header for %s", header.Name)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return nil, errors.Wrapf(err, "could not copy %s", header.Name)
+		}
+		names[header.Name] = struct{}{}
+	}
+
+	tr = tar.NewReader(bytes.NewReader(ccp.DBArtifacts))
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read database artifacts")
+		}
+		if _, exists := names[header.Name]; exists {
+			continue
+		}
+		fileBytes, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s from database artifacts", header.Name)
+		}
+		if err := writePackageFile(tw, header.Name, fileBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close code package tar")
+	}
+	if err := gw.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close code package gzip stream")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// CreateChaincodePackage assembles a versioned chaincode package from its
+// metadata, its code package and the tar stream of database artifacts
+// extracted from it, if any.  The output only depends on its inputs so that
+// packaging the same chaincode twice yields byte-identical packages and
+// hence the same package ID.
+func CreateChaincodePackage(metadata *ChaincodePackageMetadata, codePackage, dbArtifacts []byte) ([]byte, error) {
+	md := *metadata
+	md.FormatVersion = PackageFormatVersion
+	if err := md.Validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid package metadata")
+	}
+
+	metadataBytes, err := json.Marshal(&md)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal package metadata")
+	}
+
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	if err := writePackageFile(tw, MetadataFile, metadataBytes); err != nil {
+		return nil, err
+	}
+	if err := writePackageFile(tw, CodePackageFile, codePackage); err != nil {
+		return nil, err
+	}
+
+	if len(dbArtifacts) != 0 {
+		tr := tar.NewReader(bytes.NewReader(dbArtifacts))
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read database artifacts")
+			}
+			if header.Typeflag != tar.TypeReg || !strings.HasPrefix(header.Name, dbArtifactsDir) {
+				continue
+			}
+			fileBytes, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read %s from database artifacts", header.Name)
+			}
+			if err := ccmetadata.ValidateMetadataFile(header.Name, fileBytes); err != nil {
+				return nil, errors.WithMessage(err, "invalid database artifact")
+			}
+			if err := writePackageFile(tw, header.Name, fileBytes); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close package tar")
+	}
+	if err := gw.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close package gzip stream")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writePackageFile writes a regular file with a fixed mode and no timestamps
+// or ownership so that the resulting archive is reproducible.
+func writePackageFile(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  time.Unix(0, 0),
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return errors.Wrapf(err, "could not write header for %s", name)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrapf(err, "could not write %s", name)
+	}
+	return nil
+}
@@ -7,7 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package persistence_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
 	"io/ioutil"
+	"sort"
+	"strings"
 
 	"github.com/hyperledger/fabric/core/chaincode/persistence"
 	. "github.com/onsi/ginkgo"
@@ -109,4 +115,241 @@ var _ = Describe("ChaincodePackageParser", func() {
 			})
 		})
 	})
+
+	Describe("Versioned packages", func() {
+		var (
+			metadata    *persistence.ChaincodePackageMetadata
+			dbArtifacts []byte
+		)
+
+		BeforeEach(func() {
+			metadata = &persistence.ChaincodePackageMetadata{
+				Type:      "GOLANG",
+				Path:      "github.com/example/mycc",
+				Label:     "mycc_1.0",
+				BuildArgs: []string{"-tags", "nopkcs11"},
+				LockHash:  strings.Repeat("ab", 32),
+			}
+			dbArtifacts = tarEntries(map[string]string{
+				"META-INF/statedb/couchdb/indexes/indexOwner.json": `{"index":{"fields":["owner"]},"name":"indexOwner","type":"json"}`,
+			})
+		})
+
+		It("round trips through the parser", func() {
+			pkg, err := persistence.CreateChaincodePackage(metadata, []byte("code"), dbArtifacts)
+			Expect(err).NotTo(HaveOccurred())
+
+			ccPackage, err := ccpp.Parse(pkg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ccPackage.Metadata).To(Equal(&persistence.ChaincodePackageMetadata{
+				FormatVersion: 1,
+				Type:          "GOLANG",
+				Path:          "github.com/example/mycc",
+				Label:         "mycc_1.0",
+				BuildArgs:     []string{"-tags", "nopkcs11"},
+				LockHash:      strings.Repeat("ab", 32),
+			}))
+			Expect(ccPackage.CodePackage).To(Equal([]byte("code")))
+
+			tr := tar.NewReader(bytes.NewReader(ccPackage.DBArtifacts))
+			header, err := tr.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(header.Name).To(Equal("META-INF/statedb/couchdb/indexes/indexOwner.json"))
+		})
+
+		It("is reproducible", func() {
+			pkg1, err := persistence.CreateChaincodePackage(metadata, []byte("code"), dbArtifacts)
+			Expect(err).NotTo(HaveOccurred())
+			pkg2, err := persistence.CreateChaincodePackage(metadata, []byte("code"), dbArtifacts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pkg1).To(Equal(pkg2))
+			Expect(persistence.PackageID("mycc_1.0", pkg1)).To(Equal(persistence.PackageID("mycc_1.0", pkg2)))
+		})
+
+		Describe("CodePackageWithDBArtifacts", func() {
+			It("adds the database artifacts to the code package", func() {
+				codePackage := gzipTar(map[string]string{
+					"src/github.com/example/mycc/chaincode.go": "package main",
+				})
+				pkg, err := persistence.CreateChaincodePackage(metadata, codePackage, dbArtifacts)
+				Expect(err).NotTo(HaveOccurred())
+				ccPackage, err := ccpp.Parse(pkg)
+				Expect(err).NotTo(HaveOccurred())
+
+				codePackage, err = ccPackage.CodePackageWithDBArtifacts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gzipTarNames(codePackage)).To(Equal([]string{
+					"src/github.com/example/mycc/chaincode.go",
+					"META-INF/statedb/couchdb/indexes/indexOwner.json",
+				}))
+			})
+
+			It("does not duplicate the database artifacts the code package contains", func() {
+				codePackage := gzipTar(map[string]string{
+					"src/github.com/example/mycc/chaincode.go":         "package main",
+					"META-INF/statedb/couchdb/indexes/indexOwner.json": `{"index":{"fields":["owner"]},"name":"indexOwner","type":"json"}`,
+				})
+				pkg, err := persistence.CreateChaincodePackage(metadata, codePackage, dbArtifacts)
+				Expect(err).NotTo(HaveOccurred())
+				ccPackage, err := ccpp.Parse(pkg)
+				Expect(err).NotTo(HaveOccurred())
+
+				codePackage, err = ccPackage.CodePackageWithDBArtifacts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gzipTarNames(codePackage)).To(Equal([]string{
+					"META-INF/statedb/couchdb/indexes/indexOwner.json",
+					"src/github.com/example/mycc/chaincode.go",
+				}))
+			})
+
+			Context("when the package has no database artifacts", func() {
+				It("returns the code package as is", func() {
+					ccPackage := &persistence.ChaincodePackage{CodePackage: []byte("code")}
+					codePackage, err := ccPackage.CodePackageWithDBArtifacts()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(codePackage).To(Equal([]byte("code")))
+				})
+			})
+
+			Context("when the code package is not gzipped", func() {
+				It("returns an error", func() {
+					ccPackage := &persistence.ChaincodePackage{CodePackage: []byte("code"), DBArtifacts: dbArtifacts}
+					_, err := ccPackage.CodePackageWithDBArtifacts()
+					Expect(err).To(MatchError(ContainSubstring("error reading code package as gzip stream")))
+				})
+			})
+		})
+
+		Context("when the label is invalid", func() {
+			It("fails to create the package", func() {
+				metadata.Label = "my cc"
+				_, err := persistence.CreateChaincodePackage(metadata, []byte("code"), nil)
+				Expect(err).To(MatchError(ContainSubstring("invalid package metadata: invalid label 'my cc'")))
+			})
+		})
+
+		Context("when a database artifact is invalid", func() {
+			It("fails to create the package", func() {
+				dbArtifacts = tarEntries(map[string]string{
+					"META-INF/statedb/couchdb/indexes/bad.json": "not json",
+				})
+				_, err := persistence.CreateChaincodePackage(metadata, []byte("code"), dbArtifacts)
+				Expect(err).To(MatchError(ContainSubstring("invalid database artifact")))
+			})
+		})
+
+		Context("when the format version is not supported", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json": `{"FormatVersion":2,"Type":"GOLANG","Label":"mycc"}`,
+					"code.tar.gz":   "code",
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError("invalid package metadata: unsupported package format version 2"))
+			})
+		})
+
+		Context("when the type is not supported", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json": `{"FormatVersion":1,"Type":"COBOL","Label":"mycc"}`,
+					"code.tar.gz":   "code",
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError("invalid package metadata: chaincode type 'COBOL' is not supported"))
+			})
+		})
+
+		Context("when the code package is missing", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json": `{"FormatVersion":1,"Type":"GOLANG","Label":"mycc"}`,
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError("did not find a code package inside the package (missing code.tar.gz)"))
+			})
+		})
+
+		Context("when the package contains an unexpected file", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json": `{"FormatVersion":1,"Type":"GOLANG","Label":"mycc"}`,
+					"code.tar.gz":   "code",
+					"extra.txt":     "extra",
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError("unexpected file extra.txt in chaincode package"))
+			})
+		})
+
+		Context("when the package contains an invalid index", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json": `{"FormatVersion":1,"Type":"GOLANG","Label":"mycc"}`,
+					"code.tar.gz":   "code",
+					"META-INF/statedb/couchdb/indexes/bad.json": "not json",
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError(ContainSubstring("invalid database artifact")))
+			})
+		})
+
+		Context("when the package contains both metadata files", func() {
+			It("fails to parse", func() {
+				pkg := gzipTar(map[string]string{
+					"metadata.json":                   `{"FormatVersion":1,"Type":"GOLANG","Label":"mycc"}`,
+					"Chaincode-Package-Metadata.json": `{"Type":"GOLANG"}`,
+					"code.tar.gz":                     "code",
+				})
+				_, err := ccpp.Parse(pkg)
+				Expect(err).To(MatchError("found more than one package metadata file in archive"))
+			})
+		})
+	})
 })
+
+func tarEntries(files map[string]string) []byte {
+	buf := bytes.NewBuffer(nil)
+	tw := tar.NewWriter(buf)
+	for _, name := range sortedKeys(files) {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tw.Write([]byte(files[name]))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func gzipTar(files map[string]string) []byte {
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	_, err := gw.Write(tarEntries(files))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func gzipTarNames(data []byte) []string {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gr)
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return names
+		}
+		Expect(err).NotTo(HaveOccurred())
+		names = append(names, header.Name)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package persistence
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -18,6 +20,7 @@ import (
 	"github.com/hyperledger/fabric/common/chaincode"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/ccmetadata"
 	"github.com/pkg/errors"
 )
 
@@ -118,6 +121,13 @@ func (s *Store) Load(hash []byte) (ccInstallPkg []byte, name, version string, er
 		return nil, "", "", err
 	}
 
+	// the store is content-addressed, make sure that what was read back is
+	// what was installed under this hash
+	if !bytes.Equal(util.ComputeSHA256(ccInstallPkg), hash) {
+		err = errors.Errorf("chaincode install package at %s does not match its hash", ccInstallPkgPath)
+		return nil, "", "", err
+	}
+
 	metadataPath := filepath.Join(s.Path, hashString+".json")
 	name, version, err = s.LoadMetadata(metadataPath)
 	if err != nil {
@@ -127,6 +137,17 @@ func (s *Store) Load(hash []byte) (ccInstallPkg []byte, name, version string, er
 	return ccInstallPkg, name, version, nil
 }
 
+// LoadByPackageID loads a persisted chaincode install package bytes with the
+// given package ID and also returns the name and version
+func (s *Store) LoadByPackageID(packageID string) (ccInstallPkg []byte, name, version string, err error) {
+	_, hash, err := ParsePackageID(packageID)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return s.Load(hash)
+}
+
 // LoadMetadata loads the chaincode metadata stored at the specified path
 func (s *Store) LoadMetadata(path string) (name, version string, err error) {
 	metadataBytes, err := s.ReadWriter.ReadFile(path)
@@ -237,3 +258,32 @@ func toJSON(name, version string) ([]byte, error) {
 
 	return metadataBytes, nil
 }
+
+// PackageID returns the package ID of a versioned chaincode install package,
+// that is its label followed by the hex encoded SHA-256 hash of its bytes.
+// Since the ID is derived from the content of the package, two organizations
+// that computed the same package ID have installed byte-identical packages.
+func PackageID(label string, ccInstallPkg []byte) string {
+	return fmt.Sprintf("%s:%s", label, hex.EncodeToString(util.ComputeSHA256(ccInstallPkg)))
+}
+
+// ParsePackageID splits a package ID into its label and the hash of the
+// chaincode install package it refers to
+func ParsePackageID(packageID string) (label string, hash []byte, err error) {
+	i := strings.LastIndex(packageID, ":")
+	if i < 0 {
+		return "", nil, errors.Errorf("malformed package ID '%s', expected <label>:<hash>", packageID)
+	}
+
+	label, hashString := packageID[:i], packageID[i+1:]
+	if err := ccmetadata.ValidateLabel(label); err != nil {
+		return "", nil, errors.WithMessage(err, fmt.Sprintf("malformed package ID '%s'", packageID))
+	}
+
+	hash, err = hex.DecodeString(hashString)
+	if err != nil || len(hash) != sha256.Size {
+		return "", nil, errors.Errorf("malformed package ID '%s', the hash must be a hex encoded SHA-256 digest", packageID)
+	}
+
+	return label, hash, nil
+}
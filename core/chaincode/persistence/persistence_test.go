@@ -200,6 +200,7 @@ var _ = Describe("Persistence", func() {
 		var (
 			mockReadWriter *mock.IOReadWriter
 			store          *persistence.Store
+			hash           []byte
 		)
 
 		BeforeEach(func() {
@@ -209,10 +210,11 @@ var _ = Describe("Persistence", func() {
 			store = &persistence.Store{
 				ReadWriter: mockReadWriter,
 			}
+			hash = util.ComputeSHA256([]byte("cornerkick"))
 		})
 
 		It("loads successfully", func() {
-			ccInstallPkgBytes, name, version, err := store.Load([]byte("hash"))
+			ccInstallPkgBytes, name, version, err := store.Load(hash)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ccInstallPkgBytes).To(Equal([]byte("cornerkick")))
 			Expect(name).To(Equal("vuvuzela"))
@@ -225,7 +227,7 @@ var _ = Describe("Persistence", func() {
 			})
 
 			It("returns an error", func() {
-				ccInstallPkgBytes, name, version, err := store.Load([]byte("hash"))
+				ccInstallPkgBytes, name, version, err := store.Load(hash)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error reading chaincode install package"))
 				Expect(len(ccInstallPkgBytes)).To(Equal(0))
@@ -240,7 +242,7 @@ var _ = Describe("Persistence", func() {
 			})
 
 			It("returns an error", func() {
-				ccInstallPkgBytes, name, version, err := store.Load([]byte("hash"))
+				ccInstallPkgBytes, name, version, err := store.Load(hash)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error reading metadata"))
 				Expect(len(ccInstallPkgBytes)).To(Equal(0))
@@ -255,7 +257,7 @@ var _ = Describe("Persistence", func() {
 			})
 
 			It("returns an error", func() {
-				ccInstallPkgBytes, name, version, err := store.Load([]byte("hash"))
+				ccInstallPkgBytes, name, version, err := store.Load(hash)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error unmarshaling metadata"))
 				Expect(len(ccInstallPkgBytes)).To(Equal(0))
@@ -263,6 +265,53 @@ var _ = Describe("Persistence", func() {
 				Expect(version).To(Equal(""))
 			})
 		})
+
+		Context("when the chaincode install package does not match its hash", func() {
+			It("returns an error", func() {
+				ccInstallPkgBytes, name, version, err := store.Load([]byte("hash"))
+				Expect(err).To(MatchError(ContainSubstring("does not match its hash")))
+				Expect(len(ccInstallPkgBytes)).To(Equal(0))
+				Expect(name).To(Equal(""))
+				Expect(version).To(Equal(""))
+			})
+		})
+	})
+
+	Describe("LoadByPackageID", func() {
+		var (
+			mockReadWriter *mock.IOReadWriter
+			store          *persistence.Store
+		)
+
+		BeforeEach(func() {
+			mockReadWriter = &mock.IOReadWriter{}
+			mockReadWriter.ReadFileReturnsOnCall(0, []byte("cornerkick"), nil)
+			mockReadWriter.ReadFileReturnsOnCall(1, []byte(`{"Name":"vuvuzela","Version":"2.0"}`), nil)
+			store = &persistence.Store{
+				Path:       "/foo",
+				ReadWriter: mockReadWriter,
+			}
+		})
+
+		It("loads the package referenced by the hash in the package ID", func() {
+			packageID := persistence.PackageID("vuvuzela_2.0", []byte("cornerkick"))
+			ccInstallPkgBytes, name, version, err := store.LoadByPackageID(packageID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ccInstallPkgBytes).To(Equal([]byte("cornerkick")))
+			Expect(name).To(Equal("vuvuzela"))
+			Expect(version).To(Equal("2.0"))
+
+			hashString := hex.EncodeToString(util.ComputeSHA256([]byte("cornerkick")))
+			Expect(mockReadWriter.ReadFileArgsForCall(0)).To(Equal(filepath.Join("/foo", hashString+".bin")))
+		})
+
+		Context("when the package ID is malformed", func() {
+			It("returns an error", func() {
+				_, _, _, err := store.LoadByPackageID("vuvuzela")
+				Expect(err).To(MatchError("malformed package ID 'vuvuzela', expected <label>:<hash>"))
+				Expect(mockReadWriter.ReadFileCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Describe("RetrieveHash", func() {
@@ -396,6 +445,36 @@ var _ = Describe("Persistence", func() {
 		})
 	})
 
+	Describe("PackageID", func() {
+		It("is the label followed by the hash of the package", func() {
+			packageID := persistence.PackageID("mycc_1.0", []byte("cornerkick"))
+			Expect(packageID).To(Equal("mycc_1.0:" + hex.EncodeToString(util.ComputeSHA256([]byte("cornerkick")))))
+
+			label, hash, err := persistence.ParsePackageID(packageID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("mycc_1.0"))
+			Expect(hash).To(Equal(util.ComputeSHA256([]byte("cornerkick"))))
+		})
+
+		It("changes when the package changes", func() {
+			Expect(persistence.PackageID("mycc", []byte("cornerkick"))).NotTo(Equal(persistence.PackageID("mycc", []byte("freekick"))))
+		})
+
+		Context("when the label is invalid", func() {
+			It("fails to parse", func() {
+				_, _, err := persistence.ParsePackageID(":" + hex.EncodeToString(util.ComputeSHA256(nil)))
+				Expect(err).To(MatchError(ContainSubstring("invalid label ''")))
+			})
+		})
+
+		Context("when the hash is not a SHA-256 digest", func() {
+			It("fails to parse", func() {
+				_, _, err := persistence.ParsePackageID("mycc:abcd")
+				Expect(err).To(MatchError("malformed package ID 'mycc:abcd', the hash must be a hex encoded SHA-256 digest"))
+			})
+		})
+	})
+
 	Describe("GetChaincodeInstallPath", func() {
 		var (
 			store *persistence.Store
@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ccmetadata
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"unicode"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+)
+
+// The validators below check the fields of the metadata.json file carried by
+// versioned chaincode packages. They live next to the statedb validators so
+// that the peer and the CLI apply the same rules to a package.
+
+// AllowedCharsPackageLabel captures the regex pattern for a valid package label
+const AllowedCharsPackageLabel = "[[:alnum:]][[:alnum:]_.+-]*"
+
+var labelValid = regexp.MustCompile("^" + AllowedCharsPackageLabel + "$")
+
+// lockHashLength is the length of a hex encoded SHA-256 digest
+const lockHashLength = 64
+
+// InvalidPackageMetadataError is returned for package metadata with invalid content
+type InvalidPackageMetadataError struct {
+	err string
+}
+
+func (e *InvalidPackageMetadataError) Error() string {
+	return e.err
+}
+
+// ValidateLabel checks that a package label is non-empty and only contains
+// alphanumerics and the characters '_', '.', '+' and '-'
+func ValidateLabel(label string) error {
+	if !labelValid.MatchString(label) {
+		return &InvalidPackageMetadataError{fmt.Sprintf("invalid label '%s', the label must match %s", label, labelValid)}
+	}
+	return nil
+}
+
+// ValidateChaincodeType checks that the chaincode type is one of the
+// languages known to the peer
+func ValidateChaincodeType(ccType string) error {
+	if _, ok := pb.ChaincodeSpec_Type_value[ccType]; !ok || ccType == pb.ChaincodeSpec_UNDEFINED.String() {
+		return &InvalidPackageMetadataError{fmt.Sprintf("chaincode type '%s' is not supported", ccType)}
+	}
+	return nil
+}
+
+// ValidateBuildArgs checks that the build arguments passed to the platform
+// builder are non-empty and free of control characters
+func ValidateBuildArgs(buildArgs []string) error {
+	for i, arg := range buildArgs {
+		if arg == "" {
+			return &InvalidPackageMetadataError{fmt.Sprintf("build argument %d is empty", i)}
+		}
+		for _, r := range arg {
+			if unicode.IsControl(r) {
+				return &InvalidPackageMetadataError{fmt.Sprintf("build argument %d contains control characters", i)}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateLockHash checks that the dependency lock hash, when set, is a hex
+// encoded SHA-256 digest
+func ValidateLockHash(lockHash string) error {
+	if lockHash == "" {
+		return nil
+	}
+	if _, err := hex.DecodeString(lockHash); err != nil || len(lockHash) != lockHashLength {
+		return &InvalidPackageMetadataError{fmt.Sprintf("lock hash '%s' is not a hex encoded SHA-256 digest", lockHash)}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package ccmetadata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLabel(t *testing.T) {
+	for _, label := range []string{"a", "mycc", "mycc_1.0", "my-cc+v2", "0cc"} {
+		assert.NoError(t, ValidateLabel(label), "label %s should be valid", label)
+	}
+
+	for _, label := range []string{"", "_mycc", "my cc", "mycc:1", "mycc/1", "mycc\n"} {
+		err := ValidateLabel(label)
+		assert.Error(t, err, "label %q should be invalid", label)
+		_, ok := err.(*InvalidPackageMetadataError)
+		assert.True(t, ok, "Should have received an InvalidPackageMetadataError")
+	}
+}
+
+func TestValidateChaincodeType(t *testing.T) {
+	for _, ccType := range []string{"GOLANG", "NODE", "JAVA", "CAR"} {
+		assert.NoError(t, ValidateChaincodeType(ccType))
+	}
+
+	assert.EqualError(t, ValidateChaincodeType("UNDEFINED"), "chaincode type 'UNDEFINED' is not supported")
+	assert.EqualError(t, ValidateChaincodeType("golang"), "chaincode type 'golang' is not supported")
+	assert.EqualError(t, ValidateChaincodeType(""), "chaincode type '' is not supported")
+}
+
+func TestValidateBuildArgs(t *testing.T) {
+	assert.NoError(t, ValidateBuildArgs(nil))
+	assert.NoError(t, ValidateBuildArgs([]string{"-tags", "nopkcs11", "--production"}))
+
+	assert.EqualError(t, ValidateBuildArgs([]string{"-tags", ""}), "build argument 1 is empty")
+	assert.EqualError(t, ValidateBuildArgs([]string{"-tags\x00"}), "build argument 0 contains control characters")
+}
+
+func TestValidateLockHash(t *testing.T) {
+	assert.NoError(t, ValidateLockHash(""))
+	assert.NoError(t, ValidateLockHash(strings.Repeat("ab", 32)))
+
+	assert.EqualError(t, ValidateLockHash("abcd"), "lock hash 'abcd' is not a hex encoded SHA-256 digest")
+	assert.Error(t, ValidateLockHash(strings.Repeat("zz", 32)))
+}
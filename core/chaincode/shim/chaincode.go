@@ -53,7 +53,7 @@ const (
 type ChaincodeStub struct {
 	TxID                       string
 	ChannelId                  string
-	chaincodeEvent             *pb.ChaincodeEvent
+	chaincodeEvents            []*pb.ChaincodeEvent
 	args                       [][]byte
 	handler                    *Handler
 	signedProposal             *pb.SignedProposal
@@ -540,6 +540,17 @@ func (stub *ChaincodeStub) DelPrivateData(collection string, key string) error {
 	return stub.handler.handleDelState(collection, key, stub.ChannelId, stub.TxID)
 }
 
+// PurgePrivateData documentation can be found in interfaces.go
+func (stub *ChaincodeStub) PurgePrivateData(collection string, key string) error {
+	if collection == "" {
+		return fmt.Errorf("collection must not be an empty string")
+	}
+	if key == "" {
+		return fmt.Errorf("key must not be an empty string")
+	}
+	return stub.handler.handlePurgePrivateData(collection, key, stub.ChannelId, stub.TxID)
+}
+
 // GetPrivateDataByRange documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetPrivateDataByRange(collection, startKey, endKey string) (StateQueryIteratorInterface, error) {
 	if collection == "" {
@@ -1020,7 +1031,16 @@ func (stub *ChaincodeStub) SetEvent(name string, payload []byte) error {
 	if name == "" {
 		return errors.New("event name can not be nil string")
 	}
-	stub.chaincodeEvent = &pb.ChaincodeEvent{EventName: name, Payload: payload}
+	stub.chaincodeEvents = []*pb.ChaincodeEvent{{EventName: name, Payload: payload}}
+	return nil
+}
+
+// AppendEvent documentation can be found in interfaces.go
+func (stub *ChaincodeStub) AppendEvent(name string, payload []byte) error {
+	if name == "" {
+		return errors.New("event name can not be nil string")
+	}
+	stub.chaincodeEvents = append(stub.chaincodeEvents, &pb.ChaincodeEvent{EventName: name, Payload: payload})
 	return nil
 }
 
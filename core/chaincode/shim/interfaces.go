@@ -262,6 +262,13 @@ type ChaincodeStubInterface interface {
 	// when the transaction is validated and successfully committed.
 	DelPrivateData(collection, key string) error
 
+	// PurgePrivateData records the specified `key` to be purged in the private writeset
+	// of the transaction. Like DelPrivateData, the `key` and its value are deleted from
+	// the collection when the transaction is validated and successfully committed. In
+	// addition, all the historical versions of the `key` are removed from the private
+	// data stores of the peers, only the hash of the `key` remaining in the ledger.
+	PurgePrivateData(collection, key string) error
+
 	// SetPrivateDataValidationParameter sets the key-level endorsement policy
 	// for the private data specified by `key`.
 	SetPrivateDataValidationParameter(collection, key string, ep []byte) error
@@ -343,8 +350,16 @@ type ChaincodeStubInterface interface {
 	// SetEvent allows the chaincode to set an event on the response to the
 	// proposal to be included as part of a transaction. The event will be
 	// available within the transaction in the committed block regardless of the
-	// validity of the transaction.
+	// validity of the transaction. SetEvent replaces any event previously set
+	// or appended by the transaction.
 	SetEvent(name string, payload []byte) error
+
+	// AppendEvent adds an event to the ones already set or appended by the
+	// transaction, so that a single transaction can emit several events. The
+	// events are included in the transaction in the order they were added.
+	// Multiple events require the V1_4 application capability on the channel,
+	// without it the endorsement of a proposal that appends events fails.
+	AppendEvent(name string, payload []byte) error
 }
 
 // CommonIteratorInterface allows a chaincode to check whether any more result
@@ -487,7 +487,28 @@ func TestNilEventName(t *testing.T) {
 	if err := stub.SetEvent("", []byte("event payload")); err == nil {
 		t.Error("Event name can not be nil string.")
 	}
+	if err := stub.AppendEvent("", []byte("event payload")); err == nil {
+		t.Error("Event name can not be nil string.")
+	}
+}
 
+func TestAppendEvent(t *testing.T) {
+	stub := ChaincodeStub{}
+	assert.NoError(t, stub.AppendEvent("e1", []byte("p1")))
+	assert.NoError(t, stub.AppendEvent("e2", []byte("p2")))
+	assert.Len(t, stub.chaincodeEvents, 2)
+	assert.Equal(t, "e1", stub.chaincodeEvents[0].EventName)
+	assert.Equal(t, "e2", stub.chaincodeEvents[1].EventName)
+	assert.Equal(t, stub.chaincodeEvents[0], firstEvent(stub.chaincodeEvents))
+
+	// SetEvent replaces all the events
+	assert.NoError(t, stub.SetEvent("e3", []byte("p3")))
+	assert.Len(t, stub.chaincodeEvents, 1)
+	assert.Equal(t, "e3", stub.chaincodeEvents[0].EventName)
+
+	assert.NoError(t, stub.AppendEvent("e4", nil))
+	assert.Len(t, stub.chaincodeEvents, 2)
+	assert.Nil(t, firstEvent(nil))
 }
 
 type testCase struct {
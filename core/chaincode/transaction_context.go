@@ -27,6 +27,31 @@ type TransactionContext struct {
 	queryIteratorMap    map[string]commonledger.ResultsIterator
 	pendingQueryResults map[string]*PendingQueryResult
 	totalReturnCount    map[string]*int32
+
+	// caches the access of the transaction creator to the collections
+	permissionMutex       sync.Mutex
+	collectionPermissions map[string]readWritePermission
+}
+
+type readWritePermission struct {
+	read  bool
+	write bool
+}
+
+func (t *TransactionContext) collectionPermission(collection string) (readWritePermission, bool) {
+	t.permissionMutex.Lock()
+	defer t.permissionMutex.Unlock()
+	perm, ok := t.collectionPermissions[collection]
+	return perm, ok
+}
+
+func (t *TransactionContext) setCollectionPermission(collection string, perm readWritePermission) {
+	t.permissionMutex.Lock()
+	defer t.permissionMutex.Unlock()
+	if t.collectionPermissions == nil {
+		t.collectionPermissions = map[string]readWritePermission{}
+	}
+	t.collectionPermissions[collection] = perm
 }
 
 func (t *TransactionContext) InitializeQueryContext(queryID string, iter commonledger.ResultsIterator) {
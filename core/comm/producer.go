@@ -44,6 +44,9 @@ type connProducer struct {
 	endpoints         []string
 	disabledEndpoints map[string]time.Time
 	connect           ConnectionFactory
+	// offset is the index of the endpoint that is tried first, or -1 if
+	// all endpoints are tried in random order
+	offset int
 }
 
 // NewConnectionProducer creates a new ConnectionProducer with given endpoints and connection factory.
@@ -52,7 +55,21 @@ func NewConnectionProducer(factory ConnectionFactory, endpoints []string) Connec
 	if len(endpoints) == 0 {
 		return nil
 	}
-	return &connProducer{endpoints: endpoints, connect: factory, disabledEndpoints: make(map[string]time.Time)}
+	return &connProducer{endpoints: endpoints, connect: factory, disabledEndpoints: make(map[string]time.Time), offset: -1}
+}
+
+// NewStaggeredConnectionProducer creates a new ConnectionProducer with given endpoints and connection factory,
+// that tries connecting to the endpoint at the given offset (modulo the number of endpoints) first, and to the rest
+// of the endpoints in random order. This lets several clients connect to different endpoints, by using different offsets.
+// It returns nil, if the given endpoints slice is empty.
+func NewStaggeredConnectionProducer(factory ConnectionFactory, endpoints []string, offset int) ConnectionProducer {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	if offset < 0 {
+		offset = -1
+	}
+	return &connProducer{endpoints: endpoints, connect: factory, disabledEndpoints: make(map[string]time.Time), offset: offset}
 }
 
 // NewConnection creates a new connection.
@@ -69,6 +86,9 @@ func (cp *connProducer) NewConnection() (*grpc.ClientConn, string, error) {
 	}
 
 	endpoints := shuffle(cp.endpoints)
+	if cp.offset >= 0 {
+		endpoints = preferEndpoint(endpoints, cp.endpoints[cp.offset%len(cp.endpoints)])
+	}
 	checkedEndpoints := make([]string, 0)
 	for _, endpoint := range endpoints {
 		if _, ok := cp.disabledEndpoints[endpoint]; !ok {
@@ -132,6 +152,17 @@ func shuffle(a []string) []string {
 	return returnedSlice
 }
 
+// preferEndpoint moves the given endpoint to the beginning of the given endpoints
+func preferEndpoint(endpoints []string, preferred string) []string {
+	res := []string{preferred}
+	for _, endpoint := range endpoints {
+		if endpoint != preferred {
+			res = append(res, endpoint)
+		}
+	}
+	return res
+}
+
 // GetEndpoints returns configured endpoints for ordering service
 func (cp *connProducer) GetEndpoints() []string {
 	cp.RLock()
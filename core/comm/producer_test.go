@@ -132,3 +132,42 @@ func TestDisableEndpoint(t *testing.T) {
 	assert.Equal(t, "b", a)
 
 }
+
+func TestStaggeredConnectionProducer(t *testing.T) {
+	t.Parallel()
+	noopFactory := func(endpoint string) (*grpc.ClientConn, error) {
+		return nil, nil
+	}
+	assert.Nil(t, NewStaggeredConnectionProducer(noopFactory, []string{}, 0))
+
+	shouldConnFail := map[string]bool{}
+	connFactory := func(endpoint string) (*grpc.ClientConn, error) {
+		if shouldConnFail[endpoint] {
+			return nil, fmt.Errorf("Failed connecting to %s", endpoint)
+		}
+		return &grpc.ClientConn{}, nil
+	}
+	endpoints := []string{"a", "b", "c"}
+	// Producers with different offsets connect to different endpoints,
+	// and offsets wrap around the endpoints
+	for offset, expected := range []string{"a", "b", "c", "a"} {
+		producer := NewStaggeredConnectionProducer(connFactory, endpoints, offset)
+		for i := 0; i < 10; i++ {
+			_, endpoint, err := producer.NewConnection()
+			assert.NoError(t, err)
+			assert.Equal(t, expected, endpoint)
+		}
+	}
+
+	// If the preferred endpoint can't be connected to, other endpoints are used
+	shouldConnFail["b"] = true
+	producer := NewStaggeredConnectionProducer(connFactory, endpoints, 1)
+	_, endpoint, err := producer.NewConnection()
+	assert.NoError(t, err)
+	assert.NotEqual(t, "b", endpoint)
+
+	// A negative offset means no endpoint is preferred
+	producer = NewStaggeredConnectionProducer(connFactory, endpoints, -3)
+	_, _, err = producer.NewConnection()
+	assert.NoError(t, err)
+}
@@ -633,3 +633,7 @@ func (ds *dynamicCapabilities) V1_2Validation() bool {
 func (ds *dynamicCapabilities) V1_3Validation() bool {
 	return ds.support.Capabilities().V1_3Validation()
 }
+
+func (ds *dynamicCapabilities) V1_4Validation() bool {
+	return ds.support.Capabilities().V1_4Validation()
+}
@@ -863,6 +863,55 @@ func testInvokeOKPvtDataOnly(t *testing.T, l ledger.PeerLedger, v txvalidator.Va
 	assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
 }
 
+func TestInvokePurgePvtData(t *testing.T) {
+	mspmgr := &mocks2.MSPManager{}
+	idThatSatisfiesPrincipal := &mocks2.Identity{}
+	idThatSatisfiesPrincipal.SatisfiesPrincipalReturns(errors.New("principal not satisfied"))
+	idThatSatisfiesPrincipal.GetIdentifierReturns(&msp.IdentityIdentifier{})
+	mspmgr.DeserializeIdentityReturns(idThatSatisfiesPrincipal, nil)
+
+	t.Run("V1.3", func(t *testing.T) {
+		l, v := setupLedgerAndValidatorExplicitWithMSP(t, v13Capabilities(), &builtin.DefaultValidation{}, mspmgr)
+		defer ledgermgmt.CleanupTestEnv()
+		defer l.Close()
+
+		b := purgePvtDataBlock(t, l)
+		err := v.Validate(b)
+		assert.NoError(t, err)
+		assertInvalid(b, t, peer.TxValidationCode_ILLEGAL_WRITESET)
+	})
+
+	t.Run("V1.4", func(t *testing.T) {
+		capabilities := v13Capabilities()
+		capabilities.V1_4ValidationRv = true
+		l, v := setupLedgerAndValidatorExplicitWithMSP(t, capabilities, &builtin.DefaultValidation{}, mspmgr)
+		defer ledgermgmt.CleanupTestEnv()
+		defer l.Close()
+
+		// the purge is subject to the endorsement policy like any other write
+		b := purgePvtDataBlock(t, l)
+		err := v.Validate(b)
+		assert.NoError(t, err)
+		assertInvalid(b, t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE)
+	})
+}
+
+func purgePvtDataBlock(t *testing.T, l ledger.PeerLedger) *common.Block {
+	ccID := "mycc"
+
+	putCCInfo(l, ccID, signedByAnyMember([]string{"SampleOrg"}), t)
+
+	rwsetBuilder := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder.AddToPvtAndHashedWriteSetForPurge(ccID, "mycollection", "somekey")
+	rwset, err := rwsetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	rwsetBytes, err := rwset.GetPubSimulationBytes()
+	assert.NoError(t, err)
+
+	tx := getEnv(ccID, nil, rwsetBytes, t)
+	return &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{Number: 2}}
+}
+
 func TestInvokeOKMetaUpdateOnly(t *testing.T) {
 	mspmgr := &mocks2.MSPManager{}
 	idThatSatisfiesPrincipal := &mocks2.Identity{}
@@ -148,6 +148,13 @@ func (v *VsccValidatorImpl) VSCCValidateTx(seq int, payload *common.Payload, env
 		}
 	}
 
+	// purges of private data were introduced with v1.4, peers of earlier
+	// versions would commit them as plain deletes
+	if !v.support.Capabilities().V1_4Validation() && txPurgesPrivateData(txRWSet) {
+		return errors.Errorf("chaincode %s attempted to purge private data without the V1_4 application capability", ccID),
+			peer.TxValidationCode_ILLEGAL_WRITESET
+	}
+
 	// we've gathered all the info required to proceed to validation;
 	// validation will behave differently depending on the type of
 	// chaincode (system vs. application)
@@ -392,3 +399,20 @@ func (v *VsccValidatorImpl) txWritesToNamespace(ns *rwsetutil.NsRwSet) bool {
 
 	return false
 }
+
+// txPurgesPrivateData returns whether the transaction purges a private data key
+func txPurgesPrivateData(txRWSet *rwsetutil.TxRwSet) bool {
+	for _, ns := range txRWSet.NsRwSets {
+		for _, c := range ns.CollHashedRwSets {
+			if c.HashedRwSet == nil {
+				continue
+			}
+			for _, hashedWrite := range c.HashedRwSet.HashedWrites {
+				if hashedWrite.IsPurge {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -435,6 +435,9 @@ type ChaincodeData struct {
 
 	// InstantiationPolicy for the chaincode
 	InstantiationPolicy []byte `protobuf:"bytes,8,opt,name=instantiation_policy,proto3"`
+
+	// ResourceLimits requested for the chaincode instance
+	ResourceLimits *pb.ChaincodeResourceLimits `protobuf:"bytes,9,opt,name=resource_limits"`
 }
 
 // CCName returns the name of this chaincode (the name it was put in the ChaincodeRegistry with).
@@ -487,6 +490,10 @@ type ChaincodeContainerInfo struct {
 
 	// ContainerType is not a great name, but 'DOCKER' and 'SYSTEM' are the valid types
 	ContainerType string
+
+	// ResourceLimits bounds the resources the chaincode may use, nil means
+	// that the peer configuration applies
+	ResourceLimits *pb.ChaincodeResourceLimits
 }
 
 // TransactionParams are parameters which are tied to a particular transaction
@@ -509,20 +516,61 @@ type TransactionParams struct {
 // should be added below if necessary
 type ChaincodeProvider interface {
 	// Execute executes a standard chaincode invocation for a chaincode and an input
-	Execute(txParams *TransactionParams, cccid *CCContext, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
+	Execute(txParams *TransactionParams, cccid *CCContext, input *pb.ChaincodeInput) (*pb.Response, []*pb.ChaincodeEvent, error)
 	// ExecuteLegacyInit is a special case for executing chaincode deployment specs,
 	// which are not already in the LSCC, needed for old lifecycle
-	ExecuteLegacyInit(txParams *TransactionParams, cccid *CCContext, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error)
+	ExecuteLegacyInit(txParams *TransactionParams, cccid *CCContext, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, []*pb.ChaincodeEvent, error)
 	// Stop stops the chaincode give
 	Stop(ccci *ChaincodeContainerInfo) error
 }
 
 func DeploymentSpecToChaincodeContainerInfo(cds *pb.ChaincodeDeploymentSpec) *ChaincodeContainerInfo {
 	return &ChaincodeContainerInfo{
-		Name:          cds.Name(),
-		Version:       cds.Version(),
-		Path:          cds.Path(),
-		Type:          cds.CCType(),
-		ContainerType: cds.ExecEnv.String(),
+		Name:           cds.Name(),
+		Version:        cds.Version(),
+		Path:           cds.Path(),
+		Type:           cds.CCType(),
+		ContainerType:  cds.ExecEnv.String(),
+		ResourceLimits: cds.ResourceLimits,
+	}
+}
+
+// MinMilliCpus is the smallest CPU limit of a chaincode container, which
+// translates to the minimal CPU quota of 1ms per 100ms that docker accepts
+const MinMilliCpus = 10
+
+// ValidateResourceLimits checks that the resource limits requested for a
+// chaincode can be enforced
+func ValidateResourceLimits(limits *pb.ChaincodeResourceLimits) error {
+	if limits.GetMilliCpus() != 0 && limits.GetMilliCpus() < MinMilliCpus {
+		return errors.Errorf("the CPU limit of a chaincode must be at least %d milli CPUs, got %d", MinMilliCpus, limits.GetMilliCpus())
+	}
+	return nil
+}
+
+// MergeResourceLimits returns the resource limits of a chaincode definition
+// completed with the limits requested when the chaincode was installed. Limits
+// set in the definition take precedence as they are agreed upon by the channel.
+func MergeResourceLimits(defined, installed *pb.ChaincodeResourceLimits) *pb.ChaincodeResourceLimits {
+	if defined == nil {
+		return installed
+	}
+	if installed == nil {
+		return defined
+	}
+
+	merged := *installed
+	if defined.ExecuteTimeoutMs != 0 {
+		merged.ExecuteTimeoutMs = defined.ExecuteTimeoutMs
+	}
+	if defined.MaxConcurrency != 0 {
+		merged.MaxConcurrency = defined.MaxConcurrency
+	}
+	if defined.MilliCpus != 0 {
+		merged.MilliCpus = defined.MilliCpus
+	}
+	if defined.MemoryBytes != 0 {
+		merged.MemoryBytes = defined.MemoryBytes
 	}
+	return &merged
 }
@@ -23,6 +23,32 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestMergeResourceLimits(t *testing.T) {
+	installed := &peer.ChaincodeResourceLimits{ExecuteTimeoutMs: 1000, MaxConcurrency: 4, MilliCpus: 500}
+	defined := &peer.ChaincodeResourceLimits{MaxConcurrency: 8, MemoryBytes: 1 << 30}
+
+	assert.Nil(t, ccprovider.MergeResourceLimits(nil, nil))
+	assert.Equal(t, installed, ccprovider.MergeResourceLimits(nil, installed))
+	assert.Equal(t, defined, ccprovider.MergeResourceLimits(defined, nil))
+
+	merged := ccprovider.MergeResourceLimits(defined, installed)
+	assert.Equal(t, &peer.ChaincodeResourceLimits{
+		ExecuteTimeoutMs: 1000,
+		MaxConcurrency:   8,
+		MilliCpus:        500,
+		MemoryBytes:      1 << 30,
+	}, merged)
+	assert.Equal(t, uint32(4), installed.MaxConcurrency, "the installed limits must not be modified")
+}
+
+func TestValidateResourceLimits(t *testing.T) {
+	assert.NoError(t, ccprovider.ValidateResourceLimits(nil))
+	assert.NoError(t, ccprovider.ValidateResourceLimits(&peer.ChaincodeResourceLimits{MaxConcurrency: 1}))
+	assert.NoError(t, ccprovider.ValidateResourceLimits(&peer.ChaincodeResourceLimits{MilliCpus: ccprovider.MinMilliCpus}))
+	assert.EqualError(t, ccprovider.ValidateResourceLimits(&peer.ChaincodeResourceLimits{MilliCpus: 9}),
+		"the CPU limit of a chaincode must be at least 10 milli CPUs, got 9")
+}
+
 func TestInstalledCCs(t *testing.T) {
 	tmpDir := setupDirectoryStructure(t)
 	defer func() {
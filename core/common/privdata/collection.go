@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
 )
 
 // Collection defines a common interface for collections
@@ -47,6 +48,14 @@ type CollectionAccessPolicy interface {
 	// MemberOrgs returns the collection's members as MSP IDs. This serves as
 	// a human-readable way of quickly identifying who is part of a collection.
 	MemberOrgs() []string
+
+	// IsMemberOnlyRead returns whether only clients of the member orgs of
+	// the collection can read its private data
+	IsMemberOnlyRead() bool
+
+	// IsMemberOnlyWrite returns whether only clients of the member orgs of
+	// the collection can write its private data
+	IsMemberOnlyWrite() bool
 }
 
 // CollectionPersistenceConfigs encapsulates configurations related to persistece of a collection
@@ -86,6 +95,12 @@ type CollectionStore interface {
 	// RetrieveCollectionPersistenceConfigs retrieves the collection's persistence related configurations
 	RetrieveCollectionPersistenceConfigs(cc common.CollectionCriteria) (CollectionPersistenceConfigs, error)
 
+	// RetrieveReadWritePermission retrieves whether the creator of the supplied
+	// signed proposal can read and write the private data of the collection,
+	// according to the member orgs policy and the member only read and write
+	// flags of the committed collection configuration
+	RetrieveReadWritePermission(cc common.CollectionCriteria, signedProposal *pb.SignedProposal) (bool, bool, error)
+
 	CollectionFilter
 }
 
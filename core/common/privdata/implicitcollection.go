@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"strings"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/spf13/viper"
+)
+
+const (
+	// ImplicitCollectionPrefix is the reserved prefix of the names of the
+	// implicit collections, one of which exists for every organization
+	ImplicitCollectionPrefix = "_implicit_org_"
+
+	implicitCollectionRequiredPeerCountKey = "peer.gossip.pvtData.implicitCollectionDisseminationPolicy.requiredPeerCount"
+	implicitCollectionMaxPeerCountKey      = "peer.gossip.pvtData.implicitCollectionDisseminationPolicy.maxPeerCount"
+
+	defaultImplicitCollectionRequiredPeerCount = 0
+	defaultImplicitCollectionMaxPeerCount      = 1
+)
+
+// ImplicitCollectionNameForOrg returns the name of the implicit collection
+// of the organization with the given MSP ID
+func ImplicitCollectionNameForOrg(mspID string) string {
+	return ImplicitCollectionPrefix + mspID
+}
+
+// MspIDIfImplicitCollection returns true and the MSP ID of the owning
+// organization if the given collection name is an implicit collection
+func MspIDIfImplicitCollection(collectionName string) (bool, string) {
+	if !strings.HasPrefix(collectionName, ImplicitCollectionPrefix) {
+		return false, ""
+	}
+	mspID := collectionName[len(ImplicitCollectionPrefix):]
+	if mspID == "" {
+		return false, ""
+	}
+	return true, mspID
+}
+
+// GenerateImplicitCollectionForOrg returns the configuration of the implicit
+// collection of the organization with the given MSP ID. Its member policy is
+// the member principal of the organization, it is never purged and its
+// dissemination settings are read from the peer configuration
+func GenerateImplicitCollectionForOrg(mspID string) *common.StaticCollectionConfig {
+	requiredPeerCount := defaultImplicitCollectionRequiredPeerCount
+	if viper.IsSet(implicitCollectionRequiredPeerCountKey) {
+		requiredPeerCount = viper.GetInt(implicitCollectionRequiredPeerCountKey)
+	}
+	maxPeerCount := defaultImplicitCollectionMaxPeerCount
+	if viper.IsSet(implicitCollectionMaxPeerCountKey) {
+		maxPeerCount = viper.GetInt(implicitCollectionMaxPeerCountKey)
+	}
+	return &common.StaticCollectionConfig{
+		Name: ImplicitCollectionNameForOrg(mspID),
+		MemberOrgsPolicy: &common.CollectionPolicyConfig{
+			Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+				SignaturePolicy: cauthdsl.SignedByMspMember(mspID),
+			},
+		},
+		RequiredPeerCount: int32(requiredPeerCount),
+		MaximumPeerCount:  int32(maxPeerCount),
+	}
+}
+
+// ImplicitCollectionConfig returns the collection configuration of the
+// implicit collection with the given name, or nil if the name does not
+// designate an implicit collection
+func ImplicitCollectionConfig(collectionName string) *common.CollectionConfig {
+	isImplicit, mspID := MspIDIfImplicitCollection(collectionName)
+	if !isImplicit {
+		return nil
+	}
+	return &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: GenerateImplicitCollectionForOrg(mspID),
+		},
+	}
+}
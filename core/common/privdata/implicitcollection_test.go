@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	lm "github.com/hyperledger/fabric/common/mocks/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMspIDIfImplicitCollection(t *testing.T) {
+	isImplicit, mspID := MspIDIfImplicitCollection(ImplicitCollectionNameForOrg("Org1MSP"))
+	assert.True(t, isImplicit)
+	assert.Equal(t, "Org1MSP", mspID)
+
+	isImplicit, mspID = MspIDIfImplicitCollection("mycollection")
+	assert.False(t, isImplicit)
+	assert.Empty(t, mspID)
+
+	isImplicit, mspID = MspIDIfImplicitCollection(ImplicitCollectionPrefix)
+	assert.False(t, isImplicit)
+	assert.Empty(t, mspID)
+}
+
+func TestGenerateImplicitCollectionForOrg(t *testing.T) {
+	defer viper.Reset()
+
+	conf := GenerateImplicitCollectionForOrg("Org1MSP")
+	assert.Equal(t, "_implicit_org_Org1MSP", conf.Name)
+	assert.Equal(t, int32(0), conf.RequiredPeerCount)
+	assert.Equal(t, int32(1), conf.MaximumPeerCount)
+	assert.Equal(t, uint64(0), conf.BlockToLive)
+	assert.True(t, proto.Equal(cauthdsl.SignedByMspMember("Org1MSP"), conf.MemberOrgsPolicy.GetSignaturePolicy()))
+
+	viper.Set("peer.gossip.pvtData.implicitCollectionDisseminationPolicy.requiredPeerCount", 1)
+	viper.Set("peer.gossip.pvtData.implicitCollectionDisseminationPolicy.maxPeerCount", 3)
+	conf = GenerateImplicitCollectionForOrg("Org1MSP")
+	assert.Equal(t, int32(1), conf.RequiredPeerCount)
+	assert.Equal(t, int32(3), conf.MaximumPeerCount)
+
+	assert.Nil(t, ImplicitCollectionConfig("mycollection"))
+	assert.Equal(t, "_implicit_org_Org2MSP", ImplicitCollectionConfig("_implicit_org_Org2MSP").GetStaticCollectionConfig().Name)
+}
+
+func TestCollectionStoreImplicitCollection(t *testing.T) {
+	// no collection configuration is defined for the chaincode
+	support := &mockStoreSupport{Qe: &lm.MockQueryExecutor{State: map[string]map[string][]byte{"lscc": {}}}}
+	cs := NewSimpleCollectionStore(support)
+
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: ImplicitCollectionNameForOrg("Org1MSP")}
+
+	c, err := cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org1MSP"}, c.MemberOrgs())
+	assert.Equal(t, "_implicit_org_Org1MSP", c.CollectionID())
+
+	ca, err := cs.RetrieveCollectionAccessPolicy(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, ca.RequiredPeerCount())
+	assert.Equal(t, 1, ca.MaximumPeerCount())
+
+	pc, err := cs.RetrieveCollectionPersistenceConfigs(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), pc.BlockToLive())
+
+	ccr.Collection = "mycollection"
+	_, err = cs.RetrieveCollection(ccr)
+	assert.Error(t, err)
+}
+
+func TestCollectionStoreExplicitCollectionWithImplicitName(t *testing.T) {
+	// a collection named like the implicit collection of Org1MSP is defined explicitly
+	signers := [][]byte{[]byte("signer0")}
+	explicitCollection := &common.CollectionConfig{Payload: &common.CollectionConfig_StaticCollectionConfig{
+		StaticCollectionConfig: &common.StaticCollectionConfig{
+			Name:             ImplicitCollectionNameForOrg("Org1MSP"),
+			MemberOrgsPolicy: createCollectionPolicyConfig(cauthdsl.Envelope(cauthdsl.SignedBy(0), signers)),
+			BlockToLive:      10,
+		},
+	}}
+	ccpBytes, err := proto.Marshal(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{explicitCollection}})
+	assert.NoError(t, err)
+	state := map[string]map[string][]byte{"lscc": {BuildCollectionKVSKey("cc"): ccpBytes}}
+	cs := NewSimpleCollectionStore(&mockStoreSupport{Qe: &lm.MockQueryExecutor{State: state}})
+
+	// the explicit collection is not shadowed by the implicit one
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: ImplicitCollectionNameForOrg("Org1MSP")}
+	pc, err := cs.RetrieveCollectionPersistenceConfigs(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), pc.BlockToLive())
+
+	// the implicit collections of the other orgs are still available
+	ccr.Collection = ImplicitCollectionNameForOrg("Org2MSP")
+	c, err := cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org2MSP"}, c.MemberOrgs())
+}
@@ -51,6 +51,18 @@ func (sc *SimpleCollection) MaximumPeerCount() int {
 	return int(sc.conf.MaximumPeerCount)
 }
 
+// IsMemberOnlyRead returns whether only collection members can read
+// the private data of this collection
+func (sc *SimpleCollection) IsMemberOnlyRead() bool {
+	return sc.conf.MemberOnlyRead
+}
+
+// IsMemberOnlyWrite returns whether only collection members can write
+// the private data of this collection
+func (sc *SimpleCollection) IsMemberOnlyWrite() bool {
+	return sc.conf.MemberOnlyWrite
+}
+
 // AccessFilter returns the member filter function that evaluates signed data
 // against the member access policy of this collection
 func (sc *SimpleCollection) AccessFilter() Filter {
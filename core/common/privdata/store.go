@@ -13,6 +13,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
 )
 
@@ -88,9 +90,23 @@ func ParseCollectionConfig(colBytes []byte) (*common.CollectionConfigPackage, er
 
 func (c *simpleCollectionStore) retrieveCollectionConfig(cc common.CollectionCriteria) (*common.StaticCollectionConfig, error) {
 	collections, err := c.retrieveCollectionConfigPackage(cc)
-	if err != nil {
+	if err == nil {
+		config, err := staticCollectionConfig(cc, collections)
+		if _, notFound := err.(NoSuchCollectionError); !notFound {
+			return config, err
+		}
+	} else if _, notFound := err.(NoSuchCollectionError); !notFound {
 		return nil, err
 	}
+	// an implicit collection is only used if no collection
+	// with the same name is defined explicitly
+	if isImplicit, mspID := MspIDIfImplicitCollection(cc.Collection); isImplicit {
+		return GenerateImplicitCollectionForOrg(mspID), nil
+	}
+	return nil, NoSuchCollectionError(cc)
+}
+
+func staticCollectionConfig(cc common.CollectionCriteria, collections *common.CollectionConfigPackage) (*common.StaticCollectionConfig, error) {
 	if collections == nil {
 		return nil, nil
 	}
@@ -149,3 +165,66 @@ func (c *simpleCollectionStore) RetrieveCollectionPersistenceConfigs(cc common.C
 	}
 	return &SimpleCollectionPersistenceConfigs{staticCollectionConfig.BlockToLive}, nil
 }
+
+// RetrieveReadWritePermission retrieves whether the creator of the supplied
+// signed proposal can read and write the private data of the collection
+func (c *simpleCollectionStore) RetrieveReadWritePermission(
+	cc common.CollectionCriteria,
+	signedProposal *pb.SignedProposal,
+) (bool, bool, error) {
+	staticCollectionConfig, err := c.retrieveCollectionConfig(cc)
+	if err != nil {
+		return false, false, err
+	}
+	if staticCollectionConfig == nil {
+		return false, false, NoSuchCollectionError(cc)
+	}
+
+	// when neither access is restricted, there is no need
+	// to check the membership of the creator
+	if !staticCollectionConfig.MemberOnlyRead && !staticCollectionConfig.MemberOnlyWrite {
+		return true, true, nil
+	}
+
+	sc := &SimpleCollection{}
+	err = sc.Setup(staticCollectionConfig, c.s.GetIdentityDeserializer(cc.Channel))
+	if err != nil {
+		return false, false, errors.WithMessage(err, fmt.Sprintf("error setting up collection for collection criteria %#v", cc))
+	}
+
+	isMember, err := isCreatorOfProposalAMember(signedProposal, sc)
+	if err != nil {
+		return false, false, err
+	}
+	if isMember {
+		return true, true, nil
+	}
+
+	return !sc.IsMemberOnlyRead(), !sc.IsMemberOnlyWrite(), nil
+}
+
+// isCreatorOfProposalAMember returns whether the creator of the supplied
+// signed proposal satisfies the member orgs policy of the collection
+func isCreatorOfProposalAMember(signedProposal *pb.SignedProposal, collection CollectionAccessPolicy) (bool, error) {
+	if signedProposal == nil {
+		return false, errors.New("no signed proposal to check the collection membership of its creator")
+	}
+	proposal, err := utils.GetProposal(signedProposal.ProposalBytes)
+	if err != nil {
+		return false, err
+	}
+	hdr, err := utils.GetHeader(proposal.Header)
+	if err != nil {
+		return false, err
+	}
+	shdr, err := utils.GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return false, err
+	}
+
+	return collection.AccessFilter()(common.SignedData{
+		Data:      signedProposal.ProposalBytes,
+		Identity:  shdr.Creator,
+		Signature: signedProposal.Signature,
+	}), nil
+}
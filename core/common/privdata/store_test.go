@@ -15,6 +15,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 )
@@ -98,3 +100,61 @@ func TestCollectionStore(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ccc)
 }
+
+func TestRetrieveReadWritePermission(t *testing.T) {
+	wState := map[string]map[string][]byte{"lscc": {}}
+	cs := NewSimpleCollectionStore(&mockStoreSupport{Qe: &lm.MockQueryExecutor{State: wState}})
+
+	var signers = [][]byte{[]byte("signer0"), []byte("signer1")}
+	policyEnvelope := cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers)
+	setCollection := func(memberOnlyRead, memberOnlyWrite bool) {
+		ccp := &common.CollectionConfigPackage{Config: []*common.CollectionConfig{{
+			Payload: &common.CollectionConfig_StaticCollectionConfig{
+				StaticCollectionConfig: &common.StaticCollectionConfig{
+					Name:             "mycollection",
+					MemberOrgsPolicy: createCollectionPolicyConfig(policyEnvelope),
+					MemberOnlyRead:   memberOnlyRead,
+					MemberOnlyWrite:  memberOnlyWrite,
+				},
+			},
+		}}}
+		wState["lscc"][BuildCollectionKVSKey("cc")] = utils.MarshalOrPanic(ccp)
+	}
+	member, _ := utils.MockSignedEndorserProposalOrPanic("ch", &pb.ChaincodeSpec{}, []byte("signer0"), []byte("signature"))
+	nonMember, _ := utils.MockSignedEndorserProposalOrPanic("ch", &pb.ChaincodeSpec{}, []byte("outsider"), []byte("signature"))
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: "mycollection"}
+
+	// the collection is not defined
+	_, _, err := cs.RetrieveReadWritePermission(ccr, member)
+	assert.EqualError(t, err, "collection ch/cc/mycollection could not be found")
+
+	// neither access is restricted
+	setCollection(false, false)
+	read, write, err := cs.RetrieveReadWritePermission(ccr, nonMember)
+	assert.NoError(t, err)
+	assert.True(t, read)
+	assert.True(t, write)
+
+	// only writes are restricted
+	setCollection(false, true)
+	read, write, err = cs.RetrieveReadWritePermission(ccr, nonMember)
+	assert.NoError(t, err)
+	assert.True(t, read)
+	assert.False(t, write)
+
+	// both accesses are restricted
+	setCollection(true, true)
+	read, write, err = cs.RetrieveReadWritePermission(ccr, nonMember)
+	assert.NoError(t, err)
+	assert.False(t, read)
+	assert.False(t, write)
+
+	read, write, err = cs.RetrieveReadWritePermission(ccr, member)
+	assert.NoError(t, err)
+	assert.True(t, read)
+	assert.True(t, write)
+
+	// the membership cannot be checked without the signed proposal
+	_, _, err = cs.RetrieveReadWritePermission(ccr, nil)
+	assert.EqualError(t, err, "no signed proposal to check the collection membership of its creator")
+}
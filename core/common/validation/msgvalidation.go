@@ -100,6 +100,12 @@ func ValidateProposalMessage(signedProp *pb.SignedProposal) (*pb.Proposal, *comm
 
 	// validate the signature
 	err = checkSignatureFromCreator(shdr.Creator, signedProp.Signature, signedProp.ProposalBytes, chdr.ChannelId)
+	if err == nil {
+		// check online whether the creator has been revoked, which is done
+		// only here and not when validating transactions, as the outcome may
+		// differ between peers
+		err = checkCreatorRevocationOnline(shdr.Creator, chdr.ChannelId)
+	}
 	if err != nil {
 		// log the exact message on the peer but return a generic error message to
 		// avoid malicious users scanning for channels
@@ -190,6 +196,27 @@ func checkSignatureFromCreator(creatorBytes []byte, sig []byte, msg []byte, Chai
 	return nil
 }
 
+// checkCreatorRevocationOnline checks online whether the creator of a proposal
+// to a channel has been revoked. The local MSP, which deserializes the creators
+// of proposals that are not addressed to a channel, checks revocation online
+// itself when validating them.
+func checkCreatorRevocationOnline(creatorBytes []byte, ChainID string) error {
+	if ChainID == "" {
+		return nil
+	}
+
+	creator, err := mspmgmt.GetIdentityDeserializer(ChainID).DeserializeIdentity(creatorBytes)
+	if err != nil {
+		return errors.WithMessage(err, "MSP error")
+	}
+
+	err = mspmgmt.CheckRevocationOnline(creator)
+	if err != nil {
+		return errors.WithMessage(err, "creator certificate is not valid")
+	}
+	return nil
+}
+
 // checks for a valid SignatureHeader
 func validateSignatureHeader(sHdr *common.SignatureHeader) error {
 	// check for nil argument
@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	. "github.com/hyperledger/fabric/core/handlers/validation/api/capabilities"
+	"github.com/hyperledger/fabric/core/handlers/validation/api/state"
+	"github.com/pkg/errors"
+)
+
+// CollectionResources is used by validation plugins in order to
+// retrieve the collection-level validation parameters of a chaincode
+type CollectionResources interface {
+	// CollectionEndorsementPolicy returns the serialized endorsement policy
+	// that writes to the supplied collection of the supplied chaincode must
+	// satisfy, or nil if the collection does not define one, in which case
+	// the chaincode endorsement policy applies
+	CollectionEndorsementPolicy(cc, coll string) ([]byte, error)
+}
+
+// CollectionResourcesImpl retrieves the collection-level validation
+// parameters from the collection configuration package of the chaincode
+// stored in the lscc namespace. They are only honored on channels with
+// the V1_4 validation capability, so that peers of prior releases, which
+// ignore them, reach the same validation result.
+type CollectionResourcesImpl struct {
+	StateFetcher validation.StateFetcher
+	Capabilities Capabilities
+}
+
+// CollectionEndorsementPolicy implements the method of
+// the same name of the CollectionResources interface
+func (c *CollectionResourcesImpl) CollectionEndorsementPolicy(cc, coll string) ([]byte, error) {
+	if !c.Capabilities.V1_4Validation() {
+		return nil, nil
+	}
+
+	state, err := c.StateFetcher.FetchState()
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not retrieve ledger")
+	}
+	defer state.Done()
+
+	values, err := state.GetStateMultipleKeys("lscc", []string{privdata.BuildCollectionKVSKey(cc)})
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("could not retrieve collection configuration for chaincode %s", cc))
+	}
+	if len(values) == 0 || values[0] == nil {
+		return nil, nil
+	}
+
+	ccp, err := privdata.ParseCollectionConfig(values[0])
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("invalid collection configuration for chaincode %s", cc))
+	}
+
+	for _, conf := range ccp.Config {
+		staticConf := conf.GetStaticCollectionConfig()
+		if staticConf == nil || staticConf.Name != coll {
+			continue
+		}
+		sp := staticConf.GetEndorsementPolicy().GetSignaturePolicy()
+		if sp == nil {
+			return nil, nil
+		}
+		return proto.Marshal(sp)
+	}
+
+	return nil, nil
+}
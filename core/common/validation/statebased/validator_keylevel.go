@@ -26,7 +26,9 @@ import (
 type policyChecker struct {
 	someEPChecked bool
 	ccEPChecked   bool
+	collEPChecked map[string]bool
 	vpmgr         KeyLevelValidationParameterManager
+	collRes       CollectionResources
 	policySupport validation.PolicyEvaluator
 	ccEP          []byte
 	signatureSet  []*common.SignedData
@@ -56,6 +58,40 @@ func (p *policyChecker) checkCCEPIfNoEPChecked(cc string, blockNum, txNum uint64
 	return p.checkCCEPIfCondition(cc, blockNum, txNum, p.someEPChecked)
 }
 
+func (p *policyChecker) checkCollEPOrCCEPIfNotChecked(cc, coll string, blockNum, txNum uint64) commonerrors.TxValidationError {
+	if coll == "" || p.collRes == nil {
+		return p.checkCCEPIfNotChecked(cc, blockNum, txNum)
+	}
+
+	if p.collEPChecked[coll] {
+		return nil
+	}
+
+	// see if the collection specifies its own endorsement policy; the
+	// collection resources only return one if the channel enforces them
+	collEP, err := p.collRes.CollectionEndorsementPolicy(cc, coll)
+	if err != nil {
+		return &commonerrors.VSCCExecutionFailureError{
+			Err: errors.WithMessage(err, fmt.Sprintf("could not retrieve the endorsement policy of collection %s of chaincode %s", coll, cc)),
+		}
+	}
+
+	// if it does not, the regular cc endorsement policy needs to hold
+	if len(collEP) == 0 {
+		return p.checkCCEPIfNotChecked(cc, blockNum, txNum)
+	}
+
+	// validate against the collection ep
+	err = p.policySupport.Evaluate(collEP, p.signatureSet)
+	if err != nil {
+		return policyErr(errors.Wrapf(err, "validation of endorsement policy for collection %s of chaincode %s in tx %d:%d failed", coll, cc, blockNum, txNum))
+	}
+
+	p.collEPChecked[coll] = true
+	p.someEPChecked = true
+	return nil
+}
+
 func (p *policyChecker) checkSBAndCCEP(cc, coll, key string, blockNum, txNum uint64) commonerrors.TxValidationError {
 	// see if there is a key-level validation parameter for this key
 	vp, err := p.vpmgr.GetValidationParameterForKey(cc, coll, key, blockNum, txNum)
@@ -90,9 +126,10 @@ func (p *policyChecker) checkSBAndCCEP(cc, coll, key string, blockNum, txNum uin
 		}
 	}
 
-	// if no key-level validation parameter has been specified, the regular cc endorsement policy needs to hold
+	// if no key-level validation parameter has been specified, the endorsement policy
+	// of the collection, if any, or else the regular cc endorsement policy needs to hold
 	if len(vp) == 0 {
-		return p.checkCCEPIfNotChecked(cc, blockNum, txNum)
+		return p.checkCollEPOrCCEPIfNotChecked(cc, coll, blockNum, txNum)
 	}
 
 	// validate against key-level vp
@@ -115,16 +152,20 @@ type blockDependency struct {
 	txDepOnce []sync.Once
 }
 
-// KeyLevelValidator implements per-key level ep validation
+// KeyLevelValidator implements per-key level ep validation; writes to
+// keys without key-level validation parameters are validated against the
+// endorsement policy of their collection, if any, or the chaincode-wide one
 type KeyLevelValidator struct {
 	vpmgr         KeyLevelValidationParameterManager
+	collRes       CollectionResources
 	policySupport validation.PolicyEvaluator
 	blockDep      blockDependency
 }
 
-func NewKeyLevelValidator(policySupport validation.PolicyEvaluator, vpmgr KeyLevelValidationParameterManager) *KeyLevelValidator {
+func NewKeyLevelValidator(policySupport validation.PolicyEvaluator, vpmgr KeyLevelValidationParameterManager, collRes CollectionResources) *KeyLevelValidator {
 	return &KeyLevelValidator{
 		vpmgr:         vpmgr,
+		collRes:       collRes,
 		policySupport: policySupport,
 		blockDep:      blockDependency{},
 	}
@@ -218,6 +259,8 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 		policySupport: klv.policySupport,
 		signatureSet:  signatureSet,
 		vpmgr:         klv.vpmgr,
+		collRes:       klv.collRes,
+		collEPChecked: map[string]bool{},
 	}
 
 	// unpack the rwset
@@ -253,7 +296,8 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 		}
 		// writes in collections
 		// we validate writes against key-level validation parameters
-		// if any are present or the chaincode-wide endorsement policy
+		// if any are present or the collection endorsement policy
+		// if the collection defines one or the chaincode-wide endorsement policy
 		for _, collRWSet := range nsRWSet.CollHashedRwSets {
 			coll := collRWSet.CollectionName
 			for _, hashedWrite := range collRWSet.HashedRwSet.HashedWrites {
@@ -266,7 +310,8 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 		}
 		// metadata writes in collections
 		// we validate writes against key-level validation parameters
-		// if any are present or the chaincode-wide endorsement policy
+		// if any are present or the collection endorsement policy
+		// if the collection defines one or the chaincode-wide endorsement policy
 		for _, collRWSet := range nsRWSet.CollHashedRwSets {
 			coll := collRWSet.CollectionName
 			for _, hashedMdWrite := range collRWSet.HashedRwSet.MetadataWrites {
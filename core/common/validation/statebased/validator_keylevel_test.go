@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/common/errors"
+	"github.com/hyperledger/fabric/common/mocks/config"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
@@ -20,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var v14Capabilities = &config.MockApplicationCapabilities{V1_3ValidationRv: true, V1_4ValidationRv: true}
+
 type mockPolicyEvaluator struct {
 	EvaluateRV          error
 	EvaluateResByPolicy map[string]error
@@ -92,7 +96,7 @@ func TestKeylevelValidation(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -133,7 +137,7 @@ func TestKeylevelValidationPvtData(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToPvtAndHashedWriteSet("cc", "coll", "key", []byte("value"))
@@ -171,7 +175,7 @@ func TestKeylevelValidationMetaUpdate(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToMetadataWriteSet("cc", "key", map[string][]byte{})
@@ -209,7 +213,7 @@ func TestKeylevelValidationPvtMetaUpdate(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToHashedMetadataWriteSet("cc", "coll", "key", map[string][]byte{})
@@ -246,7 +250,7 @@ func TestKeylevelValidationPolicyRetrievalFailure(t *testing.T) {
 	mr := &mockState{GetStateMetadataErr: fmt.Errorf("metadata retrieval failure")}
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -277,7 +281,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: &ledger.CollConfigNotDefinedError{Ns: "mycc"}}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.NoError(t, err)
@@ -287,7 +291,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: &ledger.InvalidCollNameError{Ns: "mycc", Coll: "mycoll"}}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.NoError(t, err)
@@ -297,7 +301,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: fmt.Errorf("some I/O error")}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.Error(t, err)
@@ -316,7 +320,7 @@ func TestCCEPValidation(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToWriteSet("cc", "key", []byte("value"))
@@ -345,6 +349,105 @@ func TestCCEPValidation(t *testing.T) {
 	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
 }
 
+func TestCollectionEPValidation(t *testing.T) {
+	t.Parallel()
+
+	// Scenario: we validate a transaction that writes to a
+	// collection which defines its own endorsement policy and to
+	// a collection which doesn't; we expect the former to be
+	// validated against the collection endorsement policy and the
+	// latter against the cc-endorsement policy.
+
+	collEP := cauthdsl.SignedByMspMember("Org1MSP")
+	collEPBytes := utils.MarshalOrPanic(collEP)
+	ccp := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "collWithEP",
+						EndorsementPolicy: &common.CollectionPolicyConfig{
+							Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+								SignaturePolicy: collEP,
+							},
+						},
+					},
+				},
+			},
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "collWithoutEP",
+					},
+				},
+			},
+		},
+	}
+
+	mr := &mockState{
+		GetStateMultipleKeysRv:         [][]byte{utils.MarshalOrPanic(ccp)},
+		GetStateMetadataRv:             map[string][]byte{},
+		GetPrivateDataMetadataByHashRv: map[string][]byte{},
+	}
+	ms := &mockStateFetcher{FetchStateRv: mr}
+	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
+	pe := &mockPolicyEvaluator{
+		EvaluateResByPolicy: map[string]error{
+			"CCEP": fmt.Errorf("cc policy evaluation error"),
+		},
+	}
+	capabilities := &config.MockApplicationCapabilities{V1_3ValidationRv: true, V1_4ValidationRv: true}
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: capabilities})
+
+	rwsetFor := func(coll string) []byte {
+		rwsbu := rwsetutil.NewRWSetBuilder()
+		rwsbu.AddToPvtAndHashedWriteSet("cc", coll, "key", []byte("value"))
+		rwsb, err := rwsbu.GetTxReadWriteSet().ToProtoBytes()
+		assert.NoError(t, err)
+		return rwsb
+	}
+	prp := []byte("barf")
+	block := buildBlockWithTxs(buildTXWithRwset(rwsetUpdatingMetadataFor("cc", "key")), buildTXWithRwset(rwsetUpdatingMetadataFor("cc", "key")))
+
+	validator.PreValidate(1, block)
+
+	go func() {
+		validator.PostValidate("cc", 1, 0, fmt.Errorf(""))
+	}()
+
+	// the collection policy is satisfied, the cc policy is not checked
+	err := validator.Validate("cc", 1, 1, rwsetFor("collWithEP"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.NoError(t, err)
+
+	// the collection without a policy falls back to the cc policy
+	err = validator.Validate("cc", 1, 1, rwsetFor("collWithoutEP"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
+
+	// the collection policy is not satisfied
+	pe.EvaluateResByPolicy[string(collEPBytes)] = fmt.Errorf("collection policy evaluation error")
+	err = validator.Validate("cc", 1, 1, rwsetFor("collWithEP"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
+	assert.Contains(t, err.Error(), "validation of endorsement policy for collection collWithEP of chaincode cc in tx 1:1 failed")
+
+	// the collection configuration cannot be retrieved
+	mr.GetStateMultipleKeysErr = fmt.Errorf("ledger error")
+	err = validator.Validate("cc", 1, 1, rwsetFor("collWithEP"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCExecutionFailureError{}, err)
+
+	// without the V1_4 capability the collection policy is ignored
+	// and the cc policy applies
+	mr.GetStateMultipleKeysErr = nil
+	delete(pe.EvaluateResByPolicy, string(collEPBytes))
+	capabilities.V1_4ValidationRv = false
+	err = validator.Validate("cc", 1, 1, rwsetFor("collWithEP"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
+	assert.Contains(t, err.Error(), "cc policy evaluation error")
+}
+
 func TestCCEPValidationReads(t *testing.T) {
 	t.Parallel()
 
@@ -356,7 +459,7 @@ func TestCCEPValidationReads(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToReadSet("cc", "readkey", &version.Height{})
@@ -396,7 +499,7 @@ func TestOnlySBEPChecked(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -438,7 +541,7 @@ func TestCCEPValidationPvtReads(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToHashedReadSet("cc", "coll", "readpvtkey", &version.Height{})
@@ -477,7 +580,7 @@ func TestKeylevelValidationFailure(t *testing.T) {
 	mr := &mockState{GetStateMetadataRv: map[string][]byte{vpMetadataKey: []byte("EP")}, GetPrivateDataMetadataByHashRv: map[string][]byte{vpMetadataKey: []byte("EP")}}
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, &CollectionResourcesImpl{StateFetcher: ms, Capabilities: v14Capabilities})
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -10,6 +10,7 @@ import (
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/container/mock"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 
 	. "github.com/onsi/ginkgo"
@@ -39,7 +40,8 @@ var _ = Describe("Container", func() {
 					FilesToUpload: map[string][]byte{
 						"Foo": []byte("bar"),
 					},
-					Builder: &mock.Builder{},
+					Builder:        &mock.Builder{},
+					ResourceLimits: &pb.ChaincodeResourceLimits{MilliCpus: 500},
 				}
 			})
 
@@ -48,7 +50,7 @@ var _ = Describe("Container", func() {
 					err := startReq.Do(fakeVM)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(fakeVM.StartCallCount()).To(Equal(1))
-					ccid, args, env, filesToUpload, builder := fakeVM.StartArgsForCall(0)
+					ccid, args, env, filesToUpload, builder, limits := fakeVM.StartArgsForCall(0)
 					Expect(ccid).To(Equal(ccintf.CCID{Name: "start-name"}))
 					Expect(args).To(Equal([]string{"foo", "bar"}))
 					Expect(env).To(Equal([]string{"Bar", "Foo"}))
@@ -56,6 +58,7 @@ var _ = Describe("Container", func() {
 						"Foo": []byte("bar"),
 					}))
 					Expect(builder).To(Equal(&mock.Builder{}))
+					Expect(limits).To(Equal(&pb.ChaincodeResourceLimits{MilliCpus: 500}))
 				})
 
 				Context("when the vm provider fails", func() {
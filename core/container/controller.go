@@ -27,7 +27,7 @@ type Builder interface {
 
 //VM is an abstract virtual image for supporting arbitrary virual machines
 type VM interface {
-	Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder Builder) error
+	Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder Builder, limits *pb.ChaincodeResourceLimits) error
 	Stop(ccid ccintf.CCID, timeout uint, dontkill bool, dontremove bool) error
 }
 
@@ -115,6 +115,9 @@ type StartContainerReq struct {
 	Args          []string
 	Env           []string
 	FilesToUpload map[string][]byte
+	// ResourceLimits bounds the resources of the container, VMs that are
+	// not able to enforce them ignore it
+	ResourceLimits *pb.ChaincodeResourceLimits
 }
 
 // PlatformBuilder implements the Build interface using
@@ -145,7 +148,7 @@ func (b *PlatformBuilder) Build() (io.Reader, error) {
 }
 
 func (si StartContainerReq) Do(v VM) error {
-	return v.Start(si.CCID, si.Args, si.Env, si.FilesToUpload, si.Builder)
+	return v.Start(si.CCID, si.Args, si.Env, si.FilesToUpload, si.Builder, si.ResourceLimits)
 }
 
 func (si StartContainerReq) GetCCID() ccintf.CCID {
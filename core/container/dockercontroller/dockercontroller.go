@@ -26,6 +26,7 @@ import (
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	cutil "github.com/hyperledger/fabric/core/container/util"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/spf13/viper"
 )
 
@@ -171,11 +172,48 @@ func getDockerHostConfig() *docker.HostConfig {
 	return hostConfig
 }
 
+const (
+	// cpuPeriod is the CFS scheduler period, in microseconds, used to enforce
+	// the CPU limit of a chaincode container
+	cpuPeriod = 100000
+	// minCPUQuota is the smallest CPU quota, in microseconds, docker accepts
+	minCPUQuota = 1000
+)
+
+// getChaincodeHostConfig returns the HostConfig from core.yaml with the
+// resource limits requested for a chaincode applied on top of it
+func getChaincodeHostConfig(limits *pb.ChaincodeResourceLimits) *docker.HostConfig {
+	hc := getDockerHostConfig()
+	if limits.GetMilliCpus() == 0 && limits.GetMemoryBytes() == 0 {
+		return hc
+	}
+
+	chaincodeHostConfig := *hc
+	if limits.GetMilliCpus() != 0 {
+		chaincodeHostConfig.CPUPeriod = cpuPeriod
+		chaincodeHostConfig.CPUQuota = int64(limits.GetMilliCpus()) * cpuPeriod / 1000
+		// limits are validated when the chaincode is installed or defined, the
+		// quota is clamped nonetheless as docker rejects a smaller one
+		if chaincodeHostConfig.CPUQuota < minCPUQuota {
+			chaincodeHostConfig.CPUQuota = minCPUQuota
+		}
+	}
+	if limits.GetMemoryBytes() != 0 {
+		chaincodeHostConfig.Memory = int64(limits.GetMemoryBytes())
+		// docker refuses a swap limit lower than the memory limit
+		if chaincodeHostConfig.MemorySwap > 0 && chaincodeHostConfig.MemorySwap < chaincodeHostConfig.Memory {
+			chaincodeHostConfig.MemorySwap = chaincodeHostConfig.Memory
+		}
+	}
+
+	return &chaincodeHostConfig
+}
+
 func (vm *DockerVM) createContainer(client dockerClient,
 	imageID string, containerID string, args []string,
-	env []string, attachStdout bool) error {
+	env []string, attachStdout bool, limits *pb.ChaincodeResourceLimits) error {
 	config := docker.Config{Cmd: args, Image: imageID, Env: env, AttachStdout: attachStdout, AttachStderr: attachStdout}
-	copts := docker.CreateContainerOptions{Name: containerID, Config: &config, HostConfig: getDockerHostConfig()}
+	copts := docker.CreateContainerOptions{Name: containerID, Config: &config, HostConfig: getChaincodeHostConfig(limits)}
 	dockerLogger.Debugf("Create container: %s", containerID)
 	_, err := client.CreateContainer(copts)
 	if err != nil {
@@ -212,7 +250,7 @@ func (vm *DockerVM) deployImage(client dockerClient, ccid ccintf.CCID,
 
 //Start starts a container using a previously created docker image
 func (vm *DockerVM) Start(ccid ccintf.CCID,
-	args []string, env []string, filesToUpload map[string][]byte, builder container.Builder) error {
+	args []string, env []string, filesToUpload map[string][]byte, builder container.Builder, limits *pb.ChaincodeResourceLimits) error {
 	imageName, err := vm.GetVMNameForDocker(ccid)
 	if err != nil {
 		return err
@@ -233,7 +271,7 @@ func (vm *DockerVM) Start(ccid ccintf.CCID,
 	vm.stopInternal(client, containerName, 0, false, false)
 
 	dockerLogger.Debugf("Start container %s", containerName)
-	err = vm.createContainer(client, imageName, containerName, args, env, attachStdout)
+	err = vm.createContainer(client, imageName, containerName, args, env, attachStdout, limits)
 	if err != nil {
 		//if image not found try to create image and retry
 		if err == docker.ErrNoSuchImage {
@@ -252,7 +290,7 @@ func (vm *DockerVM) Start(ccid ccintf.CCID,
 				}
 
 				dockerLogger.Debug("start-recreated image successfully")
-				if err1 = vm.createContainer(client, imageName, containerName, args, env, attachStdout); err1 != nil {
+				if err1 = vm.createContainer(client, imageName, containerName, args, env, attachStdout, limits); err1 != nil {
 					dockerLogger.Errorf("start-could not recreate container post recreate image: %s", err1)
 					return err1
 				}
@@ -36,14 +36,14 @@ func TestIntegrationPath(t *testing.T) {
 	dc := NewDockerVM("", util.GenerateUUID())
 	ccid := ccintf.CCID{Name: "simple"}
 
-	err := dc.Start(ccid, nil, nil, nil, InMemBuilder{})
+	err := dc.Start(ccid, nil, nil, nil, InMemBuilder{}, nil)
 	require.NoError(t, err)
 
 	// Stop, killing, and deleting
 	err = dc.Stop(ccid, 0, true, true)
 	require.NoError(t, err)
 
-	err = dc.Start(ccid, nil, nil, nil, nil)
+	err = dc.Start(ccid, nil, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	// Stop, killing, but not deleting
@@ -76,6 +76,35 @@ func TestGetDockerHostConfig(t *testing.T) {
 	assert.Equal(t, int64(0), hostConfig.CPUShares)
 }
 
+func TestGetChaincodeHostConfig(t *testing.T) {
+	coreutil.SetupTestConfig()
+	hostConfig = nil
+
+	// no limits keeps the configured host config
+	assert.Equal(t, getDockerHostConfig(), getChaincodeHostConfig(nil))
+	assert.Equal(t, getDockerHostConfig(), getChaincodeHostConfig(&pb.ChaincodeResourceLimits{MaxConcurrency: 2}))
+
+	hc := getChaincodeHostConfig(&pb.ChaincodeResourceLimits{MilliCpus: 500, MemoryBytes: 256 * 1024 * 1024})
+	assert.Equal(t, int64(cpuPeriod), hc.CPUPeriod)
+	assert.Equal(t, int64(cpuPeriod/2), hc.CPUQuota)
+	assert.Equal(t, int64(256*1024*1024), hc.Memory)
+	assert.Equal(t, "host", hc.NetworkMode)
+
+	// the CPU quota is raised to the minimum docker accepts
+	hc = getChaincodeHostConfig(&pb.ChaincodeResourceLimits{MilliCpus: 5})
+	assert.Equal(t, int64(minCPUQuota), hc.CPUQuota)
+
+	// the shared host config is left untouched
+	assert.Equal(t, int64(1024*1024*1024*2), getDockerHostConfig().Memory)
+	assert.Equal(t, int64(0), getDockerHostConfig().CPUQuota)
+
+	// the swap limit is raised to the memory limit when it is lower
+	hostConfig.MemorySwap = 128 * 1024 * 1024
+	defer func() { hostConfig = nil }()
+	hc = getChaincodeHostConfig(&pb.ChaincodeResourceLimits{MemoryBytes: 256 * 1024 * 1024})
+	assert.Equal(t, int64(256*1024*1024), hc.MemorySwap)
+}
+
 func Test_Start(t *testing.T) {
 	dvm := DockerVM{}
 	ccid := ccintf.CCID{Name: "simple"}
@@ -89,25 +118,25 @@ func Test_Start(t *testing.T) {
 	// case 1: getMockClient returns error
 	dvm.getClientFnc = getMockClient
 	getClientErr = true
-	err := dvm.Start(ccid, args, env, files, nil)
+	err := dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, false)
 	getClientErr = false
 
 	// case 2: dockerClient.CreateContainer returns error
 	createErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, false)
 	createErr = false
 
 	// case 3: dockerClient.UploadToContainer returns error
 	uploadErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, false)
 	uploadErr = false
 
 	// case 4: dockerClient.StartContainer returns docker.noSuchImgErr
 	noSuchImgErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, false)
 
 	chaincodePath := "github.com/hyperledger/fabric/examples/chaincode/go/example01/cmd"
@@ -137,34 +166,34 @@ func Test_Start(t *testing.T) {
 	// docker.noSuchImgErr and dockerClient.Start returns error
 	viper.Set("vm.docker.attachStdout", true)
 	startErr = true
-	err = dvm.Start(ccid, args, env, files, bldr)
+	err = dvm.Start(ccid, args, env, files, bldr, nil)
 	testerr(t, err, false)
 	startErr = false
 
 	// Success cases
-	err = dvm.Start(ccid, args, env, files, bldr)
+	err = dvm.Start(ccid, args, env, files, bldr, nil)
 	testerr(t, err, true)
 	noSuchImgErr = false
 
 	// dockerClient.StopContainer returns error
 	stopErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, true)
 	stopErr = false
 
 	// dockerClient.KillContainer returns error
 	killErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, true)
 	killErr = false
 
 	// dockerClient.RemoveContainer returns error
 	removeErr = true
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, true)
 	removeErr = false
 
-	err = dvm.Start(ccid, args, env, files, nil)
+	err = dvm.Start(ccid, args, env, files, nil, nil)
 	testerr(t, err, true)
 }
 
@@ -174,7 +174,7 @@ func (ipc *inprocContainer) launchInProc(id string, args []string, env []string)
 }
 
 //Start starts a previously registered system codechain
-func (vm *InprocVM) Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container.Builder) error {
+func (vm *InprocVM) Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container.Builder, limits *pb.ChaincodeResourceLimits) error {
 	path := ccid.GetName()
 
 	ipctemplate := vm.registry.typeRegistry[path]
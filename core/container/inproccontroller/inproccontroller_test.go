@@ -333,7 +333,7 @@ func TestStart(t *testing.T) {
 
 	r.typeRegistry["name"] = ipc
 
-	err := vm.Start(ccid, args, env, files, nil)
+	err := vm.Start(ccid, args, env, files, nil, nil)
 	assert.Nil(t, err, "err should be nil")
 }
 
@@ -6,10 +6,11 @@ import (
 
 	container_test "github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/ccintf"
+	"github.com/hyperledger/fabric/protos/peer"
 )
 
 type VM struct {
-	StartStub        func(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container_test.Builder) error
+	StartStub        func(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container_test.Builder, limits *peer.ChaincodeResourceLimits) error
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
 		ccid          ccintf.CCID
@@ -17,6 +18,7 @@ type VM struct {
 		env           []string
 		filesToUpload map[string][]byte
 		builder       container_test.Builder
+		limits        *peer.ChaincodeResourceLimits
 	}
 	startReturns struct {
 		result1 error
@@ -42,7 +44,7 @@ type VM struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *VM) Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container_test.Builder) error {
+func (fake *VM) Start(ccid ccintf.CCID, args []string, env []string, filesToUpload map[string][]byte, builder container_test.Builder, limits *peer.ChaincodeResourceLimits) error {
 	var argsCopy []string
 	if args != nil {
 		argsCopy = make([]string, len(args))
@@ -61,11 +63,12 @@ func (fake *VM) Start(ccid ccintf.CCID, args []string, env []string, filesToUplo
 		env           []string
 		filesToUpload map[string][]byte
 		builder       container_test.Builder
-	}{ccid, argsCopy, envCopy, filesToUpload, builder})
-	fake.recordInvocation("Start", []interface{}{ccid, argsCopy, envCopy, filesToUpload, builder})
+		limits        *peer.ChaincodeResourceLimits
+	}{ccid, argsCopy, envCopy, filesToUpload, builder, limits})
+	fake.recordInvocation("Start", []interface{}{ccid, argsCopy, envCopy, filesToUpload, builder, limits})
 	fake.startMutex.Unlock()
 	if fake.StartStub != nil {
-		return fake.StartStub(ccid, args, env, filesToUpload, builder)
+		return fake.StartStub(ccid, args, env, filesToUpload, builder, limits)
 	}
 	if specificReturn {
 		return ret.result1
@@ -79,10 +82,10 @@ func (fake *VM) StartCallCount() int {
 	return len(fake.startArgsForCall)
 }
 
-func (fake *VM) StartArgsForCall(i int) (ccintf.CCID, []string, []string, map[string][]byte, container_test.Builder) {
+func (fake *VM) StartArgsForCall(i int) (ccintf.CCID, []string, []string, map[string][]byte, container_test.Builder, *peer.ChaincodeResourceLimits) {
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
-	return fake.startArgsForCall[i].ccid, fake.startArgsForCall[i].args, fake.startArgsForCall[i].env, fake.startArgsForCall[i].filesToUpload, fake.startArgsForCall[i].builder
+	return fake.startArgsForCall[i].ccid, fake.startArgsForCall[i].args, fake.startArgsForCall[i].env, fake.startArgsForCall[i].filesToUpload, fake.startArgsForCall[i].builder, fake.startArgsForCall[i].limits
 }
 
 func (fake *VM) StartReturns(result1 error) {
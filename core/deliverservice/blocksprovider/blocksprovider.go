@@ -77,6 +77,10 @@ type streamClient interface {
 	// GetEndpoints
 	GetEndpoints() []string
 
+	// GetEndpoint returns the endpoint the client is currently connected to,
+	// or an empty string if it isn't connected
+	GetEndpoint() string
+
 	// Close closes the stream and its underlying connection
 	Close()
 
@@ -97,6 +101,8 @@ type blocksProviderImpl struct {
 	done int32
 
 	wrongStatusThreshold int
+
+	monitor *censorshipMonitor
 }
 
 const wrongStatusThreshold = 10
@@ -115,12 +121,37 @@ func NewBlocksProvider(chainID string, client streamClient, gossip GossipService
 	}
 }
 
+// NewCensorshipResistantBlocksProvider creates a blocks deliverer instance that also pulls the headers
+// of blocks from the ordering service nodes it doesn't receive blocks from, and switches to another
+// ordering service node when the one it receives blocks from withholds blocks the others have.
+// If the given crypto service can't verify headers of blocks, censorship isn't detected.
+func NewCensorshipResistantBlocksProvider(chainID string, client streamClient, gossip GossipServiceAdapter, mcs api.MessageCryptoService, conf CensorshipDetectionConfig) BlocksProvider {
+	b := &blocksProviderImpl{
+		chainID:              chainID,
+		client:               client,
+		gossip:               gossip,
+		mcs:                  mcs,
+		wrongStatusThreshold: wrongStatusThreshold,
+	}
+	verifier, isHeaderVerifier := mcs.(HeaderVerifier)
+	if !isHeaderVerifier {
+		logger.Warningf("[%s] Crypto service can't verify headers of blocks, censorship won't be detected", chainID)
+		return b
+	}
+	b.monitor = newCensorshipMonitor(chainID, client, verifier, conf)
+	return b
+}
+
 // DeliverBlocks used to pull out blocks from the ordering service to
 // distributed them across peers
 func (b *blocksProviderImpl) DeliverBlocks() {
 	errorStatusCounter := 0
 	statusCounter := 0
 	defer b.client.Close()
+	if b.monitor != nil {
+		go b.monitor.run()
+		defer b.monitor.stop()
+	}
 	for !b.isDone() {
 		msg, err := b.client.Recv()
 		if err != nil {
@@ -170,6 +201,9 @@ func (b *blocksProviderImpl) DeliverBlocks() {
 				logger.Errorf("[%s] Error verifying block with sequnce number %d, due to %s", b.chainID, blockNum, err)
 				continue
 			}
+			if b.monitor != nil {
+				b.monitor.blockDelivered(blockNum)
+			}
 
 			numberOfPeers := len(b.gossip.PeersOfChannel(gossipcommon.ChainID(b.chainID)))
 			// Create payload with a block received
@@ -199,6 +233,9 @@ func (b *blocksProviderImpl) DeliverBlocks() {
 func (b *blocksProviderImpl) Stop() {
 	atomic.StoreInt32(&b.done, 1)
 	b.client.Close()
+	if b.monitor != nil {
+		b.monitor.stop()
+	}
 }
 
 // UpdateOrderingEndpoints update endpoints of ordering service
@@ -0,0 +1,282 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blocksprovider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+)
+
+// censorshipCheckInterval is the interval in which the blocks provider checks
+// whether the ordering service node it receives blocks from withholds blocks
+var censorshipCheckInterval = time.Second
+
+// HeaderVerifier verifies blocks that carry only their headers and metadata
+type HeaderVerifier interface {
+	// VerifyHeader returns nil if the header of the given block is properly signed,
+	// and the claimed seqNum is the sequence number that the block's header contains
+	VerifyHeader(chainID string, seqNum uint64, block *common.Block) error
+}
+
+// HeaderStream receives blocks that carry only their headers and metadata
+// from an ordering service node
+type HeaderStream interface {
+	// Recv retrieves a response from the ordering service
+	Recv() (*orderer.DeliverResponse, error)
+
+	// Close closes the stream and its underlying connection
+	Close()
+}
+
+// HeaderStreamFactory opens a HeaderStream to the given ordering service endpoint
+type HeaderStreamFactory func(endpoint string) (HeaderStream, error)
+
+// CensorshipDetectionConfig dictates how ordering service nodes
+// that withhold blocks from the peer are detected
+type CensorshipDetectionConfig struct {
+	// Threshold is the time the ordering service node blocks are received from may not deliver
+	// any block, while other ordering service nodes have blocks the peer didn't receive
+	Threshold time.Duration
+	// HeaderStreamFactory opens header streams to the other ordering service nodes
+	HeaderStreamFactory HeaderStreamFactory
+}
+
+// censorshipMonitor pulls the headers of blocks from all ordering service nodes but the one
+// the blocks provider receives blocks from, and disconnects from that node if it doesn't
+// deliver blocks the other nodes have for longer than the threshold
+type censorshipMonitor struct {
+	sync.Mutex
+	chainID  string
+	client   streamClient
+	verifier HeaderVerifier
+	conf     CensorshipDetectionConfig
+	// streams maps endpoints of ordering service nodes headers are received from
+	// to channels that stop receiving the headers when closed
+	streams map[string]chan struct{}
+	// delivered is the sequence of the last block delivered by the ordering service node
+	// blocks are received from, and anyDelivered is whether any block was delivered yet
+	delivered    uint64
+	anyDelivered bool
+	lastProgress time.Time
+	// announced is the highest sequence of a header received from another ordering
+	// service node, and announcer is the endpoint of the node it was received from
+	announced   uint64
+	announcer   string
+	behindSince time.Time
+	stopChan    chan struct{}
+	stopOnce    sync.Once
+}
+
+func newCensorshipMonitor(chainID string, client streamClient, verifier HeaderVerifier, conf CensorshipDetectionConfig) *censorshipMonitor {
+	return &censorshipMonitor{
+		chainID:      chainID,
+		client:       client,
+		verifier:     verifier,
+		conf:         conf,
+		streams:      make(map[string]chan struct{}),
+		lastProgress: time.Now(),
+		stopChan:     make(chan struct{}),
+	}
+}
+
+func (m *censorshipMonitor) run() {
+	ticker := time.NewTicker(censorshipCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stopChan:
+			return
+		case <-ticker.C:
+			m.updateStreams()
+			m.check()
+		}
+	}
+}
+
+// blockDelivered records that a block was delivered
+// by the ordering service node blocks are received from
+func (m *censorshipMonitor) blockDelivered(seqNum uint64) {
+	m.Lock()
+	defer m.Unlock()
+	if m.anyDelivered && seqNum <= m.delivered {
+		return
+	}
+	m.delivered = seqNum
+	m.anyDelivered = true
+	m.lastProgress = time.Now()
+	if !m.isBehind() {
+		m.behindSince = time.Time{}
+	}
+}
+
+// headerReceived records that the header of a block was received from the given endpoint
+func (m *censorshipMonitor) headerReceived(endpoint string, seqNum uint64) {
+	m.Lock()
+	defer m.Unlock()
+	if m.anyDelivered && seqNum <= m.delivered {
+		return
+	}
+	wasBehind := m.isBehind()
+	if seqNum > m.announced || m.announcer == "" {
+		m.announced = seqNum
+		m.announcer = endpoint
+	}
+	if !wasBehind {
+		m.behindSince = time.Now()
+	}
+}
+
+// isBehind returns whether another ordering service node has a block that wasn't delivered.
+// It should be invoked while holding the lock
+func (m *censorshipMonitor) isBehind() bool {
+	if m.announcer == "" {
+		return false
+	}
+	return !m.anyDelivered || m.announced > m.delivered
+}
+
+// check disconnects from the ordering service node blocks are received from,
+// if it withholds blocks for longer than the threshold
+func (m *censorshipMonitor) check() {
+	source := m.client.GetEndpoint()
+	if source == "" {
+		return
+	}
+	m.Lock()
+	if !m.isBehind() {
+		m.Unlock()
+		return
+	}
+	since := m.behindSince
+	if m.lastProgress.After(since) {
+		since = m.lastProgress
+	}
+	if time.Since(since) <= m.conf.Threshold {
+		m.Unlock()
+		return
+	}
+	logger.Warningf("[%s] Ordering service node %s didn't deliver any block for %v, although %s has block [%d], which was not delivered. "+
+		"Suspecting censorship and switching to another ordering service node", m.chainID, source, time.Since(since), m.announcer, m.announced)
+	// Give the next ordering service node the full threshold to deliver the blocks
+	m.lastProgress = time.Now()
+	m.Unlock()
+	reportCensorship(m.chainID, source)
+	m.client.Disconnect(true)
+}
+
+// updateStreams makes headers be received from all ordering service nodes
+// except the one blocks are received from
+func (m *censorshipMonitor) updateStreams() {
+	source := m.client.GetEndpoint()
+	if source == "" {
+		return
+	}
+	endpoints := make(map[string]struct{})
+	for _, endpoint := range m.client.GetEndpoints() {
+		endpoints[endpoint] = struct{}{}
+	}
+	m.Lock()
+	defer m.Unlock()
+	for endpoint, stop := range m.streams {
+		if _, exists := endpoints[endpoint]; exists && endpoint != source {
+			continue
+		}
+		close(stop)
+		delete(m.streams, endpoint)
+	}
+	for endpoint := range endpoints {
+		if _, exists := m.streams[endpoint]; exists || endpoint == source {
+			continue
+		}
+		stop := make(chan struct{})
+		m.streams[endpoint] = stop
+		go m.receiveHeaders(endpoint, stop)
+	}
+}
+
+// receiveHeaders receives headers from the given endpoint until the given channel is closed,
+// and reconnects to it whenever the stream breaks
+func (m *censorshipMonitor) receiveHeaders(endpoint string, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-m.stopChan:
+			return
+		default:
+		}
+		stream, err := m.conf.HeaderStreamFactory(endpoint)
+		if err != nil {
+			logger.Debugf("[%s] Failed opening a header stream to %s: %v", m.chainID, endpoint, err)
+		} else {
+			done := make(chan struct{})
+			go func() {
+				select {
+				case <-stop:
+				case <-m.stopChan:
+				case <-done:
+				}
+				stream.Close()
+			}()
+			m.readHeaders(endpoint, stream)
+			close(done)
+		}
+		select {
+		case <-stop:
+			return
+		case <-m.stopChan:
+			return
+		case <-time.After(m.conf.Threshold):
+		}
+	}
+}
+
+func (m *censorshipMonitor) readHeaders(endpoint string, stream HeaderStream) {
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			logger.Debugf("[%s] Header stream from %s broke: %v", m.chainID, endpoint, err)
+			return
+		}
+		block := msg.GetBlock()
+		if block == nil {
+			logger.Debugf("[%s] Header stream from %s ended with status %v", m.chainID, endpoint, msg.GetStatus())
+			return
+		}
+		if block.Header == nil {
+			logger.Warningf("[%s] Received a block without a header from %s", m.chainID, endpoint)
+			return
+		}
+		if err := m.verifier.VerifyHeader(m.chainID, block.Header.Number, block); err != nil {
+			logger.Warningf("[%s] Error verifying header of block [%d] received from %s: %v", m.chainID, block.Header.Number, endpoint, err)
+			return
+		}
+		m.headerReceived(endpoint, block.Header.Number)
+	}
+}
+
+func (m *censorshipMonitor) stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopChan)
+	})
+}
+
+// reportCensorship reports suspected censorship by the given ordering service node
+// to the metrics system, if it is enabled
+func reportCensorship(chainID string, endpoint string) {
+	if metrics.RootScope == nil {
+		return
+	}
+	metrics.RootScope.SubScope("deliver").Tagged(map[string]string{
+		"channel": chainID,
+		"orderer": endpoint,
+	}).Counter("censorship_suspected").Inc(1)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blocksprovider
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	censorshipCheckInterval = time.Millisecond * 50
+}
+
+type censorshipTestClient struct {
+	sync.Mutex
+	endpoint     string
+	endpoints    []string
+	disconnected chan string
+}
+
+func (c *censorshipTestClient) Recv() (*orderer.DeliverResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *censorshipTestClient) Send(*common.Envelope) error {
+	return errors.New("not implemented")
+}
+
+func (c *censorshipTestClient) UpdateEndpoints(endpoints []string) {}
+
+func (c *censorshipTestClient) GetEndpoints() []string {
+	return c.endpoints
+}
+
+func (c *censorshipTestClient) GetEndpoint() string {
+	c.Lock()
+	defer c.Unlock()
+	return c.endpoint
+}
+
+func (c *censorshipTestClient) Close() {}
+
+func (c *censorshipTestClient) Disconnect(disableEndpoint bool) {
+	c.Lock()
+	endpoint := c.endpoint
+	c.Unlock()
+	if disableEndpoint {
+		c.disconnected <- endpoint
+	}
+}
+
+type headerStreamMock struct {
+	headers chan *orderer.DeliverResponse
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func newHeaderStreamMock() *headerStreamMock {
+	return &headerStreamMock{
+		headers: make(chan *orderer.DeliverResponse, 100),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (hs *headerStreamMock) Recv() (*orderer.DeliverResponse, error) {
+	select {
+	case msg := <-hs.headers:
+		return msg, nil
+	case <-hs.closed:
+		return nil, errors.New("stream closed")
+	}
+}
+
+func (hs *headerStreamMock) Close() {
+	hs.once.Do(func() {
+		close(hs.closed)
+	})
+}
+
+func (hs *headerStreamMock) sendHeader(seqNum uint64) {
+	hs.headers <- &orderer.DeliverResponse{
+		Type: &orderer.DeliverResponse_Block{
+			Block: &common.Block{Header: &common.BlockHeader{Number: seqNum}},
+		},
+	}
+}
+
+type headerVerifierMock struct {
+	invalid map[uint64]struct{}
+}
+
+func (v *headerVerifierMock) VerifyHeader(chainID string, seqNum uint64, block *common.Block) error {
+	if _, isInvalid := v.invalid[seqNum]; isInvalid {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func newTestCensorshipMonitor(threshold time.Duration, verifier HeaderVerifier) (*censorshipMonitor, *censorshipTestClient, map[string]*headerStreamMock) {
+	client := &censorshipTestClient{
+		endpoint:     "orderer1",
+		endpoints:    []string{"orderer1", "orderer2", "orderer3"},
+		disconnected: make(chan string, 10),
+	}
+	streams := map[string]*headerStreamMock{
+		"orderer1": newHeaderStreamMock(),
+		"orderer2": newHeaderStreamMock(),
+		"orderer3": newHeaderStreamMock(),
+	}
+	conf := CensorshipDetectionConfig{
+		Threshold: threshold,
+		HeaderStreamFactory: func(endpoint string) (HeaderStream, error) {
+			return streams[endpoint], nil
+		},
+	}
+	return newCensorshipMonitor("testchain", client, verifier, conf), client, streams
+}
+
+func TestCensorshipDetected(t *testing.T) {
+	// Scenario: the ordering service node blocks are received from stops delivering blocks,
+	// while another ordering service node has newer blocks.
+	// Expected outcome: the blocks provider disconnects from the node, and disables it
+	m, client, streams := newTestCensorshipMonitor(time.Millisecond*500, &headerVerifierMock{})
+	go m.run()
+	defer m.stop()
+
+	m.blockDelivered(4)
+	streams["orderer2"].sendHeader(5)
+
+	select {
+	case endpoint := <-client.disconnected:
+		assert.Equal(t, "orderer1", endpoint)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Censorship wasn't detected")
+	}
+}
+
+func TestCensorshipNotDetected(t *testing.T) {
+	// Scenario: the ordering service node blocks are received from delivers the blocks
+	// the other ordering service nodes have, but some of the blocks are delivered late,
+	// and headers with invalid signatures are received from another ordering service node.
+	// Expected outcome: the blocks provider doesn't disconnect from the node
+	m, client, streams := newTestCensorshipMonitor(time.Millisecond*500, &headerVerifierMock{
+		invalid: map[uint64]struct{}{100: {}},
+	})
+	go m.run()
+	defer m.stop()
+
+	for seq := uint64(0); seq < 5; seq++ {
+		streams["orderer2"].sendHeader(seq)
+		streams["orderer3"].sendHeader(seq)
+		time.Sleep(time.Millisecond * 200)
+		m.blockDelivered(seq)
+	}
+	streams["orderer3"].sendHeader(100)
+
+	select {
+	case endpoint := <-client.disconnected:
+		t.Fatalf("Disconnected from %s although it delivered all blocks", endpoint)
+	case <-time.After(time.Second * 2):
+	}
+
+	// Headers are received from all nodes except the one blocks are received from
+	m.Lock()
+	defer m.Unlock()
+	assert.Len(t, m.streams, 2)
+	assert.NotContains(t, m.streams, "orderer1")
+}
+
+func TestCensorshipMonitorFollowsSource(t *testing.T) {
+	// Scenario: the blocks provider switches to another ordering service node.
+	// Expected outcome: headers are no longer received from the new node,
+	// and are received from the old node
+	m, client, streams := newTestCensorshipMonitor(time.Hour, &headerVerifierMock{})
+	go m.run()
+	defer m.stop()
+
+	waitUntilOrFail(t, func() bool {
+		m.Lock()
+		defer m.Unlock()
+		return len(m.streams) == 2
+	})
+
+	client.Lock()
+	client.endpoint = "orderer2"
+	client.Unlock()
+
+	waitUntilOrFail(t, func() bool {
+		m.Lock()
+		defer m.Unlock()
+		_, receivesFromOldSource := m.streams["orderer1"]
+		_, receivesFromNewSource := m.streams["orderer2"]
+		return receivesFromOldSource && !receivesFromNewSource
+	})
+
+	select {
+	case <-streams["orderer2"].closed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("Header stream from the new ordering service node wasn't closed")
+	}
+}
@@ -271,6 +271,14 @@ func (bc *broadcastClient) GetEndpoints() []string {
 	return bc.prod.GetEndpoints()
 }
 
+// GetEndpoint returns the ordering service endpoint the client is connected to,
+// or an empty string if it isn't connected
+func (bc *broadcastClient) GetEndpoint() string {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	return bc.endpoint
+}
+
 type connection struct {
 	sync.Once
 	*grpc.ClientConn
@@ -30,6 +30,7 @@ const (
 	defaultReConnectTotalTimeThreshold = time.Second * 60 * 60
 	defaultConnectionTimeout           = time.Second * 3
 	defaultReConnectBackoffThreshold   = float64(time.Hour)
+	defaultCensorshipThreshold         = time.Second * 20
 )
 
 func getReConnectTotalTimeThreshold() time.Duration {
@@ -44,6 +45,14 @@ func getReConnectBackoffThreshold() float64 {
 	return util.GetFloat64OrDefault("peer.deliveryclient.reConnectBackoffThreshold", defaultReConnectBackoffThreshold)
 }
 
+func isCensorshipDetectionEnabled() bool {
+	return viper.GetBool("peer.deliveryclient.censorshipDetection.enabled")
+}
+
+func getCensorshipThreshold() time.Duration {
+	return util.GetDurationOrDefault("peer.deliveryclient.censorshipDetection.threshold", defaultCensorshipThreshold)
+}
+
 // DeliverService used to communicate with orderers to obtain
 // new blocks and send them to the committer service
 type DeliverService interface {
@@ -52,6 +61,11 @@ type DeliverService interface {
 	// When the delivery finishes, the finalizer func is called
 	StartDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, finalizer func()) error
 
+	// StartStaggeredDeliverForChannel starts delivery of new blocks like StartDeliverForChannel,
+	// but connects to the ordering service endpoint at the given offset first, in order for several
+	// peers that deliver blocks of the same channel to connect to different ordering service endpoints
+	StartStaggeredDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, endpointOffset int, finalizer func()) error
+
 	// StopDeliverForChannel dynamically stops delivery of new blocks from ordering service
 	// to channel peers.
 	StopDeliverForChannel(chainID string) error
@@ -143,6 +157,13 @@ func (d *deliverServiceImpl) validateConfiguration() error {
 // that spawns in go routine to read new blocks starting from the position provided by ledger
 // info instance.
 func (d *deliverServiceImpl) StartDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, finalizer func()) error {
+	return d.StartStaggeredDeliverForChannel(chainID, ledgerInfo, -1, finalizer)
+}
+
+// StartStaggeredDeliverForChannel starts blocks delivery for channel like StartDeliverForChannel,
+// but connects to the ordering service endpoint at the given offset first.
+// A negative offset means the endpoints are connected to in random order.
+func (d *deliverServiceImpl) StartStaggeredDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, endpointOffset int, finalizer func()) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	if d.stopping {
@@ -155,9 +176,16 @@ func (d *deliverServiceImpl) StartDeliverForChannel(chainID string, ledgerInfo b
 		logger.Errorf(errMsg)
 		return errors.New(errMsg)
 	} else {
-		client := d.newClient(chainID, ledgerInfo)
+		client := d.newClient(chainID, ledgerInfo, endpointOffset)
 		logger.Debug("This peer will pass blocks from orderer service to other peers for channel", chainID)
-		d.blockProviders[chainID] = blocksprovider.NewBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc)
+		if isCensorshipDetectionEnabled() {
+			d.blockProviders[chainID] = blocksprovider.NewCensorshipResistantBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc, blocksprovider.CensorshipDetectionConfig{
+				Threshold:           getCensorshipThreshold(),
+				HeaderStreamFactory: d.newHeaderStreamFactory(chainID, ledgerInfo),
+			})
+		} else {
+			d.blockProviders[chainID] = blocksprovider.NewBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc)
+		}
 		go d.launchBlockProvider(chainID, finalizer)
 	}
 	return nil
@@ -208,7 +236,7 @@ func (d *deliverServiceImpl) Stop() {
 	}
 }
 
-func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo) *broadcastClient {
+func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo, endpointOffset int) *broadcastClient {
 	reconnectBackoffThreshold := getReConnectBackoffThreshold()
 	reconnectTotalTimeThreshold := getReConnectTotalTimeThreshold()
 	requester := &blocksRequester{
@@ -226,12 +254,56 @@ func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocks
 		attempt := float64(attemptNum)
 		return time.Duration(math.Min(math.Pow(2, attempt)*sleepIncrement, reconnectBackoffThreshold)), true
 	}
-	connProd := comm.NewConnectionProducer(d.conf.ConnFactory(chainID), d.conf.Endpoints)
+	connProd := comm.NewStaggeredConnectionProducer(d.conf.ConnFactory(chainID), d.conf.Endpoints, endpointOffset)
 	bClient := NewBroadcastClient(connProd, d.conf.ABCFactory, broadcastSetup, backoffPolicy)
 	requester.client = bClient
 	return bClient
 }
 
+// newHeaderStreamFactory returns a factory of streams that receive the headers of blocks, starting from the
+// current ledger height, from ordering service endpoints
+func (d *deliverServiceImpl) newHeaderStreamFactory(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo) blocksprovider.HeaderStreamFactory {
+	return func(endpoint string) (blocksprovider.HeaderStream, error) {
+		conn, err := d.conf.ConnFactory(chainID)(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := d.conf.ABCFactory(conn).Deliver(ctx)
+		if err != nil {
+			cancel()
+			conn.Close()
+			return nil, err
+		}
+		hs := &headerStream{
+			AtomicBroadcast_DeliverClient: stream,
+			conn:                          &connection{ClientConn: conn, cancel: cancel},
+		}
+		requester := &blocksRequester{
+			tls:         viper.GetBool("peer.tls.enabled"),
+			chainID:     chainID,
+			client:      stream,
+			contentType: orderer.SeekInfo_HEADER_WITH_SIG,
+		}
+		if err := requester.RequestBlocks(ledgerInfoProvider); err != nil {
+			hs.Close()
+			return nil, err
+		}
+		return hs, nil
+	}
+}
+
+// headerStream is a stream of headers of blocks from an ordering service endpoint
+type headerStream struct {
+	orderer.AtomicBroadcast_DeliverClient
+	conn *connection
+}
+
+// Close closes the stream and its underlying connection
+func (hs *headerStream) Close() {
+	hs.conn.Close()
+}
+
 func DefaultConnectionFactory(channelID string) func(endpoint string) (*grpc.ClientConn, error) {
 	return func(endpoint string) (*grpc.ClientConn, error) {
 		dialOpts := []grpc.DialOption{grpc.WithBlock()}
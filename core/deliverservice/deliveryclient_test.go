@@ -22,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,10 @@ func (*mockMCS) VerifyBlock(chainID common.ChainID, seqNum uint64, signedBlock [
 	return nil
 }
 
+func (*mockMCS) VerifyHeader(chainID string, seqNum uint64, block *cb.Block) error {
+	return nil
+}
+
 func (*mockMCS) Sign(msg []byte) ([]byte, error) {
 	return msg, nil
 }
@@ -500,6 +505,96 @@ func TestDeliverServiceShutdown(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func TestDeliverServiceStaggered(t *testing.T) {
+	defer ensureNoGoroutineLeak(t)()
+	// Scenario: Launch 2 ordering service nodes, and start delivery
+	// with an offset that points to the second node.
+	// The delivery service is expected to connect to the second node.
+	os1 := mocks.NewOrderer(5617, t)
+	os2 := mocks.NewOrderer(5618, t)
+
+	time.Sleep(time.Second)
+	gossipServiceAdapter := &mocks.MockGossipServiceAdapter{GossipBlockDisseminations: make(chan uint64)}
+
+	service, err := NewDeliverService(&Config{
+		Endpoints:   []string{"localhost:5617", "localhost:5618"},
+		Gossip:      gossipServiceAdapter,
+		CryptoSvc:   &mockMCS{},
+		ABCFactory:  DefaultABCFactory,
+		ConnFactory: DefaultConnectionFactory,
+	})
+	assert.NoError(t, err)
+
+	li := &mocks.MockLedgerInfo{Height: uint64(100)}
+	os1.SetNextExpectedSeek(uint64(100))
+	os2.SetNextExpectedSeek(uint64(100))
+	err = service.StartStaggeredDeliverForChannel("TEST_CHAINID", li, 1, func() {})
+	assert.NoError(t, err, "can't start delivery")
+
+	go os2.SendBlock(uint64(100))
+	assertBlockDissemination(100, gossipServiceAdapter.GossipBlockDisseminations, t)
+	assert.Equal(t, 0, os1.ConnCount())
+	assert.Equal(t, 1, os2.ConnCount())
+
+	service.Stop()
+	os1.Shutdown()
+	os2.Shutdown()
+	time.Sleep(time.Second)
+}
+
+func TestDeliverServiceCensorshipDetection(t *testing.T) {
+	viper.Set("peer.deliveryclient.censorshipDetection.enabled", true)
+	viper.Set("peer.deliveryclient.censorshipDetection.threshold", time.Second)
+	defer viper.Reset()
+	defer ensureNoGoroutineLeak(t)()
+	// Scenario: Launch 2 ordering service nodes, and start delivery from the first node.
+	// The second node has a block that the first node doesn't deliver.
+	// The delivery service is expected to pull headers from the second node,
+	// detect that the first node withholds the block, and switch to the second node.
+	os1 := mocks.NewOrderer(5619, t)
+	os2 := mocks.NewOrderer(5620, t)
+
+	time.Sleep(time.Second)
+	gossipServiceAdapter := &mocks.MockGossipServiceAdapter{GossipBlockDisseminations: make(chan uint64)}
+
+	service, err := NewDeliverService(&Config{
+		Endpoints:   []string{"localhost:5619", "localhost:5620"},
+		Gossip:      gossipServiceAdapter,
+		CryptoSvc:   &mockMCS{},
+		ABCFactory:  DefaultABCFactory,
+		ConnFactory: DefaultConnectionFactory,
+	})
+	assert.NoError(t, err)
+
+	li := &mocks.MockLedgerInfo{Height: uint64(100)}
+	os1.SetNextExpectedSeek(uint64(100))
+	os2.SetNextExpectedSeek(uint64(100))
+	err = service.StartStaggeredDeliverForChannel("TEST_CHAINID", li, 0, func() {})
+	assert.NoError(t, err, "can't start delivery")
+
+	// Blocks are delivered from the first node, and headers from the second node
+	assert.True(t, waitForConnectionCount(os1, 1))
+	assert.True(t, waitForConnectionCount(os2, 1))
+
+	// The second node has a block the first node doesn't deliver
+	os2.SendBlock(uint64(100))
+
+	// The delivery service disconnects from the first node,
+	// and pulls headers from it after it switched to the second node
+	assert.True(t, waitForConnectionCount(os1, 0))
+	assert.True(t, waitForConnectionCount(os1, 1))
+	assert.True(t, waitForConnectionCount(os2, 1))
+	time.Sleep(time.Millisecond * 500)
+
+	go os2.SendBlock(uint64(100))
+	assertBlockDissemination(100, gossipServiceAdapter.GossipBlockDisseminations, t)
+
+	service.Stop()
+	os1.Shutdown()
+	os2.Shutdown()
+	time.Sleep(time.Second)
+}
+
 func TestDeliverServiceShutdownRespawn(t *testing.T) {
 	// Scenario: Launch an ordering service node and let the client pull some blocks.
 	// Then, wait a few seconds, and don't send any blocks.
@@ -669,7 +764,7 @@ func TestRetryPolicyOverflow(t *testing.T) {
 			return nil, errors.New("")
 		}
 	}
-	client := (&deliverServiceImpl{conf: &Config{ConnFactory: connFactory}}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)})
+	client := (&deliverServiceImpl{conf: &Config{ConnFactory: connFactory}}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)}, -1)
 	assert.NotNil(t, client.shouldRetry)
 	for i := 0; i < 100; i++ {
 		retryTime, _ := client.shouldRetry(i, time.Second)
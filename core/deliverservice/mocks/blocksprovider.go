@@ -66,6 +66,7 @@ type MockBlocksDeliverer struct {
 	grpc.ClientStream
 	RecvCnt  int32
 	MockRecv func(mock *MockBlocksDeliverer) (*orderer.DeliverResponse, error)
+	Endpoint string
 }
 
 // Recv gets responses from the ordering service, currently mocked to return
@@ -137,6 +138,10 @@ func (mock *MockBlocksDeliverer) GetEndpoints() []string {
 	return []string{} // empty slice
 }
 
+func (mock *MockBlocksDeliverer) GetEndpoint() string {
+	return mock.Endpoint
+}
+
 // MockLedgerInfo mocking implementation of LedgerInfo interface, needed
 // for test initialization purposes
 type MockLedgerInfo struct {
@@ -112,6 +112,8 @@ func (o *Orderer) Deliver(stream orderer.AtomicBroadcast_DeliverServer) error {
 		select {
 		case <-o.stopChan:
 			return nil
+		case <-stream.Context().Done():
+			return nil
 		case seq := <-o.blockChannel:
 			if o.hasFailed() {
 				return stream.Send(statusUnavailable())
@@ -29,9 +29,10 @@ import (
 )
 
 type blocksRequester struct {
-	tls     bool
-	chainID string
-	client  blocksprovider.BlocksDeliverer
+	tls         bool
+	chainID     string
+	client      blocksprovider.BlocksDeliverer
+	contentType orderer.SeekInfo_SeekContentType
 }
 
 func (b *blocksRequester) RequestBlocks(ledgerInfoProvider blocksprovider.LedgerInfo) error {
@@ -65,9 +66,10 @@ func (b *blocksRequester) getTLSCertHash() []byte {
 
 func (b *blocksRequester) seekOldest() error {
 	seekInfo := &orderer.SeekInfo{
-		Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Oldest{Oldest: &orderer.SeekOldest{}}},
-		Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: math.MaxUint64}}},
-		Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+		Start:       &orderer.SeekPosition{Type: &orderer.SeekPosition_Oldest{Oldest: &orderer.SeekOldest{}}},
+		Stop:        &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: math.MaxUint64}}},
+		Behavior:    orderer.SeekInfo_BLOCK_UNTIL_READY,
+		ContentType: b.contentType,
 	}
 
 	//TODO- epoch and msgVersion may need to be obtained for nowfollowing usage in orderer/configupdate/configupdate.go
@@ -83,9 +85,10 @@ func (b *blocksRequester) seekOldest() error {
 
 func (b *blocksRequester) seekLatestFromCommitter(height uint64) error {
 	seekInfo := &orderer.SeekInfo{
-		Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: height}}},
-		Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: math.MaxUint64}}},
-		Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+		Start:       &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: height}}},
+		Stop:        &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: math.MaxUint64}}},
+		Behavior:    orderer.SeekInfo_BLOCK_UNTIL_READY,
+		ContentType: b.contentType,
 	}
 
 	//TODO- epoch and msgVersion may need to be obtained for nowfollowing usage in orderer/configupdate/configupdate.go
@@ -397,7 +397,7 @@ func TestEndorserGoodPathWEvents(t *testing.T) {
 		GetTransactionByIDErr:      errors.New(""),
 		ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Escc: "ESCC"},
 		ExecuteResp:                &pb.Response{Status: 200, Payload: utils.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
-		ExecuteEvent:               &pb.ChaincodeEvent{},
+		ExecuteEvents:              []*pb.ChaincodeEvent{{}},
 	}
 	attachPluginEndorser(support)
 	es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}))
@@ -409,6 +409,37 @@ func TestEndorserGoodPathWEvents(t *testing.T) {
 	assert.EqualValues(t, 200, pResp.Response.Status)
 }
 
+func TestEndorserMultipleEvents(t *testing.T) {
+	for _, v14 := range []bool{false, true} {
+		m := &mock.Mock{}
+		m.On("Sign", mock.Anything).Return([]byte{1, 2, 3, 4, 5}, nil)
+		m.On("Serialize").Return([]byte{1, 1, 1}, nil)
+		m.On("GetTxSimulator", mock.Anything, mock.Anything).Return(newMockTxSim(), nil)
+		support := &em.MockSupport{
+			Mock: m,
+			GetApplicationConfigBoolRv: true,
+			GetApplicationConfigRv:     &mc.MockApplication{CapabilitiesRv: &mc.MockApplicationCapabilities{V1_4ValidationRv: v14}},
+			GetTransactionByIDErr:      errors.New(""),
+			ChaincodeDefinitionRv:      &ccprovider.ChaincodeData{Escc: "ESCC"},
+			ExecuteResp:                &pb.Response{Status: 200, Payload: utils.MarshalOrPanic(&pb.ProposalResponse{Response: &pb.Response{}})},
+			ExecuteEvents:              []*pb.ChaincodeEvent{{EventName: "first"}, {EventName: "second"}},
+		}
+		attachPluginEndorser(support)
+		es := endorser.NewEndorserServer(pvtEmptyDistributor, support, platforms.NewRegistry(&golang.Platform{}))
+
+		signedProp := getSignedProp("ccid", "0", t)
+
+		pResp, err := es.ProcessProposal(context.Background(), signedProp)
+		assert.NoError(t, err)
+		if !v14 {
+			assert.EqualValues(t, 500, pResp.Response.Status)
+			assert.Equal(t, "chaincode ccid emitted 2 events, multiple events per transaction require the V1_4 application capability", pResp.Response.Message)
+			continue
+		}
+		assert.EqualValues(t, 200, pResp.Response.Status)
+	}
+}
+
 func TestEndorserBadChannel(t *testing.T) {
 	es := endorser.NewEndorserServer(pvtEmptyDistributor, &em.MockSupport{
 		GetApplicationConfigBoolRv: true,
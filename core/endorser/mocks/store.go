@@ -98,6 +98,20 @@ func (_m *Store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return r0
 }
 
+// PurgeByKeys provides a mock function with given fields: blockNum, purgeMarkers
+func (_m *Store) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	ret := _m.Called(blockNum, purgeMarkers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, []*ledger.PurgeMarker) error); ok {
+		r0 = rf(blockNum, purgeMarkers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PurgeByTxids provides a mock function with given fields: txids
 func (_m *Store) PurgeByTxids(txids []string) error {
 	ret := _m.Called(txids)
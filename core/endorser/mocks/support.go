@@ -100,7 +100,7 @@ type Support struct {
 	isSysCCReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	ExecuteStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error)
+	ExecuteStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, []*pb.ChaincodeEvent, error)
 	executeMutex       sync.RWMutex
 	executeArgsForCall []struct {
 		txParams   *ccprovider.TransactionParams
@@ -114,15 +114,15 @@ type Support struct {
 	}
 	executeReturns struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
 	executeReturnsOnCall map[int]struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
-	ExecuteLegacyInitStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error)
+	ExecuteLegacyInitStub        func(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, []*pb.ChaincodeEvent, error)
 	executeLegacyInitMutex       sync.RWMutex
 	executeLegacyInitArgsForCall []struct {
 		txParams   *ccprovider.TransactionParams
@@ -136,12 +136,12 @@ type Support struct {
 	}
 	executeLegacyInitReturns struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
 	executeLegacyInitReturnsOnCall map[int]struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}
 	GetChaincodeDefinitionStub        func(chaincodeID string, txsim ledger.QueryExecutor) (ccprovider.ChaincodeDefinition, error)
@@ -617,7 +617,7 @@ func (fake *Support) IsSysCCReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *Support) Execute(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (fake *Support) Execute(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, input *pb.ChaincodeInput) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	fake.executeMutex.Lock()
 	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
 	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
@@ -653,32 +653,32 @@ func (fake *Support) ExecuteArgsForCall(i int) (*ccprovider.TransactionParams, s
 	return fake.executeArgsForCall[i].txParams, fake.executeArgsForCall[i].cid, fake.executeArgsForCall[i].name, fake.executeArgsForCall[i].version, fake.executeArgsForCall[i].txid, fake.executeArgsForCall[i].signedProp, fake.executeArgsForCall[i].prop, fake.executeArgsForCall[i].input
 }
 
-func (fake *Support) ExecuteReturns(result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteReturns(result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteStub = nil
 	fake.executeReturns = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *Support) ExecuteReturnsOnCall(i int, result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteReturnsOnCall(i int, result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteStub = nil
 	if fake.executeReturnsOnCall == nil {
 		fake.executeReturnsOnCall = make(map[int]struct {
 			result1 *pb.Response
-			result2 *pb.ChaincodeEvent
+			result2 []*pb.ChaincodeEvent
 			result3 error
 		})
 	}
 	fake.executeReturnsOnCall[i] = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *Support) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error) {
+func (fake *Support) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cid string, name string, version string, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, []*pb.ChaincodeEvent, error) {
 	fake.executeLegacyInitMutex.Lock()
 	ret, specificReturn := fake.executeLegacyInitReturnsOnCall[len(fake.executeLegacyInitArgsForCall)]
 	fake.executeLegacyInitArgsForCall = append(fake.executeLegacyInitArgsForCall, struct {
@@ -714,27 +714,27 @@ func (fake *Support) ExecuteLegacyInitArgsForCall(i int) (*ccprovider.Transactio
 	return fake.executeLegacyInitArgsForCall[i].txParams, fake.executeLegacyInitArgsForCall[i].cid, fake.executeLegacyInitArgsForCall[i].name, fake.executeLegacyInitArgsForCall[i].version, fake.executeLegacyInitArgsForCall[i].txid, fake.executeLegacyInitArgsForCall[i].signedProp, fake.executeLegacyInitArgsForCall[i].prop, fake.executeLegacyInitArgsForCall[i].spec
 }
 
-func (fake *Support) ExecuteLegacyInitReturns(result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteLegacyInitReturns(result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteLegacyInitStub = nil
 	fake.executeLegacyInitReturns = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *Support) ExecuteLegacyInitReturnsOnCall(i int, result1 *pb.Response, result2 *pb.ChaincodeEvent, result3 error) {
+func (fake *Support) ExecuteLegacyInitReturnsOnCall(i int, result1 *pb.Response, result2 []*pb.ChaincodeEvent, result3 error) {
 	fake.ExecuteLegacyInitStub = nil
 	if fake.executeLegacyInitReturnsOnCall == nil {
 		fake.executeLegacyInitReturnsOnCall = make(map[int]struct {
 			result1 *pb.Response
-			result2 *pb.ChaincodeEvent
+			result2 []*pb.ChaincodeEvent
 			result3 error
 		})
 	}
 	fake.executeLegacyInitReturnsOnCall[i] = struct {
 		result1 *pb.Response
-		result2 *pb.ChaincodeEvent
+		result2 []*pb.ChaincodeEvent
 		result3 error
 	}{result1, result2, result3}
 }
@@ -61,6 +61,7 @@ type Context struct {
 	Visibility     []byte
 	Response       *pb.Response
 	Event          []byte
+	Events         []*pb.ChaincodeEvent
 	ChaincodeID    *pb.ChaincodeID
 	SimRes         []byte
 }
@@ -237,7 +238,12 @@ func proposalResponsePayloadFromContext(ctx Context) ([]byte, error) {
 		return nil, errors.Wrap(err, "could not compute proposal hash")
 	}
 
-	prpBytes, err := putils.GetBytesProposalResponsePayload(pHashBytes, ctx.Response, ctx.SimRes, ctx.Event, ctx.ChaincodeID)
+	var prpBytes []byte
+	if len(ctx.Events) == 0 {
+		prpBytes, err = putils.GetBytesProposalResponsePayload(pHashBytes, ctx.Response, ctx.SimRes, ctx.Event, ctx.ChaincodeID)
+	} else {
+		prpBytes, err = putils.GetBytesProposalResponsePayloadWithEvents(pHashBytes, ctx.Response, ctx.SimRes, ctx.Events, ctx.ChaincodeID)
+	}
 	if err != nil {
 		endorserLogger.Warning("Failed marshaling the proposal response payload to bytes", err)
 		return nil, errors.New("failure while marshaling the ProposalResponsePayload")
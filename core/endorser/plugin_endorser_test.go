@@ -118,6 +118,70 @@ func TestPluginEndorserGreenPath(t *testing.T) {
 	plugin.AssertCalled(t, "Init", sif)
 }
 
+func TestPluginEndorserEvents(t *testing.T) {
+	proposal, _, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, "mychannel", &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: &peer.ChaincodeID{Name: "mycc"},
+		},
+	}, []byte{1, 2, 3})
+	assert.NoError(t, err)
+	var prpBytes []byte
+	pluginMapper := &mocks.PluginMapper{}
+	pluginFactory := &mocks.PluginFactory{}
+	plugin := &mocks.Plugin{}
+	plugin.On("Endorse", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		prpBytes = args.Get(0).([]byte)
+	}).Return(&peer.Endorsement{}, []byte{1, 2, 3}, nil)
+	pluginMapper.On("PluginFactoryByName", endorser.PluginName("plugin")).Return(pluginFactory)
+	plugin.On("Init", mock.Anything, mock.Anything).Return(nil)
+	pluginFactory.On("New").Return(plugin)
+	cs := &mocks.ChannelStateRetriever{}
+	cs.On("NewQueryCreator", "mychannel").Return(&mocks.QueryCreator{}, nil)
+	pluginEndorser := endorser.NewPluginEndorser(&endorser.PluginSupport{
+		ChannelStateRetriever:   cs,
+		SigningIdentityFetcher:  &mocks.SigningIdentityFetcher{},
+		PluginMapper:            pluginMapper,
+		TransientStoreRetriever: mockTransientStoreRetriever,
+	})
+
+	chaincodeAction := func() *peer.ChaincodeAction {
+		prp, err := utils.GetProposalResponsePayload(prpBytes)
+		assert.NoError(t, err)
+		action, err := utils.GetChaincodeAction(prp.Extension)
+		assert.NoError(t, err)
+		return action
+	}
+
+	first := &peer.ChaincodeEvent{ChaincodeId: "mycc", EventName: "first"}
+	second := &peer.ChaincodeEvent{ChaincodeId: "mycc", EventName: "second"}
+	firstBytes, err := utils.GetBytesChaincodeEvent(first)
+	assert.NoError(t, err)
+
+	// Scenario I: a context that only carries the single event
+	ctx := endorser.Context{
+		Response:    &peer.Response{},
+		PluginName:  "plugin",
+		Proposal:    proposal,
+		ChaincodeID: &peer.ChaincodeID{Name: "mycc"},
+		Channel:     "mychannel",
+		Event:       firstBytes,
+	}
+	_, err = pluginEndorser.EndorseWithPlugin(ctx)
+	assert.NoError(t, err)
+	action := chaincodeAction()
+	assert.Equal(t, firstBytes, action.Events)
+	assert.Empty(t, action.ChaincodeEvents)
+
+	// Scenario II: a context carrying several events
+	ctx.Events = []*peer.ChaincodeEvent{first, second}
+	_, err = pluginEndorser.EndorseWithPlugin(ctx)
+	assert.NoError(t, err)
+	action = chaincodeAction()
+	assert.Equal(t, firstBytes, action.Events)
+	assert.Len(t, action.ChaincodeEvents, 2)
+	assert.True(t, proto.Equal(second, action.ChaincodeEvents[1]))
+}
+
 func TestPluginEndorserErrors(t *testing.T) {
 	pluginMapper := &mocks.PluginMapper{}
 	pluginFactory := &mocks.PluginFactory{}
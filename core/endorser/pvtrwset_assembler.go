@@ -57,7 +57,7 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 			if err != nil {
 				return nil, errors.WithMessage(err, fmt.Sprintf("error while retrieving collection config for chaincode %#v", namespace))
 			}
-			if cb == nil {
+			if cb == nil && !onlyImplicitCollections(pvtRwset) {
 				return nil, errors.New(fmt.Sprintf("no collection config for chaincode %#v", namespace))
 			}
 
@@ -69,11 +69,43 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 
 			txPvtRwSetWithConfig.CollectionConfigs[namespace] = colCP
 		}
+		addImplicitCollectionConfigs(txPvtRwSetWithConfig.CollectionConfigs[namespace], pvtRwset)
 	}
 	as.trimCollectionConfigs(txPvtRwSetWithConfig)
 	return txPvtRwSetWithConfig, nil
 }
 
+// onlyImplicitCollections returns true if all the collections written in
+// the given namespace are implicit collections
+func onlyImplicitCollections(pvtRwset *rwset.NsPvtReadWriteSet) bool {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		if isImplicit, _ := privdata.MspIDIfImplicitCollection(col.CollectionName); !isImplicit {
+			return false
+		}
+	}
+	return true
+}
+
+// addImplicitCollectionConfigs appends to the given package the configuration
+// of the implicit collections written in the given namespace, as they are not
+// part of the collection configuration defined for the chaincode
+func addImplicitCollectionConfigs(colCP *common.CollectionConfigPackage, pvtRwset *rwset.NsPvtReadWriteSet) {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		if conf := privdata.ImplicitCollectionConfig(col.CollectionName); conf != nil && !containsCollection(colCP, col.CollectionName) {
+			colCP.Config = append(colCP.Config, conf)
+		}
+	}
+}
+
+func containsCollection(colCP *common.CollectionConfigPackage, name string) bool {
+	for _, conf := range colCP.Config {
+		if colConf := conf.GetStaticCollectionConfig(); colConf != nil && colConf.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (as *rwSetAssembler) trimCollectionConfigs(pvtData *transientstore.TxPvtReadWriteSetWithConfigInfo) {
 	flags := make(map[string]map[string]struct{})
 	for _, pvtRWset := range pvtData.PvtRwset.NsPvtRwset {
@@ -88,3 +88,73 @@ func TestAssemblePvtRWSet(t *testing.T) {
 	assert.Equal(t, 1, len(pvtReadWriteSetWithConfigInfo.PvtRwset.NsPvtRwset))
 
 }
+
+func TestAssemblePvtRWSetImplicitCollections(t *testing.T) {
+	collectionsConfigCC1 := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "mycollection-1",
+					},
+				},
+			},
+		},
+	}
+	colB, err := proto.Marshal(collectionsConfigCC1)
+	assert.NoError(t, err)
+
+	configRetriever := &mockCollectionConfigRetriever{}
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("myCC")).Return(colB, nil)
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("noCollectionsCC")).Return([]byte(nil), nil)
+
+	assembler := rwSetAssembler{}
+
+	privData := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "myCC",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{
+						CollectionName: "mycollection-1",
+						Rwset:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					},
+					{
+						CollectionName: "_implicit_org_Org1MSP",
+						Rwset:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					},
+				},
+			},
+			{
+				Namespace: "noCollectionsCC",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{
+						CollectionName: "_implicit_org_Org2MSP",
+						Rwset:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					},
+				},
+			},
+		},
+	}
+
+	pvtReadWriteSetWithConfigInfo, err := assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.NoError(t, err)
+	configPackages := pvtReadWriteSetWithConfigInfo.CollectionConfigs
+
+	configs := configPackages["myCC"]
+	assert.Equal(t, 2, len(configs.Config))
+	assert.Equal(t, "mycollection-1", configs.Config[0].GetStaticCollectionConfig().Name)
+	assert.Equal(t, "_implicit_org_Org1MSP", configs.Config[1].GetStaticCollectionConfig().Name)
+
+	configs = configPackages["noCollectionsCC"]
+	assert.Equal(t, 1, len(configs.Config))
+	assert.Equal(t, "_implicit_org_Org2MSP", configs.Config[0].GetStaticCollectionConfig().Name)
+
+	// a chaincode without collection config cannot write to explicit collections
+	privData.NsPvtRwset[1].CollectionPvtRwset = append(privData.NsPvtRwset[1].CollectionPvtRwset, &rwset.CollectionPvtReadWriteSet{
+		CollectionName: "mycollection-1",
+	})
+	_, err = assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.EqualError(t, err, `no collection config for chaincode "noCollectionsCC"`)
+}
@@ -1929,6 +1929,18 @@ func TestValidateRWSetAndCollectionForDeploy(t *testing.T) {
 	state["lscc"][privdata.BuildCollectionKVSKey(ccid)] = ccpBytes
 	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1}, cdRWSet, lsccFunc, ac, chid)
 	assert.EqualError(t, err, "collection data should not exist for chaincode mycc:1.0")
+
+	// Test 14: collection with an endorsement policy -> success as the v1.2 validation,
+	// like peers of prior releases, ignores it
+	delete(state["lscc"], privdata.BuildCollectionKVSKey(ccid))
+	coll3 = createCollectionConfig(collName3, cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers), requiredPeerCount, maximumPeerCount, blockToLive)
+	coll3.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{
+		Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+			SignaturePolicy: policyEnvelope,
+		},
+	}
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.NoError(t, err)
 }
 
 func TestValidateRWSetAndCollectionForUpgrade(t *testing.T) {
@@ -53,7 +53,7 @@ func (vscc *Validator) checkInstantiationPolicy(chainName string, env *common.En
 	return nil
 }
 
-func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfig) error {
+func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfig, ac channelconfig.ApplicationCapabilities) error {
 	newCollectionsMap := make(map[string]bool, len(newCollectionConfigs))
 	// Process each collection config from a set of collection configs
 	for _, newCollectionConfig := range newCollectionConfigs {
@@ -70,6 +70,11 @@ func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfi
 			return err
 		}
 
+		// the names of the implicit collections are reserved as of v1.4
+		if isImplicit, _ := privdata.MspIDIfImplicitCollection(collectionName); isImplicit && ac.V1_4Validation() {
+			return fmt.Errorf("collection-name: %s uses the reserved prefix %s", collectionName, privdata.ImplicitCollectionPrefix)
+		}
+
 		if _, ok := newCollectionsMap[collectionName]; !ok {
 			newCollectionsMap[collectionName] = true
 		} else {
@@ -95,6 +100,12 @@ func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfi
 		if err != nil {
 			return errors.WithMessage(err, fmt.Sprintf("collection-name: %s -- error in member org policy", collectionName))
 		}
+
+		// make sure that the endorsement policy, if any, is a signature policy;
+		// collection endorsement policies are ignored without the V1_4 capability
+		if ac.V1_4Validation() && newCollection.EndorsementPolicy != nil && newCollection.EndorsementPolicy.GetSignaturePolicy() == nil {
+			return fmt.Errorf("collection-name: %s -- collection endorsement policy is empty", collectionName)
+		}
 	}
 	return nil
 }
@@ -301,7 +312,7 @@ func (vscc *Validator) validateRWSetAndCollection(
 
 	if ac.V1_2Validation() {
 		newCollectionConfigs := newCollectionConfigPackage.GetConfig()
-		if err := validateNewCollectionConfigs(newCollectionConfigs); err != nil {
+		if err := validateNewCollectionConfigs(newCollectionConfigs, ac); err != nil {
 			return policyErr(err)
 		}
 
@@ -584,11 +595,143 @@ func (vscc *Validator) ValidateLSCCInvocation(
 
 		// all is good!
 		return nil
+	case lscc.UPDATECOLLECTIONS:
+		// peers without the V1_4 capability reject the invocation as an
+		// unknown lscc function, and so must we on channels without it
+		if !ac.V1_4Validation() {
+			return policyErr(fmt.Errorf("VSCC error: committing an invocation of function %s of lscc is invalid", lsccFunc))
+		}
+
+		logger.Debugf("VSCC info: validating invocation of lscc function %s on arguments %#v", lsccFunc, lsccArgs)
+
+		return vscc.validateUpdateCollections(chid, env, cap, payl, lsccArgs, ac)
 	default:
 		return policyErr(fmt.Errorf("VSCC error: committing an invocation of function %s of lscc is invalid", lsccFunc))
 	}
 }
 
+// validateUpdateCollections validates an invocation of the lscc function
+// that updates the collection configuration of an instantiated chaincode
+func (vscc *Validator) validateUpdateCollections(
+	chid string,
+	env *common.Envelope,
+	cap *pb.ChaincodeActionPayload,
+	payl *common.Payload,
+	lsccArgs [][]byte,
+	ac channelconfig.ApplicationCapabilities,
+) commonerrors.TxValidationError {
+	if len(lsccArgs) != 3 {
+		return policyErr(fmt.Errorf("Wrong number of arguments for invocation lscc(%s): expected 3, received %d", lscc.UPDATECOLLECTIONS, len(lsccArgs)))
+	}
+	ccName := string(lsccArgs[1])
+	collectionsConfigArg := lsccArgs[2]
+
+	if cap.Action == nil || cap.Action.ProposalResponsePayload == nil {
+		return policyErr(fmt.Errorf("VSCC error: invocation of lscc(%s) does not have appropriate arguments", lscc.UPDATECOLLECTIONS))
+	}
+	pRespPayload, err := utils.GetProposalResponsePayload(cap.Action.ProposalResponsePayload)
+	if err != nil {
+		return policyErr(fmt.Errorf("GetProposalResponsePayload error %s", err))
+	}
+	if pRespPayload.Extension == nil {
+		return policyErr(fmt.Errorf("nil pRespPayload.Extension"))
+	}
+	respPayload, err := utils.GetChaincodeAction(pRespPayload.Extension)
+	if err != nil {
+		return policyErr(fmt.Errorf("GetChaincodeAction error %s", err))
+	}
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+		return policyErr(fmt.Errorf("txRWSet.FromProtoBytes error %s", err))
+	}
+
+	/******************************************/
+	/* security check 0 - validation of rwset */
+	/******************************************/
+	// the only write must be the collection configuration of the chaincode
+	var lsccrwset *kvrwset.KVRWSet
+	for _, ns := range txRWSet.NsRwSets {
+		if ns.NameSpace == "lscc" {
+			lsccrwset = ns.KvRwSet
+			continue
+		}
+		if len(ns.KvRwSet.Writes) > 0 {
+			return policyErr(fmt.Errorf("LSCC invocation is attempting to write to namespace %s", ns.NameSpace))
+		}
+	}
+	if lsccrwset == nil || len(lsccrwset.Writes) != 1 {
+		return policyErr(fmt.Errorf("LSCC must issue a single putState upon collection update"))
+	}
+	key := privdata.BuildCollectionKVSKey(ccName)
+	if lsccrwset.Writes[0].Key != key {
+		return policyErr(fmt.Errorf("invalid key for the collection of chaincode %s; expected '%s', received '%s'",
+			ccName, key, lsccrwset.Writes[0].Key))
+	}
+	if !bytes.Equal(collectionsConfigArg, lsccrwset.Writes[0].Value) {
+		return policyErr(fmt.Errorf("collection configuration arguments supplied for chaincode %s do not match the configuration in the lscc writeset", ccName))
+	}
+
+	/**************************************************************/
+	/* security check 1 - cc in the LCCC table of instantiated cc */
+	/**************************************************************/
+	cdLedger, ccExistsOnLedger, err := vscc.getInstantiatedCC(chid, ccName)
+	if err != nil {
+		return &commonerrors.VSCCExecutionFailureError{Err: err}
+	}
+	if !ccExistsOnLedger {
+		return policyErr(fmt.Errorf("Updating collections of non-existent chaincode %s", ccName))
+	}
+
+	/*************************************************/
+	/* security check 2 - validation of collections */
+	/*************************************************/
+	newCollectionConfigPackage := &common.CollectionConfigPackage{}
+	if err := proto.Unmarshal(collectionsConfigArg, newCollectionConfigPackage); err != nil {
+		return policyErr(fmt.Errorf("invalid collection configuration supplied for chaincode %s", ccName))
+	}
+	newCollectionConfigs := newCollectionConfigPackage.GetConfig()
+	if err := validateNewCollectionConfigs(newCollectionConfigs, ac); err != nil {
+		return policyErr(err)
+	}
+	newCollectionsMap := make(map[string]*common.StaticCollectionConfig, len(newCollectionConfigs))
+	for _, newCollectionConfig := range newCollectionConfigs {
+		newCollection := newCollectionConfig.GetStaticCollectionConfig()
+		newCollectionsMap[newCollection.GetName()] = newCollection
+	}
+
+	channelState, err := vscc.stateFetcher.FetchState()
+	if err != nil {
+		return &commonerrors.VSCCExecutionFailureError{Err: fmt.Errorf("failed obtaining query executor: %v", err)}
+	}
+	defer channelState.Done()
+
+	// unlike upgrades, updates may change the BlockToLive of the existing
+	// collections, but they still cannot remove them
+	collectionCriteria := common.CollectionCriteria{Channel: chid, Namespace: ccName}
+	oldCollectionConfigPackage, err := privdata.RetrieveCollectionConfigPackageFromState(collectionCriteria, &state{channelState})
+	if err != nil {
+		if _, ok := err.(privdata.NoSuchCollectionError); !ok {
+			return &commonerrors.VSCCExecutionFailureError{Err: fmt.Errorf("unable to check whether collection existed earlier for chaincode %s: %v",
+				ccName, err),
+			}
+		}
+	}
+	if oldCollectionConfigPackage != nil {
+		if err := checkForMissingCollections(newCollectionsMap, oldCollectionConfigPackage.GetConfig()); err != nil {
+			return policyErr(err)
+		}
+	}
+
+	/*****************************************************/
+	/* security check 3 - check the instantiation policy */
+	/*****************************************************/
+	pol := cdLedger.InstantiationPolicy
+	if pol == nil {
+		return policyErr(fmt.Errorf("No instantiation policy was specified"))
+	}
+	return vscc.checkInstantiationPolicy(chid, env, pol, payl)
+}
+
 func (vscc *Validator) getInstantiatedCC(chid, ccid string) (cd *ccprovider.ChaincodeData, exists bool, err error) {
 	qe, err := vscc.stateFetcher.FetchState()
 	if err != nil {
@@ -147,3 +147,17 @@ func (_m *Capabilities) V1_3Validation() bool {
 
 	return r0
 }
+
+// V1_4Validation provides a mock function with given fields:
+func (_m *Capabilities) V1_4Validation() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
@@ -1647,6 +1647,121 @@ func validateUpgradeWithNewFailAllIP(t *testing.T, v11capability, expecterr bool
 	}
 }
 
+func createUpdateCollectionsTx(ccname string, ccpBytes []byte, res []byte) (*common.Envelope, error) {
+	cis := &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: &peer.ChaincodeID{Name: "lscc"},
+			Input: &peer.ChaincodeInput{
+				Args: [][]byte{[]byte(lscc.UPDATECOLLECTIONS), []byte(chainId), []byte(ccname), ccpBytes},
+			},
+			Type: peer.ChaincodeSpec_GOLANG,
+		},
+	}
+
+	prop, _, err := utils.CreateProposalFromCIS(common.HeaderType_ENDORSER_TRANSACTION, chainId, cis, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	presp, err := utils.CreateProposalResponse(prop.Header, prop.Payload, &peer.Response{Status: 200}, res, nil, &peer.ChaincodeID{Name: "lscc"}, nil, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.CreateSignedTx(prop, id, presp)
+}
+
+func TestValidateUpdateCollections(t *testing.T) {
+	ccname := "mycc"
+	policy, err := getSignedByMSPMemberPolicy(mspid)
+	assert.NoError(t, err)
+
+	coll1 := createCollectionConfig("mycollection1", cauthdsl.SignedByMspMember(mspid), 1, 2, 0)
+	coll2 := createCollectionConfig("mycollection2", cauthdsl.SignedByMspMember(mspid), 1, 2, 0)
+	updatedColl1 := createCollectionConfig("mycollection1", cauthdsl.SignedByMspMember(mspid), 0, 3, 10)
+	implicitColl := createCollectionConfig("_implicit_org_"+mspid, cauthdsl.SignedByMspMember(mspid), 0, 1, 0)
+
+	capabilities := &mc.MockApplicationCapabilities{V1_3ValidationRv: true, V1_4ValidationRv: true}
+	validate := func(instantiationPolicy []byte, newConfigs []*common.CollectionConfig, writes map[string]map[string][]byte) error {
+		state := make(map[string]map[string][]byte)
+		state["lscc"] = map[string][]byte{
+			privdata.BuildCollectionKVSKey(ccname): utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1}}),
+		}
+		if instantiationPolicy != nil {
+			state["lscc"][ccname] = utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: ccname, Version: "1", InstantiationPolicy: instantiationPolicy})
+		}
+		qec := &mocks2.QueryExecutorCreator{}
+		qec.On("NewQueryExecutor").Return(lm.NewMockQueryExecutor(state), nil)
+		v := newCustomValidationInstance(qec, capabilities)
+
+		ccpBytes := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: newConfigs})
+		if writes == nil {
+			writes = map[string]map[string][]byte{"lscc": {privdata.BuildCollectionKVSKey(ccname): ccpBytes}}
+		}
+		rwsetBuilder := rwsetutil.NewRWSetBuilder()
+		for ns, kvs := range writes {
+			for k, v := range kvs {
+				rwsetBuilder.AddToWriteSet(ns, k, v)
+			}
+		}
+		sr, err := rwsetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		res, err := sr.GetPubSimulationBytes()
+		assert.NoError(t, err)
+
+		tx, err := createUpdateCollectionsTx(ccname, ccpBytes, res)
+		assert.NoError(t, err)
+		envBytes, err := utils.GetBytesEnvelope(tx)
+		assert.NoError(t, err)
+
+		bl := &common.Block{Data: &common.BlockData{Data: [][]byte{envBytes}}, Header: &common.BlockHeader{}}
+		return v.Validate(bl, "lscc", 0, 0, policy)
+	}
+
+	// good path: the existing collection is updated and a new one is added
+	err = validate(policy, []*common.CollectionConfig{updatedColl1, coll2}, nil)
+	assert.NoError(t, err)
+
+	err = validate(nil, []*common.CollectionConfig{coll1, coll2}, nil)
+	assert.EqualError(t, err, "Updating collections of non-existent chaincode mycc")
+
+	err = validate(policy, []*common.CollectionConfig{coll2}, nil)
+	assert.EqualError(t, err, "the following existing collections are missing in the new collection configuration package: [mycollection1]")
+
+	err = validate(policy, []*common.CollectionConfig{coll1, implicitColl}, nil)
+	assert.EqualError(t, err, "collection-name: _implicit_org_"+mspid+" uses the reserved prefix _implicit_org_")
+
+	err = validate(policy, []*common.CollectionConfig{coll1, coll1}, nil)
+	assert.EqualError(t, err, "collection-name: mycollection1 -- found duplicate collection configuration")
+
+	barfPolicy, err := getSignedByMSPMemberPolicy("barf")
+	assert.NoError(t, err)
+	err = validate(barfPolicy, []*common.CollectionConfig{coll1, coll2}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "chaincode instantiation policy violated")
+
+	err = validate(policy, []*common.CollectionConfig{coll1, coll2}, map[string]map[string][]byte{
+		"lscc": {privdata.BuildCollectionKVSKey(ccname): []byte("barf")},
+	})
+	assert.EqualError(t, err, "collection configuration arguments supplied for chaincode mycc do not match the configuration in the lscc writeset")
+
+	err = validate(policy, []*common.CollectionConfig{coll1, coll2}, map[string]map[string][]byte{
+		"lscc": {ccname: []byte("barf")},
+	})
+	assert.EqualError(t, err, "invalid key for the collection of chaincode mycc; expected 'mycc~collection', received 'mycc'")
+
+	err = validate(policy, []*common.CollectionConfig{coll1, coll2}, map[string]map[string][]byte{
+		"lscc": {privdata.BuildCollectionKVSKey(ccname): []byte("barf")},
+		ccname: {"key": []byte("value")},
+	})
+	assert.EqualError(t, err, "LSCC invocation is attempting to write to namespace mycc")
+
+	// without the V1_4 capability the update is invalid
+	capabilities = &mc.MockApplicationCapabilities{V1_3ValidationRv: true}
+	err = validate(policy, []*common.CollectionConfig{updatedColl1, coll2}, nil)
+	assert.EqualError(t, err, "VSCC error: committing an invocation of function updatecollections of lscc is invalid")
+}
+
 func TestValidateUpgradeWithPoliciesFail(t *testing.T) {
 	state := make(map[string]map[string][]byte)
 	mp := (&scc.MocksccProviderFactory{
@@ -1903,6 +2018,40 @@ func TestValidateRWSetAndCollectionForDeploy(t *testing.T) {
 	state["lscc"][privdata.BuildCollectionKVSKey(ccid)] = ccpBytes
 	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1}, cdRWSet, lsccFunc, ac, chid)
 	assert.EqualError(t, err, "collection data should not exist for chaincode mycc:1.0")
+
+	// Test 14: collection with an endorsement policy -> success
+	delete(state["lscc"], privdata.BuildCollectionKVSKey(ccid))
+	coll3 = createCollectionConfig(collName3, cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers), requiredPeerCount, maximumPeerCount, blockToLive)
+	coll3.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{
+		Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+			SignaturePolicy: policyEnvelope,
+		},
+	}
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.NoError(t, err)
+
+	// Test 15: collection with an empty endorsement policy -> success
+	// as collection endorsement policies are ignored without the V1_4 capability
+	coll3.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{}
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.NoError(t, err)
+
+	// Test 16: collection with an empty endorsement policy and the V1_4 capability -> error
+	v14 := capabilities.NewApplicationProvider(map[string]*common.Capability{
+		capabilities.ApplicationV1_4: {},
+	})
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, coll3}, cdRWSet, lsccFunc, v14, chid)
+	assert.EqualError(t, err, "collection-name: mycollection3 -- collection endorsement policy is empty")
+
+	// Test 17: collection named with the prefix reserved for implicit collections -> success
+	// as implicit collections are not enabled without the V1_4 capability
+	implicitColl := createCollectionConfig("_implicit_org_Org1MSP", cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers), requiredPeerCount, maximumPeerCount, blockToLive)
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, implicitColl}, cdRWSet, lsccFunc, ac, chid)
+	assert.NoError(t, err)
+
+	// Test 18: collection named with the prefix reserved for implicit collections and the V1_4 capability -> error
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll1, implicitColl}, cdRWSet, lsccFunc, v14, chid)
+	assert.EqualError(t, err, "collection-name: _implicit_org_Org1MSP uses the reserved prefix _implicit_org_")
 }
 
 func TestValidateRWSetAndCollectionForUpgrade(t *testing.T) {
@@ -0,0 +1,198 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protos/utils"
+)
+
+// constructValidAndInvalidPvtData checks the pvt data of the given already committed blocks against the hashes
+// present in the blocks. It returns the pvt data that matches the hashes along with the information about the
+// collections whose pvt data does not match. The pvt data of the invalid transactions is left out
+func constructValidAndInvalidPvtData(blocksPvtData []*ledger.BlockPvtData, blockStore *ledgerstorage.Store) (
+	[]*ledger.BlockPvtData, []*ledger.PvtdataHashMismatch, error) {
+	var validBlocksPvtData []*ledger.BlockPvtData
+	var invalidPvtData []*ledger.PvtdataHashMismatch
+	for _, blockPvtData := range blocksPvtData {
+		validData, invalidData, err := findValidAndInvalidBlockPvtData(blockPvtData, blockStore)
+		if err != nil {
+			return nil, nil, err
+		}
+		validBlocksPvtData = append(validBlocksPvtData, validData)
+		invalidPvtData = append(invalidPvtData, invalidData...)
+	}
+	return validBlocksPvtData, invalidPvtData, nil
+}
+
+func findValidAndInvalidBlockPvtData(blockPvtData *ledger.BlockPvtData, blockStore *ledgerstorage.Store) (
+	*ledger.BlockPvtData, []*ledger.PvtdataHashMismatch, error) {
+	block, err := blockStore.RetrieveBlockByNumber(blockPvtData.BlockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	txFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+
+	validData := &ledger.BlockPvtData{BlockNum: blockPvtData.BlockNum, WriteSets: make(map[uint64]*ledger.TxPvtData)}
+	var invalidData []*ledger.PvtdataHashMismatch
+	for txNum, txPvtData := range blockPvtData.WriteSets {
+		if txPvtData.WriteSet == nil {
+			continue
+		}
+		if txNum >= uint64(len(block.Data.Data)) || txNum >= uint64(len(txFilter)) || txFilter.IsInvalid(int(txNum)) {
+			logger.Debugf("Skipping the pvt data for block [%d], tx [%d] as the transaction is not a valid one",
+				blockPvtData.BlockNum, txNum)
+			continue
+		}
+		txRWSet, err := retrieveTxRWSet(block.Data.Data[txNum])
+		if err != nil {
+			// such as a config transaction, which carries no pvt data
+			logger.Warningf("Skipping the pvt data for block [%d], tx [%d] as the read-write set of the transaction could not be retrieved: %s",
+				blockPvtData.BlockNum, txNum, err)
+			continue
+		}
+		validWriteSet, mismatches, err := findValidAndInvalidTxPvtData(blockPvtData.BlockNum, txNum, txPvtData.WriteSet, txRWSet, blockStore)
+		if err != nil {
+			return nil, nil, err
+		}
+		invalidData = append(invalidData, mismatches...)
+		if len(validWriteSet.NsPvtRwset) > 0 {
+			validData.WriteSets[txNum] = &ledger.TxPvtData{SeqInBlock: txNum, WriteSet: validWriteSet}
+		}
+	}
+	return validData, invalidData, nil
+}
+
+func findValidAndInvalidTxPvtData(blockNum, txNum uint64, txPvtWriteSet *rwset.TxPvtReadWriteSet, txRWSet *rwsetutil.TxRwSet,
+	blockStore *ledgerstorage.Store) (*rwset.TxPvtReadWriteSet, []*ledger.PvtdataHashMismatch, error) {
+	validWriteSet := &rwset.TxPvtReadWriteSet{DataModel: txPvtWriteSet.DataModel}
+	var invalidData []*ledger.PvtdataHashMismatch
+	for _, nsPvtRwset := range txPvtWriteSet.NsPvtRwset {
+		validNsPvtRwset := &rwset.NsPvtReadWriteSet{Namespace: nsPvtRwset.Namespace}
+		for _, collPvtRwset := range nsPvtRwset.CollectionPvtRwset {
+			collHashedRwSet := collHashedRwSetInBlock(txRWSet, nsPvtRwset.Namespace, collPvtRwset.CollectionName)
+			if collHashedRwSet == nil {
+				logger.Debugf("Skipping the pvt data for block [%d], tx [%d], namespace [%s], collection [%s] as the transaction does not write to it",
+					blockNum, txNum, nsPvtRwset.Namespace, collPvtRwset.CollectionName)
+				continue
+			}
+			expectedHash := collHashedRwSet.PvtRwSetHash
+			valid := bytes.Equal(util.ComputeHash(collPvtRwset.Rwset), expectedHash)
+			if !valid {
+				// the pvt data served by a peer that purged some of its keys no longer matches
+				// the hash of the whole collection, but still matches the hash of each key
+				var err error
+				if valid, err = matchesPurgedHashedRwSet(blockNum, txNum, nsPvtRwset.Namespace, collPvtRwset, collHashedRwSet, blockStore); err != nil {
+					return nil, nil, err
+				}
+			}
+			if !valid {
+				invalidData = append(invalidData, &ledger.PvtdataHashMismatch{
+					BlockNum:       blockNum,
+					TxNum:          txNum,
+					ChaincodeName:  nsPvtRwset.Namespace,
+					CollectionName: collPvtRwset.CollectionName,
+					ExpectedHash:   expectedHash,
+				})
+				continue
+			}
+			validNsPvtRwset.CollectionPvtRwset = append(validNsPvtRwset.CollectionPvtRwset, collPvtRwset)
+		}
+		if len(validNsPvtRwset.CollectionPvtRwset) > 0 {
+			validWriteSet.NsPvtRwset = append(validWriteSet.NsPvtRwset, validNsPvtRwset)
+		}
+	}
+	return validWriteSet, invalidData, nil
+}
+
+// matchesPurgedHashedRwSet returns true if each write in the pvt data of the collection matches a hashed
+// write of the transaction, and each hashed write of the transaction missing from the pvt data is on a
+// key that was purged after the transaction
+func matchesPurgedHashedRwSet(blockNum, txNum uint64, ns string, collPvtRwset *rwset.CollectionPvtReadWriteSet,
+	collHashedRwSet *rwsetutil.CollHashedRwSet, blockStore *ledgerstorage.Store) (bool, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtRwset.Rwset, kvRWSet); err != nil {
+		return false, nil
+	}
+	if len(kvRWSet.Reads) > 0 || len(kvRWSet.RangeQueriesInfo) > 0 {
+		return false, nil
+	}
+
+	// the hashes of the keys written by the transaction that are missing from the pvt data
+	missingKeyHashes := make(map[string]struct{})
+	hashedWrites := make(map[string]*kvrwset.KVWriteHash)
+	for _, hashedWrite := range collHashedRwSet.HashedRwSet.HashedWrites {
+		hashedWrites[string(hashedWrite.KeyHash)] = hashedWrite
+		missingKeyHashes[string(hashedWrite.KeyHash)] = struct{}{}
+	}
+	for _, write := range kvRWSet.Writes {
+		keyHash := string(util.ComputeStringHash(write.Key))
+		hashedWrite, ok := hashedWrites[keyHash]
+		if !ok || hashedWrite.IsDelete != write.IsDelete {
+			return false, nil
+		}
+		if !write.IsDelete && !bytes.Equal(util.ComputeHash(write.Value), hashedWrite.ValueHash) {
+			return false, nil
+		}
+		delete(missingKeyHashes, keyHash)
+	}
+
+	hashedMetadataWrites := make(map[string]bool)
+	for _, hashedMetadataWrite := range collHashedRwSet.HashedRwSet.MetadataWrites {
+		hashedMetadataWrites[string(hashedMetadataWrite.KeyHash)] = true
+		missingKeyHashes[string(hashedMetadataWrite.KeyHash)] = struct{}{}
+	}
+	for _, metadataWrite := range kvRWSet.MetadataWrites {
+		keyHash := string(util.ComputeStringHash(metadataWrite.Key))
+		if !hashedMetadataWrites[keyHash] {
+			return false, nil
+		}
+		delete(missingKeyHashes, keyHash)
+	}
+
+	for keyHash := range missingKeyHashes {
+		if purged, err := blockStore.IsPurgedAfter(ns, collPvtRwset.CollectionName, []byte(keyHash), blockNum, txNum); err != nil || !purged {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func retrieveTxRWSet(envBytes []byte) (*rwsetutil.TxRwSet, error) {
+	action, err := utils.GetActionFromEnvelope(envBytes)
+	if err != nil {
+		return nil, err
+	}
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err := txRWSet.FromProtoBytes(action.Results); err != nil {
+		return nil, err
+	}
+	return txRWSet, nil
+}
+
+func collHashedRwSetInBlock(txRWSet *rwsetutil.TxRwSet, ns, coll string) *rwsetutil.CollHashedRwSet {
+	for _, nsRwSet := range txRWSet.NsRwSets {
+		if nsRwSet.NameSpace != ns {
+			continue
+		}
+		for _, collHashedRwSet := range nsRwSet.CollHashedRwSets {
+			if collHashedRwSet.CollectionName == coll {
+				return collHashedRwSet
+			}
+		}
+	}
+	return nil
+}
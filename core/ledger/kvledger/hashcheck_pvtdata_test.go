@@ -0,0 +1,184 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	btltestutil "github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructValidAndInvalidPvtData(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := ledgerstorage.NewProvider()
+	defer provider.Close()
+	blockStore, err := provider.Open("TestConstructValidAndInvalidPvtData")
+	assert.NoError(t, err)
+	defer blockStore.Shutdown()
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns", "coll-1", 0)
+	cs.SetBTL("ns", "coll-2", 0)
+	blockStore.Init(pvtdatapolicy.ConstructBTLPolicy(cs))
+
+	// tx 0 writes to coll-1 and coll-2, tx 1 writes to coll-1 but is invalid
+	tx0SimRes := pvtSimulationResultsForTest(t, map[string]string{"coll-1": "value-1", "coll-2": "value-2"})
+	tx1SimRes := pvtSimulationResultsForTest(t, map[string]string{"coll-1": "value-3"})
+	tx0PubSimBytes, err := tx0SimRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	tx1PubSimBytes, err := tx1SimRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+
+	bg, gb := testutil.NewBlockGenerator(t, "TestConstructValidAndInvalidPvtData", false)
+	block1 := bg.NextBlock([][]byte{tx0PubSimBytes, tx1PubSimBytes})
+	txFilter := util.TxValidationFlags(block1.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	txFilter.SetFlag(1, peer.TxValidationCode_MVCC_READ_CONFLICT)
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: gb}))
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: block1}))
+
+	// the pvt data of coll-2 is tampered with and an unrelated coll-3 is added
+	tx0PvtWriteSet := tx0SimRes.PvtSimulationResults
+	originalRwset := tx0PvtWriteSet.NsPvtRwset[0].CollectionPvtRwset[1].Rwset
+	tx0PvtWriteSet.NsPvtRwset[0].CollectionPvtRwset[1].Rwset = []byte("tampered-rwset")
+	tx0PvtWriteSet.NsPvtRwset[0].CollectionPvtRwset = append(tx0PvtWriteSet.NsPvtRwset[0].CollectionPvtRwset,
+		pvtSimulationResultsForTest(t, map[string]string{"coll-3": "value-4"}).PvtSimulationResults.NsPvtRwset[0].CollectionPvtRwset[0])
+	blocksPvtData := []*ledger.BlockPvtData{
+		{
+			BlockNum: 1,
+			WriteSets: map[uint64]*ledger.TxPvtData{
+				0: {SeqInBlock: 0, WriteSet: tx0PvtWriteSet},
+				1: {SeqInBlock: 1, WriteSet: tx1SimRes.PvtSimulationResults},
+				5: {SeqInBlock: 5, WriteSet: tx1SimRes.PvtSimulationResults},
+			},
+		},
+	}
+
+	validPvtData, hashMismatches, err := constructValidAndInvalidPvtData(blocksPvtData, blockStore)
+	assert.NoError(t, err)
+
+	// only coll-1 of tx 0 matches the hash in the block
+	assert.Len(t, validPvtData, 1)
+	assert.Equal(t, uint64(1), validPvtData[0].BlockNum)
+	assert.Len(t, validPvtData[0].WriteSets, 1)
+	validTxPvtData := validPvtData[0].WriteSets[0]
+	assert.True(t, validTxPvtData.Has("ns", "coll-1"))
+	assert.False(t, validTxPvtData.Has("ns", "coll-2"))
+	assert.False(t, validTxPvtData.Has("ns", "coll-3"))
+
+	expectedHash := collHashedRwSetInBlock(txRWSetForTest(t, tx0SimRes), "ns", "coll-2").PvtRwSetHash
+	assert.Equal(t, util.ComputeHash(originalRwset), expectedHash)
+	assert.Equal(t, []*ledger.PvtdataHashMismatch{
+		{BlockNum: 1, TxNum: 0, ChaincodeName: "ns", CollectionName: "coll-2", ExpectedHash: expectedHash},
+	}, hashMismatches)
+
+	// the pvt data of a block that is not committed is rejected
+	_, _, err = constructValidAndInvalidPvtData([]*ledger.BlockPvtData{{BlockNum: 2}}, blockStore)
+	assert.Error(t, err)
+}
+
+func TestConstructValidAndInvalidPurgedPvtData(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := ledgerstorage.NewProvider()
+	defer provider.Close()
+	blockStore, err := provider.Open("TestConstructValidAndInvalidPurgedPvtData")
+	assert.NoError(t, err)
+	defer blockStore.Shutdown()
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns", "coll-1", 0)
+	blockStore.Init(pvtdatapolicy.ConstructBTLPolicy(cs))
+
+	// block 1 writes key1 and key2 without its pvt data, which block 2 purges key1 of
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSet("ns", "coll-1", "key1", []byte("value1"))
+	builder.AddToPvtAndHashedWriteSet("ns", "coll-1", "key2", []byte("value2"))
+	writeSimRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	writePubSimBytes, err := writeSimRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	builder = rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSetForPurge("ns", "coll-1", "key1")
+	purgeSimRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	purgePubSimBytes, err := purgeSimRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+
+	bg, gb := testutil.NewBlockGenerator(t, "TestConstructValidAndInvalidPurgedPvtData", false)
+	block1 := bg.NextBlock([][]byte{writePubSimBytes})
+	block2 := bg.NextBlock([][]byte{purgePubSimBytes})
+	purgeMarkers, err := rwsetutil.GetPurgeMarkers(block2)
+	assert.NoError(t, err)
+	assert.Len(t, purgeMarkers, 1)
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: gb}))
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: block1}))
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: block2, PurgeMarkers: purgeMarkers}))
+
+	reconcile := func(collValues map[string][]byte) ([]*ledger.BlockPvtData, []*ledger.PvtdataHashMismatch) {
+		builder := rwsetutil.NewRWSetBuilder()
+		for key, value := range collValues {
+			builder.AddToPvtAndHashedWriteSet("ns", "coll-1", key, value)
+		}
+		simRes, err := builder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		validPvtData, hashMismatches, err := constructValidAndInvalidPvtData([]*ledger.BlockPvtData{
+			{BlockNum: 1, WriteSets: map[uint64]*ledger.TxPvtData{0: {SeqInBlock: 0, WriteSet: simRes.PvtSimulationResults}}},
+		}, blockStore)
+		assert.NoError(t, err)
+		return validPvtData, hashMismatches
+	}
+
+	// the pvt data served by a peer that purged key1 matches the hash of key2
+	validPvtData, hashMismatches := reconcile(map[string][]byte{"key2": []byte("value2")})
+	assert.Empty(t, hashMismatches)
+	assert.Len(t, validPvtData[0].WriteSets, 1)
+	assert.True(t, validPvtData[0].WriteSets[0].Has("ns", "coll-1"))
+
+	// the pvt data as written, before the purge, still matches
+	validPvtData, hashMismatches = reconcile(map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")})
+	assert.Empty(t, hashMismatches)
+	assert.Len(t, validPvtData[0].WriteSets, 1)
+
+	// key2 was not purged, hence it cannot be left out
+	validPvtData, hashMismatches = reconcile(map[string][]byte{"key1": []byte("value1")})
+	assert.Len(t, hashMismatches, 1)
+	assert.Empty(t, validPvtData[0].WriteSets)
+
+	// the value of key2 does not match its hash
+	validPvtData, hashMismatches = reconcile(map[string][]byte{"key2": []byte("tampered-value")})
+	assert.Len(t, hashMismatches, 1)
+	assert.Empty(t, validPvtData[0].WriteSets)
+
+	// key3 is not written by the transaction
+	validPvtData, hashMismatches = reconcile(map[string][]byte{"key2": []byte("value2"), "key3": []byte("value3")})
+	assert.Len(t, hashMismatches, 1)
+	assert.Empty(t, validPvtData[0].WriteSets)
+}
+
+func pvtSimulationResultsForTest(t *testing.T, collValues map[string]string) *ledger.TxSimulationResults {
+	builder := rwsetutil.NewRWSetBuilder()
+	for coll, value := range collValues {
+		builder.AddToPvtAndHashedWriteSet("ns", coll, "key", []byte(value))
+	}
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	return simRes
+}
+
+func txRWSetForTest(t *testing.T, simRes *ledger.TxSimulationResults) *rwsetutil.TxRwSet {
+	txRWSet, err := rwsetutil.TxRwSetFromProtoMsg(simRes.PubSimulationResults)
+	assert.NoError(t, err)
+	return txRWSet
+}
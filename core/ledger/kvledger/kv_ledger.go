@@ -7,7 +7,6 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
-	"fmt"
 	"sync"
 	"time"
 
@@ -20,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr/lockbasedtxmgr"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
@@ -68,6 +68,7 @@ func newKVLedger(
 	if ccEventListener != nil {
 		cceventmgmt.GetMgr().Register(ledgerID, ccEventListener)
 	}
+	l.configHistoryRetriever = configHistoryMgr.GetRetriever(ledgerID, l)
 	btlPolicy := pvtdatapolicy.NewBTLPolicy(l)
 	if err := l.initTxMgr(versionedDB, stateListeners, btlPolicy, bookkeeperProvider); err != nil {
 		return nil, err
@@ -77,7 +78,6 @@ func newKVLedger(
 	if err := l.recoverDBs(); err != nil {
 		panic(errors.WithMessage(err, "error during state DB recovery"))
 	}
-	l.configHistoryRetriever = configHistoryMgr.GetRetriever(ledgerID, l)
 	return l, nil
 }
 
@@ -262,6 +262,9 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 	if err != nil {
 		return err
 	}
+	if pvtdataAndBlock.PurgeMarkers, err = rwsetutil.GetPurgeMarkers(block); err != nil {
+		return err
+	}
 	elapsedStateValidation := time.Since(startStateValidation) / time.Millisecond // duration in ms
 
 	startCommitBlockStorage := time.Now()
@@ -304,6 +307,11 @@ func (l *kvLedger) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledge
 	return l.blockStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// GetMissingPvtDataStats returns the number of the private write sets, not yet expired, that are missing on the peer
+func (l *kvLedger) GetMissingPvtDataStats() (*ledger.MissingPvtDataStats, error) {
+	return l.blockStore.GetMissingPvtDataStats()
+}
+
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 // The pvt data is filtered by the list of 'collections' supplied
 func (l *kvLedger) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
@@ -338,8 +346,29 @@ func (l *kvLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, e
 	return l.configHistoryRetriever, nil
 }
 
+// CommitPvtData commits the private data corresponding to the already committed blocks. The private data
+// that does not match the hashes present in the blocks is not committed and is returned as mismatches
 func (l *kvLedger) CommitPvtData(pvtData []*ledger.BlockPvtData) ([]*ledger.PvtdataHashMismatch, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	// the write lock keeps a block commit from interleaving with the commit of the pvt data of the old blocks
+	l.blockAPIsRWLock.Lock()
+	defer l.blockAPIsRWLock.Unlock()
+
+	validPvtData, hashMismatches, err := constructValidAndInvalidPvtData(pvtData, l.blockStore)
+	if err != nil {
+		return nil, err
+	}
+
+	// The state database is updated first. If the peer crashes before the pvt data store is updated,
+	// the pvt data is still recorded as missing and is fetched again, and the state update is idempotent
+	logger.Debugf("[%s] Committing pvt data of [%d] old blocks to the state database", l.ledgerID, len(validPvtData))
+	if err := l.txtmgmt.CommitPvtDataOfOldBlocks(validPvtData); err != nil {
+		return nil, err
+	}
+	logger.Debugf("[%s] Committing pvt data of [%d] old blocks to the pvt data store", l.ledgerID, len(validPvtData))
+	if err := l.blockStore.CommitPvtDataOfOldBlocks(validPvtData); err != nil {
+		return nil, err
+	}
+	return hashMismatches, nil
 }
 
 func (l *kvLedger) GetMissingPvtDataTracker() (ledger.MissingPvtDataTracker, error) {
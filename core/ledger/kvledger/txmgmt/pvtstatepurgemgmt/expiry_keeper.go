@@ -41,6 +41,8 @@ type expiryKeeper interface {
 	updateBookkeeping(toTrack []*expiryInfo, toClear []*expiryInfoKey) error
 	// retrieve returns the keys info that are supposed to be expired by the given block number
 	retrieve(expiringAtBlkNum uint64) ([]*expiryInfo, error)
+	// retrieveByExpiryKey returns the keys info for the given expiry key, an empty keys info is returned if none is found
+	retrieveByExpiryKey(expiryKey *expiryInfoKey) (*expiryInfo, error)
 }
 
 func newExpiryKeeper(ledgerid string, provider bookkeeping.Provider) expiryKeeper {
@@ -95,6 +97,18 @@ func (ek *expKeeper) retrieve(expiringAtBlkNum uint64) ([]*expiryInfo, error) {
 	return listExpinfo, nil
 }
 
+func (ek *expKeeper) retrieveByExpiryKey(expiryKey *expiryInfoKey) (*expiryInfo, error) {
+	key := encodeExpiryInfoKey(expiryKey)
+	value, err := ek.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return &expiryInfo{expiryInfoKey: expiryKey, pvtdataKeys: newPvtdataKeys()}, nil
+	}
+	return decodeExpiryInfo(key, value)
+}
+
 func encodeKV(expinfo *expiryInfo) (key []byte, value []byte, err error) {
 	key = encodeExpiryInfoKey(expinfo.expiryInfoKey)
 	value, err = encodeExpiryInfoValue(expinfo.pvtdataKeys)
@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 )
 
 // PurgeMgr manages purging of the expired pvtdata
@@ -29,6 +30,9 @@ type PurgeMgr interface {
 		hashedUpdates *privacyenabledstate.HashedUpdateBatch) error
 	// BlockCommitDone is a callback to the PurgeMgr when the block is committed to the ledger
 	BlockCommitDone() error
+	// UpdateBookkeepingForPvtDataOfOldBlocks updates the bookkeeping for the pvtdata of the already committed
+	// blocks, which is added to the state later, so that the pvtdata keys are purged along with the key hashes
+	UpdateBookkeepingForPvtDataOfOldBlocks(pvtUpdates *privacyenabledstate.PvtUpdateBatch) error
 }
 
 type keyAndVersion struct {
@@ -129,6 +133,49 @@ func (p *purgeMgr) DeleteExpiredAndUpdateBookkeeping(
 	return p.expKeeper.updateBookkeeping(listExpiryInfo, nil)
 }
 
+// UpdateBookkeepingForPvtDataOfOldBlocks implements function in the interface 'PurgeMgr'
+func (p *purgeMgr) UpdateBookkeepingForPvtDataOfOldBlocks(pvtUpdates *privacyenabledstate.PvtUpdateBatch) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	builder := newExpiryScheduleBuilder(p.btlPolicy)
+	for pvtUpdateKey, vv := range pvtUpdates.ToCompositeKeyMap() {
+		keyHash := util.ComputeStringHash(pvtUpdateKey.Key)
+		if err := builder.add(pvtUpdateKey.Namespace, pvtUpdateKey.CollectionName, pvtUpdateKey.Key, keyHash, vv); err != nil {
+			return err
+		}
+	}
+
+	// The expiry entries for the key hashes were added with the commit of the blocks. The pvtdata keys are
+	// merged into these entries. If the keys are expiring with the next block, for which the working set
+	// may have been prepared already, the keys are added to the working set as well
+	var listExpiryInfo []*expiryInfo
+	for _, toMerge := range builder.getExpiryInfo() {
+		expinfo, err := p.expKeeper.retrieveByExpiryKey(toMerge.expiryInfoKey)
+		if err != nil {
+			return err
+		}
+		for ns, colls := range toMerge.pvtdataKeys.Map {
+			for coll, keysAndHashes := range colls.Map {
+				for _, keyAndHash := range keysAndHashes.List {
+					expinfo.pvtdataKeys.add(ns, coll, keyAndHash.Key, keyAndHash.Hash)
+				}
+			}
+		}
+		listExpiryInfo = append(listExpiryInfo, expinfo)
+		if p.workingset != nil && p.workingset.err == nil && p.workingset.expiringBlk == toMerge.expiryInfoKey.expiryBlk {
+			if p.workingset.toPurge == nil {
+				p.workingset.toPurge = make(expiryInfoMap)
+			}
+			for compositeKey, keyAndVersion := range transformToExpiryInfoMap([]*expiryInfo{toMerge}) {
+				p.workingset.toPurge[compositeKey] = keyAndVersion
+			}
+			p.workingset.toClearFromSchedule = append(p.workingset.toClearFromSchedule, toMerge.expiryInfoKey)
+		}
+	}
+	return p.expKeeper.updateBookkeeping(listExpiryInfo, nil)
+}
+
 // BlockCommitDone implements function in the interface 'PurgeMgr'
 // These orphan entries for purge-schedule can be cleared off in bulk in a separate background routine as well
 // If we maintian the following logic (i.e., clear off entries just after block commit), we need a TODO -
@@ -211,6 +211,47 @@ func TestOnlyHashDeleteBeforeExpiryBlock(t *testing.T) {
 	testHelper.checkPvtdataDoesNotExist("ns", "coll", "pvtkey")
 }
 
+func TestPvtDataOfOldBlocksExpiry(t *testing.T) {
+	dbEnv := &privacyenabledstate.LevelDBCommonStorageTestEnv{}
+	ledgerid := "testledger-perge-mgr"
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns", "coll", 1) // expiry block = committing block + 2
+	btlPolicy := pvtdatapolicy.ConstructBTLPolicy(cs)
+	helper := &testHelper{}
+	helper.init(t, ledgerid, btlPolicy, dbEnv)
+	defer helper.cleanup()
+
+	// block-1 updates: the pvt data of both keys is missing, only the hashes are committed
+	block1Updates := privacyenabledstate.NewUpdateBatch()
+	putHashUpdates(block1Updates, "ns", "coll", "pvtkey1", []byte("pvtvalue-1"), version.NewHeight(1, 1))
+	putHashUpdates(block1Updates, "ns", "coll", "pvtkey2", []byte("pvtvalue-2"), version.NewHeight(1, 2))
+	helper.commitUpdatesForTesting(1, block1Updates)
+	helper.checkExpiryEntryExistsForBlockNum(3, 1)
+
+	// the pvt data of pvtkey1 arrives before block-2
+	helper.commitPvtDataOfOldBlocksForTesting("ns", "coll", "pvtkey1", []byte("pvtvalue-1"), version.NewHeight(1, 1))
+	helper.checkPvtdataExists("ns", "coll", "pvtkey1", []byte("pvtvalue-1"))
+	helper.checkExpiryEntryExistsForBlockNum(3, 1)
+
+	// block-2 update: no Updates
+	helper.commitUpdatesForTesting(2, privacyenabledstate.NewUpdateBatch())
+
+	// the pvt data of pvtkey2 arrives after the keys expiring with block-3 have been prepared
+	helper.purgeMgr.PrepareForExpiringKeys(3)
+	helper.commitPvtDataOfOldBlocksForTesting("ns", "coll", "pvtkey2", []byte("pvtvalue-2"), version.NewHeight(1, 2))
+	helper.checkPvtdataExists("ns", "coll", "pvtkey2", []byte("pvtvalue-2"))
+
+	// block-3 update: no Updates, both the pvt keys are purged along with the key hashes
+	noPvtdataUpdates := privacyenabledstate.NewUpdateBatch()
+	assert.NoError(t, helper.purgeMgr.DeleteExpiredAndUpdateBookkeeping(noPvtdataUpdates.PvtUpdates, noPvtdataUpdates.HashUpdates))
+	assert.NoError(t, helper.db.ApplyPrivacyAwareUpdates(noPvtdataUpdates, version.NewHeight(3, 1)))
+	helper.db.ClearCachedVersions()
+	helper.purgeMgr.BlockCommitDone()
+	helper.checkPvtdataDoesNotExist("ns", "coll", "pvtkey1")
+	helper.checkPvtdataDoesNotExist("ns", "coll", "pvtkey2")
+	helper.checkNoExpiryEntryExistsForBlockNum(3)
+}
+
 type testHelper struct {
 	t              *testing.T
 	bookkeepingEnv *bookkeeping.TestEnv
@@ -245,6 +286,16 @@ func (h *testHelper) commitUpdatesForTesting(blkNum uint64, updates *privacyenab
 	h.purgeMgr.BlockCommitDone()
 }
 
+func (h *testHelper) commitPvtDataOfOldBlocksForTesting(ns, coll, key string, value []byte, ver *version.Height) {
+	updates := privacyenabledstate.NewUpdateBatch()
+	updates.PvtUpdates.Put(ns, coll, key, value, ver)
+	assert.NoError(h.t, h.purgeMgr.UpdateBookkeepingForPvtDataOfOldBlocks(updates.PvtUpdates))
+	savepoint, err := h.db.GetLatestSavePoint()
+	assert.NoError(h.t, err)
+	assert.NoError(h.t, h.db.ApplyPrivacyAwareUpdates(updates, savepoint))
+	h.db.ClearCachedVersions()
+}
+
 func (h *testHelper) checkPvtdataExists(ns, coll, key string, value []byte) {
 	vv, _ := h.fetchPvtdataFronDB(ns, coll, key)
 	vv, hashVersion := h.fetchPvtdataFronDB(ns, coll, key)
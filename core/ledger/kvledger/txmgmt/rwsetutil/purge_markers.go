@@ -0,0 +1,67 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rwsetutil
+
+import (
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	putils "github.com/hyperledger/fabric/protos/utils"
+)
+
+// GetPurgeMarkers returns the purge markers recorded in the hashed write sets of the
+// valid endorser transactions of the given block. The validation flags of the block are
+// expected to be final, i.e., this function is to be invoked on a validated block
+func GetPurgeMarkers(block *common.Block) ([]*ledger.PurgeMarker, error) {
+	var purgeMarkers []*ledger.PurgeMarker
+	txsFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+
+	for txNum, envBytes := range block.Data.Data {
+		if txsFilter.IsInvalid(txNum) {
+			continue
+		}
+		env, err := putils.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := putils.GetPayload(env)
+		if err != nil {
+			return nil, err
+		}
+		chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil {
+			return nil, err
+		}
+		if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+			continue
+		}
+		respPayload, err := putils.GetActionFromEnvelope(envBytes)
+		if err != nil {
+			return nil, err
+		}
+		txRWSet := &TxRwSet{}
+		if err := txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+			return nil, err
+		}
+		for _, nsRWSet := range txRWSet.NsRwSets {
+			for _, collHashedRWSet := range nsRWSet.CollHashedRwSets {
+				for _, hashedWrite := range collHashedRWSet.HashedRwSet.HashedWrites {
+					if !hashedWrite.IsPurge {
+						continue
+					}
+					purgeMarkers = append(purgeMarkers, &ledger.PurgeMarker{
+						SeqInBlock: uint64(txNum),
+						Namespace:  nsRWSet.NameSpace,
+						Collection: collHashedRWSet.CollectionName,
+						KeyHash:    hashedWrite.KeyHash,
+					})
+				}
+			}
+		}
+	}
+	return purgeMarkers, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rwsetutil
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPurgeMarkers(t *testing.T) {
+	var simulationResults [][]byte
+
+	// tx 0 purges a key and writes another one
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key1")
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key2", []byte("value2"))
+	simRes, err := rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubSimResBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	simulationResults = append(simulationResults, pubSimResBytes)
+
+	// tx 1 purges a key but is invalid
+	rwSetBuilder = NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key3")
+	simRes, err = rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubSimResBytes, err = simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	simulationResults = append(simulationResults, pubSimResBytes)
+
+	// tx 2 purges a key in another namespace and collection
+	rwSetBuilder = NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns2", "coll2", "key4")
+	simRes, err = rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubSimResBytes, err = simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	simulationResults = append(simulationResults, pubSimResBytes)
+
+	block := testutil.ConstructBlock(t, 1, []byte("previousHash"), simulationResults, false)
+	txsFilter := util.NewTxValidationFlagsSetValue(len(block.Data.Data), peer.TxValidationCode_VALID)
+	txsFilter.SetFlag(1, peer.TxValidationCode_MVCC_READ_CONFLICT)
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = txsFilter
+
+	purgeMarkers, err := GetPurgeMarkers(block)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ledger.PurgeMarker{
+		{SeqInBlock: 0, Namespace: "ns1", Collection: "coll1", KeyHash: util.ComputeStringHash("key1")},
+		{SeqInBlock: 2, Namespace: "ns2", Collection: "coll2", KeyHash: util.ComputeStringHash("key4")},
+	}, purgeMarkers)
+}
+
+func TestGetPurgeMarkersMalformedTx(t *testing.T) {
+	block := testutil.ConstructBlock(t, 1, []byte("previousHash"), [][]byte{[]byte("malformed")}, false)
+	block.Data.Data[0] = []byte("malformed")
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = util.NewTxValidationFlagsSetValue(1, peer.TxValidationCode_VALID)
+	_, err := GetPurgeMarkers(block)
+	assert.Error(t, err)
+}
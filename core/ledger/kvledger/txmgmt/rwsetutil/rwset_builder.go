@@ -48,6 +48,7 @@ type collHashRwBuilder struct {
 	readMap          map[string]*kvrwset.KVReadHash
 	writeMap         map[string]*kvrwset.KVWriteHash
 	metadataWriteMap map[string]*kvrwset.KVMetadataWriteHash
+	purgeKeys        map[string]bool
 	pvtDataHash      []byte
 }
 
@@ -116,6 +117,14 @@ func (b *RWSetBuilder) AddToPvtAndHashedWriteSet(ns string, coll string, key str
 	b.getOrCreateCollHashedRwBuilder(ns, coll).writeMap[key] = kvWriteHash
 }
 
+// AddToPvtAndHashedWriteSetForPurge adds a delete of the key to the private and hashed
+// write-set and marks the hashed write as a purge of all the previous versions of the key.
+// A later write to the key in the same transaction keeps the purge marker
+func (b *RWSetBuilder) AddToPvtAndHashedWriteSetForPurge(ns, coll, key string) {
+	b.AddToPvtAndHashedWriteSet(ns, coll, key, nil)
+	b.getOrCreateCollHashedRwBuilder(ns, coll).purgeKeys[key] = true
+}
+
 // AddToHashedMetadataWriteSet adds a metadata to a key in the hashed write-set
 func (b *RWSetBuilder) AddToHashedMetadataWriteSet(ns, coll, key string, metadata map[string][]byte) {
 	// pvt write set just need the key; not the entire metadata. The metadata is stored only
@@ -242,6 +251,9 @@ func (b *collHashRwBuilder) build() *CollHashedRwSet {
 	var writeSet []*kvrwset.KVWriteHash
 	var metadataWriteSet []*kvrwset.KVMetadataWriteHash
 
+	for key := range b.purgeKeys {
+		b.writeMap[key].IsPurge = true
+	}
 	util.GetValuesBySortedKeys(&(b.readMap), &readSet)
 	util.GetValuesBySortedKeys(&(b.writeMap), &writeSet)
 	util.GetValuesBySortedKeys(&(b.metadataWriteMap), &metadataWriteSet)
@@ -330,6 +342,7 @@ func newCollHashRwBuilder(collName string) *collHashRwBuilder {
 		make(map[string]*kvrwset.KVReadHash),
 		make(map[string]*kvrwset.KVWriteHash),
 		make(map[string]*kvrwset.KVMetadataWriteHash),
+		make(map[string]bool),
 		nil,
 	}
 }
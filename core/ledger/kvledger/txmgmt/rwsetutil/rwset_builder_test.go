@@ -215,6 +215,54 @@ func TestTxSimulationResultWithPvtData(t *testing.T) {
 	assert.Equal(t, expectedPubRWSet, actualSimRes.PubSimulationResults)
 }
 
+func TestTxSimulationResultWithPurge(t *testing.T) {
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key1")
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key2")
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key2", []byte("value2"))
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key3", []byte("value3"))
+
+	actualSimRes, err := rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+
+	// the pvt rwset carries a delete for the purged key
+	pvt_Ns1_Coll1 := &kvrwset.KVRWSet{
+		Writes: []*kvrwset.KVWrite{
+			newKVWrite("key1", nil),
+			newKVWrite("key2", []byte("value2")),
+			newKVWrite("key3", []byte("value3")),
+		},
+	}
+	expectedPvtRWSet := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "ns1",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{
+						CollectionName: "coll1",
+						Rwset:          serializeTestProtoMsg(t, pvt_Ns1_Coll1),
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedPvtRWSet, actualSimRes.PvtSimulationResults)
+
+	// the hashed rwset marks the purged keys, including the one written after the purge
+	txRWSet, err := TxRwSetFromProtoMsg(actualSimRes.PubSimulationResults)
+	assert.NoError(t, err)
+	hashedWrites := txRWSet.NsRwSets[0].CollHashedRwSets[0].HashedRwSet.HashedWrites
+	assert.Len(t, hashedWrites, 3)
+	purgedKeyHashes := map[string]bool{}
+	for _, hashedWrite := range hashedWrites {
+		purgedKeyHashes[string(hashedWrite.KeyHash)] = hashedWrite.IsPurge
+	}
+	assert.True(t, purgedKeyHashes[string(util.ComputeStringHash("key1"))])
+	assert.True(t, purgedKeyHashes[string(util.ComputeStringHash("key2"))])
+	assert.False(t, purgedKeyHashes[string(util.ComputeStringHash("key3"))])
+}
+
 func TestTxSimulationResultWithMetadata(t *testing.T) {
 	rwSetBuilder := NewRWSetBuilder()
 	// public rws ns1
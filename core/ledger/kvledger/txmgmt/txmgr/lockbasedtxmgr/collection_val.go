@@ -28,6 +28,10 @@ func newCollNameValidator(queryHelper *queryHelper) *collNameValidator {
 }
 
 func (v *collNameValidator) validateCollName(ns, coll string) error {
+	// implicit collections exist in every namespace without being defined
+	if isImplicit, _ := privdata.MspIDIfImplicitCollection(coll); isImplicit {
+		return nil
+	}
 	if !v.cache.isPopulatedFor(ns) {
 		conf, err := v.retrieveCollConfigFromStateDB(ns)
 		if err != nil {
@@ -49,6 +49,13 @@ func TestCollectionValidation(t *testing.T) {
 
 	err = sim.SetPrivateData("ns1", "coll1", "key1", []byte("val1"))
 	assert.NoError(t, err)
+
+	// implicit collections need no collection config
+	err = sim.SetPrivateData("ns1", "_implicit_org_Org1MSP", "key1", []byte("val1"))
+	assert.NoError(t, err)
+
+	err = sim.SetPrivateData("ns3", "_implicit_org_Org1MSP", "key1", []byte("val1"))
+	assert.NoError(t, err)
 }
 
 func TestPvtGetNoCollection(t *testing.T) {
@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package lockbasedtxmgr
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/flogging"
@@ -14,10 +15,12 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/pvtstatepurgemgmt"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/queryutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/valimpl"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 )
@@ -193,6 +196,82 @@ func (txmgr *LockBasedTxMgr) Commit() error {
 	return nil
 }
 
+// CommitPvtDataOfOldBlocks implements method in interface `txmgmt.TxMgr`
+// The pvtdata is expected to match the hashes present in the corresponding blocks. A private write is added
+// to the state only if the hashed state still holds the hash of the written value, i.e., the key has not been
+// updated, deleted, or purged since. The version of the key is taken from the hashed state
+func (txmgr *LockBasedTxMgr) CommitPvtDataOfOldBlocks(blocksPvtData []*ledger.BlockPvtData) error {
+	txmgr.commitRWLock.Lock()
+	defer txmgr.commitRWLock.Unlock()
+
+	batch := privacyenabledstate.NewUpdateBatch()
+	for _, blockPvtData := range blocksPvtData {
+		for _, txPvtData := range blockPvtData.WriteSets {
+			if txPvtData.WriteSet == nil {
+				continue
+			}
+			pvtRWSet, err := rwsetutil.TxPvtRwSetFromProtoMsg(txPvtData.WriteSet)
+			if err != nil {
+				return err
+			}
+			if err := txmgr.addPvtWritesMatchingHashedState(batch.PvtUpdates, pvtRWSet); err != nil {
+				return err
+			}
+		}
+	}
+	if batch.PvtUpdates.IsEmpty() {
+		logger.Debugf("No pvt data of the old blocks is to be committed to the state database")
+		return nil
+	}
+
+	if err := txmgr.pvtdataPurgeMgr.UpdateBookkeepingForPvtDataOfOldBlocks(batch.PvtUpdates); err != nil {
+		return err
+	}
+	// the savepoint is kept as is, as no new block is committed
+	savepoint, err := txmgr.db.GetLatestSavePoint()
+	if err != nil {
+		return err
+	}
+	defer txmgr.clearCache()
+	if err := txmgr.db.ApplyPrivacyAwareUpdates(batch, savepoint); err != nil {
+		return err
+	}
+	logger.Debugf("Committed the pvt data of the old blocks to the state database")
+	return nil
+}
+
+func (txmgr *LockBasedTxMgr) addPvtWritesMatchingHashedState(pvtUpdates *privacyenabledstate.PvtUpdateBatch, pvtRWSet *rwsetutil.TxPvtRwSet) error {
+	for _, nsPvtRwSet := range pvtRWSet.NsPvtRwSet {
+		ns := nsPvtRwSet.NameSpace
+		for _, collPvtRwSet := range nsPvtRwSet.CollPvtRwSets {
+			coll := collPvtRwSet.CollectionName
+			for _, kvWrite := range collPvtRwSet.KvRwSet.Writes {
+				if kvWrite.IsDelete {
+					// the key is either deleted in the hashed state as well or has been written afterwards
+					continue
+				}
+				hashedVal, err := txmgr.db.GetValueHash(ns, coll, util.ComputeStringHash(kvWrite.Key))
+				if err != nil {
+					return err
+				}
+				if hashedVal == nil || !bytes.Equal(hashedVal.Value, util.ComputeHash(kvWrite.Value)) {
+					logger.Debugf("Skipping the stale pvt data for key [%s] in namespace [%s], collection [%s]", kvWrite.Key, ns, coll)
+					continue
+				}
+				committedVal, err := txmgr.db.GetPrivateData(ns, coll, kvWrite.Key)
+				if err != nil {
+					return err
+				}
+				if committedVal != nil && version.AreSame(committedVal.Version, hashedVal.Version) {
+					continue
+				}
+				pvtUpdates.Put(ns, coll, kvWrite.Key, kvWrite.Value, hashedVal.Version)
+			}
+		}
+	}
+	return nil
+}
+
 // Rollback implements method in interface `txmgmt.TxMgr`
 func (txmgr *LockBasedTxMgr) Rollback() {
 	txmgr.reset()
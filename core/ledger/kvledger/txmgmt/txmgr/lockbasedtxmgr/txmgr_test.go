@@ -17,6 +17,8 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
@@ -1102,6 +1104,70 @@ func TestTxSimulatorMissingPvtdataExpiry(t *testing.T) {
 	simulator.Done()
 }
 
+func TestCommitPvtDataOfOldBlocks(t *testing.T) {
+	ledgerid := "TestCommitPvtDataOfOldBlocks"
+	testEnv := testEnvs[0]
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns", "coll", 0)
+	testEnv.init(t, ledgerid, pvtdatapolicy.ConstructBTLPolicy(cs))
+	defer testEnv.cleanup()
+	txMgr := testEnv.getTxMgr()
+	db := testEnv.getVDB()
+
+	// block 1 commits only the hashes of key1, key2 and key3
+	updateBatch := privacyenabledstate.NewUpdateBatch()
+	updateBatch.HashUpdates.Put("ns", "coll", util.ComputeStringHash("key1"), util.ComputeStringHash("value1"), version.NewHeight(1, 1))
+	updateBatch.HashUpdates.Put("ns", "coll", util.ComputeStringHash("key2"), util.ComputeStringHash("value2"), version.NewHeight(1, 1))
+	updateBatch.HashUpdates.Put("ns", "coll", util.ComputeStringHash("key3"), util.ComputeStringHash("value3"), version.NewHeight(1, 1))
+	assert.NoError(t, db.ApplyPrivacyAwareUpdates(updateBatch, version.NewHeight(1, 1)))
+
+	// block 2 updates key2 and deletes key3
+	updateBatch = privacyenabledstate.NewUpdateBatch()
+	updateBatch.HashUpdates.Put("ns", "coll", util.ComputeStringHash("key2"), util.ComputeStringHash("value2-new"), version.NewHeight(2, 1))
+	updateBatch.PvtUpdates.Put("ns", "coll", "key2", []byte("value2-new"), version.NewHeight(2, 1))
+	updateBatch.HashUpdates.Delete("ns", "coll", util.ComputeStringHash("key3"), version.NewHeight(2, 1))
+	assert.NoError(t, db.ApplyPrivacyAwareUpdates(updateBatch, version.NewHeight(2, 1)))
+
+	// the pvt data of block 1 arrives later
+	rwsetBuilder := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder.AddToPvtAndHashedWriteSet("ns", "coll", "key1", []byte("value1"))
+	rwsetBuilder.AddToPvtAndHashedWriteSet("ns", "coll", "key2", []byte("value2"))
+	rwsetBuilder.AddToPvtAndHashedWriteSet("ns", "coll", "key3", []byte("value3"))
+	simRes, err := rwsetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	blocksPvtData := []*ledger.BlockPvtData{
+		{
+			BlockNum: 1,
+			WriteSets: map[uint64]*ledger.TxPvtData{
+				1: {SeqInBlock: 1, WriteSet: simRes.PvtSimulationResults},
+			},
+		},
+	}
+	assert.NoError(t, txMgr.CommitPvtDataOfOldBlocks(blocksPvtData))
+
+	// only key1 still holds the hash of the value written in block 1
+	vv, err := db.GetPrivateData("ns", "coll", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, &statedb.VersionedValue{Value: []byte("value1"), Version: version.NewHeight(1, 1)}, vv)
+	vv, err = db.GetPrivateData("ns", "coll", "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2-new"), vv.Value)
+	vv, err = db.GetPrivateData("ns", "coll", "key3")
+	assert.NoError(t, err)
+	assert.Nil(t, vv)
+
+	// the savepoint is not changed
+	savepoint, err := db.GetLatestSavePoint()
+	assert.NoError(t, err)
+	assert.Equal(t, version.NewHeight(2, 1), savepoint)
+
+	// committing the same pvt data again is a no-op
+	assert.NoError(t, txMgr.CommitPvtDataOfOldBlocks(blocksPvtData))
+	vv, err = db.GetPrivateData("ns", "coll", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), vv.Value)
+}
+
 func TestTxWithPubMetadata(t *testing.T) {
 	for _, testEnv := range testEnvs {
 		t.Logf("Running test for TestEnv = %s", testEnv.getName())
@@ -193,6 +193,9 @@ type TxSimulator interface {
 	SetPrivateDataMultipleKeys(namespace, collection string, kvs map[string][]byte) error
 	// DeletePrivateData deletes the given tuple <namespace, collection, key> from private data
 	DeletePrivateData(namespace, collection, key string) error
+	// PurgePrivateData deletes the given tuple <namespace, collection, key> from private data and, once the
+	// transaction commits, removes all the previous versions of the key from the private data of the peers
+	PurgePrivateData(namespace, collection, key string) error
 	// SetPrivateDataMetadata sets the metadata associated with an existing key-tuple <namespace, collection, key>
 	SetPrivateDataMetadata(namespace, collection, key string, metadata map[string][]byte) error
 	// DeletePrivateDataMetadata deletes the metadata associated with an existing key-tuple <namespace, collection, key>
@@ -238,12 +241,26 @@ type MissingPrivateDataList struct {
 	List []*MissingPrivateData
 }
 
+// PurgeMarker represents the purge of a private data key by the transaction
+// at position SeqInBlock of a block. All the versions of the key committed
+// before this transaction are to be removed from the private data of the peer.
+// Only the hash of the key is recorded in the block
+type PurgeMarker struct {
+	SeqInBlock uint64
+	Namespace  string
+	Collection string
+	KeyHash    []byte
+}
+
 // BlockAndPvtData encapsulates the block and a map that contains the tuples <seqInBlock, *TxPvtData>
 // The map is expected to contain the entries only for the transactions that has associated pvt data
 type BlockAndPvtData struct {
 	Block        *common.Block
 	BlockPvtData map[uint64]*TxPvtData
 	Missing      *MissingPrivateDataList
+	// PurgeMarkers is populated by the ledger, upon the validation of the block, with
+	// the private data keys purged by the valid transactions of the block
+	PurgeMarkers []*PurgeMarker
 }
 
 // BlockPvtData contains the private data for a block
@@ -367,6 +384,17 @@ type ConfigHistoryRetriever interface {
 // MissingPvtDataTracker allows getting information about the private data that is not missing on the peer
 type MissingPvtDataTracker interface {
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlocks int) (MissingPvtDataInfo, error)
+	// GetMissingPvtDataStats returns the number of the private write sets, not yet expired, that are missing on the peer
+	GetMissingPvtDataStats() (*MissingPvtDataStats, error)
+}
+
+// MissingPvtDataStats contains the number of the private write sets missing on the peer
+type MissingPvtDataStats struct {
+	// Eligible is the number of missing private write sets of the collections the peer is a member of,
+	// which are yet to be reconciled
+	Eligible uint64
+	// Ineligible is the number of missing private write sets of the collections the peer is not a member of
+	Ineligible uint64
 }
 
 // MissingPvtDataInfo is a map of block number to MissingBlockPvtdataInfo
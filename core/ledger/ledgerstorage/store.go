@@ -21,7 +21,6 @@ import (
 )
 
 var logger = flogging.MustGetLogger("ledgerstorage")
-var isMissingDataReconEnabled = false
 
 // Provider encapusaltes two providers 1) block store provider and 2) and pvt data store provider
 type Provider struct {
@@ -89,13 +88,6 @@ func (s *Store) Init(btlPolicy pvtdatapolicy.BTLPolicy) {
 // CommitWithPvtData commits the block and the corresponding pvt data in an atomic operation
 func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error {
 	blockNum := blockAndPvtdata.Block.Header.Number
-	missingDataList := blockAndPvtdata.Missing
-
-	if !isMissingDataReconEnabled {
-		// should not store any entries for missing data
-		missingDataList = nil
-	}
-
 	s.rwlock.Lock()
 	defer s.rwlock.Unlock()
 
@@ -113,7 +105,7 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 		for _, v := range blockAndPvtdata.BlockPvtData {
 			pvtdata = append(pvtdata, v)
 		}
-		if err := s.pvtdataStore.Prepare(blockAndPvtdata.Block.Header.Number, pvtdata, missingDataList); err != nil {
+		if err := s.pvtdataStore.Prepare(blockAndPvtdata.Block.Header.Number, pvtdata, blockAndPvtdata.Missing, blockAndPvtdata.PurgeMarkers); err != nil {
 			return err
 		}
 		writtenToPvtStore = true
@@ -132,6 +124,22 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 	return nil
 }
 
+// CommitPvtDataOfOldBlocks commits the pvt data of the blocks that were committed without it. The pvt
+// data is expected to have been checked against the hashes present in the corresponding blocks
+func (s *Store) CommitPvtDataOfOldBlocks(blocksPvtData []*ledger.BlockPvtData) error {
+	s.rwlock.Lock()
+	defer s.rwlock.Unlock()
+	return s.pvtdataStore.CommitPvtDataOfOldBlocks(blocksPvtData)
+}
+
+// IsPurgedAfter returns true if the key of the given hash in the collection was purged by
+// a transaction committed after the transaction `txNum` of the block `blockNum`
+func (s *Store) IsPurgedAfter(ns, coll string, keyHash []byte, blockNum, txNum uint64) (bool, error) {
+	s.rwlock.RLock()
+	defer s.rwlock.RUnlock()
+	return s.pvtdataStore.IsPurgedAfter(ns, coll, keyHash, blockNum, txNum)
+}
+
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 // The pvt data is filtered by the list of 'collections' supplied
 func (s *Store) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
@@ -179,6 +187,12 @@ func (s *Store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 	return s.pvtdataStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// GetMissingPvtDataStats returns the number of the private write sets, not yet expired, that are missing on the peer
+func (s *Store) GetMissingPvtDataStats() (*ledger.MissingPvtDataStats, error) {
+	// as in the case of GetMissingPvtDataInfoForMostRecentBlocks, a read lock is not acquired
+	return s.pvtdataStore.GetMissingPvtDataStats()
+}
+
 // init first invokes function `initFromExistingBlockchain`
 // in order to check whether the pvtdata store is present because of an upgrade
 // of peer from 1.0 and need to be updated with the existing blockchain. If, this is
@@ -28,66 +28,128 @@ type BTLPolicy interface {
 
 // LSCCBasedBTLPolicy implements interface BTLPolicy.
 // This implementation loads the BTL policy from lscc namespace which is populated
-// with the collection configuration during chaincode initialization
+// with the collection configuration during chaincode initialization. As the collection
+// configuration can be updated, the BTL of the data committed in a block is taken from
+// the collection configuration that was in force at that block, as recorded in the
+// collection config history
 type LSCCBasedBTLPolicy struct {
 	collectionStore privdata.CollectionStore
+	configHistory   ConfigHistorySupport
 	cache           map[btlkey]uint64
 	lock            sync.Mutex
 }
 
+// ConfigHistorySupport provides access to the history of the collection configurations
+type ConfigHistorySupport interface {
+	// GetConfigHistoryRetriever returns the ConfigHistoryRetriever of the ledger
+	GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, error)
+}
+
+// btlkey identifies the BTL of a collection in the version of the collection
+// configuration committed at configBlockNum. A zero configBlockNum denotes the
+// version in the state, used when the config history has no entry for the collection
 type btlkey struct {
-	ns   string
-	coll string
+	ns             string
+	coll           string
+	configBlockNum uint64
 }
 
 // NewBTLPolicy constructs an instance of LSCCBasedBTLPolicy
 func NewBTLPolicy(ledger ledger.PeerLedger) BTLPolicy {
-	return ConstructBTLPolicy(privdata.NewSimpleCollectionStore(&collectionSupport{lgr: ledger}))
+	return ConstructVersionedBTLPolicy(privdata.NewSimpleCollectionStore(&collectionSupport{lgr: ledger}), ledger)
 }
 
-// ConstructBTLPolicy constructs an instance of LSCCBasedBTLPolicy
+// ConstructBTLPolicy constructs an instance of LSCCBasedBTLPolicy that always
+// uses the collection configuration in the state
 func ConstructBTLPolicy(collectionStore privdata.CollectionStore) BTLPolicy {
+	return ConstructVersionedBTLPolicy(collectionStore, nil)
+}
+
+// ConstructVersionedBTLPolicy constructs an instance of LSCCBasedBTLPolicy that uses
+// the collection configuration in force at each block, as recorded in the config history
+func ConstructVersionedBTLPolicy(collectionStore privdata.CollectionStore, configHistory ConfigHistorySupport) BTLPolicy {
 	return &LSCCBasedBTLPolicy{
 		collectionStore: collectionStore,
+		configHistory:   configHistory,
 		cache:           make(map[btlkey]uint64)}
 }
 
-// GetBTL implements corresponding function in interface `BTLPolicyMgr`
+// GetBTL implements corresponding function in interface `BTLPolicyMgr`.
+// It returns the BTL of the most recent version of the collection configuration
 func (p *LSCCBasedBTLPolicy) GetBTL(namesapce string, collection string) (uint64, error) {
-	var btl uint64
-	var ok bool
-	key := btlkey{namesapce, collection}
+	return p.getBTLBelow(namesapce, collection, math.MaxUint64)
+}
+
+// GetExpiringBlock implements function from the interface `BTLPolicy`
+func (p *LSCCBasedBTLPolicy) GetExpiringBlock(namesapce string, collection string, committingBlock uint64) (uint64, error) {
+	btl, err := p.getBTLBelow(namesapce, collection, committingBlock)
+	if err != nil {
+		return 0, err
+	}
+	expiryBlk := committingBlock + btl + uint64(1)
+	if expiryBlk <= committingBlock { // committingBlk + btl overflows uint64-max
+		expiryBlk = math.MaxUint64
+	}
+	return expiryBlk, nil
+}
+
+// getBTLBelow returns the BTL of the collection in the version of the collection
+// configuration committed most recently below the given block
+func (p *LSCCBasedBTLPolicy) getBTLBelow(namesapce string, collection string, blockNum uint64) (uint64, error) {
+	staticConfig, configBlockNum, err := p.collectionConfigBelow(namesapce, collection, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	key := btlkey{namesapce, collection, configBlockNum}
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	btl, ok = p.cache[key]
-	if !ok {
+	btl, ok := p.cache[key]
+	if ok {
+		return btl, nil
+	}
+	var btlConfigured uint64
+	if staticConfig != nil {
+		btlConfigured = staticConfig.BlockToLive
+	} else {
 		persistenceConf, err := p.collectionStore.RetrieveCollectionPersistenceConfigs(
 			common.CollectionCriteria{Namespace: namesapce, Collection: collection})
 		if err != nil {
 			return 0, err
 		}
-		btlConfigured := persistenceConf.BlockToLive()
-		if btlConfigured > 0 {
-			btl = uint64(btlConfigured)
-		} else {
-			btl = defaultBTL
-		}
-		p.cache[key] = btl
+		btlConfigured = persistenceConf.BlockToLive()
 	}
+	if btlConfigured > 0 {
+		btl = uint64(btlConfigured)
+	} else {
+		btl = defaultBTL
+	}
+	p.cache[key] = btl
 	return btl, nil
 }
 
-// GetExpiringBlock implements function from the interface `BTLPolicy`
-func (p *LSCCBasedBTLPolicy) GetExpiringBlock(namesapce string, collection string, committingBlock uint64) (uint64, error) {
-	btl, err := p.GetBTL(namesapce, collection)
-	if err != nil {
-		return 0, err
+// collectionConfigBelow looks up the collection in the config history and returns its
+// configuration committed most recently below the given block along with the number of
+// the block that committed it. A nil configuration is returned if the history is not
+// available or does not contain the collection
+func (p *LSCCBasedBTLPolicy) collectionConfigBelow(namesapce string, collection string, blockNum uint64) (*common.StaticCollectionConfig, uint64, error) {
+	if p.configHistory == nil {
+		return nil, 0, nil
 	}
-	expiryBlk := committingBlock + btl + uint64(1)
-	if expiryBlk <= committingBlock { // committingBlk + btl overflows uint64-max
-		expiryBlk = math.MaxUint64
+	retriever, err := p.configHistory.GetConfigHistoryRetriever()
+	if err != nil || retriever == nil {
+		return nil, 0, err
 	}
-	return expiryBlk, nil
+	configInfo, err := retriever.MostRecentCollectionConfigBelow(blockNum, namesapce)
+	if err != nil || configInfo == nil {
+		return nil, 0, err
+	}
+	for _, config := range configInfo.CollectionConfig.Config {
+		staticConfig := config.GetStaticCollectionConfig()
+		if staticConfig != nil && staticConfig.Name == collection {
+			return staticConfig, configInfo.CommittingBlockNum, nil
+		}
+	}
+	return nil, 0, nil
 }
 
 type collectionSupport struct {
@@ -7,11 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package pvtdatapolicy
 
 import (
+	"errors"
 	"math"
 	"testing"
 
 	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,3 +65,104 @@ func TestExpiringBlock(t *testing.T) {
 	_, ok := err.(privdata.NoSuchCollectionError)
 	assert.True(t, ok)
 }
+
+func TestVersionedBTLPolicy(t *testing.T) {
+	// the state holds the most recent version of the collection configuration
+	mockCollectionStore := testutil.NewMockCollectionStore()
+	mockCollectionStore.SetBTL("ns1", "coll1", 300)
+	mockCollectionStore.SetBTL("ns1", "coll2", 200)
+
+	configHistory := &mockConfigHistory{
+		retriever: &mockConfigHistoryRetriever{
+			configs: map[uint64]*common.CollectionConfigPackage{
+				10: collectionConfigPackage("coll1", 100),
+				20: collectionConfigPackage("coll1", 0),
+				30: collectionConfigPackage("coll1", 300),
+			},
+		},
+	}
+	btlPolicy := ConstructVersionedBTLPolicy(mockCollectionStore, configHistory)
+
+	// the data committed before the first update expires according to the first version
+	expiringBlk, err := btlPolicy.GetExpiringBlock("ns1", "coll1", 15)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(116), expiringBlk)
+
+	// the version committed in a block applies from the next block onwards
+	expiringBlk, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(121), expiringBlk)
+
+	expiringBlk, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 21)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), expiringBlk)
+
+	expiringBlk, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 31)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(332), expiringBlk)
+
+	btl, err := btlPolicy.GetBTL("ns1", "coll1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(300), btl)
+
+	// a collection missing from the history is looked up in the state
+	expiringBlk, err = btlPolicy.GetExpiringBlock("ns1", "coll2", 15)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(216), expiringBlk)
+
+	// the blocks below the first version are looked up in the state
+	expiringBlk, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(306), expiringBlk)
+
+	configHistory.retriever.err = errors.New("history failure")
+	_, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 15)
+	assert.EqualError(t, err, "history failure")
+
+	configHistory.err = errors.New("history not available")
+	_, err = btlPolicy.GetExpiringBlock("ns1", "coll1", 15)
+	assert.EqualError(t, err, "history not available")
+}
+
+type mockConfigHistory struct {
+	retriever *mockConfigHistoryRetriever
+	err       error
+}
+
+func (m *mockConfigHistory) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, error) {
+	return m.retriever, m.err
+}
+
+type mockConfigHistoryRetriever struct {
+	configs map[uint64]*common.CollectionConfigPackage
+	err     error
+}
+
+func (m *mockConfigHistoryRetriever) MostRecentCollectionConfigBelow(blockNum uint64, chaincodeName string) (*ledger.CollectionConfigInfo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var info *ledger.CollectionConfigInfo
+	for configBlockNum, config := range m.configs {
+		if configBlockNum < blockNum && (info == nil || configBlockNum > info.CommittingBlockNum) {
+			info = &ledger.CollectionConfigInfo{CollectionConfig: config, CommittingBlockNum: configBlockNum}
+		}
+	}
+	return info, nil
+}
+
+func (m *mockConfigHistoryRetriever) CollectionConfigAt(blockNum uint64, chaincodeName string) (*ledger.CollectionConfigInfo, error) {
+	return nil, errors.New("not implemented")
+}
+
+func collectionConfigPackage(collection string, btl uint64) *common.CollectionConfigPackage {
+	return &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{Name: collection, BlockToLive: btl},
+				},
+			},
+		},
+	}
+}
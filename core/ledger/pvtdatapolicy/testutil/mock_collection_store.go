@@ -9,6 +9,7 @@ package testutil
 import (
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 )
 
@@ -47,6 +48,10 @@ func (m *MockCollectionStore) AccessFilter(channelName string, collectionPolicyC
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockCollectionStore) RetrieveReadWritePermission(common.CollectionCriteria, *pb.SignedProposal) (bool, bool, error) {
+	return false, false, errors.New("not implemented")
+}
+
 func (m *MockCollectionStore) SetBTL(ns, collection string, btl uint64) {
 	m.dummyData[[2]string{ns, collection}] = btl
 }
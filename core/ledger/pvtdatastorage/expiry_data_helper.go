@@ -11,14 +11,21 @@ func newExpiryData() *ExpiryData {
 }
 
 func (e *ExpiryData) getOrCreateCollections(ns string) *Collections {
+	if e.Map == nil {
+		e.Map = make(map[string]*Collections)
+	}
 	collections, ok := e.Map[ns]
 	if !ok {
-		collections = &Collections{
-			Map:            make(map[string]*TxNums),
-			MissingDataMap: make(map[string]bool)}
+		collections = &Collections{}
 		e.Map[ns] = collections
 	}
-
+	// an entry decoded from the db does not carry the maps that were empty when it was encoded
+	if collections.Map == nil {
+		collections.Map = make(map[string]*TxNums)
+	}
+	if collections.MissingDataMap == nil {
+		collections.MissingDataMap = make(map[string]bool)
+	}
 	return collections
 }
 
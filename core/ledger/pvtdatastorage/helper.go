@@ -7,16 +7,24 @@ SPDX-License-Identifier: Apache-2.0
 package pvtdatastorage
 
 import (
+	"bytes"
 	"math"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/willf/bitset"
 )
 
-func prepareStoreEntries(blockNum uint64, pvtdata []*ledger.TxPvtData, btlPolicy pvtdatapolicy.BTLPolicy, missingData *ledger.MissingPrivateDataList) (*storeEntries, error) {
-	dataEntries := prepareDataEntries(blockNum, pvtdata)
+func prepareStoreEntries(blockNum uint64, pvtdata []*ledger.TxPvtData, btlPolicy pvtdatapolicy.BTLPolicy,
+	missingData *ledger.MissingPrivateDataList, purgeMarkers []*ledger.PurgeMarker) (*storeEntries, error) {
+	dataEntries, err := removePurgedWrites(prepareDataEntries(blockNum, pvtdata), purgeMarkers)
+	if err != nil {
+		return nil, err
+	}
 
 	missingDataEntries := prepareMissingDataEntries(blockNum, missingData)
 
@@ -47,6 +55,93 @@ func prepareDataEntries(blockNum uint64, pvtData []*ledger.TxPvtData) []*dataEnt
 	return dataEntries
 }
 
+// removePurgedWrites removes from the data entries the writes on the keys that are purged by
+// a later transaction in the same block. A data entry that is left with no writes is dropped
+func removePurgedWrites(dataEntries []*dataEntry, purgeMarkers []*ledger.PurgeMarker) ([]*dataEntry, error) {
+	if len(purgeMarkers) == 0 {
+		return dataEntries, nil
+	}
+	var remainingEntries []*dataEntry
+	for _, entry := range dataEntries {
+		collPvtdata := entry.value
+		for _, purgeMarker := range purgeMarkers {
+			if purgeMarker.SeqInBlock <= entry.key.txNum ||
+				purgeMarker.Namespace != entry.key.ns || purgeMarker.Collection != entry.key.coll {
+				continue
+			}
+			var err error
+			if collPvtdata, err = removeWritesOnKeyHash(collPvtdata, purgeMarker.KeyHash); err != nil {
+				return nil, err
+			}
+			if collPvtdata == nil {
+				break
+			}
+		}
+		if collPvtdata != nil {
+			remainingEntries = append(remainingEntries, &dataEntry{key: entry.key, value: collPvtdata})
+		}
+	}
+	return remainingEntries, nil
+}
+
+// removeWritesOnKeyHash returns a copy of the collection pvt data without the writes (and the metadata
+// writes) on the key that hashes to the given keyHash. The same collection pvt data is returned if it
+// does not contain such writes and nil is returned if no writes remain after the removal
+func removeWritesOnKeyHash(collPvtdata *rwset.CollectionPvtReadWriteSet, keyHash []byte) (*rwset.CollectionPvtReadWriteSet, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtdata.Rwset, kvRWSet); err != nil {
+		return nil, err
+	}
+	var writes []*kvrwset.KVWrite
+	for _, write := range kvRWSet.Writes {
+		if !bytes.Equal(util.ComputeStringHash(write.Key), keyHash) {
+			writes = append(writes, write)
+		}
+	}
+	var metadataWrites []*kvrwset.KVMetadataWrite
+	for _, metadataWrite := range kvRWSet.MetadataWrites {
+		if !bytes.Equal(util.ComputeStringHash(metadataWrite.Key), keyHash) {
+			metadataWrites = append(metadataWrites, metadataWrite)
+		}
+	}
+	if len(writes) == len(kvRWSet.Writes) && len(metadataWrites) == len(kvRWSet.MetadataWrites) {
+		return collPvtdata, nil
+	}
+	if len(writes) == 0 && len(metadataWrites) == 0 {
+		return nil, nil
+	}
+	kvRWSet.Writes = writes
+	kvRWSet.MetadataWrites = metadataWrites
+	rwsetBytes, err := proto.Marshal(kvRWSet)
+	if err != nil {
+		return nil, err
+	}
+	return &rwset.CollectionPvtReadWriteSet{
+		CollectionName: collPvtdata.CollectionName,
+		Rwset:          rwsetBytes,
+	}, nil
+}
+
+// keyHashesOf returns the hashes of the keys written (or whose metadata is written) by the collection pvt data
+func keyHashesOf(collPvtdata *rwset.CollectionPvtReadWriteSet) ([][]byte, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtdata.Rwset, kvRWSet); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]struct{})
+	for _, write := range kvRWSet.Writes {
+		keys[write.Key] = struct{}{}
+	}
+	for _, metadataWrite := range kvRWSet.MetadataWrites {
+		keys[metadataWrite.Key] = struct{}{}
+	}
+	var keyHashes [][]byte
+	for key := range keys {
+		keyHashes = append(keyHashes, util.ComputeStringHash(key))
+	}
+	return keyHashes, nil
+}
+
 func prepareMissingDataEntries(committingBlk uint64, missingData *ledger.MissingPrivateDataList) map[missingDataKey]*bitset.BitSet {
 	if missingData == nil {
 		return nil
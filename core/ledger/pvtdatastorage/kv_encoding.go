@@ -23,6 +23,9 @@ var (
 	expiryKeyPrefix                = []byte{3}
 	eligibleMissingDataKeyPrefix   = []byte{4}
 	ineligibleMissingDataKeyPrefix = []byte{5}
+	keyHashIndexKeyPrefix          = []byte{6}
+	keyHashIndexBuiltKey           = []byte{7}
+	purgedKeyHashKeyPrefix         = []byte{8}
 
 	nilByte    = byte(0)
 	emptyValue = []byte{}
@@ -151,3 +154,41 @@ func createRangeScanKeysForEligibleMissingDataEntries(blkNum uint64) (startKey,
 
 	return startKey, endKey
 }
+
+// encodeKeyHashIndexKey encodes the key of an index entry that maps the hash of a key written by the
+// private data of a transaction to the corresponding data key. The structure of the key is
+// <keyHashIndexKeyPrefix><ns><nilByte><coll><nilByte><keyHash><blkNum, txNum>
+func encodeKeyHashIndexKey(dataKey *dataKey, keyHash []byte) []byte {
+	keyBytes := encodeKeyHashIndexKeyPrefix(dataKey.ns, dataKey.coll, keyHash)
+	return append(keyBytes, version.NewHeight(dataKey.blkNum, dataKey.txNum).ToBytes()...)
+}
+
+func encodeKeyHashIndexKeyPrefix(ns, coll string, keyHash []byte) []byte {
+	keyBytes := append(keyHashIndexKeyPrefix, []byte(ns)...)
+	keyBytes = append(keyBytes, nilByte)
+	keyBytes = append(keyBytes, []byte(coll)...)
+	keyBytes = append(keyBytes, nilByte)
+	return append(keyBytes, keyHash...)
+}
+
+func decodeKeyHashIndexKey(keyBytes []byte, ns, coll string, keyHash []byte) *dataKey {
+	height, _ := version.NewHeightFromBytes(keyBytes[len(encodeKeyHashIndexKeyPrefix(ns, coll, keyHash)):])
+	return &dataKey{nsCollBlk{ns, coll, height.BlockNum}, height.TxNum}
+}
+
+func getKeyHashIndexKeysForRangeScan(ns, coll string, keyHash []byte) (startKey, endKey []byte) {
+	startKey = encodeKeyHashIndexKeyPrefix(ns, coll, keyHash)
+	// As the key hash is of a fixed length, 0xff can be used as a stopper
+	endKey = append(encodeKeyHashIndexKeyPrefix(ns, coll, keyHash), 0xff)
+	return
+}
+
+// encodePurgedKeyHashKey encodes the key of an entry that records the height of the transaction that
+// last purged a key. The structure of the key is <purgedKeyHashKeyPrefix><ns><nilByte><coll><nilByte><keyHash>
+func encodePurgedKeyHashKey(ns, coll string, keyHash []byte) []byte {
+	keyBytes := append(purgedKeyHashKeyPrefix, []byte(ns)...)
+	keyBytes = append(keyBytes, nilByte)
+	keyBytes = append(keyBytes, []byte(coll)...)
+	keyBytes = append(keyBytes, nilByte)
+	return append(keyBytes, keyHash...)
+}
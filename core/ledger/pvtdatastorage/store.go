@@ -46,6 +46,9 @@ type Store interface {
 	// GetMissingPvtDataInfoForMostRecentBlocks returns the missing private data information for the
 	// most recent `maxBlock` blocks which miss at least a private data of a eligible collection.
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.MissingPvtDataInfo, error)
+	// GetMissingPvtDataStats returns the number of the eligible and the ineligible missing private
+	// data entries that are not yet expired
+	GetMissingPvtDataStats() (*ledger.MissingPvtDataStats, error)
 	// Prepare prepares the Store for commiting the pvt data and storing both eligible and ineligible
 	// missing private data --- `eligible` denotes that the missing private data belongs to a collection
 	// for which this peer is a member; `ineligible` denotes that the missing private data belong to a
@@ -54,10 +57,18 @@ type Store interface {
 	// is expected to call either `Commit` or `Rollback` function. Return from this should ensure
 	// that enough preparation is done such that `Commit` function invoked afterwards can commit the
 	// data and the store is capable of surviving a crash between this function call and the next
-	// invoke to the `Commit`
-	Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missing *ledger.MissingPrivateDataList) error
+	// invoke to the `Commit`. The writes on the keys purged by the supplied purge markers are removed from the
+	// pvt data of this block (for the transactions prior to the purging one) and of all the blocks committed earlier
+	Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missing *ledger.MissingPrivateDataList, purgeMarkers []*ledger.PurgeMarker) error
 	// Commit commits the pvt data passed in the previous invoke to the `Prepare` function
 	Commit() error
+	// CommitPvtDataOfOldBlocks commits the pvt data of the blocks that were committed without it. Only the
+	// pvt data that is recorded as missing for a collection this peer is eligible for, and that has not
+	// expired, is committed. The writes on the keys purged after the transaction are left out
+	CommitPvtDataOfOldBlocks(blocksPvtData []*ledger.BlockPvtData) error
+	// IsPurgedAfter returns true if the key of the given hash in the collection was purged by a transaction
+	// committed after the transaction `txNum` of the block `blockNum`
+	IsPurgedAfter(ns, coll string, keyHash []byte, blockNum, txNum uint64) (bool, error)
 	// Rollback rolls back the pvt data passed in the previous invoke to the `Prepare` function
 	Rollback() error
 	// IsEmpty returns true if the store does not have any block committed yet
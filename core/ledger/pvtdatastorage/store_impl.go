@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
@@ -92,6 +93,9 @@ func (p *provider) OpenStore(ledgerid string) (Store, error) {
 	if err := s.initState(); err != nil {
 		return nil, err
 	}
+	if err := s.buildKeyHashIndexIfNotExists(); err != nil {
+		return nil, err
+	}
 	logger.Debugf("Pvtdata store opened. Initial state: isEmpty [%t], lastCommittedBlock [%d], batchPending [%t]",
 		s.isEmpty, s.lastCommittedBlock, s.batchPending)
 	return s, nil
@@ -117,12 +121,48 @@ func (s *store) initState() error {
 	return nil
 }
 
+// buildKeyHashIndexIfNotExists indexes the keys written by the data entries that were committed
+// by a version of the store that did not maintain the key hash index. This is performed only once,
+// the store maintains the index for the data entries that are committed afterwards
+func (s *store) buildKeyHashIndexIfNotExists() error {
+	built, err := s.db.Get(keyHashIndexBuiltKey)
+	if err != nil || built != nil {
+		return err
+	}
+	batch := leveldbhelper.NewUpdateBatch()
+	itr := s.db.GetIterator(pvtDataKeyPrefix, []byte{pvtDataKeyPrefix[0] + 1})
+	defer itr.Release()
+	numEntries := 0
+	for itr.Next() {
+		dataKeyBytes := itr.Key()
+		if v11Format(dataKeyBytes) {
+			// the data entries of a v1.1 store hold the pvt data of a transaction
+			// as a whole and cannot be purged by key, hence they are not indexed
+			continue
+		}
+		dataKey := decodeDatakey(dataKeyBytes)
+		collPvtdata, err := decodeDataValue(itr.Value())
+		if err != nil {
+			return err
+		}
+		addKeyHashIndexEntries(batch, dataKey, collPvtdata)
+		numEntries++
+	}
+	batch.Put(keyHashIndexBuiltKey, emptyValue)
+	if err := s.db.WriteBatch(batch, true); err != nil {
+		return err
+	}
+	logger.Infof("[%s] - Indexed the keys of [%d] private data entries", s.ledgerid, numEntries)
+	return nil
+}
+
 func (s *store) Init(btlPolicy pvtdatapolicy.BTLPolicy) {
 	s.btlPolicy = btlPolicy
 }
 
 // Prepare implements the function in the interface `Store`
-func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingData *ledger.MissingPrivateDataList) error {
+func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingData *ledger.MissingPrivateDataList,
+	purgeMarkers []*ledger.PurgeMarker) error {
 	if s.batchPending {
 		return &ErrIllegalCall{`A pending batch exists as as result of last invoke to "Prepare" call.
 			 Invoke "Commit" or "Rollback" on the pending batch before invoking "Prepare" function`}
@@ -136,7 +176,7 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingDat
 	var err error
 	var keyBytes, valBytes []byte
 
-	storeEntries, err := prepareStoreEntries(blockNum, pvtData, s.btlPolicy, missingData)
+	storeEntries, err := prepareStoreEntries(blockNum, pvtData, s.btlPolicy, missingData, purgeMarkers)
 
 	if err != nil {
 		return err
@@ -148,6 +188,7 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingDat
 			return err
 		}
 		batch.Put(keyBytes, valBytes)
+		addKeyHashIndexEntries(batch, dataEntry.key, dataEntry.value)
 	}
 
 	for _, expiryEntry := range storeEntries.expiryEntries {
@@ -166,6 +207,16 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingDat
 		batch.Put(keyBytes, valBytes)
 	}
 
+	if len(purgeMarkers) > 0 {
+		// the purger lock ensures that an expired data entry is not written back
+		// by the purge while the purger is removing it
+		s.purgerLock.Lock()
+		defer s.purgerLock.Unlock()
+		if err := s.preparePurgeOfCommittedData(batch, blockNum, purgeMarkers); err != nil {
+			return err
+		}
+	}
+
 	batch.Put(pendingCommitKey, emptyValue)
 	if err := s.db.WriteBatch(batch, true); err != nil {
 		return err
@@ -175,6 +226,285 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingDat
 	return nil
 }
 
+// preparePurgeOfCommittedData adds to the batch the updates that remove, from the data entries of the
+// blocks committed prior to the given blockNum, the writes on the keys purged by the given purge markers.
+// The data entries are located via the key hash index. Note that the purge is not reverted by a subsequent
+// `Rollback` call, as the next try is expected to commit the same (already validated) block
+func (s *store) preparePurgeOfCommittedData(batch *leveldbhelper.UpdateBatch, blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	// a nil value denotes a data entry that is to be deleted
+	updatedEntries := make(map[dataKey]*rwset.CollectionPvtReadWriteSet)
+	for _, purgeMarker := range purgeMarkers {
+		startKey, endKey := getKeyHashIndexKeysForRangeScan(purgeMarker.Namespace, purgeMarker.Collection, purgeMarker.KeyHash)
+		itr := s.db.GetIterator(startKey, endKey)
+		for itr.Next() {
+			indexKeyBytes := itr.Key()
+			dataKey := decodeKeyHashIndexKey(indexKeyBytes, purgeMarker.Namespace, purgeMarker.Collection, purgeMarker.KeyHash)
+			if dataKey.blkNum >= blockNum {
+				// left over by an earlier attempt to commit this block, the data entries of
+				// this block are already taken care of while preparing the store entries
+				continue
+			}
+			batch.Delete(indexKeyBytes)
+			collPvtdata, ok := updatedEntries[*dataKey]
+			if !ok {
+				dataValueBytes, err := s.db.Get(encodeDataKey(dataKey))
+				if err != nil {
+					itr.Release()
+					return err
+				}
+				if dataValueBytes == nil {
+					// the data entry has expired
+					continue
+				}
+				if collPvtdata, err = decodeDataValue(dataValueBytes); err != nil {
+					itr.Release()
+					return err
+				}
+			}
+			if collPvtdata == nil {
+				continue
+			}
+			purgedCollPvtdata, err := removeWritesOnKeyHash(collPvtdata, purgeMarker.KeyHash)
+			if err != nil {
+				itr.Release()
+				return err
+			}
+			updatedEntries[*dataKey] = purgedCollPvtdata
+		}
+		itr.Release()
+	}
+
+	// the purges are recorded so that the pvt data of the earlier blocks fetched afterwards
+	// from other peers does not bring the purged keys back
+	for _, purgeMarker := range purgeMarkers {
+		batch.Put(encodePurgedKeyHashKey(purgeMarker.Namespace, purgeMarker.Collection, purgeMarker.KeyHash),
+			version.NewHeight(blockNum, purgeMarker.SeqInBlock).ToBytes())
+	}
+
+	for dataKey, collPvtdata := range updatedEntries {
+		dataKeyBytes := encodeDataKey(&dataKey)
+		if collPvtdata == nil {
+			batch.Delete(dataKeyBytes)
+			continue
+		}
+		dataValueBytes, err := encodeDataValue(collPvtdata)
+		if err != nil {
+			return err
+		}
+		batch.Put(dataKeyBytes, dataValueBytes)
+	}
+	logger.Debugf("Purged %d private data keys from [%d] data entries of the blocks prior to block [%d]",
+		len(purgeMarkers), len(updatedEntries), blockNum)
+	return nil
+}
+
+// CommitPvtDataOfOldBlocks implements the function in the interface `Store`
+func (s *store) CommitPvtDataOfOldBlocks(blocksPvtData []*ledger.BlockPvtData) error {
+	if s.batchPending {
+		return &ErrIllegalCall{`A pending batch exists as as result of last invoke to "Prepare" call.
+			 Invoke "Commit" or "Rollback" on the pending batch before invoking "CommitPvtDataOfOldBlocks" function`}
+	}
+	// the purger lock ensures that an expired data entry is not written back
+	// while the purger is removing it
+	s.purgerLock.Lock()
+	defer s.purgerLock.Unlock()
+
+	lastCommittedBlock := atomic.LoadUint64(&s.lastCommittedBlock)
+	oldBlocksData := &oldBlocksDataEntries{
+		missingDataEntries: make(map[missingDataKey]*bitset.BitSet),
+		expiryEntries:      make(map[expiryKey]*ExpiryData),
+	}
+	batch := leveldbhelper.NewUpdateBatch()
+	for _, blockPvtData := range blocksPvtData {
+		if s.isEmpty || blockPvtData.BlockNum > lastCommittedBlock {
+			return &ErrIllegalArgs{fmt.Sprintf("Block [%d] is not yet committed, the last committed block is [%d]",
+				blockPvtData.BlockNum, lastCommittedBlock)}
+		}
+		for _, txPvtData := range blockPvtData.WriteSets {
+			if txPvtData.WriteSet == nil {
+				continue
+			}
+			for _, nsPvtRwset := range txPvtData.WriteSet.NsPvtRwset {
+				for _, collPvtRwset := range nsPvtRwset.CollectionPvtRwset {
+					dataKey := &dataKey{nsCollBlk{nsPvtRwset.Namespace, collPvtRwset.CollectionName, blockPvtData.BlockNum}, txPvtData.SeqInBlock}
+					if err := s.prepareOldBlockDataEntry(batch, oldBlocksData, dataKey, collPvtRwset, lastCommittedBlock); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for missingDataKey, bitmap := range oldBlocksData.missingDataEntries {
+		keyBytes := encodeMissingDataKey(&missingDataKey)
+		if bitmap.None() {
+			batch.Delete(keyBytes)
+			continue
+		}
+		valBytes, err := encodeMissingDataValue(bitmap)
+		if err != nil {
+			return err
+		}
+		batch.Put(keyBytes, valBytes)
+	}
+	for expiryKey, expiryData := range oldBlocksData.expiryEntries {
+		valBytes, err := encodeExpiryValue(expiryData)
+		if err != nil {
+			return err
+		}
+		batch.Put(encodeExpiryKey(&expiryKey), valBytes)
+	}
+	return s.db.WriteBatch(batch, true)
+}
+
+// oldBlocksDataEntries holds the missing data and expiry entries updated while committing the
+// pvt data of old blocks, as several data entries may update the same missing data or expiry entry
+type oldBlocksDataEntries struct {
+	missingDataEntries map[missingDataKey]*bitset.BitSet
+	expiryEntries      map[expiryKey]*ExpiryData
+}
+
+// prepareOldBlockDataEntry adds to the batch the data entry for the pvt data of a collection of an old
+// block, if it is still missing, and records the updates of the corresponding missing data and expiry entries
+func (s *store) prepareOldBlockDataEntry(batch *leveldbhelper.UpdateBatch, oldBlocksData *oldBlocksDataEntries,
+	dataKey *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet, lastCommittedBlock uint64) error {
+	missingKey := missingDataKey{nsCollBlk: dataKey.nsCollBlk, isEligible: true}
+	bitmap, ok := oldBlocksData.missingDataEntries[missingKey]
+	if !ok {
+		bitmapBytes, err := s.db.Get(encodeMissingDataKey(&missingKey))
+		if err != nil {
+			return err
+		}
+		if bitmapBytes != nil {
+			if bitmap, err = decodeMissingDataValue(bitmapBytes); err != nil {
+				return err
+			}
+		}
+	}
+	if bitmap == nil || !bitmap.Test(uint(dataKey.txNum)) {
+		logger.Debugf("Skipping the pvt data for block [%d], tx [%d], namespace [%s], collection [%s] as it is not missing",
+			dataKey.blkNum, dataKey.txNum, dataKey.ns, dataKey.coll)
+		return nil
+	}
+
+	expiringBlk, err := s.btlPolicy.GetExpiringBlock(dataKey.ns, dataKey.coll, dataKey.blkNum)
+	if err != nil {
+		return err
+	}
+	if lastCommittedBlock >= expiringBlk {
+		logger.Debugf("Skipping the pvt data for block [%d], tx [%d], namespace [%s], collection [%s] as it has expired",
+			dataKey.blkNum, dataKey.txNum, dataKey.ns, dataKey.coll)
+		return nil
+	}
+
+	if collPvtdata, err = s.removeWritesPurgedLater(dataKey, collPvtdata); err != nil {
+		return err
+	}
+	bitmap.Clear(uint(dataKey.txNum))
+	oldBlocksData.missingDataEntries[missingKey] = bitmap
+	if collPvtdata == nil {
+		// all the keys written have been purged since
+		return nil
+	}
+
+	valBytes, err := encodeDataValue(collPvtdata)
+	if err != nil {
+		return err
+	}
+	batch.Put(encodeDataKey(dataKey), valBytes)
+	addKeyHashIndexEntries(batch, dataKey, collPvtdata)
+
+	if neverExpires(expiringBlk) {
+		return nil
+	}
+	expKey := expiryKey{expiringBlk: expiringBlk, committingBlk: dataKey.blkNum}
+	expiryData, ok := oldBlocksData.expiryEntries[expKey]
+	if !ok {
+		expiryValueBytes, err := s.db.Get(encodeExpiryKey(&expKey))
+		if err != nil {
+			return err
+		}
+		if expiryValueBytes == nil {
+			expiryData = newExpiryData()
+		} else if expiryData, err = decodeExpiryValue(expiryValueBytes); err != nil {
+			return err
+		}
+		oldBlocksData.expiryEntries[expKey] = expiryData
+	}
+	expiryData.addPresentData(dataKey.ns, dataKey.coll, dataKey.txNum)
+	return nil
+}
+
+// removeWritesPurgedLater removes from the pvt data of a collection the writes on the keys that
+// were purged by a transaction committed after the one of the given data key
+func (s *store) removeWritesPurgedLater(dataKey *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet) (*rwset.CollectionPvtReadWriteSet, error) {
+	keyHashes, err := keyHashesOf(collPvtdata)
+	if err != nil {
+		// such a write set is stored as is, see addKeyHashIndexEntries
+		return collPvtdata, nil
+	}
+	for _, keyHash := range keyHashes {
+		purged, err := s.IsPurgedAfter(dataKey.ns, dataKey.coll, keyHash, dataKey.blkNum, dataKey.txNum)
+		if err != nil {
+			return nil, err
+		}
+		if !purged {
+			continue
+		}
+		if collPvtdata, err = removeWritesOnKeyHash(collPvtdata, keyHash); err != nil || collPvtdata == nil {
+			return nil, err
+		}
+	}
+	return collPvtdata, nil
+}
+
+// IsPurgedAfter implements the function in the interface `Store`
+func (s *store) IsPurgedAfter(ns, coll string, keyHash []byte, blockNum, txNum uint64) (bool, error) {
+	purgeHeightBytes, err := s.db.Get(encodePurgedKeyHashKey(ns, coll, keyHash))
+	if err != nil || purgeHeightBytes == nil {
+		return false, err
+	}
+	purgeHeight, _ := version.NewHeightFromBytes(purgeHeightBytes)
+	return purgeHeight.Compare(version.NewHeight(blockNum, txNum)) > 0, nil
+}
+
+// addKeyHashIndexEntries adds to the batch the index entries for the keys written by the given data entry
+func addKeyHashIndexEntries(batch *leveldbhelper.UpdateBatch, dataKey *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet) {
+	keyHashes, err := keyHashesOf(collPvtdata)
+	if err != nil {
+		// the store otherwise treats the pvt data as opaque bytes, hence such a write set is
+		// stored as is and is not indexed for a purge by key
+		logger.Warningf("Could not index the keys in private data for block [%d], tx [%d], namespace [%s], collection [%s]: %s",
+			dataKey.blkNum, dataKey.txNum, dataKey.ns, dataKey.coll, err)
+		return
+	}
+	for _, keyHash := range keyHashes {
+		batch.Put(encodeKeyHashIndexKey(dataKey, keyHash), emptyValue)
+	}
+}
+
+// deleteKeyHashIndexEntries adds to the batch the deletion of the index entries for the keys
+// written by the data entry that is stored against the given data key, if any
+func (s *store) deleteKeyHashIndexEntries(batch *leveldbhelper.UpdateBatch, dataKey *dataKey) error {
+	dataValueBytes, err := s.db.Get(encodeDataKey(dataKey))
+	if err != nil || dataValueBytes == nil {
+		return err
+	}
+	collPvtdata, err := decodeDataValue(dataValueBytes)
+	if err != nil {
+		return err
+	}
+	keyHashes, err := keyHashesOf(collPvtdata)
+	if err != nil {
+		// such a data entry is not indexed
+		return nil
+	}
+	for _, keyHash := range keyHashes {
+		batch.Delete(encodeKeyHashIndexKey(dataKey, keyHash))
+	}
+	return nil
+}
+
 // Commit implements the function in the interface `Store`
 func (s *store) Commit() error {
 	if !s.batchPending {
@@ -352,6 +682,38 @@ func (s *store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 	return missingPvtDataInfo, nil
 }
 
+// GetMissingPvtDataStats implements the function in the interface `Store`
+func (s *store) GetMissingPvtDataStats() (*ledger.MissingPvtDataStats, error) {
+	lastCommittedBlock := atomic.LoadUint64(&s.lastCommittedBlock)
+	stats := &ledger.MissingPvtDataStats{}
+	for _, prefix := range [][]byte{eligibleMissingDataKeyPrefix, ineligibleMissingDataKeyPrefix} {
+		dbItr := s.db.GetIterator(prefix, []byte{prefix[0] + 1})
+		for dbItr.Next() {
+			missingDataKey := decodeMissingDataKey(dbItr.Key())
+			expired, err := isExpired(missingDataKey.nsCollBlk, s.btlPolicy, lastCommittedBlock)
+			if err != nil {
+				dbItr.Release()
+				return nil, err
+			}
+			if expired {
+				continue
+			}
+			bitmap, err := decodeMissingDataValue(dbItr.Value())
+			if err != nil {
+				dbItr.Release()
+				return nil, err
+			}
+			if missingDataKey.isEligible {
+				stats.Eligible += uint64(bitmap.Count())
+			} else {
+				stats.Ineligible += uint64(bitmap.Count())
+			}
+		}
+		dbItr.Release()
+	}
+	return stats, nil
+}
+
 func (s *store) performPurgeIfScheduled(latestCommittedBlk uint64) {
 	if latestCommittedBlk%ledgerconfig.GetPvtdataStorePurgeInterval() != 0 {
 		return
@@ -380,6 +742,9 @@ func (s *store) purgeExpiredData(minBlkNum, maxBlkNum uint64) error {
 		batch.Delete(encodeExpiryKey(expiryEntry.key))
 		dataKeys, missingDataKeys := deriveKeys(expiryEntry)
 		for _, dataKey := range dataKeys {
+			if err := s.deleteKeyHashIndexEntries(batch, dataKey); err != nil {
+				return err
+			}
 			batch.Delete(encodeDataKey(dataKey))
 		}
 		for _, missingDataKey := range missingDataKeys {
@@ -17,9 +17,12 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	btltestutil "github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -80,15 +83,15 @@ func TestStoreBasicCommitAndRetrieval(t *testing.T) {
 	blk2MissingData.Add("tx1", 1, "ns-1", "coll-2", true)
 
 	// no pvt data with block 0
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// pvt data with block 1 - commit
-	assert.NoError(store.Prepare(1, testData, blk1MissingData))
+	assert.NoError(store.Prepare(1, testData, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// pvt data with block 2 - rollback
-	assert.NoError(store.Prepare(2, testData, nil))
+	assert.NoError(store.Prepare(2, testData, nil, nil))
 	assert.NoError(store.Rollback())
 
 	// pvt data retrieval for block 0 should return nil
@@ -126,7 +129,7 @@ func TestStoreBasicCommitAndRetrieval(t *testing.T) {
 	assert.Nil(retrievedData)
 
 	// pvt data with block 2 - commit
-	assert.NoError(store.Prepare(2, testData, blk2MissingData))
+	assert.NoError(store.Prepare(2, testData, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// retrieve the stored missing entries using GetMissingPvtDataInfoForMostRecentBlocks
@@ -193,7 +196,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	blk2MissingData.Add("tx1", 1, "ns-1", "coll-2", true)
 
 	// no pvt data with block 0
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// write pvt data for block 1
@@ -201,7 +204,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 4, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData))
+	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// write pvt data for block 2
@@ -209,7 +212,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 		produceSamplePvtdata(t, 3, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 5, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData))
+	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	retrievedData, _ := store.GetPvtDataByBlockNum(1, nil)
@@ -233,8 +236,12 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
 
+	missingPvtDataStats, err := store.GetMissingPvtDataStats()
+	assert.NoError(err)
+	assert.Equal(&ledger.MissingPvtDataStats{Eligible: 4, Ineligible: 2}, missingPvtDataStats)
+
 	// Commit block 3 with no pvtdata
-	assert.NoError(store.Prepare(3, nil, nil))
+	assert.NoError(store.Prepare(3, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// After committing block 3, the data for "ns-1:coll1" of block 1 should have expired and should not be returned by the store
@@ -257,8 +264,13 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
 
+	// the missing data of "ns-3:coll-1" in block1-tx4 should have expired as well
+	missingPvtDataStats, err = store.GetMissingPvtDataStats()
+	assert.NoError(err)
+	assert.Equal(&ledger.MissingPvtDataStats{Eligible: 3, Ineligible: 1}, missingPvtDataStats)
+
 	// Commit block 4 with no pvtdata
-	assert.NoError(store.Prepare(4, nil, nil))
+	assert.NoError(store.Prepare(4, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// After committing block 4, the data for "ns-2:coll2" of block 1 should also have expired and should not be returned by the store
@@ -309,7 +321,7 @@ func TestStorePurge(t *testing.T) {
 	s := env.TestStore
 
 	// no pvt data with block 0
-	assert.NoError(s.Prepare(0, nil, nil))
+	assert.NoError(s.Prepare(0, nil, nil, nil))
 	assert.NoError(s.Commit())
 
 	// construct missing data for block 1
@@ -326,11 +338,11 @@ func TestStorePurge(t *testing.T) {
 		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 4, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(s.Prepare(1, testDataForBlk1, blk1MissingData))
+	assert.NoError(s.Prepare(1, testDataForBlk1, blk1MissingData, nil))
 	assert.NoError(s.Commit())
 
 	// write pvt data for block 2
-	assert.NoError(s.Prepare(2, nil, nil))
+	assert.NoError(s.Prepare(2, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 and ns-2:coll-2 should exist in store
 	ns1_coll1 := &dataKey{nsCollBlk: nsCollBlk{ns: "ns-1", coll: "coll-1", blkNum: 1}, txNum: 2}
@@ -355,7 +367,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3_coll2_inelgMD))
 
 	// write pvt data for block 3
-	assert.NoError(s.Prepare(3, nil, nil))
+	assert.NoError(s.Prepare(3, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 and ns-2:coll-2 should exist in store (because purger should not be launched at block 3)
 	testWaitForPurgerRoutineToFinish(s)
@@ -369,13 +381,16 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3_coll2_inelgMD))
 
 	// write pvt data for block 4
-	assert.NoError(s.Prepare(4, nil, nil))
+	assert.NoError(s.Prepare(4, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 should not exist in store (because purger should be launched at block 4)
 	// but ns-2:coll-2 should exist because it expires at block 5
 	testWaitForPurgerRoutineToFinish(s)
 	assert.False(testDataKeyExists(t, s, ns1_coll1))
 	assert.True(testDataKeyExists(t, s, ns2_coll2))
+	// the key hash index entries of the purged data should have been removed as well
+	assert.Empty(testKeyHashIndexEntries(t, s, "ns-1", "coll-1", util.ComputeStringHash("key-ns-1-coll-1")))
+	assert.Len(testKeyHashIndexEntries(t, s, "ns-2", "coll-2", util.ComputeStringHash("key-ns-2-coll-2")), 2)
 	// eligible missingData entries for ns-1:coll-1 should have expired and ns-1:coll-2 (neverExpires) should exist in store
 	assert.False(testMissingDataKeyExists(t, s, ns1_coll1_elgMD))
 	assert.True(testMissingDataKeyExists(t, s, ns1_coll2_elgMD))
@@ -384,7 +399,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3_coll2_inelgMD))
 
 	// write pvt data for block 5
-	assert.NoError(s.Prepare(5, nil, nil))
+	assert.NoError(s.Prepare(5, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// ns-2:coll-2 should exist because though the data expires at block 5 but purger is launched every second block
 	testWaitForPurgerRoutineToFinish(s)
@@ -392,7 +407,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testDataKeyExists(t, s, ns2_coll2))
 
 	// write pvt data for block 6
-	assert.NoError(s.Prepare(6, nil, nil))
+	assert.NoError(s.Prepare(6, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// ns-2:coll-2 should not exists now (because purger should be launched at block 6)
 	testWaitForPurgerRoutineToFinish(s)
@@ -416,14 +431,14 @@ func TestStoreState(t *testing.T) {
 	testData := []*ledger.TxPvtData{
 		produceSamplePvtdata(t, 0, []string{"ns-1:coll-1", "ns-1:coll-2"}),
 	}
-	_, ok := store.Prepare(1, testData, nil).(*ErrIllegalArgs)
+	_, ok := store.Prepare(1, testData, nil, nil).(*ErrIllegalArgs)
 	assert.True(ok)
 
-	assert.Nil(store.Prepare(0, testData, nil))
+	assert.Nil(store.Prepare(0, testData, nil, nil))
 	assert.NoError(store.Commit())
 
-	assert.Nil(store.Prepare(1, testData, nil))
-	_, ok = store.Prepare(2, testData, nil).(*ErrIllegalCall)
+	assert.Nil(store.Prepare(1, testData, nil, nil))
+	_, ok = store.Prepare(2, testData, nil, nil).(*ErrIllegalCall)
 	assert.True(ok)
 }
 
@@ -449,6 +464,212 @@ func TestInitLastCommittedBlock(t *testing.T) {
 	assert.True(ok)
 }
 
+func TestStorePurgeByKey(t *testing.T) {
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns-1", "coll-1", 0)
+	cs.SetBTL("ns-1", "coll-2", 0)
+	btlPolicy := pvtdatapolicy.ConstructBTLPolicy(cs)
+	env := NewTestStoreEnv(t, "TestStorePurgeByKey", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	s := env.TestStore
+
+	purgedKeyHash := util.ComputeStringHash("key-ns-1-coll-1")
+
+	// block 0: tx 2 writes the key in both the collections and tx 4 writes the key along with another key
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "key-ns-1-coll-1", []byte("value-1"))
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "another-key", []byte("another-value"))
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(err)
+	testDataForBlk0 := []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2"}),
+		{SeqInBlock: 4, WriteSet: simRes.PvtSimulationResults},
+	}
+	assert.NoError(s.Prepare(0, testDataForBlk0, nil, nil))
+	assert.NoError(s.Commit())
+
+	// block 1: tx 3 purges the key that is written by tx 1 and tx 5
+	testDataForBlk1 := []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 1, []string{"ns-1:coll-1"}),
+		produceSamplePvtdata(t, 5, []string{"ns-1:coll-1"}),
+	}
+	purgeMarkers := []*ledger.PurgeMarker{
+		{SeqInBlock: 3, Namespace: "ns-1", Collection: "coll-1", KeyHash: purgedKeyHash},
+	}
+	assert.NoError(s.Prepare(1, testDataForBlk1, nil, purgeMarkers))
+	assert.NoError(s.Commit())
+
+	// the key is removed from block 0 while the other writes remain
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 0}, 2}))
+	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-2", 0}, 2}))
+	blk0Pvtdata, err := s.GetPvtDataByBlockNum(0, nil)
+	assert.NoError(err)
+	assert.Len(blk0Pvtdata, 2)
+	assert.Equal(uint64(4), blk0Pvtdata[1].SeqInBlock)
+	kvRWSet := &kvrwset.KVRWSet{}
+	assert.NoError(proto.Unmarshal(blk0Pvtdata[1].WriteSet.NsPvtRwset[0].CollectionPvtRwset[0].Rwset, kvRWSet))
+	assert.Len(kvRWSet.Writes, 1)
+	assert.Equal("another-key", kvRWSet.Writes[0].Key)
+
+	// within block 1, only the write prior to the purging transaction is removed
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 1}))
+	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 5}))
+
+	// the index entries for the purged data are removed
+	assert.Equal([]*dataKey{{nsCollBlk{"ns-1", "coll-1", 1}, 5}}, testKeyHashIndexEntries(t, s, "ns-1", "coll-1", purgedKeyHash))
+
+	// the key is purged after the writes prior to the purging transaction only
+	for _, testCase := range []struct {
+		coll            string
+		keyHash         []byte
+		blockNum, txNum uint64
+		purged          bool
+	}{
+		{"coll-1", purgedKeyHash, 0, 2, true},
+		{"coll-1", purgedKeyHash, 1, 1, true},
+		{"coll-1", purgedKeyHash, 1, 3, false},
+		{"coll-1", purgedKeyHash, 1, 5, false},
+		{"coll-2", purgedKeyHash, 0, 2, false},
+		{"coll-1", util.ComputeStringHash("another-key"), 0, 4, false},
+	} {
+		purged, err := s.IsPurgedAfter("ns-1", testCase.coll, testCase.keyHash, testCase.blockNum, testCase.txNum)
+		assert.NoError(err)
+		assert.Equal(testCase.purged, purged, "block %d, tx %d, collection %s", testCase.blockNum, testCase.txNum, testCase.coll)
+	}
+}
+
+func TestStorePurgeByKeyOfDataCommittedWithoutIndex(t *testing.T) {
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns-1", "coll-1", 0)
+	btlPolicy := pvtdatapolicy.ConstructBTLPolicy(cs)
+	env := NewTestStoreEnv(t, "TestStorePurgeByKeyOfDataCommittedWithoutIndex", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	s := env.TestStore
+
+	purgedKeyHash := util.ComputeStringHash("key-ns-1-coll-1")
+	testDataForBlk0 := []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1"}),
+	}
+	assert.NoError(s.Prepare(0, testDataForBlk0, nil, nil))
+	assert.NoError(s.Commit())
+
+	// simulate a store populated by a prior version that did not maintain the key hash index
+	batch := leveldbhelper.NewUpdateBatch()
+	batch.Delete(encodeKeyHashIndexKey(&dataKey{nsCollBlk{"ns-1", "coll-1", 0}, 2}, purgedKeyHash))
+	batch.Delete(keyHashIndexBuiltKey)
+	assert.NoError(s.(*store).db.WriteBatch(batch, true))
+	assert.Empty(testKeyHashIndexEntries(t, s, "ns-1", "coll-1", purgedKeyHash))
+
+	// the index is built when the store is opened
+	env.CloseAndReopen()
+	s = env.TestStore
+	assert.Equal([]*dataKey{{nsCollBlk{"ns-1", "coll-1", 0}, 2}}, testKeyHashIndexEntries(t, s, "ns-1", "coll-1", purgedKeyHash))
+
+	purgeMarkers := []*ledger.PurgeMarker{
+		{SeqInBlock: 0, Namespace: "ns-1", Collection: "coll-1", KeyHash: purgedKeyHash},
+	}
+	assert.NoError(s.Prepare(1, nil, nil, purgeMarkers))
+	assert.NoError(s.Commit())
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 0}, 2}))
+	assert.Empty(testKeyHashIndexEntries(t, s, "ns-1", "coll-1", purgedKeyHash))
+}
+
+func TestCommitPvtDataOfOldBlocks(t *testing.T) {
+	viper.Set("ledger.pvtdataStore.purgeInterval", 1)
+	cs := btltestutil.NewMockCollectionStore()
+	cs.SetBTL("ns-1", "coll-1", 0)
+	cs.SetBTL("ns-1", "coll-2", 1)
+	cs.SetBTL("ns-2", "coll-1", 0)
+	cs.SetBTL("ns-3", "coll-1", 1)
+	btlPolicy := pvtdatapolicy.ConstructBTLPolicy(cs)
+	env := NewTestStoreEnv(t, "TestCommitPvtDataOfOldBlocks", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	s := env.TestStore
+
+	// block 0: the pvt data of tx 1 is missing and expires with block 2
+	blk0MissingData := &ledger.MissingPrivateDataList{}
+	blk0MissingData.Add("tx1", 1, "ns-3", "coll-1", true)
+	assert.NoError(s.Prepare(0, nil, blk0MissingData, nil))
+	assert.NoError(s.Commit())
+
+	// block 1: the pvt data of tx 1 is missing, the pvt data of tx 3 is present
+	blk1MissingData := &ledger.MissingPrivateDataList{}
+	blk1MissingData.Add("tx1", 1, "ns-1", "coll-1", true)
+	blk1MissingData.Add("tx1", 1, "ns-1", "coll-2", true)
+	blk1MissingData.Add("tx1", 1, "ns-2", "coll-1", true)
+	testDataForBlk1 := []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 3, []string{"ns-1:coll-1"}),
+	}
+	assert.NoError(s.Prepare(1, testDataForBlk1, blk1MissingData, nil))
+	assert.NoError(s.Commit())
+
+	// block 2: tx 3 purges the key written by tx 1 of block 1 in ns-2:coll-1
+	purgeMarkers := []*ledger.PurgeMarker{
+		{SeqInBlock: 3, Namespace: "ns-2", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-ns-2-coll-1")},
+	}
+	assert.NoError(s.Prepare(2, nil, nil, purgeMarkers))
+	assert.NoError(s.Commit())
+	testWaitForPurgerRoutineToFinish(s)
+
+	// the pvt data of a block that is not yet committed is not accepted
+	err := s.CommitPvtDataOfOldBlocks([]*ledger.BlockPvtData{{BlockNum: 3}})
+	_, ok := err.(*ErrIllegalArgs)
+	assert.True(ok)
+
+	blk1Tx3Pvtdata := produceSamplePvtdata(t, 3, []string{"ns-1:coll-1"})
+	blk1Tx3Pvtdata.WriteSet.NsPvtRwset[0].CollectionPvtRwset[0].Rwset = []byte("tampered-rwset")
+	oldBlocksPvtData := []*ledger.BlockPvtData{
+		{
+			BlockNum: 0,
+			WriteSets: map[uint64]*ledger.TxPvtData{
+				1: produceSamplePvtdata(t, 1, []string{"ns-3:coll-1"}),
+			},
+		},
+		{
+			BlockNum: 1,
+			WriteSets: map[uint64]*ledger.TxPvtData{
+				1: produceSamplePvtdata(t, 1, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1"}),
+				3: blk1Tx3Pvtdata,
+			},
+		},
+	}
+	assert.NoError(s.CommitPvtDataOfOldBlocks(oldBlocksPvtData))
+
+	// the missing pvt data is committed and indexed, except the expired pvt data and the purged key
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-3", "coll-1", 0}, 1}))
+	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 1}))
+	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-2", 1}, 1}))
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-2", "coll-1", 1}, 1}))
+	assert.Equal(
+		[]*dataKey{{nsCollBlk{"ns-1", "coll-2", 1}, 1}},
+		testKeyHashIndexEntries(t, s, "ns-1", "coll-2", util.ComputeStringHash("key-ns-1-coll-2")),
+	)
+
+	// the pvt data that was not missing is left as is
+	blk1Pvtdata, err := s.GetPvtDataByBlockNum(1, nil)
+	assert.NoError(err)
+	assert.Len(blk1Pvtdata, 2)
+	assert.True(proto.Equal(testDataForBlk1[0].WriteSet, blk1Pvtdata[1].WriteSet))
+
+	// no pvt data is recorded as missing anymore
+	assert.False(testMissingDataKeyExists(t, s, &missingDataKey{nsCollBlk{"ns-1", "coll-1", 1}, true}))
+	assert.False(testMissingDataKeyExists(t, s, &missingDataKey{nsCollBlk{"ns-1", "coll-2", 1}, true}))
+	assert.False(testMissingDataKeyExists(t, s, &missingDataKey{nsCollBlk{"ns-2", "coll-1", 1}, true}))
+	missingPvtDataInfo, err := s.GetMissingPvtDataInfoForMostRecentBlocks(10)
+	assert.NoError(err)
+	assert.Empty(missingPvtDataInfo)
+
+	// the committed pvt data expires as per the BTL policy
+	assert.NoError(s.Prepare(3, nil, nil, nil))
+	assert.NoError(s.Commit())
+	testWaitForPurgerRoutineToFinish(s)
+	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 1}))
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-2", 1}, 1}))
+}
+
 // TODO Add tests for simulating a crash between calls `Prepare` and `Commit`/`Rollback`
 
 func testEmpty(expectedEmpty bool, assert *assert.Assertions, store Store) {
@@ -476,6 +697,17 @@ func testDataKeyExists(t *testing.T, s Store, dataKey *dataKey) bool {
 	return len(val) != 0
 }
 
+func testKeyHashIndexEntries(t *testing.T, s Store, ns, coll string, keyHash []byte) []*dataKey {
+	startKey, endKey := getKeyHashIndexKeysForRangeScan(ns, coll, keyHash)
+	itr := s.(*store).db.GetIterator(startKey, endKey)
+	defer itr.Release()
+	var dataKeys []*dataKey
+	for itr.Next() {
+		dataKeys = append(dataKeys, decodeKeyHashIndexKey(itr.Key(), ns, coll, keyHash))
+	}
+	return dataKeys
+}
+
 func testMissingDataKeyExists(t *testing.T, s Store, missingDataKey *missingDataKey) bool {
 	dataKeyBytes := encodeMissingDataKey(missingDataKey)
 	val, err := s.(*store).db.Get(dataKeyBytes)
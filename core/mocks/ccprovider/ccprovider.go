@@ -14,7 +14,7 @@ import (
 )
 
 type ExecuteChaincodeResultProvider interface {
-	ExecuteChaincodeResult() (*peer.Response, *peer.ChaincodeEvent, error)
+	ExecuteChaincodeResult() (*peer.Response, []*peer.ChaincodeEvent, error)
 }
 
 // MockCcProviderFactory is a factory that returns
@@ -89,6 +89,10 @@ func (m *MockTxSim) DeletePrivateData(namespace, collection, key string) error {
 	return nil
 }
 
+func (m *MockTxSim) PurgePrivateData(namespace, collection, key string) error {
+	return nil
+}
+
 func (m *MockTxSim) ExecuteQueryOnPrivateData(namespace, collection, query string) (commonledger.ResultsIterator, error) {
 	return nil, nil
 }
@@ -142,12 +146,12 @@ func (m *MockTxSim) DeletePrivateDataMetadata(namespace, collection, key string)
 }
 
 // ExecuteInit executes the chaincode given context and spec deploy
-func (c *MockCcProviderImpl) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *peer.ChaincodeDeploymentSpec) (*peer.Response, *peer.ChaincodeEvent, error) {
+func (c *MockCcProviderImpl) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *peer.ChaincodeDeploymentSpec) (*peer.Response, []*peer.ChaincodeEvent, error) {
 	return &peer.Response{}, nil, nil
 }
 
 // Execute executes the chaincode given context and spec invocation
-func (c *MockCcProviderImpl) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *peer.ChaincodeInput) (*peer.Response, *peer.ChaincodeEvent, error) {
+func (c *MockCcProviderImpl) Execute(txParams *ccprovider.TransactionParams, cccid *ccprovider.CCContext, spec *peer.ChaincodeInput) (*peer.Response, []*peer.ChaincodeEvent, error) {
 	return &peer.Response{}, nil, nil
 }
 
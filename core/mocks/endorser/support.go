@@ -23,10 +23,10 @@ type MockSupport struct {
 	IsSysCCAndNotInvokableExternalRv bool
 	IsSysCCRv                        bool
 	ExecuteCDSResp                   *pb.Response
-	ExecuteCDSEvent                  *pb.ChaincodeEvent
+	ExecuteCDSEvents                 []*pb.ChaincodeEvent
 	ExecuteCDSError                  error
 	ExecuteResp                      *pb.Response
-	ExecuteEvent                     *pb.ChaincodeEvent
+	ExecuteEvents                    []*pb.ChaincodeEvent
 	ExecuteError                     error
 	ChaincodeDefinitionRv            ccprovider.ChaincodeDefinition
 	ChaincodeDefinitionError         error
@@ -94,12 +94,12 @@ func (s *MockSupport) IsSysCC(name string) bool {
 	return s.IsSysCCRv
 }
 
-func (s *MockSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, *pb.ChaincodeEvent, error) {
-	return s.ExecuteCDSResp, s.ExecuteCDSEvent, s.ExecuteCDSError
+func (s *MockSupport) ExecuteLegacyInit(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeDeploymentSpec) (*pb.Response, []*pb.ChaincodeEvent, error) {
+	return s.ExecuteCDSResp, s.ExecuteCDSEvents, s.ExecuteCDSError
 }
 
-func (s *MockSupport) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeInput) (*pb.Response, *pb.ChaincodeEvent, error) {
-	return s.ExecuteResp, s.ExecuteEvent, s.ExecuteError
+func (s *MockSupport) Execute(txParams *ccprovider.TransactionParams, cid, name, version, txid string, signedProp *pb.SignedProposal, prop *pb.Proposal, spec *pb.ChaincodeInput) (*pb.Response, []*pb.ChaincodeEvent, error) {
+	return s.ExecuteResp, s.ExecuteEvents, s.ExecuteError
 }
 
 func (s *MockSupport) GetChaincodeDeploymentSpecFS(cds *pb.ChaincodeDeploymentSpec) (*pb.ChaincodeDeploymentSpec, error) {
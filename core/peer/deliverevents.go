@@ -16,6 +16,7 @@ limitations under the License.
 package peer
 
 import (
+	"regexp"
 	"runtime/debug"
 	"time"
 
@@ -43,6 +44,7 @@ type PolicyCheckerProvider func(resourceName string) deliver.PolicyCheckerFunc
 type server struct {
 	dh                    *deliver.Handler
 	policyCheckerProvider PolicyCheckerProvider
+	multipleEvents        func(channelID string) bool
 }
 
 // blockResponseSender structure used to send block responses
@@ -69,6 +71,8 @@ func (brs *blockResponseSender) SendBlockResponse(block *common.Block) error {
 // filteredBlockResponseSender structure used to send filtered block responses
 type filteredBlockResponseSender struct {
 	peer.Deliver_DeliverFilteredServer
+	eventFilter    *chaincodeEventFilter
+	multipleEvents func(channelID string) bool
 }
 
 func (fbrs *filteredBlockResponseSender) SendStatusResponse(status common.Status) error {
@@ -82,7 +86,7 @@ func (fbrs *filteredBlockResponseSender) SendStatusResponse(status common.Status
 func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block) error {
 	// Generates filtered block response
 	b := blockEvent(*block)
-	filteredBlock, err := b.toFilteredBlock()
+	filteredBlock, err := b.toFilteredBlock(fbrs.eventFilter, fbrs.multipleEvents)
 	if err != nil {
 		logger.Warningf("Failed to generate filtered block due to: %s", err)
 		return fbrs.SendStatusResponse(common.Status_BAD_REQUEST)
@@ -93,6 +97,77 @@ func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block)
 	return fbrs.Send(response)
 }
 
+// Recv receives the next seek envelope and extracts the chaincode event
+// filter it carries, which applies to the filtered blocks sent in response.
+// Envelopes with an invalid filter are answered with BAD_REQUEST and
+// skipped, as the deliver handler does for other malformed requests.
+func (fbrs *filteredBlockResponseSender) Recv() (*common.Envelope, error) {
+	for {
+		envelope, err := fbrs.Deliver_DeliverFilteredServer.Recv()
+		if err != nil {
+			return nil, err
+		}
+		filter, err := chaincodeEventFilterFromEnvelope(envelope)
+		if err != nil {
+			logger.Warningf("Rejecting deliver request due to invalid chaincode event filter: %s", err)
+			if err := fbrs.SendStatusResponse(common.Status_BAD_REQUEST); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		fbrs.eventFilter = filter
+		return envelope, nil
+	}
+}
+
+// chaincodeEventFilter selects the chaincode events included in filtered
+// blocks. A nil filter selects all the events.
+type chaincodeEventFilter struct {
+	chaincodeName string
+	eventName     *regexp.Regexp
+}
+
+// chaincodeEventFilterFromEnvelope returns the filter carried in the channel
+// header extension of the seek envelope, if any. Envelopes that can not be
+// parsed are left to the deliver handler to reject.
+func chaincodeEventFilterFromEnvelope(envelope *common.Envelope) (*chaincodeEventFilter, error) {
+	payload, err := utils.UnmarshalPayload(envelope.Payload)
+	if err != nil || payload.Header == nil {
+		return nil, nil
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil || len(chdr.Extension) == 0 {
+		return nil, nil
+	}
+
+	filterMsg := &peer.ChaincodeEventFilter{}
+	if err := proto.Unmarshal(chdr.Extension, filterMsg); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling ChaincodeEventFilter")
+	}
+	filter := &chaincodeEventFilter{chaincodeName: filterMsg.ChaincodeName}
+	if filterMsg.EventNameRegex != "" {
+		filter.eventName, err = regexp.Compile(filterMsg.EventNameRegex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid event name regular expression %s", filterMsg.EventNameRegex)
+		}
+	}
+	return filter, nil
+}
+
+// matches returns whether the event is selected by the filter
+func (f *chaincodeEventFilter) matches(event *peer.ChaincodeEvent) bool {
+	if f == nil {
+		return true
+	}
+	if f.chaincodeName != "" && f.chaincodeName != event.ChaincodeId {
+		return false
+	}
+	if f.eventName != nil && !f.eventName.MatchString(event.EventName) {
+		return false
+	}
+	return true
+}
+
 // transactionActions aliasing for peer.TransactionAction pointers slice
 type transactionActions []*peer.TransactionAction
 
@@ -105,12 +180,14 @@ func (s *server) DeliverFiltered(srv peer.Deliver_DeliverFilteredServer) error {
 	logger.Debugf("Starting new DeliverFiltered handler")
 	defer dumpStacktraceOnPanic()
 	// getting policy checker based on resources.Event_FilteredBlock resource name
+	responseSender := &filteredBlockResponseSender{
+		Deliver_DeliverFilteredServer: srv,
+		multipleEvents:                s.multipleEvents,
+	}
 	deliverServer := &deliver.Server{
-		Receiver:      srv,
-		PolicyChecker: s.policyCheckerProvider(resources.Event_FilteredBlock),
-		ResponseSender: &filteredBlockResponseSender{
-			Deliver_DeliverFilteredServer: srv,
-		},
+		Receiver:       responseSender,
+		PolicyChecker:  s.policyCheckerProvider(resources.Event_FilteredBlock),
+		ResponseSender: responseSender,
 	}
 	return s.dh.Handle(srv.Context(), deliverServer)
 }
@@ -142,7 +219,19 @@ func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerP
 	return &server{
 		dh: deliver.NewHandler(chainManager, timeWindow, mutualTLS),
 		policyCheckerProvider: policyCheckerProvider,
+		multipleEvents:        supportsMultipleEvents,
+	}
+}
+
+// supportsMultipleEvents returns whether the channel has the V1_4 capability,
+// without which only the first event of a transaction is delivered
+func supportsMultipleEvents(channelID string) bool {
+	res := GetStableChannelConfig(channelID)
+	if res == nil {
+		return false
 	}
+	ac, ok := res.ApplicationConfig()
+	return ok && ac.Capabilities().V1_4Validation()
 }
 
 func (s *server) sendProducer(srv peer.Deliver_DeliverFilteredServer) func(msg proto.Message) error {
@@ -156,7 +245,7 @@ func (s *server) sendProducer(srv peer.Deliver_DeliverFilteredServer) func(msg p
 	}
 }
 
-func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
+func (block *blockEvent) toFilteredBlock(filter *chaincodeEventFilter, multipleEvents func(channelID string) bool) (*peer.FilteredBlock, error) {
 	filteredBlock := &peer.FilteredBlock{
 		Number: block.Header.Number,
 	}
@@ -208,7 +297,7 @@ func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
 				return nil, errors.WithMessage(err, "error unmarshal transaction payload for block event")
 			}
 
-			filteredTransaction.Data, err = transactionActions(tx.Actions).toFilteredActions()
+			filteredTransaction.Data, err = transactionActions(tx.Actions).toFilteredActions(filter, multipleEvents != nil && multipleEvents(chdr.ChannelId))
 			if err != nil {
 				logger.Errorf(err.Error())
 				return nil, err
@@ -221,7 +310,7 @@ func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
 	return filteredBlock, nil
 }
 
-func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_TransactionActions, error) {
+func (ta transactionActions) toFilteredActions(filter *chaincodeEventFilter, multipleEvents bool) (*peer.FilteredTransaction_TransactionActions, error) {
 	transactionActions := &peer.FilteredTransactionActions{}
 	for _, action := range ta {
 		chaincodeActionPayload, err := utils.GetChaincodeActionPayload(action.Payload)
@@ -243,12 +332,19 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 			return nil, errors.WithMessage(err, "error unmarshal chaincode action for block event")
 		}
 
-		ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
+		if !multipleEvents {
+			// only the single legacy event is honored without the V1_4 capability
+			caPayload.ChaincodeEvents = nil
+		}
+		ccEvents, err := utils.GetChaincodeActionEvents(caPayload)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error unmarshal chaincode event for block event")
 		}
 
-		if ccEvent.GetChaincodeId() != "" {
+		for _, ccEvent := range ccEvents {
+			if ccEvent.GetChaincodeId() == "" || !filter.matches(ccEvent) {
+				continue
+			}
 			filteredAction := &peer.FilteredChaincodeAction{
 				ChaincodeEvent: &peer.ChaincodeEvent{
 					TxId:        ccEvent.TxId,
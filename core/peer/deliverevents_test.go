@@ -510,3 +510,136 @@ func createTestBlock(data []*common.Envelope) (*common.Block, error) {
 	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(data))
 	return block, nil
 }
+
+func TestFilteredBlockChaincodeEventFilter(t *testing.T) {
+	events := []*peer.ChaincodeEvent{
+		{ChaincodeId: "asset", TxId: "tx1", EventName: "AssetCreated"},
+		{ChaincodeId: "asset", TxId: "tx1", EventName: "AssetTransferred"},
+		{ChaincodeId: "token", TxId: "tx1", EventName: "TokenMinted"},
+	}
+	firstEventBytes, err := proto.Marshal(events[0])
+	assert.NoError(t, err)
+	actionBytes, err := proto.Marshal(&peer.ChaincodeAction{
+		Events:          firstEventBytes,
+		ChaincodeEvents: events,
+	})
+	assert.NoError(t, err)
+	chaincodeActionPayload := &peer.ChaincodeActionPayload{
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: utils.MarshalOrPanic(&peer.ProposalResponsePayload{Extension: actionBytes}),
+		},
+	}
+	payload, err := createEndorsement("testChainID", "tx1", chaincodeActionPayload)
+	assert.NoError(t, err)
+	block, err := createTestBlock([]*common.Envelope{{Payload: utils.MarshalOrPanic(payload)}})
+	assert.NoError(t, err)
+
+	v14 := func(string) bool { return true }
+	eventNames := func(filter *chaincodeEventFilter) []string {
+		b := blockEvent(*block)
+		filteredBlock, err := b.toFilteredBlock(filter, v14)
+		assert.NoError(t, err)
+		assert.Len(t, filteredBlock.FilteredTransactions, 1)
+		var names []string
+		for _, action := range filteredBlock.FilteredTransactions[0].GetTransactionActions().ChaincodeActions {
+			names = append(names, action.ChaincodeEvent.EventName)
+		}
+		return names
+	}
+
+	filterFor := func(chaincodeName, eventNameRegex string) *chaincodeEventFilter {
+		env := &common.Envelope{
+			Payload: utils.MarshalOrPanic(&common.Payload{
+				Header: &common.Header{
+					ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+						ChannelId: "testChainID",
+						Extension: utils.MarshalOrPanic(&peer.ChaincodeEventFilter{
+							ChaincodeName:  chaincodeName,
+							EventNameRegex: eventNameRegex,
+						}),
+					}),
+				},
+			}),
+		}
+		filter, err := chaincodeEventFilterFromEnvelope(env)
+		assert.NoError(t, err)
+		return filter
+	}
+
+	assert.Equal(t, []string{"AssetCreated", "AssetTransferred", "TokenMinted"}, eventNames(nil))
+	assert.Equal(t, []string{"AssetCreated", "AssetTransferred"}, eventNames(filterFor("asset", "")))
+	assert.Equal(t, []string{"AssetTransferred"}, eventNames(filterFor("asset", "^Asset(Transferred|Deleted)$")))
+	assert.Equal(t, []string{"AssetCreated", "TokenMinted"}, eventNames(filterFor("", "Created|Minted")))
+	assert.Empty(t, eventNames(filterFor("other", "")))
+
+	// without the V1_4 capability only the first event is delivered
+	v14 = func(string) bool { return false }
+	assert.Equal(t, []string{"AssetCreated"}, eventNames(nil))
+	assert.Empty(t, eventNames(filterFor("token", "")))
+}
+
+func TestChaincodeEventFilterFromEnvelope(t *testing.T) {
+	envelopeWithExtension := func(extension []byte) *common.Envelope {
+		return &common.Envelope{
+			Payload: utils.MarshalOrPanic(&common.Payload{
+				Header: &common.Header{
+					ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+						ChannelId: "testChainID",
+						Extension: extension,
+					}),
+				},
+			}),
+		}
+	}
+
+	filter, err := chaincodeEventFilterFromEnvelope(envelopeWithExtension(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, filter)
+
+	// malformed envelopes are left to the deliver handler
+	filter, err = chaincodeEventFilterFromEnvelope(&common.Envelope{Payload: []byte("garbage")})
+	assert.NoError(t, err)
+	assert.Nil(t, filter)
+
+	_, err = chaincodeEventFilterFromEnvelope(envelopeWithExtension([]byte("garbage")))
+	assert.Error(t, err)
+
+	_, err = chaincodeEventFilterFromEnvelope(envelopeWithExtension(utils.MarshalOrPanic(&peer.ChaincodeEventFilter{EventNameRegex: "("})))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid event name regular expression (")
+}
+
+func TestFilteredBlockResponseSenderRecv(t *testing.T) {
+	badRequest := utils.MarshalOrPanic(&common.Payload{
+		Header: &common.Header{
+			ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+				Extension: utils.MarshalOrPanic(&peer.ChaincodeEventFilter{EventNameRegex: "["}),
+			}),
+		},
+	})
+	goodRequest := utils.MarshalOrPanic(&common.Payload{
+		Header: &common.Header{
+			ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+				Extension: utils.MarshalOrPanic(&peer.ChaincodeEventFilter{ChaincodeName: "asset"}),
+			}),
+		},
+	})
+
+	deliverServer := &mockDeliverServer{}
+	deliverServer.On("Recv").Return(&common.Envelope{Payload: badRequest}, nil).Once()
+	deliverServer.On("Recv").Return(&common.Envelope{Payload: goodRequest}, nil).Once()
+	deliverServer.On("Send", &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_Status{Status: common.Status_BAD_REQUEST},
+	}).Return(nil).Once()
+
+	sender := &filteredBlockResponseSender{Deliver_DeliverFilteredServer: deliverServer}
+	env, err := sender.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, goodRequest, env.Payload)
+	assert.Equal(t, "asset", sender.eventFilter.chaincodeName)
+	deliverServer.AssertExpectations(t)
+
+	deliverServer.On("Recv").Return(nil, io.EOF)
+	_, err = sender.Recv()
+	assert.Equal(t, io.EOF, err)
+}
@@ -47,6 +47,12 @@ func (ds *mockDeliveryClient) StartDeliverForChannel(chainID string, ledgerInfo
 	return nil
 }
 
+// StartStaggeredDeliverForChannel dynamically starts delivery of new blocks from ordering service
+// to channel peers, connecting to the ordering service endpoint at the given offset first.
+func (ds *mockDeliveryClient) StartStaggeredDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, endpointOffset int, f func()) error {
+	return nil
+}
+
 // StopDeliverForChannel dynamically stops delivery of new blocks from ordering service
 // to channel peers.
 func (ds *mockDeliveryClient) StopDeliverForChannel(chainID string) error {
@@ -134,6 +134,27 @@ func (f CollectionsConfigUpgradesNotAllowed) Error() string {
 	return "as V1_2 capability is not enabled, collection upgrades are not allowed"
 }
 
+// CollectionsConfigUpdatesNotAllowed when V1_4 capability is not enabled
+type CollectionsConfigUpdatesNotAllowed string
+
+func (f CollectionsConfigUpdatesNotAllowed) Error() string {
+	return "as V1_4 capability is not enabled, collection updates without chaincode upgrade are not allowed"
+}
+
+// CollectionEndorsementPolicyNotAllowed when V1_4 capability is not enabled
+type CollectionEndorsementPolicyNotAllowed string
+
+func (f CollectionEndorsementPolicyNotAllowed) Error() string {
+	return fmt.Sprintf("as V1_4 capability is not enabled, collection %s cannot define an endorsement policy", string(f))
+}
+
+// ResourceLimitsNotAllowed when V1_4 capability is not enabled
+type ResourceLimitsNotAllowed string
+
+func (f ResourceLimitsNotAllowed) Error() string {
+	return "as V1_4 capability is not enabled, the chaincode definition cannot set resource limits"
+}
+
 // PrivateChannelDataNotAvailable when V1_2 or later capability is not enabled
 type PrivateChannelDataNotAvailable string
 
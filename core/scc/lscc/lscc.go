@@ -9,9 +9,11 @@ package lscc
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/aclmgmt"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
@@ -92,6 +94,9 @@ const (
 	// GETCOLLECTIONSCONFIGALIAS gets the collections config for a chaincode
 	GETCOLLECTIONSCONFIGALIAS = "getcollectionsconfig"
 
+	// UPDATECOLLECTIONS updates the collections config of an instantiated chaincode
+	UPDATECOLLECTIONS = "updatecollections"
+
 	allowedChaincodeName = "^[a-zA-Z0-9]+([-_][a-zA-Z0-9]+)*$"
 	allowedCharsVersion  = "[A-Za-z0-9_.+-]+"
 )
@@ -185,7 +190,14 @@ func (lscc *LifeCycleSysCC) ChaincodeContainerInfo(channelID, ccName string) (*c
 		return nil, errors.Wrapf(err, "could not get chaincode code")
 	}
 
-	return ccprovider.DeploymentSpecToChaincodeContainerInfo(cds), nil
+	cd, err := lscc.getChaincodeData(ccName, chaincodeDataBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get chaincode data")
+	}
+
+	ccci := ccprovider.DeploymentSpecToChaincodeContainerInfo(cds)
+	ccci.ResourceLimits = ccprovider.MergeResourceLimits(cd.ResourceLimits, cds.ResourceLimits)
+	return ccci, nil
 }
 
 func (lscc *LifeCycleSysCC) ChaincodeDefinition(chaincodeName string, txsim ledger.QueryExecutor) (ccprovider.ChaincodeDefinition, error) {
@@ -321,6 +333,13 @@ func (lscc *LifeCycleSysCC) putChaincodeCollectionData(stub shim.ChaincodeStubIn
 		return errors.Errorf("invalid collection configuration supplied for chaincode %s:%s", cd.Name, cd.Version)
 	}
 
+	for _, collectionConfig := range collections.Config {
+		name := collectionConfig.GetStaticCollectionConfig().GetName()
+		if ep := collectionConfig.GetStaticCollectionConfig().GetEndorsementPolicy(); ep != nil && ep.GetSignaturePolicy() == nil {
+			return errors.Errorf("collection %s has an empty endorsement policy", name)
+		}
+	}
+
 	mspmgr := mgmt.GetManagerForChain(stub.GetChannelID())
 	if mspmgr == nil {
 		return fmt.Errorf("could not get MSP manager for channel %s", stub.GetChannelID())
@@ -342,6 +361,69 @@ func (lscc *LifeCycleSysCC) putChaincodeCollectionData(stub shim.ChaincodeStubIn
 	return nil
 }
 
+// checkCollectionEndorsementPolicies makes sure that the collections of the
+// supplied configuration package only define endorsement policies if the
+// validation of the channel enforces them
+func checkCollectionEndorsementPolicies(collectionConfigBytes []byte, ac channelconfig.Application) error {
+	if len(collectionConfigBytes) == 0 || ac.Capabilities().V1_4Validation() {
+		return nil
+	}
+
+	collections := &common.CollectionConfigPackage{}
+	err := proto.Unmarshal(collectionConfigBytes, collections)
+	if err != nil {
+		// malformed configurations are rejected by putChaincodeCollectionData
+		return nil
+	}
+
+	for _, collectionConfig := range collections.Config {
+		coll := collectionConfig.GetStaticCollectionConfig()
+		if coll.GetEndorsementPolicy() != nil {
+			return CollectionEndorsementPolicyNotAllowed(coll.GetName())
+		}
+	}
+
+	return nil
+}
+
+// checkCollectionNames makes sure that the collections of the supplied
+// configuration package do not use the reserved prefix of the implicit
+// collections if the channel supports them
+func checkCollectionNames(collectionConfigBytes []byte, ac channelconfig.Application) error {
+	if len(collectionConfigBytes) == 0 || !ac.Capabilities().V1_4Validation() {
+		return nil
+	}
+
+	collections := &common.CollectionConfigPackage{}
+	err := proto.Unmarshal(collectionConfigBytes, collections)
+	if err != nil {
+		// malformed configurations are rejected by putChaincodeCollectionData
+		return nil
+	}
+
+	for _, collectionConfig := range collections.Config {
+		name := collectionConfig.GetStaticCollectionConfig().GetName()
+		if strings.HasPrefix(name, privdata.ImplicitCollectionPrefix) {
+			return errors.Errorf("collection name %s uses the reserved prefix %s", name, privdata.ImplicitCollectionPrefix)
+		}
+	}
+
+	return nil
+}
+
+// checkResourceLimits makes sure that resource limits are only recorded in
+// the chaincode definition if the channel has the V1_4 capability and that
+// they can be enforced
+func checkResourceLimits(limits *pb.ChaincodeResourceLimits, ac channelconfig.Application) error {
+	if limits == nil {
+		return nil
+	}
+	if !ac.Capabilities().V1_4Validation() {
+		return ResourceLimitsNotAllowed("")
+	}
+	return ccprovider.ValidateResourceLimits(limits)
+}
+
 // getChaincodeCollectionData retrieve collections config.
 func (lscc *LifeCycleSysCC) getChaincodeCollectionData(stub shim.ChaincodeStubInterface, chaincodeName string) pb.Response {
 	key := privdata.BuildCollectionKVSKey(chaincodeName)
@@ -580,6 +662,10 @@ func (lscc *LifeCycleSysCC) executeInstall(stub shim.ChaincodeStubInterface, ccb
 		return errors.Errorf("cannot install: %s is the name of a system chaincode", cds.ChaincodeSpec.ChaincodeId.Name)
 	}
 
+	if err = ccprovider.ValidateResourceLimits(cds.ResourceLimits); err != nil {
+		return err
+	}
+
 	// Get any statedb artifacts from the chaincode package, e.g. couchdb index definitions
 	statedbArtifactsTar, err := ccprovider.ExtractStatedbArtifactsFromCCPackage(ccpack, lscc.PlatformRegistry)
 	if err != nil {
@@ -680,6 +766,7 @@ func (lscc *LifeCycleSysCC) executeDeploy(
 	cdfs.Escc = string(escc)
 	cdfs.Vscc = string(vscc)
 	cdfs.Policy = policy
+	cdfs.ResourceLimits = cds.ResourceLimits
 
 	// retrieve and evaluate instantiation policy
 	cdfs.InstantiationPolicy, err = lscc.Support.GetInstantiationPolicy(chainname, ccpackfs)
@@ -751,6 +838,7 @@ func (lscc *LifeCycleSysCC) executeUpgrade(stub shim.ChaincodeStubInterface, cha
 	cdfs.Escc = string(escc)
 	cdfs.Vscc = string(vscc)
 	cdfs.Policy = policy
+	cdfs.ResourceLimits = cds.ResourceLimits
 
 	// retrieve and evaluate new instantiation policy
 	cdfs.InstantiationPolicy, err = lscc.Support.GetInstantiationPolicy(chainName, ccpackfs)
@@ -789,6 +877,77 @@ func (lscc *LifeCycleSysCC) executeUpgrade(stub shim.ChaincodeStubInterface, cha
 	return cdfs, nil
 }
 
+// executeUpdateCollections implements the "updatecollections" Invoke transaction.
+// It replaces the collection configuration of an instantiated chaincode without
+// upgrading it; the update is governed by the instantiation policy of the chaincode
+func (lscc *LifeCycleSysCC) executeUpdateCollections(stub shim.ChaincodeStubInterface, chainName string, chaincodeName string, collectionConfigBytes []byte) error {
+	if len(collectionConfigBytes) == 0 {
+		return errors.Errorf("no collection configuration supplied for chaincode %s", chaincodeName)
+	}
+
+	cdbytes, _ := lscc.getCCInstance(stub, chaincodeName)
+	if cdbytes == nil {
+		return NotFoundErr(chaincodeName)
+	}
+
+	cdLedger, err := lscc.getChaincodeData(chaincodeName, cdbytes)
+	if err != nil {
+		return err
+	}
+
+	//do not update if instantiation policy is violated
+	if cdLedger.InstantiationPolicy == nil {
+		return InstantiationPolicyMissing("")
+	}
+	signedProp, err := stub.GetSignedProposal()
+	if err != nil {
+		return err
+	}
+	err = lscc.Support.CheckInstantiationPolicy(signedProp, chainName, cdLedger.InstantiationPolicy)
+	if err != nil {
+		return err
+	}
+
+	newCollections := &common.CollectionConfigPackage{}
+	err = proto.Unmarshal(collectionConfigBytes, newCollections)
+	if err != nil {
+		return errors.Errorf("invalid collection configuration supplied for chaincode %s", chaincodeName)
+	}
+
+	oldCollectionsBytes, err := stub.GetState(privdata.BuildCollectionKVSKey(chaincodeName))
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error getting collections for chaincode %s", chaincodeName))
+	}
+	if oldCollectionsBytes != nil {
+		oldCollections, err := privdata.ParseCollectionConfig(oldCollectionsBytes)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("invalid collection configuration on the ledger for chaincode %s", chaincodeName))
+		}
+		if missing := missingCollections(newCollections, oldCollections); len(missing) > 0 {
+			return errors.Errorf("the following existing collections are missing in the new collection configuration package: %v", missing)
+		}
+	}
+
+	return lscc.putChaincodeCollectionData(stub, cdLedger, collectionConfigBytes)
+}
+
+// missingCollections returns the names of the collections of the old
+// package that are not part of the new package
+func missingCollections(newCollections, oldCollections *common.CollectionConfigPackage) []string {
+	newNames := make(map[string]struct{}, len(newCollections.Config))
+	for _, collectionConfig := range newCollections.Config {
+		newNames[collectionConfig.GetStaticCollectionConfig().GetName()] = struct{}{}
+	}
+	var missing []string
+	for _, collectionConfig := range oldCollections.Config {
+		name := collectionConfig.GetStaticCollectionConfig().GetName()
+		if _, ok := newNames[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
 //-------------- the chaincode stub interface implementation ----------
 
 //Init is mostly useless for SCC
@@ -796,8 +955,9 @@ func (lscc *LifeCycleSysCC) Init(stub shim.ChaincodeStubInterface) pb.Response {
 	return shim.Success(nil)
 }
 
-// Invoke implements lifecycle functions "deploy", "start", "stop", "upgrade".
+// Invoke implements lifecycle functions "deploy", "start", "stop", "upgrade", "updatecollections".
 // Deploy's arguments -  {[]byte("deploy"), []byte(<chainname>), <unmarshalled pb.ChaincodeDeploymentSpec>}
+// Updatecollections' arguments - {[]byte("updatecollections"), []byte(<chainname>), []byte(<chaincodename>), <marshalled common.CollectionConfigPackage>}
 //
 // Invoke also implements some query-like functions
 // Get chaincode arguments -  {[]byte("getid"), []byte(<chainname>), []byte(<chaincodename>)}
@@ -905,6 +1065,18 @@ func (lscc *LifeCycleSysCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response
 			collectionsConfig = args[6]
 		}
 
+		if err := checkCollectionEndorsementPolicies(collectionsConfig, ac); err != nil {
+			return shim.Error(err.Error())
+		}
+
+		if err := checkCollectionNames(collectionsConfig, ac); err != nil {
+			return shim.Error(err.Error())
+		}
+
+		if err := checkResourceLimits(cds.ResourceLimits, ac); err != nil {
+			return shim.Error(err.Error())
+		}
+
 		cd, err := lscc.executeDeployOrUpgrade(stub, channel, cds, EP, escc, vscc, collectionsConfig, function)
 		if err != nil {
 			return shim.Error(err.Error())
@@ -995,6 +1167,33 @@ func (lscc *LifeCycleSysCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response
 		}
 
 		return lscc.getChaincodeCollectionData(stub, chaincodeName)
+	case UPDATECOLLECTIONS:
+		// we expect the function name, the chain name, the
+		// chaincode name and the collection configuration
+		if len(args) != 4 {
+			return shim.Error(InvalidArgsLenErr(len(args)).Error())
+		}
+
+		channel := string(args[1])
+
+		if !lscc.isValidChannelName(channel) {
+			return shim.Error(InvalidChannelNameErr(channel).Error())
+		}
+
+		ac, exists := lscc.SCCProvider.GetApplicationConfig(channel)
+		if !exists {
+			logger.Panicf("programming error, non-existent appplication config for channel '%s'", channel)
+		}
+
+		if !ac.Capabilities().V1_4Validation() {
+			return shim.Error(CollectionsConfigUpdatesNotAllowed("").Error())
+		}
+
+		err := lscc.executeUpdateCollections(stub, channel, string(args[2]), args[3])
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
 	}
 
 	return shim.Error(InvalidFunctionErr(function).Error())
@@ -27,6 +27,7 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/platforms/golang"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	cutil "github.com/hyperledger/fabric/core/container/util"
 	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
 	"github.com/hyperledger/fabric/core/mocks/scc/lscc"
@@ -571,6 +572,103 @@ func TestUpgrade(t *testing.T) {
 	assert.Equal(t, ccpBytes, actualccpBytes)
 }
 
+func TestUpdateCollections(t *testing.T) {
+	scc := New(NewMockProvider(), mockAclProvider, platforms.NewRegistry(&golang.Platform{}))
+	scc.Support = &lscc.MockSupport{}
+	stub := shim.NewMockStub("lscc", scc)
+	res := stub.MockInit("1", nil)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	sProp, _ := putils.MockSignedEndorserProposal2OrPanic(chainid, &pb.ChaincodeSpec{}, id)
+
+	policyEnvelope := &common.SignaturePolicyEnvelope{}
+	coll1 := createCollectionConfig("mycollection1", policyEnvelope, 1, 2)
+	coll2 := createCollectionConfig("mycollection2", policyEnvelope, 1, 2)
+	ccpBytes := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, coll2}})
+	args := [][]byte{[]byte("updatecollections"), []byte("test"), []byte("example02"), ccpBytes}
+
+	// As v14 capability is not enabled, an error is expected
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, "as V1_4 capability is not enabled, collection updates without chaincode upgrade are not allowed", res.Message)
+
+	// the v13 capability is not enough either
+	mocksccProvider := (&mscc.MocksccProviderFactory{
+		ApplicationConfigBool: true,
+		ApplicationConfigRv: &config.MockApplication{
+			CapabilitiesRv: &config.MockApplicationCapabilities{
+				PrivateChannelDataRv: true,
+				V1_3ValidationRv:     true,
+			},
+		},
+	}).NewSystemChaincodeProvider().(*mscc.MocksccProviderImpl)
+	scc = New(mocksccProvider, mockAclProvider, platforms.NewRegistry(&golang.Platform{}))
+	scc.Support = &lscc.MockSupport{}
+	stub = shim.NewMockStub("lscc", scc)
+	res = stub.MockInit("1", nil)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, "as V1_4 capability is not enabled, collection updates without chaincode upgrade are not allowed", res.Message)
+
+	mocksccProvider = (&mscc.MocksccProviderFactory{
+		ApplicationConfigBool: true,
+		ApplicationConfigRv: &config.MockApplication{
+			CapabilitiesRv: &config.MockApplicationCapabilities{
+				PrivateChannelDataRv: true,
+				V1_3ValidationRv:     true,
+				V1_4ValidationRv:     true,
+			},
+		},
+	}).NewSystemChaincodeProvider().(*mscc.MocksccProviderImpl)
+	scc = New(mocksccProvider, mockAclProvider, platforms.NewRegistry(&golang.Platform{}))
+	scc.Support = &lscc.MockSupport{}
+	stub = shim.NewMockStub("lscc", scc)
+	res = stub.MockInit("1", nil)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+
+	res = stub.MockInvokeWithSignedProposal("1", args[:3], sProp)
+	assert.Equal(t, InvalidArgsLenErr(3).Error(), res.Message)
+
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, NotFoundErr("example02").Error(), res.Message)
+
+	putChaincodeData := func(cd *ccprovider.ChaincodeData) {
+		stub.MockTransactionStart("1")
+		err := stub.PutState("example02", utils.MarshalOrPanic(cd))
+		assert.NoError(t, err)
+		stub.MockTransactionEnd("1")
+	}
+	putChaincodeData(&ccprovider.ChaincodeData{Name: "example02", Version: "0"})
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, InstantiationPolicyMissing("").Error(), res.Message)
+
+	putChaincodeData(&ccprovider.ChaincodeData{Name: "example02", Version: "0", InstantiationPolicy: []byte("instantiation policy")})
+	scc.Support.(*lscc.MockSupport).CheckInstantiationPolicyMap = map[string]error{"instantiation policy": errors.New("barf")}
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, "barf", res.Message)
+	scc.Support.(*lscc.MockSupport).CheckInstantiationPolicyMap = nil
+
+	res = stub.MockInvokeWithSignedProposal("1", [][]byte{[]byte("updatecollections"), []byte("test"), []byte("example02"), nil}, sProp)
+	assert.Equal(t, "no collection configuration supplied for chaincode example02", res.Message)
+
+	res = stub.MockInvokeWithSignedProposal("1", [][]byte{[]byte("updatecollections"), []byte("test"), []byte("example02"), []byte("barf")}, sProp)
+	assert.Equal(t, "invalid collection configuration supplied for chaincode example02", res.Message)
+
+	res = stub.MockInvokeWithSignedProposal("1", args, sProp)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.Equal(t, ccpBytes, stub.State["example02~collection"])
+
+	// the existing collections cannot be removed
+	ccpBytes = utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll2}})
+	res = stub.MockInvokeWithSignedProposal("1", [][]byte{[]byte("updatecollections"), []byte("test"), []byte("example02"), ccpBytes}, sProp)
+	assert.Equal(t, "the following existing collections are missing in the new collection configuration package: [mycollection1]", res.Message)
+
+	// but their block to live can be updated
+	coll1.GetStaticCollectionConfig().BlockToLive = 10
+	ccpBytes = utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, coll2}})
+	res = stub.MockInvokeWithSignedProposal("1", [][]byte{[]byte("updatecollections"), []byte("test"), []byte("example02"), ccpBytes}, sProp)
+	assert.Equal(t, int32(shim.OK), res.Status, res.Message)
+	assert.Equal(t, ccpBytes, stub.State["example02~collection"])
+}
+
 func testUpgrade(t *testing.T, ccname string, version string, newccname string, newversion string, path string, expectedErrorMsg string, scc *LifeCycleSysCC, stub *shim.MockStub, collectionConfigBytes []byte) {
 	if scc == nil {
 		scc = New(NewMockProvider(), mockAclProvider, platforms.NewRegistry(&golang.Platform{}))
@@ -889,6 +987,66 @@ func TestPutChaincodeCollectionData(t *testing.T) {
 	err = scc.putChaincodeCollectionData(stub, cd, ccpBytes)
 	assert.NoError(t, err)
 	stub.MockTransactionEnd("foo")
+
+	collWithEmptyEP := createCollectionConfig("mycollection2", policyEnvelope, 1, 2)
+	collWithEmptyEP.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{}
+	ccp = &common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, collWithEmptyEP}}
+	ccpBytes, err = proto.Marshal(ccp)
+	assert.NoError(t, err)
+
+	stub.MockTransactionStart("foo")
+	err = scc.putChaincodeCollectionData(stub, cd, ccpBytes)
+	assert.EqualError(t, err, "collection mycollection2 has an empty endorsement policy")
+	stub.MockTransactionEnd("foo")
+}
+
+func TestCheckCollectionEndorsementPolicies(t *testing.T) {
+	policyEnvelope := cauthdsl.SignedByMspMember("Org1MSP")
+	coll1 := createCollectionConfig("mycollection1", policyEnvelope, 1, 2)
+	coll2 := createCollectionConfig("mycollection2", policyEnvelope, 1, 2)
+	coll2.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{
+		Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+			SignaturePolicy: policyEnvelope,
+		},
+	}
+	withoutEP := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1}})
+	withEP := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, coll2}})
+
+	v12 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{PrivateChannelDataRv: true}}
+	v13 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{PrivateChannelDataRv: true, V1_3ValidationRv: true}}
+	v14 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{PrivateChannelDataRv: true, V1_3ValidationRv: true, V1_4ValidationRv: true}}
+
+	assert.NoError(t, checkCollectionEndorsementPolicies(nil, v12))
+	assert.NoError(t, checkCollectionEndorsementPolicies(withoutEP, v12))
+	assert.NoError(t, checkCollectionEndorsementPolicies(withEP, v14))
+	assert.EqualError(t, checkCollectionEndorsementPolicies(withEP, v12), "as V1_4 capability is not enabled, collection mycollection2 cannot define an endorsement policy")
+	assert.EqualError(t, checkCollectionEndorsementPolicies(withEP, v13), "as V1_4 capability is not enabled, collection mycollection2 cannot define an endorsement policy")
+}
+
+func TestCheckCollectionNames(t *testing.T) {
+	policyEnvelope := cauthdsl.SignedByMspMember("Org1MSP")
+	coll1 := createCollectionConfig("mycollection1", policyEnvelope, 1, 2)
+	implicitColl := createCollectionConfig(privdata.ImplicitCollectionNameForOrg("Org1MSP"), policyEnvelope, 1, 2)
+	withoutImplicit := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1}})
+	withImplicit := utils.MarshalOrPanic(&common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, implicitColl}})
+
+	v13 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{PrivateChannelDataRv: true, V1_3ValidationRv: true}}
+	v14 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{PrivateChannelDataRv: true, V1_3ValidationRv: true, V1_4ValidationRv: true}}
+
+	assert.NoError(t, checkCollectionNames(nil, v14))
+	assert.NoError(t, checkCollectionNames(withoutImplicit, v14))
+	assert.NoError(t, checkCollectionNames(withImplicit, v13))
+	assert.EqualError(t, checkCollectionNames(withImplicit, v14), "collection name _implicit_org_Org1MSP uses the reserved prefix _implicit_org_")
+}
+
+func TestCheckResourceLimits(t *testing.T) {
+	v13 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{V1_3ValidationRv: true}}
+	v14 := &config.MockApplication{CapabilitiesRv: &config.MockApplicationCapabilities{V1_3ValidationRv: true, V1_4ValidationRv: true}}
+
+	assert.NoError(t, checkResourceLimits(nil, v13))
+	assert.NoError(t, checkResourceLimits(&pb.ChaincodeResourceLimits{MilliCpus: 500}, v14))
+	assert.EqualError(t, checkResourceLimits(&pb.ChaincodeResourceLimits{MilliCpus: 500}, v13), "as V1_4 capability is not enabled, the chaincode definition cannot set resource limits")
+	assert.EqualError(t, checkResourceLimits(&pb.ChaincodeResourceLimits{MilliCpus: 5}, v14), "the CPU limit of a chaincode must be at least 10 milli CPUs, got 5")
 }
 
 func TestGetChaincodeCollectionData(t *testing.T) {
@@ -78,6 +78,11 @@ type Store interface {
 	// after successful block commit, PurgeByHeight() is still required to remove orphan entries (as
 	// transaction that gets endorsed may not be submitted by the client for commit)
 	PurgeByHeight(maxBlockNumToRetain uint64) error
+	// PurgeByKeys removes the private write sets, received at a block height lesser than or equal
+	// to the given blockNum, which write (or set the metadata of) a key purged by one of the given
+	// purge markers. This ensures that a private data key purged by a transaction in the block blockNum
+	// is not retained in the transient store via the write sets simulated before the purge was committed
+	PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error
 	// GetMinTransientBlkHt returns the lowest block height remaining in transient store
 	GetMinTransientBlkHt() (uint64, error)
 	Shutdown()
@@ -337,6 +342,61 @@ func (s *store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return s.db.WriteBatch(dbBatch, true)
 }
 
+// PurgeByKeys removes the private write sets, received at a block height lesser than or equal
+// to the given blockNum, which write (or set the metadata of) a key purged by one of the given purge markers
+func (s *store) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	if len(purgeMarkers) == 0 {
+		return nil
+	}
+	logger.Debugf("Purging private write sets of [%d] purged keys from transient store received till block [%d]", len(purgeMarkers), blockNum)
+
+	purgedKeyHashes := make(map[string]map[string]map[string]struct{})
+	for _, purgeMarker := range purgeMarkers {
+		collKeyHashes, ok := purgedKeyHashes[purgeMarker.Namespace]
+		if !ok {
+			collKeyHashes = make(map[string]map[string]struct{})
+			purgedKeyHashes[purgeMarker.Namespace] = collKeyHashes
+		}
+		keyHashes, ok := collKeyHashes[purgeMarker.Collection]
+		if !ok {
+			keyHashes = make(map[string]struct{})
+			collKeyHashes[purgeMarker.Collection] = keyHashes
+		}
+		keyHashes[string(purgeMarker.KeyHash)] = struct{}{}
+	}
+
+	startKey := createPurgeIndexByHeightRangeStartKey(0)
+	endKey := createPurgeIndexByHeightRangeEndKey(blockNum)
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	for iter.Next() {
+		compositeKeyPurgeIndexByHeight := iter.Key()
+		txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByHeight(compositeKeyPurgeIndexByHeight)
+		compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
+		dbVal, err := s.db.Get(compositeKeyPvtRWSet)
+		if err != nil {
+			return err
+		}
+		if dbVal == nil {
+			continue
+		}
+		pvtRWSet, err := unmarshalPvtRWSet(dbVal)
+		if err != nil {
+			return err
+		}
+		if !writesAnyKey(pvtRWSet, purgedKeyHashes) {
+			continue
+		}
+		logger.Debugf("Purging from transient store private data of purged keys simulated at block [%d]: txid [%s] uuid [%s]", blockHeight, txid, uuid)
+		dbBatch.Delete(compositeKeyPvtRWSet)
+		dbBatch.Delete(createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight))
+		dbBatch.Delete(compositeKeyPurgeIndexByHeight)
+	}
+	return s.db.WriteBatch(dbBatch, true)
+}
+
 // GetMinTransientBlkHt returns the lowest block height remaining in transient store
 func (s *store) GetMinTransientBlkHt() (uint64, error) {
 	// Current approach performs a range query on purgeIndex with startKey
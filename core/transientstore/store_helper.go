@@ -11,11 +11,15 @@ import (
 	"errors"
 	"path/filepath"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/ledger"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protos/transientstore"
 )
 
 var (
@@ -184,6 +188,57 @@ func GetTransientStorePath() string {
 	return filepath.Join(sysPath, "transientStore")
 }
 
+// unmarshalPvtRWSet returns the private write set stored in the transient store, which may be
+// persisted either as `TxPvtReadWriteSet` or, prefixed with a nil byte, as `TxPvtReadWriteSetWithConfigInfo`
+func unmarshalPvtRWSet(dbVal []byte) (*rwset.TxPvtReadWriteSet, error) {
+	if len(dbVal) > 0 && dbVal[0] == nilByte {
+		txPvtRWSetWithConfig := &transientstore.TxPvtReadWriteSetWithConfigInfo{}
+		if err := proto.Unmarshal(dbVal[1:], txPvtRWSetWithConfig); err != nil {
+			return nil, err
+		}
+		return txPvtRWSetWithConfig.GetPvtRwset(), nil
+	}
+	txPvtRWSet := &rwset.TxPvtReadWriteSet{}
+	if err := proto.Unmarshal(dbVal, txPvtRWSet); err != nil {
+		return nil, err
+	}
+	return txPvtRWSet, nil
+}
+
+// writesAnyKey returns true if the private write set writes (or sets the metadata of) a key whose
+// hash is present in the supplied map of namespace to collection to key hashes
+func writesAnyKey(pvtWSet *rwset.TxPvtReadWriteSet, keyHashes map[string]map[string]map[string]struct{}) bool {
+	for _, ns := range pvtWSet.GetNsPvtRwset() {
+		collKeyHashes, ok := keyHashes[ns.Namespace]
+		if !ok {
+			continue
+		}
+		for _, coll := range ns.CollectionPvtRwset {
+			hashes, ok := collKeyHashes[coll.CollectionName]
+			if !ok {
+				continue
+			}
+			kvRWSet := &kvrwset.KVRWSet{}
+			if err := proto.Unmarshal(coll.Rwset, kvRWSet); err != nil {
+				logger.Warningf("Could not unmarshal the private write set of namespace [%s], collection [%s]: %s",
+					ns.Namespace, coll.CollectionName, err)
+				continue
+			}
+			for _, write := range kvRWSet.Writes {
+				if _, ok := hashes[string(ledgerutil.ComputeStringHash(write.Key))]; ok {
+					return true
+				}
+			}
+			for _, metadataWrite := range kvRWSet.MetadataWrites {
+				if _, ok := hashes[string(ledgerutil.ComputeStringHash(metadataWrite.Key))]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // trimPvtWSet returns a `TxPvtReadWriteSet` that retains only list of 'ns/collections' supplied in the filter
 // A nil filter does not filter any results and returns the original `pvtWSet` as is
 func trimPvtWSet(pvtWSet *rwset.TxPvtReadWriteSet, filter ledger.PvtNsCollFilter) *rwset.TxPvtReadWriteSet {
@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/transientstore"
 
 	"github.com/spf13/viper"
@@ -457,6 +458,71 @@ func TestTransientStorePurgeByHeight(t *testing.T) {
 	env.Cleanup()
 }
 
+func TestTransientStorePurgeByKeys(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	pvtRWSetWriting := func(coll, key string) *rwset.TxPvtReadWriteSet {
+		kvRWSetBytes, err := proto.Marshal(&kvrwset.KVRWSet{
+			Writes: []*kvrwset.KVWrite{{Key: key, Value: []byte("value")}},
+		})
+		assert.NoError(err)
+		return &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				{
+					Namespace:          "ns-1",
+					CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{{CollectionName: coll, Rwset: kvRWSetBytes}},
+				},
+			},
+		}
+	}
+
+	// txid-1 writes the purged key prior to the purge, in both the old and the new proto
+	assert.NoError(env.TestStore.Persist("txid-1", 10, pvtRWSetWriting("coll-1", "purged-key")))
+	assert.NoError(env.TestStore.PersistWithConfig("txid-1", 11, &transientstore.TxPvtReadWriteSetWithConfigInfo{
+		PvtRwset: pvtRWSetWriting("coll-1", "purged-key"),
+	}))
+	// txid-2 writes another key, txid-3 writes the purged key in another collection
+	assert.NoError(env.TestStore.Persist("txid-2", 10, pvtRWSetWriting("coll-1", "another-key")))
+	assert.NoError(env.TestStore.Persist("txid-3", 10, pvtRWSetWriting("coll-2", "purged-key")))
+	// txid-4 writes the purged key after the purge is committed at block 11
+	assert.NoError(env.TestStore.Persist("txid-4", 12, pvtRWSetWriting("coll-1", "purged-key")))
+
+	assert.NoError(env.TestStore.PurgeByKeys(11, []*ledger.PurgeMarker{
+		{SeqInBlock: 1, Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("purged-key")},
+	}))
+
+	countResults := func(txid string) int {
+		iter, err := env.TestStore.GetTxPvtRWSetByTxid(txid, nil)
+		assert.NoError(err)
+		defer iter.Close()
+		count := 0
+		for {
+			result, err := iter.NextWithConfig()
+			assert.NoError(err)
+			if result == nil {
+				return count
+			}
+			count++
+		}
+	}
+	assert.Equal(0, countResults("txid-1"))
+	assert.Equal(1, countResults("txid-2"))
+	assert.Equal(1, countResults("txid-3"))
+	assert.Equal(1, countResults("txid-4"))
+
+	// the indexes of the purged write sets are removed as well
+	minBlkHt, err := env.TestStore.GetMinTransientBlkHt()
+	assert.NoError(err)
+	assert.Equal(uint64(10), minBlkHt)
+	assert.NoError(env.TestStore.PurgeByTxids([]string{"txid-2", "txid-3"}))
+	minBlkHt, err = env.TestStore.GetMinTransientBlkHt()
+	assert.NoError(err)
+	assert.Equal(uint64(12), minBlkHt)
+}
+
 func TestTransientStoreRetrievalWithFilter(t *testing.T) {
 	env := NewTestStoreEnv(t)
 	store := env.TestStore
@@ -61,6 +61,15 @@ type Gossip struct {
 	peersReturnsOnCall map[int]struct {
 		result1 []discovery.NetworkMember
 	}
+	DeadPeersStub        func() []discovery.NetworkMember
+	deadPeersMutex       sync.RWMutex
+	deadPeersArgsForCall []struct{}
+	deadPeersReturns     struct {
+		result1 []discovery.NetworkMember
+	}
+	deadPeersReturnsOnCall map[int]struct {
+		result1 []discovery.NetworkMember
+	}
 	PeersOfChannelStub        func(common.ChainID) []discovery.NetworkMember
 	peersOfChannelMutex       sync.RWMutex
 	peersOfChannelArgsForCall []struct {
@@ -147,6 +156,17 @@ type Gossip struct {
 	identityInfoReturnsOnCall map[int]struct {
 		result1 api.PeerIdentitySet
 	}
+	UpdateIdentityStub        func(identity api.PeerIdentityType) error
+	updateIdentityMutex       sync.RWMutex
+	updateIdentityArgsForCall []struct {
+		identity api.PeerIdentityType
+	}
+	updateIdentityReturns struct {
+		result1 error
+	}
+	updateIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StopStub         func()
 	stopMutex        sync.RWMutex
 	stopArgsForCall  []struct{}
@@ -356,6 +376,46 @@ func (fake *Gossip) PeersReturnsOnCall(i int, result1 []discovery.NetworkMember)
 	}{result1}
 }
 
+func (fake *Gossip) DeadPeers() []discovery.NetworkMember {
+	fake.deadPeersMutex.Lock()
+	ret, specificReturn := fake.deadPeersReturnsOnCall[len(fake.deadPeersArgsForCall)]
+	fake.deadPeersArgsForCall = append(fake.deadPeersArgsForCall, struct{}{})
+	fake.recordInvocation("DeadPeers", []interface{}{})
+	fake.deadPeersMutex.Unlock()
+	if fake.DeadPeersStub != nil {
+		return fake.DeadPeersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deadPeersReturns.result1
+}
+
+func (fake *Gossip) DeadPeersCallCount() int {
+	fake.deadPeersMutex.RLock()
+	defer fake.deadPeersMutex.RUnlock()
+	return len(fake.deadPeersArgsForCall)
+}
+
+func (fake *Gossip) DeadPeersReturns(result1 []discovery.NetworkMember) {
+	fake.DeadPeersStub = nil
+	fake.deadPeersReturns = struct {
+		result1 []discovery.NetworkMember
+	}{result1}
+}
+
+func (fake *Gossip) DeadPeersReturnsOnCall(i int, result1 []discovery.NetworkMember) {
+	fake.DeadPeersStub = nil
+	if fake.deadPeersReturnsOnCall == nil {
+		fake.deadPeersReturnsOnCall = make(map[int]struct {
+			result1 []discovery.NetworkMember
+		})
+	}
+	fake.deadPeersReturnsOnCall[i] = struct {
+		result1 []discovery.NetworkMember
+	}{result1}
+}
+
 func (fake *Gossip) PeersOfChannel(arg1 common.ChainID) []discovery.NetworkMember {
 	fake.peersOfChannelMutex.Lock()
 	ret, specificReturn := fake.peersOfChannelReturnsOnCall[len(fake.peersOfChannelArgsForCall)]
@@ -729,6 +789,54 @@ func (fake *Gossip) IdentityInfoReturnsOnCall(i int, result1 api.PeerIdentitySet
 	}{result1}
 }
 
+func (fake *Gossip) UpdateIdentity(identity api.PeerIdentityType) error {
+	fake.updateIdentityMutex.Lock()
+	ret, specificReturn := fake.updateIdentityReturnsOnCall[len(fake.updateIdentityArgsForCall)]
+	fake.updateIdentityArgsForCall = append(fake.updateIdentityArgsForCall, struct {
+		identity api.PeerIdentityType
+	}{identity})
+	fake.recordInvocation("UpdateIdentity", []interface{}{identity})
+	fake.updateIdentityMutex.Unlock()
+	if fake.UpdateIdentityStub != nil {
+		return fake.UpdateIdentityStub(identity)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateIdentityReturns.result1
+}
+
+func (fake *Gossip) UpdateIdentityCallCount() int {
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
+	return len(fake.updateIdentityArgsForCall)
+}
+
+func (fake *Gossip) UpdateIdentityArgsForCall(i int) api.PeerIdentityType {
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
+	return fake.updateIdentityArgsForCall[i].identity
+}
+
+func (fake *Gossip) UpdateIdentityReturns(result1 error) {
+	fake.UpdateIdentityStub = nil
+	fake.updateIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Gossip) UpdateIdentityReturnsOnCall(i int, result1 error) {
+	fake.UpdateIdentityStub = nil
+	if fake.updateIdentityReturnsOnCall == nil {
+		fake.updateIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Gossip) Stop() {
 	fake.stopMutex.Lock()
 	fake.stopArgsForCall = append(fake.stopArgsForCall, struct{}{})
@@ -758,6 +866,8 @@ func (fake *Gossip) Invocations() map[string][][]interface{} {
 	defer fake.sendByCriteriaMutex.RUnlock()
 	fake.peersMutex.RLock()
 	defer fake.peersMutex.RUnlock()
+	fake.deadPeersMutex.RLock()
+	defer fake.deadPeersMutex.RUnlock()
 	fake.peersOfChannelMutex.RLock()
 	defer fake.peersOfChannelMutex.RUnlock()
 	fake.updateMetadataMutex.RLock()
@@ -780,6 +890,8 @@ func (fake *Gossip) Invocations() map[string][][]interface{} {
 	defer fake.suspectPeersMutex.RUnlock()
 	fake.identityInfoMutex.RLock()
 	defer fake.identityInfoMutex.RUnlock()
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
 	fake.stopMutex.RLock()
 	defer fake.stopMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -47,6 +47,10 @@ type Comm interface {
 	// CloseConn closes a connection to a certain endpoint
 	CloseConn(peer *RemotePeer)
 
+	// UpdateIdentity replaces the identity this instance authenticates with to remote peers,
+	// and closes all connections so that they are re-established with the given identity
+	UpdateIdentity(identity api.PeerIdentityType)
+
 	// Stop stops the module
 	Stop()
 }
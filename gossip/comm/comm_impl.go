@@ -127,6 +127,7 @@ type commImpl struct {
 	tlsCerts       *common.TLSCertificates
 	pubSub         *util.PubSub
 	peerIdentity   api.PeerIdentityType
+	identityLock   sync.RWMutex
 	idMapper       identity.Mapper
 	logger         util.Logger
 	opts           []grpc.DialOption
@@ -370,6 +371,17 @@ func (c *commImpl) CloseConn(peer *RemotePeer) {
 	c.connStore.closeConn(peer)
 }
 
+// UpdateIdentity replaces the identity this instance authenticates with to remote peers,
+// and closes all connections so that they are re-established with the given identity
+func (c *commImpl) UpdateIdentity(identity api.PeerIdentityType) {
+	c.identityLock.Lock()
+	c.peerIdentity = identity
+	c.PKIID = c.idMapper.GetPKIidOfCert(identity)
+	c.identityLock.Unlock()
+	c.logger.Info("Closing all connections, as the identity of the peer changed to", c.GetPKIid())
+	c.connStore.closeAll()
+}
+
 func (c *commImpl) closeSubscriptions() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -399,6 +411,8 @@ func (c *commImpl) Stop() {
 }
 
 func (c *commImpl) GetPKIid() common.PKIidType {
+	c.identityLock.RLock()
+	defer c.identityLock.RUnlock()
 	return c.PKIID
 }
 
@@ -440,7 +454,10 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 		return nil, fmt.Errorf("No TLS certificate")
 	}
 
-	cMsg, err = c.createConnectionMsg(c.PKIID, selfCertHash, c.peerIdentity, signer)
+	c.identityLock.RLock()
+	pkiID, peerIdentity := c.PKIID, c.peerIdentity
+	c.identityLock.RUnlock()
+	cMsg, err = c.createConnectionMsg(pkiID, selfCertHash, peerIdentity, signer)
 	if err != nil {
 		return nil, err
 	}
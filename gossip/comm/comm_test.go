@@ -839,6 +839,34 @@ func TestProbe(t *testing.T) {
 	assert.Equal(t, api.PeerIdentityType("localhost:6612"), id)
 }
 
+func TestUpdateIdentity(t *testing.T) {
+	t.Parallel()
+	comm1, _ := newCommInstance(6621, naiveSec)
+	defer comm1.Stop()
+	comm2, _ := newCommInstance(6622, naiveSec)
+	defer comm2.Stop()
+	m2 := comm2.Accept(acceptAll)
+
+	comm1.Send(createGossipMsg(), remotePeer(6622))
+	select {
+	case <-m2:
+	case <-time.After(time.Second * 10):
+		assert.Fail(t, "Didn't receive a message within a timely period")
+	}
+	assert.Equal(t, 1, comm2.(*commImpl).connStore.connNum())
+
+	// The connections are closed, and re-established with the renewed identity
+	renewedID := api.PeerIdentityType("renewed:6622")
+	comm2.UpdateIdentity(renewedID)
+	assert.Equal(t, common.PKIidType(renewedID), comm2.GetPKIid())
+	assert.Equal(t, 0, comm2.(*commImpl).connStore.connNum())
+	id, err := comm1.Handshake(&RemotePeer{Endpoint: "localhost:6622"})
+	assert.NoError(t, err)
+	assert.Equal(t, renewedID, id)
+	_, err = comm1.Handshake(remotePeer(6622))
+	assert.Error(t, err)
+}
+
 func TestPresumedDead(t *testing.T) {
 	t.Parallel()
 	comm1, _ := newCommInstance(4611, naiveSec)
@@ -142,13 +142,18 @@ func (cs *connectionStore) closeConn(peer *RemotePeer) {
 func (cs *connectionStore) shutdown() {
 	cs.Lock()
 	cs.isClosing = true
-	pkiIds2conn := cs.pki2Conn
+	cs.Unlock()
+	cs.closeAll()
+}
 
+// closeAll closes all connections, new ones are established on demand
+func (cs *connectionStore) closeAll() {
+	cs.RLock()
 	var connections2Close []*connection
-	for _, conn := range pkiIds2conn {
+	for _, conn := range cs.pki2Conn {
 		connections2Close = append(connections2Close, conn)
 	}
-	cs.Unlock()
+	cs.RUnlock()
 
 	wg := sync.WaitGroup{}
 	for _, conn := range connections2Close {
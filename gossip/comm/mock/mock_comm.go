@@ -185,6 +185,11 @@ func (mock *commMock) CloseConn(peer *comm.RemotePeer) {
 	// NOOP
 }
 
+// UpdateIdentity replaces the identity this instance authenticates with
+func (mock *commMock) UpdateIdentity(identity api.PeerIdentityType) {
+	// NOOP
+}
+
 // Stop stops the module
 func (mock *commMock) Stop() {
 	logger.Debug("Stopping communication module, closing all accepting channels.")
@@ -135,11 +135,12 @@ func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoServi
 
 // Lookup returns a network member, or nil if not found
 func (d *gossipDiscoveryImpl) Lookup(PKIID common.PKIidType) *NetworkMember {
-	if bytes.Equal(PKIID, d.self.PKIid) {
-		return &d.self
-	}
 	d.lock.RLock()
 	defer d.lock.RUnlock()
+	if bytes.Equal(PKIID, d.self.PKIid) {
+		self := d.self
+		return &self
+	}
 	nm := d.id2Member[string(PKIID)]
 	return nm
 }
@@ -491,13 +492,16 @@ func (d *gossipDiscoveryImpl) handleAliveMessage(m *proto.SignedGossipMessage) {
 	}
 
 	pkiID := m.GetAliveMsg().Membership.PkiId
-	if equalPKIid(pkiID, d.self.PKIid) {
+	d.lock.RLock()
+	self := d.self
+	d.lock.RUnlock()
+	if equalPKIid(pkiID, self.PKIid) {
 		d.logger.Debug("Got alive message about ourselves,", m)
-		diffExternalEndpoint := d.self.Endpoint != m.GetAliveMsg().Membership.Endpoint
+		diffExternalEndpoint := self.Endpoint != m.GetAliveMsg().Membership.Endpoint
 		var diffInternalEndpoint bool
 		secretEnvelope := m.GetSecretEnvelope()
 		if secretEnvelope != nil && secretEnvelope.InternalEndpoint() != "" {
-			diffInternalEndpoint = secretEnvelope.InternalEndpoint() != d.self.InternalEndpoint
+			diffInternalEndpoint = secretEnvelope.InternalEndpoint() != self.InternalEndpoint
 		}
 		if diffInternalEndpoint || diffExternalEndpoint {
 			d.logger.Error("Bad configuration detected: Received AliveMessage from a peer with the same PKI-ID as myself:", m.GossipMessage)
@@ -928,6 +932,31 @@ func (d *gossipDiscoveryImpl) GetMembership() []NetworkMember {
 
 }
 
+func (d *gossipDiscoveryImpl) GetDeadMembership() []NetworkMember {
+	if d.toDie() {
+		return []NetworkMember{}
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	response := []NetworkMember{}
+	for _, m := range d.deadMembership.ToSlice() {
+		member := m.GetAliveMsg()
+		var internalEndpoint string
+		if netMember := d.id2Member[string(member.Membership.PkiId)]; netMember != nil {
+			internalEndpoint = netMember.InternalEndpoint
+		}
+		response = append(response, NetworkMember{
+			PKIid:            member.Membership.PkiId,
+			Endpoint:         member.Membership.Endpoint,
+			Metadata:         member.Membership.Metadata,
+			InternalEndpoint: internalEndpoint,
+			Envelope:         m.Envelope,
+		})
+	}
+	return response
+}
+
 func tsToTime(ts uint64) time.Time {
 	return time.Unix(int64(0), int64(ts))
 }
@@ -945,9 +974,16 @@ func (d *gossipDiscoveryImpl) UpdateEndpoint(endpoint string) {
 	d.self.Endpoint = endpoint
 }
 
+func (d *gossipDiscoveryImpl) UpdatePKIid(pkiID common.PKIidType) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.self.PKIid = pkiID
+}
+
 func (d *gossipDiscoveryImpl) Self() NetworkMember {
 	var env *proto.Envelope
-	msg, _ := d.aliveMsgAndInternalEndpoint()
+	msg, internalEndpoint := d.aliveMsgAndInternalEndpoint()
 	sMsg, err := msg.NoopSign()
 	if err != nil {
 		d.logger.Warning("Failed creating SignedGossipMessage:", err)
@@ -956,10 +992,11 @@ func (d *gossipDiscoveryImpl) Self() NetworkMember {
 	}
 	mem := msg.GetAliveMsg().Membership
 	return NetworkMember{
-		Endpoint: mem.Endpoint,
-		Metadata: mem.Metadata,
-		PKIid:    mem.PkiId,
-		Envelope: env,
+		Endpoint:         mem.Endpoint,
+		Metadata:         mem.Metadata,
+		PKIid:            mem.PkiId,
+		Envelope:         env,
+		InternalEndpoint: internalEndpoint,
 	}
 }
 
@@ -593,6 +593,17 @@ func TestExpiration(t *testing.T) {
 
 	assertMembership(t, instances[:len(instances)-2], nodeNum-3)
 
+	// The stopped instances are considered dead by the rest of the instances
+	for _, inst := range instances[:len(instances)-2] {
+		var deadEndpoints []string
+		for _, member := range inst.GetDeadMembership() {
+			deadEndpoints = append(deadEndpoints, member.Endpoint)
+		}
+		assert.Len(t, deadEndpoints, 2)
+		assert.Contains(t, deadEndpoints, "localhost:2614")
+		assert.Contains(t, deadEndpoints, "localhost:2615")
+	}
+
 	stopAction := &sync.WaitGroup{}
 	for i, inst := range instances {
 		if i+2 == nodeNum {
@@ -8,10 +8,13 @@ package election
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/spf13/viper"
 )
@@ -26,6 +29,9 @@ import (
 //   is the number of network partitions, but when the partition heals,
 //   only 1 leader should be left eventually
 // - Peers communicate by gossiping leadership proposal or declaration messages
+// - The algorithm can be configured to elect N leaders instead of 1, in which case
+//   the N peers with the lowest IDs among the proposing peers become leaders,
+//   and a leader steps down when N leaders with lower IDs than its own are known
 
 // The Algorithm, in pseudo code:
 //
@@ -96,6 +102,26 @@ type LeaderElectionService interface {
 	// Yield relinquishes the leadership until a new leader is elected,
 	// or a timeout expires
 	Yield()
+
+	// Status returns the current state of the leader election
+	Status() Status
+}
+
+// Status describes the state of the leader election, as seen by the peer
+type Status struct {
+	// IsLeader is whether this peer is a leader
+	IsLeader bool
+	// Leaders are the IDs of the peers currently known to be leaders,
+	// including this peer if it is a leader, sorted by ID
+	Leaders []common.PKIidType
+	// LeaderCount is the number of leaders the election aims for
+	LeaderCount int
+	// LastChange is the time the leadership last changed
+	LastChange time.Time
+	// Reason describes why the leadership last changed
+	Reason string
+	// Changes is the number of times the leadership changed since the peer started
+	Changes uint64
 }
 
 type peerID []byte
@@ -121,6 +147,10 @@ func noopCallback(_ bool) {
 
 // NewLeaderElectionService returns a new LeaderElectionService
 func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback leadershipCallback) LeaderElectionService {
+	return newLeaderElectionService(adapter, id, callback, getLeaderCount())
+}
+
+func newLeaderElectionService(adapter LeaderElectionAdapter, id string, callback leadershipCallback, leaderCount int) LeaderElectionService {
 	if len(id) == 0 {
 		panic("Empty id")
 	}
@@ -132,6 +162,8 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 		interruptChan: make(chan struct{}, 1),
 		logger:        util.GetLogger(util.LoggingElectionModule, ""),
 		callback:      noopCallback,
+		leaderCount:   leaderCount,
+		leaders:       make(map[string]time.Time),
 	}
 
 	if callback != nil {
@@ -159,6 +191,12 @@ type leaderElectionSvcImpl struct {
 	logger        util.Logger
 	callback      leadershipCallback
 	yieldTimer    *time.Timer
+	leaderCount   int
+	// leaders maps IDs of peers that declared themselves leaders to the time of their last declaration
+	leaders    map[string]time.Time
+	lastChange time.Time
+	reason     string
+	changes    uint64
 }
 
 func (le *leaderElectionSvcImpl) start() {
@@ -200,12 +238,20 @@ func (le *leaderElectionSvcImpl) handleMessage(msg Msg) {
 	if msg.IsProposal() {
 		le.proposals.Add(string(msg.SenderID()))
 	} else if msg.IsDeclaration() {
+		sender := string(msg.SenderID())
+		if _, known := le.leaders[sender]; !known {
+			le.leadershipChanged(fmt.Sprintf("peer %s declared itself a leader", common.PKIidType(msg.SenderID())))
+		}
+		le.leaders[sender] = time.Now()
+		if len(le.aliveLeaders()) < le.leaderCount {
+			return
+		}
 		atomic.StoreInt32(&le.leaderExists, int32(1))
 		if le.sleeping && len(le.interruptChan) == 0 {
 			le.interruptChan <- struct{}{}
 		}
-		if bytes.Compare(msg.SenderID(), le.id) < 0 && le.IsLeader() {
-			le.stopBeingLeader()
+		if le.IsLeader() && le.betterLeaders() >= le.leaderCount {
+			le.stopBeingLeader("enough peers with lower IDs declared themselves leaders")
 		}
 	} else {
 		// We shouldn't get here
@@ -283,16 +329,26 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 		le.logger.Debug(le.id, ": Aborting leader election because yielding")
 		return
 	}
-	// Leader doesn't exist, let's see if there is a better candidate than us
-	// for being a leader
+	// Not enough leaders exist, let's see if there are enough better candidates than us
+	// for being the remaining leaders
+	le.Lock()
+	leaders := le.aliveLeaders()
+	le.Unlock()
+	betterCandidates := 0
 	for _, o := range le.proposals.ToArray() {
 		id := o.(string)
+		if _, isLeader := leaders[id]; isLeader {
+			continue
+		}
 		if bytes.Compare(peerID(id), le.id) < 0 {
-			return
+			betterCandidates++
 		}
 	}
-	// If we got here, there is no one that proposed being a leader
-	// that's a better candidate than us.
+	if betterCandidates >= le.leaderCount-len(leaders) {
+		return
+	}
+	// If we got here, there are less peers that proposed being a leader
+	// that are better candidates than us, than the number of missing leaders.
 	le.beLeader()
 	atomic.StoreInt32(&le.leaderExists, int32(1))
 }
@@ -316,6 +372,11 @@ func (le *leaderElectionSvcImpl) follower() {
 	case <-le.stopChan:
 		le.stopChan <- struct{}{}
 	}
+	le.Lock()
+	defer le.Unlock()
+	if !le.isLeaderExists() && len(le.aliveLeaders()) < le.leaderCount && !le.shouldStop() {
+		le.leadershipChanged("leaders stopped declaring their leadership")
+	}
 }
 
 func (le *leaderElectionSvcImpl) leader() {
@@ -372,16 +433,79 @@ func (le *leaderElectionSvcImpl) IsLeader() bool {
 
 func (le *leaderElectionSvcImpl) beLeader() {
 	le.logger.Info(le.id, ": Becoming a leader")
+	le.Lock()
+	le.leadershipChanged("elected as a leader")
+	le.Unlock()
 	atomic.StoreInt32(&le.isLeader, int32(1))
 	le.callback(true)
 }
 
-func (le *leaderElectionSvcImpl) stopBeingLeader() {
-	le.logger.Info(le.id, "Stopped being a leader")
+// stopBeingLeader should be invoked while holding the lock
+func (le *leaderElectionSvcImpl) stopBeingLeader(reason string) {
+	le.logger.Info(le.id, "Stopped being a leader:", reason)
+	le.leadershipChanged("stopped being a leader, " + reason)
 	atomic.StoreInt32(&le.isLeader, int32(0))
 	le.callback(false)
 }
 
+// leadershipChanged records a change in the leadership.
+// It should be invoked while holding the lock
+func (le *leaderElectionSvcImpl) leadershipChanged(reason string) {
+	le.lastChange = time.Now()
+	le.reason = reason
+	le.changes++
+}
+
+// aliveLeaders returns the IDs of the remote peers that declared themselves leaders
+// within the leader alive threshold, and forgets about the rest.
+// It should be invoked while holding the lock
+func (le *leaderElectionSvcImpl) aliveLeaders() map[string]struct{} {
+	res := make(map[string]struct{})
+	for id, lastDeclaration := range le.leaders {
+		if time.Since(lastDeclaration) > getLeaderAliveThreshold() {
+			delete(le.leaders, id)
+			continue
+		}
+		res[id] = struct{}{}
+	}
+	return res
+}
+
+// betterLeaders returns the number of alive leaders with lower IDs than this peer.
+// It should be invoked while holding the lock
+func (le *leaderElectionSvcImpl) betterLeaders() int {
+	count := 0
+	for id := range le.aliveLeaders() {
+		if bytes.Compare(peerID(id), le.id) < 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// Status returns the current state of the leader election
+func (le *leaderElectionSvcImpl) Status() Status {
+	le.Lock()
+	defer le.Unlock()
+	status := Status{
+		IsLeader:    le.IsLeader(),
+		LeaderCount: le.leaderCount,
+		LastChange:  le.lastChange,
+		Reason:      le.reason,
+		Changes:     le.changes,
+	}
+	for id := range le.aliveLeaders() {
+		status.Leaders = append(status.Leaders, common.PKIidType(id))
+	}
+	if status.IsLeader {
+		status.Leaders = append(status.Leaders, common.PKIidType(le.id))
+	}
+	sort.Slice(status.Leaders, func(i, j int) bool {
+		return bytes.Compare(status.Leaders[i], status.Leaders[j]) < 0
+	})
+	return status
+}
+
 func (le *leaderElectionSvcImpl) shouldStop() bool {
 	return atomic.LoadInt32(&le.toDie) == int32(1)
 }
@@ -409,7 +533,7 @@ func (le *leaderElectionSvcImpl) Yield() {
 	// Turn on the yield flag
 	atomic.StoreInt32(&le.yield, int32(1))
 	// Stop being a leader
-	le.stopBeingLeader()
+	le.stopBeingLeader("yielded the leadership")
 	// Clear the leader exists flag since it could be that we are the leader
 	atomic.StoreInt32(&le.leaderExists, int32(0))
 	// Clear the yield flag in any case afterwards
@@ -450,6 +574,11 @@ func SetLeaderElectionDuration(t time.Duration) {
 	viper.Set("peer.gossip.election.leaderElectionDuration", t)
 }
 
+// SetLeaderCount configures the number of leaders to elect
+func SetLeaderCount(count int) {
+	viper.Set("peer.gossip.election.leaderCount", count)
+}
+
 func getStartupGracePeriod() time.Duration {
 	return util.GetDurationOrDefault("peer.gossip.election.startupGracePeriod", time.Second*15)
 }
@@ -462,6 +591,14 @@ func getLeaderAliveThreshold() time.Duration {
 	return util.GetDurationOrDefault("peer.gossip.election.leaderAliveThreshold", time.Second*10)
 }
 
+func getLeaderCount() int {
+	count := util.GetIntOrDefault("peer.gossip.election.leaderCount", 1)
+	if count < 1 {
+		return 1
+	}
+	return count
+}
+
 func getLeadershipDeclarationInterval() time.Duration {
 	return time.Duration(getLeaderAliveThreshold() / 2)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/util"
 )
 
@@ -144,11 +145,15 @@ func (p *peer) isCallbackInvoked() bool {
 }
 
 func createPeers(spawnInterval time.Duration, ids ...int) []*peer {
+	return createPeersWithLeaderCount(spawnInterval, 1, ids...)
+}
+
+func createPeersWithLeaderCount(spawnInterval time.Duration, leaderCount int, ids ...int) []*peer {
 	peers := make([]*peer, len(ids))
 	peerMap := make(map[string]*peer)
 	l := &sync.RWMutex{}
 	for i, id := range ids {
-		p := createPeer(id, peerMap, l)
+		p := createPeer(id, leaderCount, peerMap, l)
 		if spawnInterval != 0 {
 			time.Sleep(spawnInterval)
 		}
@@ -157,11 +162,11 @@ func createPeers(spawnInterval time.Duration, ids ...int) []*peer {
 	return peers
 }
 
-func createPeer(id int, peerMap map[string]*peer, l *sync.RWMutex) *peer {
+func createPeer(id int, leaderCount int, peerMap map[string]*peer, l *sync.RWMutex) *peer {
 	idStr := fmt.Sprintf("p%d", id)
 	c := make(chan Msg, 100)
 	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), leaderFromCallback: false, callbackInvoked: false}
-	p.LeaderElectionService = NewLeaderElectionService(p, idStr, p.leaderCallback)
+	p.LeaderElectionService = newLeaderElectionService(p, idStr, p.leaderCallback, leaderCount)
 	l.Lock()
 	peerMap[idStr] = p
 	l.Unlock()
@@ -418,6 +423,67 @@ func TestPartition(t *testing.T) {
 
 }
 
+func TestMultipleLeaders(t *testing.T) {
+	// Scenario: Peers are configured to elect 2 leaders, and are spawned at the same time.
+	// After a while, one of the leaders stops.
+	// Expected outcome: the 2 peers with the lowest IDs are the leaders,
+	// and the peer with the next lowest ID takes over the stopped leader's place
+	t.Parallel()
+	peers := createPeersWithLeaderCount(0, 2, 5, 4, 3, 2, 1, 0)
+	defer func() {
+		for _, p := range peers[:len(peers)-1] {
+			p.Stop()
+		}
+	}()
+	time.Sleep(getStartupGracePeriod() + getLeaderElectionDuration())
+	leaders := waitForMultipleLeadersElection(t, peers, 2)
+	assert.Len(t, leaders, 2)
+	assert.Contains(t, leaders, "p0")
+	assert.Contains(t, leaders, "p1")
+
+	for _, p := range peers {
+		status := p.Status()
+		assert.Equal(t, 2, status.LeaderCount)
+		assert.Equal(t, p.IsLeader(), status.IsLeader)
+	}
+
+	// Stop p0
+	peers[len(peers)-1].Stop()
+	time.Sleep(getLeadershipDeclarationInterval() + getLeaderAliveThreshold()*3)
+	leaders = waitForMultipleLeadersElection(t, peers[:len(peers)-1], 2)
+	assert.Len(t, leaders, 2)
+	assert.Contains(t, leaders, "p1")
+	assert.Contains(t, leaders, "p2")
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+	// Scenario: Peers spawn and a leader is elected, and then the leader yields.
+	// Expected outcome: the status of the peers reflects the leadership and its changes
+	peers := createPeers(0, 10, 11, 12)
+	leaders := waitForLeaderElection(t, peers)
+	assert.Equal(t, []string{"p10"}, leaders)
+
+	status := peers[0].Status()
+	assert.True(t, status.IsLeader)
+	assert.Equal(t, 1, status.LeaderCount)
+	assert.Equal(t, "elected as a leader", status.Reason)
+	assert.Equal(t, uint64(1), status.Changes)
+	assert.False(t, status.LastChange.IsZero())
+	assert.Contains(t, status.Leaders, common.PKIidType("p10"))
+
+	waitForBoolFunc(t, func() bool {
+		return len(peers[1].Status().Leaders) == 1 && string(peers[1].Status().Leaders[0]) == "p10"
+	}, true, "p11 doesn't know that p10 is the leader")
+	assert.False(t, peers[1].Status().IsLeader)
+
+	peers[0].Yield()
+	status = peers[0].Status()
+	assert.False(t, status.IsLeader)
+	assert.Equal(t, "stopped being a leader, yielded the leadership", status.Reason)
+	assert.Equal(t, uint64(2), status.Changes)
+}
+
 func TestConfigFromFile(t *testing.T) {
 	preStartupGracePeriod := getStartupGracePeriod()
 	preMembershipSampleInterval := getMembershipSampleInterval()
@@ -439,6 +505,7 @@ func TestConfigFromFile(t *testing.T) {
 	assert.Equal(t, time.Second*10, getLeaderAliveThreshold())
 	assert.Equal(t, time.Second*5, getLeaderElectionDuration())
 	assert.Equal(t, getLeaderAliveThreshold()/2, getLeadershipDeclarationInterval())
+	assert.Equal(t, 1, getLeaderCount())
 
 	//Verify reading the values from config file
 	viper.Reset()
@@ -454,6 +521,7 @@ func TestConfigFromFile(t *testing.T) {
 	assert.Equal(t, time.Second*10, getLeaderAliveThreshold())
 	assert.Equal(t, time.Second*5, getLeaderElectionDuration())
 	assert.Equal(t, getLeaderAliveThreshold()/2, getLeadershipDeclarationInterval())
+	assert.Equal(t, 1, getLeaderCount())
 }
 
 func waitForBoolFunc(t *testing.T, f func() bool, expectedValue bool, msgAndArgs ...interface{}) {
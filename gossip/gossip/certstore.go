@@ -20,11 +20,10 @@ import (
 
 // certStore supports pull dissemination of identity messages
 type certStore struct {
-	selfIdentity api.PeerIdentityType
-	idMapper     identity.Mapper
-	pull         pull.Mediator
-	logger       util.Logger
-	mcs          api.MessageCryptoService
+	idMapper identity.Mapper
+	pull     pull.Mediator
+	logger   util.Logger
+	mcs      api.MessageCryptoService
 }
 
 func newCertStore(puller pull.Mediator, idMapper identity.Mapper, selfIdentity api.PeerIdentityType, mcs api.MessageCryptoService) *certStore {
@@ -32,18 +31,17 @@ func newCertStore(puller pull.Mediator, idMapper identity.Mapper, selfIdentity a
 	logger := util.GetLogger(util.LoggingGossipModule, string(selfPKIID))
 
 	certStore := &certStore{
-		mcs:          mcs,
-		pull:         puller,
-		idMapper:     idMapper,
-		selfIdentity: selfIdentity,
-		logger:       logger,
+		mcs:      mcs,
+		pull:     puller,
+		idMapper: idMapper,
+		logger:   logger,
 	}
 
 	if err := certStore.idMapper.Put(selfPKIID, selfIdentity); err != nil {
 		certStore.logger.Panicf("Failed associating self PKIID to cert: %+v", errors.WithStack(err))
 	}
 
-	selfIDMsg, err := certStore.createIdentityMessage()
+	selfIDMsg, err := certStore.createIdentityMessage(selfIdentity)
 	if err != nil {
 		certStore.logger.Panicf("Failed creating self identity message: %+v", errors.WithStack(err))
 	}
@@ -106,11 +104,11 @@ func (cs *certStore) validateIdentityMsg(msg *proto.SignedGossipMessage) error {
 	return cs.mcs.ValidateIdentity(api.PeerIdentityType(idMsg.Cert))
 }
 
-func (cs *certStore) createIdentityMessage() (*proto.SignedGossipMessage, error) {
+func (cs *certStore) createIdentityMessage(selfIdentity api.PeerIdentityType) (*proto.SignedGossipMessage, error) {
 	pi := &proto.PeerIdentity{
-		Cert:     cs.selfIdentity,
+		Cert:     selfIdentity,
 		Metadata: nil,
-		PkiId:    cs.idMapper.GetPKIidOfCert(cs.selfIdentity),
+		PkiId:    cs.idMapper.GetPKIidOfCert(selfIdentity),
 	}
 	m := &proto.GossipMessage{
 		Channel: nil,
@@ -130,6 +128,20 @@ func (cs *certStore) createIdentityMessage() (*proto.SignedGossipMessage, error)
 	return sMsg, errors.WithStack(err)
 }
 
+// updateSelfIdentity starts disseminating a renewed identity of the peer.
+// The identity message of the previous identity is disseminated until the previous identity expires.
+func (cs *certStore) updateSelfIdentity(selfIdentity api.PeerIdentityType) error {
+	if err := cs.idMapper.UpdateSelfIdentity(selfIdentity); err != nil {
+		return errors.Wrap(err, "failed associating self PKIID to cert")
+	}
+	selfIDMsg, err := cs.createIdentityMessage(selfIdentity)
+	if err != nil {
+		return errors.Wrap(err, "failed creating self identity message")
+	}
+	cs.pull.Add(selfIDMsg)
+	return nil
+}
+
 func (cs *certStore) suspectPeers(isSuspected api.PeerSuspector) {
 	cs.idMapper.SuspectPeers(isSuspected)
 }
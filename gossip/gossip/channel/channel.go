@@ -39,6 +39,9 @@ type Config struct {
 	RequestStateInfoInterval    time.Duration
 	BlockExpirationInterval     time.Duration
 	StateInfoCacheSweepInterval time.Duration
+	BlockTreeDissemination      bool
+	BlockTreeFanout             int
+	BlockTreeGraftTimeout       time.Duration
 }
 
 // GossipChannel defines an object that deals with all channel-related messages
@@ -65,6 +68,10 @@ type GossipChannel interface {
 	// to other peers in the channel
 	UpdateChaincodes(chaincode []*proto.Chaincode)
 
+	// UpdatePKIid updates the PKI-ID the peer publishes
+	// to other peers in the channel
+	UpdatePKIid(pkiID common.PKIidType)
+
 	// IsOrgInChannel returns whether the given organization is in the channel
 	IsOrgInChannel(membersOrg api.OrgIdentityType) bool
 
@@ -78,6 +85,10 @@ type GossipChannel interface {
 	// AddToMsgStore adds a given GossipMessage to the message store
 	AddToMsgStore(msg *proto.SignedGossipMessage)
 
+	// DisseminateBlock disseminates a block that originates at this peer
+	// via the epidemic broadcast tree of the channel, if it is enabled
+	DisseminateBlock(msg *proto.SignedGossipMessage)
+
 	// ConfigureChannel (re)configures the list of organizations
 	// that are eligible to be in the channel
 	ConfigureChannel(joinMsg api.JoinChannelMessage)
@@ -132,7 +143,7 @@ type gossipChannel struct {
 	sync.RWMutex
 	shouldGossipStateInfo     int32
 	mcs                       api.MessageCryptoService
-	pkiID                     common.PKIidType
+	pkiID                     atomic.Value // common.PKIidType
 	selfOrg                   api.OrgIdentityType
 	stopChan                  chan struct{}
 	stateInfoMsg              *proto.SignedGossipMessage
@@ -143,6 +154,7 @@ type gossipChannel struct {
 	leaderMsgStore            msgstore.MessageStore
 	chainID                   common.ChainID
 	blocksPuller              pull.Mediator
+	blockTree                 *blockTree
 	logger                    util.Logger
 	stateInfoPublishScheduler *time.Ticker
 	stateInfoRequestScheduler *time.Ticker
@@ -177,7 +189,6 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 	gc := &gossipChannel{
 		incTime:                   uint64(time.Now().UnixNano()),
 		selfOrg:                   org,
-		pkiID:                     pkiID,
 		mcs:                       mcs,
 		Adapter:                   adapter,
 		logger:                    util.GetLogger(util.LoggingChannelModule, adapter.GetConf().ID),
@@ -189,6 +200,7 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 		chainID: chainID,
 	}
 
+	gc.pkiID.Store(pkiID)
 	gc.memFilter = &membershipFilter{adapter: gc.Adapter, gossipChannel: gc}
 
 	comparator := proto.NewGossipMessageComparator(adapter.GetConf().MaxBlockCountToStore)
@@ -211,7 +223,7 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 	verifyStateInfoMsg := func(msg *proto.SignedGossipMessage, orgs ...api.OrgIdentityType) bool {
 		si := msg.GetStateInfo()
 		// No point in verifying ourselves
-		if bytes.Equal(gc.pkiID, si.PkiId) {
+		if bytes.Equal(gc.selfPKIid(), si.PkiId) {
 			return true
 		}
 		peerIdentity := adapter.GetIdentityByPKIID(si.PkiId)
@@ -257,6 +269,10 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 
 	gc.leaderMsgStore = msgstore.NewMessageStoreExpirable(pol, msgstore.Noop, ttl, nil, nil, nil)
 
+	if conf := gc.GetConf(); conf.BlockTreeDissemination {
+		gc.blockTree = newBlockTree(chainID, conf.BlockTreeFanout, conf.BlockTreeGraftTimeout, &blockTreeAdapterImpl{gc}, gc.logger)
+	}
+
 	gc.ConfigureChannel(joinMsg)
 
 	// Periodically publish state info
@@ -275,6 +291,9 @@ func (gc *gossipChannel) Stop() {
 	gc.leaderMsgStore.Stop()
 	gc.stateInfoMsgStore.Stop()
 	gc.blockMsgStore.Stop()
+	if gc.blockTree != nil {
+		gc.blockTree.stop()
+	}
 }
 
 func (gc *gossipChannel) periodicalInvocation(fn func(), c <-chan time.Time) {
@@ -394,6 +413,9 @@ func (gc *gossipChannel) createBlockPuller() pull.Mediator {
 		MemSvc:      gc.memFilter,
 		IdExtractor: seqNumFromMsg,
 		MsgCons: func(msg *proto.SignedGossipMessage) {
+			if gc.blockTree != nil {
+				gc.blockTree.blockReceived(msg.GetDataMsg().Payload.SeqNum)
+			}
 			gc.DeMultiplex(msg)
 		},
 	}
@@ -491,6 +513,15 @@ func (gc *gossipChannel) AddToMsgStore(msg *proto.SignedGossipMessage) {
 	}
 }
 
+// DisseminateBlock disseminates a block that originates at this peer
+// via the epidemic broadcast tree of the channel, if it is enabled
+func (gc *gossipChannel) DisseminateBlock(msg *proto.SignedGossipMessage) {
+	if gc.blockTree == nil || !msg.IsDataMsg() {
+		return
+	}
+	gc.blockTree.disseminate(msg, nil)
+}
+
 // ConfigureChannel (re)configures the list of organizations
 // that are eligible to be in the channel
 func (gc *gossipChannel) ConfigureChannel(joinMsg api.JoinChannelMessage) {
@@ -558,6 +589,9 @@ func (gc *gossipChannel) HandleMessage(msg proto.ReceivedMessage) {
 			}
 			// Would this block go into the message store if it was verified?
 			if !gc.blockMsgStore.CheckValid(msg.GetGossipMessage()) {
+				if gc.blockTree != nil {
+					gc.blockTree.duplicate(msg.GetConnectionInfo().ID)
+				}
 				return
 			}
 			if !gc.verifyBlock(m.GossipMessage, msg.GetConnectionInfo().ID) {
@@ -571,8 +605,13 @@ func (gc *gossipChannel) HandleMessage(msg proto.ReceivedMessage) {
 		}
 
 		if added {
-			// Forward the message
-			gc.Forward(msg)
+			if m.IsDataMsg() && gc.blockTree != nil {
+				// Push the block down the tree instead of forwarding it to random peers
+				gc.blockTree.disseminate(m, msg.GetConnectionInfo().ID)
+			} else {
+				// Forward the message
+				gc.Forward(msg)
+			}
 			// DeMultiplex to local subscribers
 			gc.DeMultiplex(m)
 
@@ -626,6 +665,9 @@ func (gc *gossipChannel) HandleMessage(msg proto.ReceivedMessage) {
 					// exists in memory or that it is too far in the past
 					continue
 				}
+				if gc.blockTree != nil {
+					gc.blockTree.blockReceived(gMsg.GetDataMsg().Payload.SeqNum)
+				}
 				filteredEnvelopes = append(filteredEnvelopes, item)
 			}
 			// Replace the update message with just the blocks that should be processed
@@ -634,6 +676,19 @@ func (gc *gossipChannel) HandleMessage(msg proto.ReceivedMessage) {
 		gc.blocksPuller.HandleMessage(msg)
 	}
 
+	if m.IsBlockTreeMsg() {
+		if gc.blockTree == nil {
+			gc.logger.Debug("Got block tree message from", msg.GetConnectionInfo(), "but block tree dissemination is disabled")
+			return
+		}
+		if !gc.eligibleForChannelAndSameOrg(discovery.NetworkMember{PKIid: msg.GetConnectionInfo().ID}) {
+			gc.logger.Warning(msg.GetConnectionInfo(), "isn't eligible for disseminating blocks of", string(gc.chainID))
+			return
+		}
+		gc.blockTree.handleMessage(msg)
+		return
+	}
+
 	if m.IsLeadershipMsg() {
 		// Handling leadership message
 		added := gc.leaderMsgStore.Add(m)
@@ -794,7 +849,7 @@ func (gc *gossipChannel) createStateInfoRequest() (*proto.SignedGossipMessage, e
 		Nonce: 0,
 		Content: &proto.GossipMessage_StateInfoPullReq{
 			StateInfoPullReq: &proto.StateInfoPullRequest{
-				Channel_MAC: GenerateMAC(gc.pkiID, gc.chainID),
+				Channel_MAC: GenerateMAC(gc.selfPKIid(), gc.chainID),
 			},
 		},
 	}).NoopSign()
@@ -830,6 +885,26 @@ func (gc *gossipChannel) UpdateChaincodes(chaincodes []*proto.Chaincode) {
 	gc.updateProperties(ledgerHeight, chaincodes, leftChannel)
 }
 
+// UpdatePKIid updates the PKI-ID the peer publishes
+// to other peers in the channel
+func (gc *gossipChannel) UpdatePKIid(pkiID common.PKIidType) {
+	gc.Lock()
+	defer gc.Unlock()
+
+	gc.pkiID.Store(pkiID)
+	prevMsg := gc.stateInfoMsg
+	if prevMsg == nil {
+		return
+	}
+	properties := prevMsg.GetStateInfo().Properties
+	gc.updateProperties(properties.LedgerHeight, properties.Chaincodes, properties.LeftChannel)
+}
+
+// selfPKIid returns the PKI-ID the peer publishes in the channel
+func (gc *gossipChannel) selfPKIid() common.PKIidType {
+	return gc.pkiID.Load().(common.PKIidType)
+}
+
 // UpdateStateInfo updates this channel's StateInfo message
 // that is periodically published
 func (gc *gossipChannel) updateStateInfo(msg *proto.SignedGossipMessage) {
@@ -840,9 +915,10 @@ func (gc *gossipChannel) updateStateInfo(msg *proto.SignedGossipMessage) {
 }
 
 func (gc *gossipChannel) updateProperties(ledgerHeight uint64, chaincodes []*proto.Chaincode, leftChannel bool) {
+	pkiID := gc.selfPKIid()
 	stateInfMsg := &proto.StateInfo{
-		Channel_MAC: GenerateMAC(gc.pkiID, gc.chainID),
-		PkiId:       gc.pkiID,
+		Channel_MAC: GenerateMAC(pkiID, gc.chainID),
+		PkiId:       pkiID,
 		Timestamp: &proto.PeerTime{
 			IncNum: gc.incTime,
 			SeqNum: uint64(time.Now().UnixNano()),
@@ -954,7 +1030,9 @@ func (cache *stateInfoCache) Stop() {
 // GenerateMAC returns a byte slice that is derived from the peer's PKI-ID
 // and a channel name
 func GenerateMAC(pkiID common.PKIidType, channelID common.ChainID) []byte {
-	// Hash is computed on (PKI-ID || channel ID)
-	preImage := append([]byte(pkiID), []byte(channelID)...)
+	// Hash is computed on (PKI-ID || channel ID), without writing
+	// into the spare capacity of the given PKI-ID
+	preImage := make([]byte, 0, len(pkiID)+len(channelID))
+	preImage = append(append(preImage, pkiID...), channelID...)
 	return common_utils.ComputeSHA256(preImage)
 }
@@ -1974,3 +1974,91 @@ func sequence(start uint64, end uint64) []uint64 {
 	return sequence
 
 }
+
+func TestChannelBlockTreeDissemination(t *testing.T) {
+	t.Parallel()
+	pkiIDInOrg1Peer2 := common.PKIidType("pkiIDInOrg1Peer2")
+	treeConf := conf
+	treeConf.BlockTreeDissemination = true
+	treeConf.BlockTreeFanout = 3
+	treeConf.BlockTreeGraftTimeout = time.Hour
+
+	type sent struct {
+		msg  *proto.SignedGossipMessage
+		peer string
+	}
+	sentMessages := make(chan sent, 10)
+	receivedMessages := make(chan *proto.SignedGossipMessage, 10)
+	cs := &cryptoService{}
+	cs.On("VerifyBlock", mock.Anything).Return(nil)
+	adapter := new(gossipAdapterMock)
+	adapter.On("GetConf").Return(treeConf)
+	adapter.On("GetOrgOfPeer", pkiIDInOrg1Peer2).Return(orgInChannelA)
+	configureAdapter(adapter, discovery.NetworkMember{PKIid: pkiIDInOrg1, Endpoint: "p1"},
+		discovery.NetworkMember{PKIid: pkiIDInOrg1Peer2, Endpoint: "p2"})
+	adapter.On("Gossip", mock.Anything)
+	adapter.On("Forward", mock.Anything).Run(func(args mock.Arguments) {
+		assert.False(t, args.Get(0).(proto.ReceivedMessage).GetGossipMessage().IsDataMsg(), "Blocks shouldn't be forwarded")
+	})
+	adapter.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		msg := args.Get(0).(*proto.SignedGossipMessage)
+		if !msg.IsDataMsg() && !msg.IsBlockTreeMsg() {
+			return
+		}
+		for _, p := range args.Get(1).([]*comm.RemotePeer) {
+			sentMessages <- sent{msg: msg, peer: p.Endpoint}
+		}
+	})
+	adapter.On("DeMultiplex", mock.Anything).Run(func(args mock.Arguments) {
+		if msg, isSignedMsg := args.Get(0).(*proto.SignedGossipMessage); isSignedMsg && msg.IsDataMsg() {
+			receivedMessages <- msg
+		}
+	})
+	gc := NewGossipChannel(pkiIDInOrg1, orgInChannelA, cs, channelA, adapter, &joinChanMsg{})
+	defer gc.Stop()
+	gc.HandleMessage(&receivedMsg{msg: createStateInfoMsg(1, pkiIDInOrg1, channelA), PKIID: pkiIDInOrg1})
+	gc.HandleMessage(&receivedMsg{msg: createStateInfoMsg(1, pkiIDInOrg1Peer2, channelA), PKIID: pkiIDInOrg1Peer2})
+
+	expectSent := func(peer string) *proto.SignedGossipMessage {
+		select {
+		case m := <-sentMessages:
+			assert.Equal(t, peer, m.peer)
+			return m.msg
+		case <-time.After(time.Second * 5):
+			t.Fatal("Didn't send a message to", peer, "in time")
+		}
+		return nil
+	}
+
+	// A block is pushed down the tree instead of being forwarded to random peers,
+	// and isn't sent back to the peer it was received from
+	gc.HandleMessage(&receivedMsg{msg: createDataMsg(1, channelA), PKIID: pkiIDInOrg1})
+	assert.Len(t, receivedMessages, 1)
+	<-receivedMessages
+	assert.Equal(t, uint64(1), expectSent("p2").GetDataMsg().Payload.SeqNum)
+
+	// A peer that sends us a block we already have is pruned
+	gc.HandleMessage(&receivedMsg{msg: createDataMsg(1, channelA), PKIID: pkiIDInOrg1Peer2})
+	assert.NotNil(t, expectSent("p2").GetTreePrune())
+	assert.Len(t, receivedMessages, 0)
+
+	// Tree messages of peers that aren't eligible for the channel are ignored
+	graft, _ := (&proto.GossipMessage{
+		Tag:     proto.GossipMessage_CHAN_AND_ORG,
+		Channel: []byte(channelA),
+		Content: &proto.GossipMessage_TreeGraft{TreeGraft: &proto.BlockTreeGraft{SeqNums: []uint64{1}}},
+	}).NoopSign()
+	gc.HandleMessage(&receivedMsg{msg: graft, PKIID: pkiIDInOrg1ButNotEligible})
+	assert.Len(t, sentMessages, 0)
+
+	// A peer that grafts us gets the block it asked for
+	gc.HandleMessage(&receivedMsg{msg: graft, PKIID: pkiIDInOrg1})
+	assert.Equal(t, uint64(1), expectSent("p1").GetDataMsg().Payload.SeqNum)
+
+	// Blocks that originate at the peer are pushed to eager peers and announced to lazy peers
+	block := createDataMsg(2, channelA)
+	gc.AddToMsgStore(block)
+	gc.DisseminateBlock(block)
+	assert.Equal(t, uint64(2), expectSent("p1").GetDataMsg().Payload.SeqNum)
+	assert.Equal(t, []uint64{2}, expectSent("p2").GetTreeIhave().SeqNums)
+}
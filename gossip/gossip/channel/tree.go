@@ -0,0 +1,386 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/comm"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+)
+
+// blockTreeAdapter enables the blockTree to access the membership
+// and the blocks of the channel, and to send messages to peers
+type blockTreeAdapter interface {
+	// members returns the peers of the organization in the channel
+	members() []discovery.NetworkMember
+
+	// send sends a message to the given peers
+	send(msg *proto.SignedGossipMessage, peers ...*comm.RemotePeer)
+
+	// block returns the block with the given sequence, or nil if the peer doesn't have it in memory
+	block(seqNum uint64) *proto.SignedGossipMessage
+
+	// needsBlock returns whether the peer neither has, nor has committed the block with the given sequence
+	needsBlock(seqNum uint64) bool
+}
+
+// blockTree disseminates the blocks of a channel via an epidemic broadcast tree,
+// in the spirit of Plumtree: blocks are eagerly pushed to a few peers of the organization,
+// and are only announced to the rest of them.
+// A peer that receives a block it already has prunes the link the block arrived from,
+// so that the eager links converge into a spanning tree of the organization's peers.
+// A peer that is announced a block which doesn't arrive in time grafts the link of the
+// announcing peer into the tree, and asks it for the block.
+// Blocks that are missed nonetheless are obtained by the periodical pull of blocks.
+type blockTree struct {
+	sync.Mutex
+	chainID      common.ChainID
+	fanout       int
+	graftTimeout time.Duration
+	adapter      blockTreeAdapter
+	logger       util.Logger
+	// eager are the peers blocks are pushed to, and lazy are the peers blocks are announced to
+	eager   map[string]discovery.NetworkMember
+	lazy    map[string]discovery.NetworkMember
+	missing map[uint64]*missingBlock
+	stopped bool
+}
+
+// missingBlock is a block that was announced to the peer but hasn't arrived yet
+type missingBlock struct {
+	announcers []discovery.NetworkMember
+	timer      *time.Timer
+}
+
+func newBlockTree(chainID common.ChainID, fanout int, graftTimeout time.Duration, adapter blockTreeAdapter, logger util.Logger) *blockTree {
+	return &blockTree{
+		chainID:      chainID,
+		fanout:       fanout,
+		graftTimeout: graftTimeout,
+		adapter:      adapter,
+		logger:       logger,
+		eager:        make(map[string]discovery.NetworkMember),
+		lazy:         make(map[string]discovery.NetworkMember),
+		missing:      make(map[uint64]*missingBlock),
+	}
+}
+
+// disseminate pushes the given block to the eager peers and announces it to the lazy peers,
+// except for the peer the block was received from, which is nil for blocks that originate at this peer
+func (t *blockTree) disseminate(msg *proto.SignedGossipMessage, from common.PKIidType) {
+	seqNum := msg.GetDataMsg().Payload.SeqNum
+	t.refresh()
+
+	t.Lock()
+	t.blockArrived(seqNum)
+	if from != nil {
+		// The peer that sent us the block first is part of the tree
+		t.makeEager(string(from))
+	}
+	eagerPeers := remotePeersExcept(t.eager, from)
+	lazyPeers := remotePeersExcept(t.lazy, from)
+	t.Unlock()
+
+	t.adapter.send(msg, eagerPeers...)
+	if len(lazyPeers) == 0 {
+		return
+	}
+	iHave, err := t.createMessage(&proto.GossipMessage{
+		Content: &proto.GossipMessage_TreeIhave{
+			TreeIhave: &proto.BlockTreeIHave{SeqNums: []uint64{seqNum}},
+		},
+	})
+	if err != nil {
+		t.logger.Warningf("Failed creating IHave message for block %d: %+v", seqNum, errors.WithStack(err))
+		return
+	}
+	t.adapter.send(iHave, lazyPeers...)
+}
+
+// duplicate handles a block that was received from the given peer, while it was already received before,
+// by pruning the link to the peer
+func (t *blockTree) duplicate(from common.PKIidType) {
+	t.Lock()
+	if _, isEager := t.eager[string(from)]; !isEager {
+		t.Unlock()
+		return
+	}
+	t.makeLazy(string(from))
+	t.Unlock()
+
+	prune, err := t.createMessage(&proto.GossipMessage{
+		Content: &proto.GossipMessage_TreePrune{
+			TreePrune: &proto.BlockTreePrune{},
+		},
+	})
+	if err != nil {
+		t.logger.Warningf("Failed creating Prune message: %+v", errors.WithStack(err))
+		return
+	}
+	t.logger.Debug("Pruning", from, "which sent us a block we already have")
+	t.adapter.send(prune, &comm.RemotePeer{PKIID: from, Endpoint: t.endpointOf(from)})
+}
+
+// blockReceived notifies that the block with the given sequence was obtained other than through the tree
+func (t *blockTree) blockReceived(seqNum uint64) {
+	t.Lock()
+	defer t.Unlock()
+	t.blockArrived(seqNum)
+}
+
+// handleMessage handles an IHave, Graft or Prune message sent by a peer of the organization
+func (t *blockTree) handleMessage(msg proto.ReceivedMessage) {
+	m := msg.GetGossipMessage()
+	sender := msg.GetConnectionInfo().ID
+	switch {
+	case m.GetTreeIhave() != nil:
+		t.handleIHave(sender, m.GetTreeIhave().SeqNums)
+	case m.GetTreeGraft() != nil:
+		t.handleGraft(sender, m.GetTreeGraft().SeqNums)
+	case m.GetTreePrune() != nil:
+		t.Lock()
+		t.makeLazy(string(sender))
+		t.Unlock()
+	}
+}
+
+func (t *blockTree) handleIHave(sender common.PKIidType, seqNums []uint64) {
+	t.refresh()
+	t.Lock()
+	defer t.Unlock()
+	if t.stopped {
+		return
+	}
+	announcer, known := t.lookup(string(sender))
+	if !known {
+		t.logger.Debug("Got IHave message from", sender, "which isn't a peer of the organization in the channel")
+		return
+	}
+	for _, seqNum := range seqNums {
+		if !t.adapter.needsBlock(seqNum) {
+			continue
+		}
+		mb, exists := t.missing[seqNum]
+		if !exists {
+			mb = &missingBlock{}
+			seq := seqNum
+			mb.timer = time.AfterFunc(t.graftTimeout, func() {
+				t.graft(seq)
+			})
+			t.missing[seqNum] = mb
+		}
+		if !containsMember(mb.announcers, sender) {
+			mb.announcers = append(mb.announcers, announcer)
+		}
+	}
+}
+
+// graft is invoked when an announced block didn't arrive in time,
+// and asks the peer that announced it first to send it, and to eagerly push blocks to this peer
+func (t *blockTree) graft(seqNum uint64) {
+	t.Lock()
+	mb, exists := t.missing[seqNum]
+	if t.stopped || !exists {
+		t.Unlock()
+		return
+	}
+	if !t.adapter.needsBlock(seqNum) || len(mb.announcers) == 0 {
+		delete(t.missing, seqNum)
+		t.Unlock()
+		return
+	}
+	announcer := mb.announcers[0]
+	mb.announcers = mb.announcers[1:]
+	if len(mb.announcers) > 0 {
+		// Wait for the block again, before grafting the next peer that announced it
+		mb.timer.Reset(t.graftTimeout)
+	} else {
+		delete(t.missing, seqNum)
+	}
+	t.makeEager(string(announcer.PKIid))
+	t.Unlock()
+
+	graft, err := t.createMessage(&proto.GossipMessage{
+		Content: &proto.GossipMessage_TreeGraft{
+			TreeGraft: &proto.BlockTreeGraft{SeqNums: []uint64{seqNum}},
+		},
+	})
+	if err != nil {
+		t.logger.Warningf("Failed creating Graft message for block %d: %+v", seqNum, errors.WithStack(err))
+		return
+	}
+	t.logger.Debug("Block", seqNum, "didn't arrive in time, grafting", announcer.PreferredEndpoint())
+	t.adapter.send(graft, &comm.RemotePeer{PKIID: announcer.PKIid, Endpoint: announcer.PreferredEndpoint()})
+}
+
+func (t *blockTree) handleGraft(sender common.PKIidType, seqNums []uint64) {
+	t.refresh()
+	t.Lock()
+	if _, known := t.lookup(string(sender)); !known {
+		t.Unlock()
+		t.logger.Debug("Got Graft message from", sender, "which isn't a peer of the organization in the channel")
+		return
+	}
+	t.makeEager(string(sender))
+	t.Unlock()
+
+	peer := &comm.RemotePeer{PKIID: sender, Endpoint: t.endpointOf(sender)}
+	for _, seqNum := range seqNums {
+		if block := t.adapter.block(seqNum); block != nil {
+			t.adapter.send(block, peer)
+		}
+	}
+}
+
+// refresh aligns the eager and lazy peers with the membership of the channel.
+// Peers that left are removed, and new peers are pushed blocks to, as long as
+// there are less eager peers than the fanout
+func (t *blockTree) refresh() {
+	members := t.adapter.members()
+	t.Lock()
+	defer t.Unlock()
+	alive := make(map[string]discovery.NetworkMember, len(members))
+	for _, member := range members {
+		alive[string(member.PKIid)] = member
+	}
+	for _, peers := range []map[string]discovery.NetworkMember{t.eager, t.lazy} {
+		for pkiID := range peers {
+			member, isAlive := alive[pkiID]
+			if !isAlive {
+				delete(peers, pkiID)
+				continue
+			}
+			peers[pkiID] = member
+		}
+	}
+	for pkiID, member := range alive {
+		if _, known := t.lookup(pkiID); known {
+			continue
+		}
+		if len(t.eager) < t.fanout {
+			t.eager[pkiID] = member
+		} else {
+			t.lazy[pkiID] = member
+		}
+	}
+}
+
+func (t *blockTree) stop() {
+	t.Lock()
+	defer t.Unlock()
+	t.stopped = true
+	for seqNum, mb := range t.missing {
+		mb.timer.Stop()
+		delete(t.missing, seqNum)
+	}
+}
+
+// blockArrived cancels the graft of the block with the given sequence, if one is pending.
+// It should be invoked while holding the lock
+func (t *blockTree) blockArrived(seqNum uint64) {
+	if mb, exists := t.missing[seqNum]; exists {
+		mb.timer.Stop()
+		delete(t.missing, seqNum)
+	}
+}
+
+// makeEager and makeLazy should be invoked while holding the lock
+func (t *blockTree) makeEager(pkiID string) {
+	if member, isLazy := t.lazy[pkiID]; isLazy {
+		delete(t.lazy, pkiID)
+		t.eager[pkiID] = member
+	}
+}
+
+func (t *blockTree) makeLazy(pkiID string) {
+	if member, isEager := t.eager[pkiID]; isEager {
+		delete(t.eager, pkiID)
+		t.lazy[pkiID] = member
+	}
+}
+
+// lookup should be invoked while holding the lock
+func (t *blockTree) lookup(pkiID string) (discovery.NetworkMember, bool) {
+	if member, exists := t.eager[pkiID]; exists {
+		return member, true
+	}
+	member, exists := t.lazy[pkiID]
+	return member, exists
+}
+
+func (t *blockTree) endpointOf(pkiID common.PKIidType) string {
+	t.Lock()
+	defer t.Unlock()
+	member, _ := t.lookup(string(pkiID))
+	return member.PreferredEndpoint()
+}
+
+func (t *blockTree) createMessage(msg *proto.GossipMessage) (*proto.SignedGossipMessage, error) {
+	msg.Channel = t.chainID
+	msg.Tag = proto.GossipMessage_CHAN_AND_ORG
+	return msg.NoopSign()
+}
+
+func remotePeersExcept(peers map[string]discovery.NetworkMember, except common.PKIidType) []*comm.RemotePeer {
+	var res []*comm.RemotePeer
+	for pkiID, member := range peers {
+		if pkiID == string(except) {
+			continue
+		}
+		res = append(res, &comm.RemotePeer{PKIID: member.PKIid, Endpoint: member.PreferredEndpoint()})
+	}
+	return res
+}
+
+func containsMember(members []discovery.NetworkMember, pkiID common.PKIidType) bool {
+	for _, member := range members {
+		if member.PKIid.IsNotSameFilter(pkiID) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// blockTreeAdapterImpl implements blockTreeAdapter on top of the gossipChannel
+type blockTreeAdapterImpl struct {
+	gc *gossipChannel
+}
+
+func (a *blockTreeAdapterImpl) members() []discovery.NetworkMember {
+	return a.gc.memFilter.GetMembership()
+}
+
+func (a *blockTreeAdapterImpl) send(msg *proto.SignedGossipMessage, peers ...*comm.RemotePeer) {
+	if len(peers) == 0 {
+		return
+	}
+	a.gc.Send(msg, peers...)
+}
+
+func (a *blockTreeAdapterImpl) block(seqNum uint64) *proto.SignedGossipMessage {
+	for _, m := range a.gc.blockMsgStore.Get() {
+		msg := m.(*proto.SignedGossipMessage)
+		if msg.GetDataMsg().Payload.SeqNum == seqNum {
+			return msg
+		}
+	}
+	return nil
+}
+
+func (a *blockTreeAdapterImpl) needsBlock(seqNum uint64) bool {
+	a.gc.RLock()
+	height := a.gc.ledgerHeight
+	a.gc.RUnlock()
+	return seqNum >= height && a.block(seqNum) == nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channel
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/comm"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+type sentMsg struct {
+	msg  *proto.SignedGossipMessage
+	peer string
+}
+
+type blockTreeAdapterMock struct {
+	sync.Mutex
+	peers  []discovery.NetworkMember
+	blocks map[uint64]*proto.SignedGossipMessage
+	sent   chan sentMsg
+}
+
+func newBlockTreeAdapterMock(peers ...string) *blockTreeAdapterMock {
+	a := &blockTreeAdapterMock{
+		blocks: make(map[uint64]*proto.SignedGossipMessage),
+		sent:   make(chan sentMsg, 100),
+	}
+	for _, p := range peers {
+		a.peers = append(a.peers, discovery.NetworkMember{PKIid: common.PKIidType(p), Endpoint: p})
+	}
+	return a
+}
+
+func (a *blockTreeAdapterMock) members() []discovery.NetworkMember {
+	a.Lock()
+	defer a.Unlock()
+	return a.peers
+}
+
+func (a *blockTreeAdapterMock) send(msg *proto.SignedGossipMessage, peers ...*comm.RemotePeer) {
+	for _, p := range peers {
+		a.sent <- sentMsg{msg: msg, peer: p.Endpoint}
+	}
+}
+
+func (a *blockTreeAdapterMock) block(seqNum uint64) *proto.SignedGossipMessage {
+	a.Lock()
+	defer a.Unlock()
+	return a.blocks[seqNum]
+}
+
+func (a *blockTreeAdapterMock) needsBlock(seqNum uint64) bool {
+	return a.block(seqNum) == nil
+}
+
+func (a *blockTreeAdapterMock) drain() []sentMsg {
+	var res []sentMsg
+	for {
+		select {
+		case m := <-a.sent:
+			res = append(res, m)
+		default:
+			return res
+		}
+	}
+}
+
+func treeMsg(sender string, msg *proto.GossipMessage) proto.ReceivedMessage {
+	sMsg, _ := msg.NoopSign()
+	return &receivedMsg{PKIID: common.PKIidType(sender), msg: sMsg}
+}
+
+func newTestBlockTree(fanout int, graftTimeout time.Duration, adapter blockTreeAdapter) *blockTree {
+	return newBlockTree(channelA, fanout, graftTimeout, adapter, util.GetLogger(util.LoggingChannelModule, ""))
+}
+
+func (t *blockTree) isEager(pkiID string) bool {
+	t.Lock()
+	defer t.Unlock()
+	_, isEager := t.eager[pkiID]
+	return isEager
+}
+
+func TestBlockTreeDisseminate(t *testing.T) {
+	adapter := newBlockTreeAdapterMock("p1", "p2", "p3", "p4")
+	tree := newTestBlockTree(2, time.Hour, adapter)
+	defer tree.stop()
+
+	// Blocks are pushed to as many peers as the fanout, and announced to the rest
+	tree.disseminate(createDataMsg(1, channelA), nil)
+	blocks, iHaves := 0, 0
+	for _, m := range adapter.drain() {
+		if m.msg.IsDataMsg() {
+			blocks++
+			continue
+		}
+		assert.Equal(t, []uint64{1}, m.msg.GetTreeIhave().SeqNums)
+		assert.Equal(t, proto.GossipMessage_CHAN_AND_ORG, m.msg.Tag)
+		iHaves++
+	}
+	assert.Equal(t, 2, blocks)
+	assert.Equal(t, 2, iHaves)
+	assert.Len(t, tree.eager, 2)
+	assert.Len(t, tree.lazy, 2)
+
+	// A block is not sent back to the peer it was received from,
+	// and that peer becomes an eager peer
+	var lazyPeer string
+	for pkiID := range tree.lazy {
+		lazyPeer = pkiID
+	}
+	tree.disseminate(createDataMsg(2, channelA), common.PKIidType(lazyPeer))
+	for _, m := range adapter.drain() {
+		assert.NotEqual(t, lazyPeer, m.peer)
+	}
+	assert.Contains(t, tree.eager, lazyPeer)
+
+	// Peers that leave are removed from the tree
+	adapter.Lock()
+	adapter.peers = adapter.peers[:1]
+	adapter.Unlock()
+	tree.disseminate(createDataMsg(3, channelA), nil)
+	sent := adapter.drain()
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "p1", sent[0].peer)
+	assert.Equal(t, 1, len(tree.eager)+len(tree.lazy))
+}
+
+func TestBlockTreePrune(t *testing.T) {
+	adapter := newBlockTreeAdapterMock("p1", "p2")
+	tree := newTestBlockTree(2, time.Hour, adapter)
+	defer tree.stop()
+	tree.refresh()
+
+	// A duplicate block from an eager peer prunes it
+	tree.duplicate(common.PKIidType("p1"))
+	sent := adapter.drain()
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "p1", sent[0].peer)
+	assert.NotNil(t, sent[0].msg.GetTreePrune())
+	assert.Contains(t, tree.lazy, "p1")
+
+	// A duplicate block from a lazy peer doesn't prune it again
+	tree.duplicate(common.PKIidType("p1"))
+	assert.Empty(t, adapter.drain())
+
+	// A peer that prunes us becomes a lazy peer
+	tree.handleMessage(treeMsg("p2", &proto.GossipMessage{
+		Content: &proto.GossipMessage_TreePrune{TreePrune: &proto.BlockTreePrune{}},
+	}))
+	assert.Empty(t, tree.eager)
+	tree.disseminate(createDataMsg(1, channelA), nil)
+	for _, m := range adapter.drain() {
+		assert.NotNil(t, m.msg.GetTreeIhave())
+	}
+}
+
+func TestBlockTreeGraft(t *testing.T) {
+	adapter := newBlockTreeAdapterMock("p1", "p2")
+	tree := newTestBlockTree(0, time.Millisecond*100, adapter)
+	defer tree.stop()
+
+	iHave := func(sender string, seqNum uint64) proto.ReceivedMessage {
+		return treeMsg(sender, &proto.GossipMessage{
+			Content: &proto.GossipMessage_TreeIhave{TreeIhave: &proto.BlockTreeIHave{SeqNums: []uint64{seqNum}}},
+		})
+	}
+
+	// A block that arrives in time isn't grafted
+	tree.handleMessage(iHave("p1", 1))
+	tree.blockReceived(1)
+	time.Sleep(time.Millisecond * 300)
+	assert.Empty(t, adapter.drain())
+
+	// A block that doesn't arrive in time is requested from the peers that announced it, one by one
+	tree.handleMessage(iHave("p1", 2))
+	tree.handleMessage(iHave("p2", 2))
+	for _, expectedPeer := range []string{"p1", "p2"} {
+		select {
+		case m := <-adapter.sent:
+			assert.Equal(t, expectedPeer, m.peer)
+			assert.Equal(t, []uint64{2}, m.msg.GetTreeGraft().SeqNums)
+			assert.True(t, tree.isEager(expectedPeer))
+		case <-time.After(time.Second * 5):
+			t.Fatal("Didn't graft peer", expectedPeer, "in time")
+		}
+	}
+	time.Sleep(time.Millisecond * 300)
+	assert.Empty(t, adapter.drain())
+
+	// Blocks the peer already has aren't grafted
+	adapter.Lock()
+	adapter.blocks[3] = createDataMsg(3, channelA)
+	adapter.Unlock()
+	tree.handleMessage(iHave("p1", 3))
+	time.Sleep(time.Millisecond * 300)
+	assert.Empty(t, adapter.drain())
+
+	// Announcements from peers that aren't in the channel are ignored
+	tree.handleMessage(iHave("p3", 4))
+	time.Sleep(time.Millisecond * 300)
+	assert.Empty(t, adapter.drain())
+}
+
+func TestBlockTreeHandleGraft(t *testing.T) {
+	adapter := newBlockTreeAdapterMock("p1", "p2")
+	adapter.blocks[5] = createDataMsg(5, channelA)
+	tree := newTestBlockTree(0, time.Hour, adapter)
+	defer tree.stop()
+
+	graft := func(sender string) proto.ReceivedMessage {
+		return treeMsg(sender, &proto.GossipMessage{
+			Content: &proto.GossipMessage_TreeGraft{TreeGraft: &proto.BlockTreeGraft{SeqNums: []uint64{5, 6}}},
+		})
+	}
+
+	// The grafting peer gets the blocks we have, and becomes an eager peer
+	tree.handleMessage(graft("p1"))
+	sent := adapter.drain()
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "p1", sent[0].peer)
+	assert.Equal(t, uint64(5), sent[0].msg.GetDataMsg().Payload.SeqNum)
+	assert.Contains(t, tree.eager, "p1")
+
+	// Peers that aren't in the channel get nothing
+	tree.handleMessage(graft("p3"))
+	assert.Empty(t, adapter.drain())
+}
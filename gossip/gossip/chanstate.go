@@ -84,6 +84,17 @@ func (cs *channelState) getGossipChannelByMAC(receivedMAC []byte, pkiID common.P
 	return nil
 }
 
+func (cs *channelState) updatePKIid(pkiID common.PKIidType) {
+	if cs.isStopping() {
+		return
+	}
+	cs.RLock()
+	defer cs.RUnlock()
+	for _, gc := range cs.channels {
+		gc.UpdatePKIid(pkiID)
+	}
+}
+
 func (cs *channelState) getGossipChannelByChainID(chainID common.ChainID) channel.GossipChannel {
 	if cs.isStopping() {
 		return nil
@@ -124,6 +135,9 @@ func (ga *gossipAdapterImpl) GetConf() channel.Config {
 		RequestStateInfoInterval:    ga.conf.RequestStateInfoInterval,
 		BlockExpirationInterval:     ga.conf.PullInterval * 100,
 		StateInfoCacheSweepInterval: ga.conf.PullInterval * 5,
+		BlockTreeDissemination:      ga.conf.BlockTreeDissemination,
+		BlockTreeFanout:             ga.conf.PropagatePeerNum,
+		BlockTreeGraftTimeout:       ga.conf.BlockTreeGraftTimeout,
 	}
 }
 
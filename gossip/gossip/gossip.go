@@ -36,6 +36,9 @@ type Gossip interface {
 	// GetPeers returns the NetworkMembers considered alive
 	Peers() []discovery.NetworkMember
 
+	// DeadPeers returns the NetworkMembers considered dead
+	DeadPeers() []discovery.NetworkMember
+
 	// PeersOfChannel returns the NetworkMembers considered alive
 	// and also subscribed to the channel given
 	PeersOfChannel(common.ChainID) []discovery.NetworkMember
@@ -81,6 +84,9 @@ type Gossip interface {
 	// IdentityInfo returns information known peer identities
 	IdentityInfo() api.PeerIdentitySet
 
+	// UpdateIdentity replaces the identity of the peer with a renewed identity of its organization
+	UpdateIdentity(identity api.PeerIdentityType) error
+
 	// Stop stops the gossip component
 	Stop()
 }
@@ -125,6 +131,9 @@ type Config struct {
 
 	SkipBlockVerification bool // Should we skip verifying block messages or not
 
+	BlockTreeDissemination bool          // Should blocks be disseminated via an epidemic broadcast tree instead of random peers
+	BlockTreeGraftTimeout  time.Duration // Time to wait for an announced block before asking the announcing peer for it
+
 	PublishCertPeriod        time.Duration // Time from startup certificates are included in Alive messages
 	PublishStateInfoInterval time.Duration // Determines frequency of pushing state info messages to peers
 	RequestStateInfoInterval time.Duration // Determines frequency of pulling state info messages from peers
@@ -691,6 +691,10 @@ func (g *gossipServiceImpl) Gossip(msg *proto.GossipMessage) {
 		}
 		if msg.IsDataMsg() {
 			gc.AddToMsgStore(sMsg)
+			if g.conf.BlockTreeDissemination {
+				gc.DisseminateBlock(sMsg)
+				return
+			}
 		}
 	}
 
@@ -720,6 +724,11 @@ func (g *gossipServiceImpl) Peers() []discovery.NetworkMember {
 	return g.disc.GetMembership()
 }
 
+// DeadPeers returns the NetworkMembers considered dead
+func (g *gossipServiceImpl) DeadPeers() []discovery.NetworkMember {
+	return g.disc.GetDeadMembership()
+}
+
 // PeersOfChannel returns the NetworkMembers considered alive
 // and also subscribed to the channel given
 func (g *gossipServiceImpl) PeersOfChannel(channel common.ChainID) []discovery.NetworkMember {
@@ -781,6 +790,31 @@ func (g *gossipServiceImpl) Stop() {
 	comWG.Wait()
 }
 
+// UpdateIdentity replaces the identity of the peer with a renewed identity of its organization.
+// The peer is advertised with the PKI-ID of the renewed identity from now on, while
+// the previous identity is still disseminated to other peers until it expires.
+func (g *gossipServiceImpl) UpdateIdentity(identity api.PeerIdentityType) error {
+	pkiID := g.mcs.GetPKIidOfCert(identity)
+	if len(pkiID) == 0 {
+		return errors.New("failed computing the PKI-ID of the identity")
+	}
+	if bytes.Equal(pkiID, g.comm.GetPKIid()) {
+		return nil
+	}
+	if org := g.secAdvisor.OrgByPeerIdentity(identity); !bytes.Equal(org, g.selfOrg) {
+		return errors.Errorf("identity of organization %s cannot replace an identity of organization %s", org, g.selfOrg)
+	}
+	if err := g.certStore.updateSelfIdentity(identity); err != nil {
+		return err
+	}
+	g.disSecAdap.updateIdentity(identity, time.Now().Add(g.conf.PublishCertPeriod))
+	g.comm.UpdateIdentity(identity)
+	g.disc.UpdatePKIid(pkiID)
+	g.chanState.updatePKIid(pkiID)
+	g.logger.Info("Updated the identity of the peer, its PKI-ID is now", pkiID)
+	return nil
+}
+
 func (g *gossipServiceImpl) UpdateMetadata(md []byte) {
 	g.disc.UpdateMetadata(md)
 }
@@ -980,6 +1014,7 @@ func (da *discoveryAdapter) CloseConn(peer *discovery.NetworkMember) {
 }
 
 type discoverySecurityAdapter struct {
+	sync.RWMutex
 	identity              api.PeerIdentityType
 	includeIdentityPeriod time.Time
 	idMapper              identity.Mapper
@@ -1040,9 +1075,11 @@ func (sa *discoverySecurityAdapter) SignMessage(m *proto.GossipMessage, internal
 	signer := func(msg []byte) ([]byte, error) {
 		return sa.mcs.Sign(msg)
 	}
+	sa.RLock()
 	if m.IsAliveMsg() && time.Now().Before(sa.includeIdentityPeriod) {
 		m.GetAliveMsg().Identity = sa.identity
 	}
+	sa.RUnlock()
 	sMsg := &proto.SignedGossipMessage{
 		GossipMessage: m,
 	}
@@ -1063,6 +1100,14 @@ func (sa *discoverySecurityAdapter) SignMessage(m *proto.GossipMessage, internal
 	return e
 }
 
+// updateIdentity replaces the identity included in alive messages until the given time
+func (sa *discoverySecurityAdapter) updateIdentity(identity api.PeerIdentityType, includeIdentityPeriod time.Time) {
+	sa.Lock()
+	defer sa.Unlock()
+	sa.identity = identity
+	sa.includeIdentityPeriod = includeIdentityPeriod
+}
+
 func (sa *discoverySecurityAdapter) validateAliveMsgSignature(m *proto.SignedGossipMessage, identity api.PeerIdentityType) bool {
 	am := m.GetAliveMsg()
 	// At this point we got the certificate of the peer, proceed to verifying the AliveMessage
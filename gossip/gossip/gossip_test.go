@@ -44,6 +44,7 @@ var tests = []func(t *testing.T){
 	TestMembershipRequestSpoofing,
 	TestDataLeakage,
 	TestLeaveChannel,
+	TestUpdateIdentity,
 	//TestDisseminateAll2All: {},
 	TestIdentityExpiration,
 	TestSendByCriteria,
@@ -323,6 +324,78 @@ func TestLeaveChannel(t *testing.T) {
 
 }
 
+func TestUpdateIdentity(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	portPrefix := 19610
+	// Scenario: Have 3 peers in a channel and renew the identity of one of them.
+	// Ensure the other peers recognize the peer by the PKI-ID of its renewed identity,
+	// while the peer keeps its previous identity until it expires
+
+	p0 := newGossipInstance(portPrefix, 0, 100)
+	p0.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p0.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p0.Stop()
+
+	p1 := newGossipInstance(portPrefix, 1, 100, 0)
+	p1.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p1.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p1.Stop()
+
+	p2 := newGossipInstance(portPrefix, 2, 100, 0)
+	p2.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p2.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p2.Stop()
+
+	knowsPeer := func(g Gossip, pkiID common.PKIidType) func() bool {
+		return func() bool {
+			for _, member := range g.PeersOfChannel(common.ChainID("A")) {
+				if bytes.Equal(member.PKIid, pkiID) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	prevPKIID := common.PKIidType(fmt.Sprintf("localhost:%d", portPrefix+2))
+	waitUntilOrFail(t, knowsPeer(p0, prevPKIID))
+	waitUntilOrFail(t, knowsPeer(p1, prevPKIID))
+
+	// The identity the peer has is left in place
+	assert.NoError(t, p2.UpdateIdentity(api.PeerIdentityType(prevPKIID)))
+	assert.Equal(t, prevPKIID, p2.SelfMembershipInfo().PKIid)
+
+	// An identity that isn't valid is rejected
+	renewedIdentity := api.PeerIdentityType(fmt.Sprintf("renewed:%d", portPrefix+2))
+	renewedPKIID := common.PKIidType(renewedIdentity)
+	mcs := p2.(*gossipServiceImpl).mcs.(*naiveCryptoService)
+	mcs.revoke(renewedPKIID)
+	assert.EqualError(t, p2.UpdateIdentity(renewedIdentity), "failed associating self PKIID to cert: revoked")
+	assert.Equal(t, prevPKIID, p2.SelfMembershipInfo().PKIid)
+
+	mcs.Lock()
+	mcs.revokedPkiIDS = nil
+	mcs.Unlock()
+	assert.NoError(t, p2.UpdateIdentity(renewedIdentity))
+	assert.Equal(t, renewedPKIID, p2.SelfMembershipInfo().PKIid)
+	assert.Equal(t, renewedPKIID, common.PKIidType(p2.SelfChannelInfo(common.ChainID("A")).GetStateInfo().PkiId))
+
+	// Ensure the other peers see the peer in the channel under its renewed PKI-ID
+	waitUntilOrFail(t, knowsPeer(p0, renewedPKIID))
+	waitUntilOrFail(t, knowsPeer(p1, renewedPKIID))
+	waitUntilOrFail(t, knowsPeer(p2, common.PKIidType(fmt.Sprintf("localhost:%d", portPrefix))))
+
+	// The previous identity of the peer is kept
+	idMapper := p2.(*gossipServiceImpl).idMapper
+	identity, err := idMapper.Get(prevPKIID)
+	assert.NoError(t, err)
+	assert.Equal(t, api.PeerIdentityType(prevPKIID), identity)
+	identity, err = idMapper.Get(renewedPKIID)
+	assert.NoError(t, err)
+	assert.Equal(t, renewedIdentity, identity)
+}
+
 func TestPull(t *testing.T) {
 	t.Parallel()
 	defer testWG.Done()
@@ -44,6 +44,11 @@ type Mapper interface {
 	// GetPKIidOfCert returns the PKI-ID of a certificate
 	GetPKIidOfCert(api.PeerIdentityType) common.PKIidType
 
+	// UpdateSelfIdentity associates a renewed identity of the peer itself to its pkiID.
+	// The identities the peer had before are kept until they expire, and like the
+	// renewed one, they are never purged for not being used
+	UpdateSelfIdentity(identity api.PeerIdentityType) error
+
 	// SuspectPeers re-validates all peers that match the given predicate
 	SuspectPeers(isSuspected api.PeerSuspector)
 
@@ -65,7 +70,7 @@ type identityMapperImpl struct {
 	sync.RWMutex
 	stopChan chan struct{}
 	sync.Once
-	selfPKIID string
+	selfPKIIDs map[string]struct{}
 }
 
 // NewIdentityMapper method, all we need is a reference to a MessageCryptoService
@@ -76,7 +81,7 @@ func NewIdentityMapper(mcs api.MessageCryptoService, selfIdentity api.PeerIdenti
 		mcs:        mcs,
 		pkiID2Cert: make(map[string]*storedIdentity),
 		stopChan:   make(chan struct{}),
-		selfPKIID:  string(selfPKIID),
+		selfPKIIDs: map[string]struct{}{string(selfPKIID): {}},
 		sa:         sa,
 	}
 	if err := idMapper.Put(selfPKIID, selfIdentity); err != nil {
@@ -185,6 +190,18 @@ func (is *identityMapperImpl) GetPKIidOfCert(identity api.PeerIdentityType) comm
 	return is.mcs.GetPKIidOfCert(identity)
 }
 
+// UpdateSelfIdentity associates a renewed identity of the peer itself to its pkiID
+func (is *identityMapperImpl) UpdateSelfIdentity(identity api.PeerIdentityType) error {
+	pkiID := is.mcs.GetPKIidOfCert(identity)
+	if err := is.Put(pkiID, identity); err != nil {
+		return err
+	}
+	is.Lock()
+	defer is.Unlock()
+	is.selfPKIIDs[string(pkiID)] = struct{}{}
+	return nil
+}
+
 // SuspectPeers re-validates all peers that match the given predicate
 func (is *identityMapperImpl) SuspectPeers(isSuspected api.PeerSuspector) {
 	for _, identity := range is.validateIdentities(isSuspected) {
@@ -201,7 +218,7 @@ func (is *identityMapperImpl) validateIdentities(isSuspected api.PeerSuspector)
 	defer is.RUnlock()
 	var revokedIdentities []*storedIdentity
 	for pkiID, storedIdentity := range is.pkiID2Cert {
-		if pkiID != is.selfPKIID && storedIdentity.fetchLastAccessTime().Add(usageThreshold).Before(now) {
+		if _, isSelf := is.selfPKIIDs[pkiID]; !isSelf && storedIdentity.fetchLastAccessTime().Add(usageThreshold).Before(now) {
 			revokedIdentities = append(revokedIdentities, storedIdentity)
 			continue
 		}
@@ -235,6 +252,7 @@ func (is *identityMapperImpl) delete(pkiID common.PKIidType, identity api.PeerId
 	defer is.Unlock()
 	is.onPurge(pkiID, identity)
 	delete(is.pkiID2Cert, string(pkiID))
+	delete(is.selfPKIIDs, string(pkiID))
 }
 
 type storedIdentity struct {
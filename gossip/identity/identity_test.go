@@ -40,6 +40,7 @@ func init() {
 	msgCryptoService.On("Expiration", api.PeerIdentityType("yacovm")).Return(time.Now().Add(time.Hour), nil)
 	msgCryptoService.On("Expiration", api.PeerIdentityType("not-yacovm")).Return(time.Now().Add(time.Hour), nil)
 	msgCryptoService.On("Expiration", api.PeerIdentityType("invalidIdentity")).Return(time.Now().Add(time.Hour), nil)
+	msgCryptoService.On("Expiration", api.PeerIdentityType("renewedID")).Return(time.Now().Add(time.Hour), nil)
 }
 
 func (cs *naiveCryptoService) OrgByPeerIdentity(id api.PeerIdentityType) api.OrgIdentityType {
@@ -238,6 +239,31 @@ func TestListInvalidIdentities(t *testing.T) {
 	assert.NotNil(t, cert)
 }
 
+func TestUpdateSelfIdentity(t *testing.T) {
+	SetIdentityUsageThreshold(time.Millisecond * 500)
+	idStore := NewIdentityMapper(msgCryptoService, dummyID, noopPurgeTrigger, msgCryptoService)
+	defer idStore.Stop()
+
+	// A revoked identity isn't accepted
+	renewedID := api.PeerIdentityType("renewedID")
+	renewedPKIID := msgCryptoService.GetPKIidOfCert(renewedID)
+	msgCryptoService.revokedIdentities[string(renewedPKIID)] = struct{}{}
+	assert.Error(t, idStore.UpdateSelfIdentity(renewedID))
+	_, err := idStore.Get(renewedPKIID)
+	assert.Error(t, err)
+
+	msgCryptoService.revokedIdentities = map[string]struct{}{}
+	assert.NoError(t, idStore.UpdateSelfIdentity(renewedID))
+	// Neither the renewed nor the previous identity are purged for not being used
+	time.Sleep(time.Second * 2)
+	cert, err := idStore.Get(renewedPKIID)
+	assert.NoError(t, err)
+	assert.Equal(t, renewedID, cert)
+	cert, err = idStore.Get(msgCryptoService.GetPKIidOfCert(dummyID))
+	assert.NoError(t, err)
+	assert.Equal(t, dummyID, cert)
+}
+
 func TestExpiration(t *testing.T) {
 	deletedIdentities := make(chan string, 1)
 	SetIdentityUsageThreshold(time.Second * 500)
@@ -51,6 +51,8 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 		RequestStateInfoInterval:   util.GetDurationOrDefault("peer.gossip.requestStateInfoInterval", 4*time.Second),
 		PublishStateInfoInterval:   util.GetDurationOrDefault("peer.gossip.publishStateInfoInterval", 4*time.Second),
 		SkipBlockVerification:      viper.GetBool("peer.gossip.skipBlockVerification"),
+		BlockTreeDissemination:     viper.GetBool("peer.gossip.blockTree.enabled"),
+		BlockTreeGraftTimeout:      util.GetDurationOrDefault("peer.gossip.blockTree.graftTimeout", time.Second),
 		TLSCerts:                   certs,
 	}
 
@@ -0,0 +1,138 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/discovery"
+	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/spf13/viper"
+)
+
+const (
+	reconcilePeerBackoffConfigKey    = "peer.gossip.pvtData.reconcilePeerBackoff"
+	reconcilePeerBackoffDefault      = time.Second * 10
+	reconcileMaxPeerBackoffConfigKey = "peer.gossip.pvtData.reconcileMaxPeerBackoff"
+	reconcileMaxPeerBackoffDefault   = time.Minute * 10
+)
+
+// peerBackoff keeps track of peers that failed to respond to private data requests,
+// and excludes them from being asked again for a period that doubles upon each
+// consecutive failure, up to a maximum period
+type peerBackoff struct {
+	sync.Mutex
+	interval    time.Duration
+	maxInterval time.Duration
+	now         func() time.Time
+	peers       map[string]*peerBackoffState
+}
+
+type peerBackoffState struct {
+	failures uint
+	until    time.Time
+}
+
+func newPeerBackoff(interval, maxInterval time.Duration) *peerBackoff {
+	return &peerBackoff{
+		interval:    interval,
+		maxInterval: maxInterval,
+		now:         time.Now,
+		peers:       make(map[string]*peerBackoffState),
+	}
+}
+
+// getPeerBackoff creates a peerBackoff out of the configuration in core.yaml
+func getPeerBackoff() *peerBackoff {
+	interval := viper.GetDuration(reconcilePeerBackoffConfigKey)
+	if interval == 0 {
+		interval = reconcilePeerBackoffDefault
+	}
+	maxInterval := viper.GetDuration(reconcileMaxPeerBackoffConfigKey)
+	if maxInterval == 0 {
+		maxInterval = reconcileMaxPeerBackoffDefault
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	return newPeerBackoff(interval, maxInterval)
+}
+
+// filter returns the given members, excluding the ones that are currently backed off
+func (b *peerBackoff) filter(members []discovery.NetworkMember) []discovery.NetworkMember {
+	b.Lock()
+	defer b.Unlock()
+	now := b.now()
+	var res []discovery.NetworkMember
+	for _, member := range members {
+		if state, exists := b.peers[string(member.PKIid)]; exists && now.Before(state.until) {
+			logger.Debug("Skipping", member.Endpoint, "which failed", state.failures, "consecutive time(s), until", state.until)
+			continue
+		}
+		res = append(res, member)
+	}
+	return res
+}
+
+// failed records a failure of the given peer, and backs it off
+func (b *peerBackoff) failed(pkiID string) {
+	b.Lock()
+	defer b.Unlock()
+	state, exists := b.peers[pkiID]
+	if !exists {
+		state = &peerBackoffState{}
+		b.peers[pkiID] = state
+	}
+	state.failures++
+	period := b.interval
+	for i := uint(1); i < state.failures && period < b.maxInterval; i++ {
+		period *= 2
+	}
+	if period > b.maxInterval {
+		period = b.maxInterval
+	}
+	state.until = b.now().Add(period)
+}
+
+// succeeded resets the backoff of the given peer
+func (b *peerBackoff) succeeded(pkiID string) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.peers, pkiID)
+}
+
+// update records the outcome of requests sent to peers, where each peer
+// that provided data for none of the digests it was asked for is considered to have failed
+func (b *peerBackoff) update(peer2digests peer2Digests, answered map[privdatacommon.DigKey]struct{}) {
+	for peer, digests := range peer2digests {
+		succeeded := false
+		for _, dig := range digests {
+			if _, exists := answered[digKeyOf(dig)]; exists {
+				succeeded = true
+				break
+			}
+		}
+		if succeeded {
+			b.succeeded(peer.pkiID)
+		} else {
+			logger.Debug("Peer", peer.endpoint, "did not provide any of the requested private data, backing off")
+			b.failed(peer.pkiID)
+		}
+	}
+}
+
+func digKeyOf(dig proto.PvtDataDigest) privdatacommon.DigKey {
+	return privdatacommon.DigKey{
+		TxId:       dig.TxId,
+		BlockSeq:   dig.BlockSeq,
+		SeqInBlock: dig.SeqInBlock,
+		Namespace:  dig.Namespace,
+		Collection: dig.Collection,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerBackoff(t *testing.T) {
+	now := time.Now()
+	b := newPeerBackoff(time.Second, time.Second*5)
+	b.now = func() time.Time {
+		return now
+	}
+
+	p1 := discovery.NetworkMember{PKIid: common.PKIidType("p1"), Endpoint: "p1"}
+	p2 := discovery.NetworkMember{PKIid: common.PKIidType("p2"), Endpoint: "p2"}
+	members := []discovery.NetworkMember{p1, p2}
+	assert.Equal(t, members, b.filter(members))
+
+	// p1 fails once and is backed off for a second
+	b.failed("p1")
+	assert.Equal(t, []discovery.NetworkMember{p2}, b.filter(members))
+	now = now.Add(time.Second)
+	assert.Equal(t, members, b.filter(members))
+
+	// Consecutive failures double the backoff period, up to the maximum
+	expectedPeriods := []time.Duration{time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}
+	for _, period := range expectedPeriods {
+		b.failed("p1")
+		now = now.Add(period - time.Millisecond)
+		assert.Equal(t, []discovery.NetworkMember{p2}, b.filter(members))
+		now = now.Add(time.Millisecond)
+		assert.Equal(t, members, b.filter(members))
+	}
+
+	// A success resets the backoff
+	b.failed("p1")
+	b.succeeded("p1")
+	assert.Equal(t, members, b.filter(members))
+	b.failed("p1")
+	now = now.Add(time.Second)
+	assert.Equal(t, members, b.filter(members))
+}
+
+func TestPeerBackoffUpdate(t *testing.T) {
+	b := newPeerBackoff(time.Minute, time.Hour)
+
+	dig1 := proto.PvtDataDigest{TxId: "tx1", Namespace: "ns", Collection: "c1", BlockSeq: 1}
+	dig2 := proto.PvtDataDigest{TxId: "tx2", Namespace: "ns", Collection: "c1", BlockSeq: 2}
+	dig3 := proto.PvtDataDigest{TxId: "tx3", Namespace: "ns", Collection: "c1", BlockSeq: 3}
+	peer2digests := peer2Digests{
+		remotePeer{pkiID: "p1", endpoint: "p1"}: {dig1, dig2},
+		remotePeer{pkiID: "p2", endpoint: "p2"}: {dig3},
+	}
+	// p1 provided some of the digests it was asked for, p2 provided none
+	answered := map[privdatacommon.DigKey]struct{}{
+		digKeyOf(dig2): {},
+	}
+	b.update(peer2digests, answered)
+
+	members := []discovery.NetworkMember{
+		{PKIid: common.PKIidType("p1"), Endpoint: "p1"},
+		{PKIid: common.PKIidType("p2"), Endpoint: "p2"},
+	}
+	assert.Equal(t, members[:1], b.filter(members))
+}
+
+func TestGetPeerBackoff(t *testing.T) {
+	defer func() {
+		viper.Set(reconcilePeerBackoffConfigKey, nil)
+		viper.Set(reconcileMaxPeerBackoffConfigKey, nil)
+	}()
+
+	b := getPeerBackoff()
+	assert.Equal(t, reconcilePeerBackoffDefault, b.interval)
+	assert.Equal(t, reconcileMaxPeerBackoffDefault, b.maxInterval)
+
+	viper.Set(reconcilePeerBackoffConfigKey, time.Minute)
+	viper.Set(reconcileMaxPeerBackoffConfigKey, time.Second)
+	b = getPeerBackoff()
+	assert.Equal(t, time.Minute, b.interval)
+	assert.Equal(t, time.Minute, b.maxInterval)
+}
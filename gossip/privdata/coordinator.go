@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -63,6 +64,10 @@ type TransientStore interface {
 	// after successful block commit, PurgeByHeight() is still required to remove orphan entries (as
 	// transaction that gets endorsed may not be submitted by the client for commit)
 	PurgeByHeight(maxBlockNumToRetain uint64) error
+
+	// PurgeByKeys removes the private write sets, received at a block height lesser than or equal
+	// to the given blockNum, which write a key purged by one of the given purge markers
+	PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error
 }
 
 // Coordinator orchestrates the flow of the new
@@ -85,6 +90,10 @@ type Coordinator interface {
 	// Get recent block sequence number
 	LedgerHeight() (uint64, error)
 
+	// UpdateSelfSignedData replaces the signed data the eligibility of the peer
+	// to private data is evaluated with, after the identity of the peer was renewed
+	UpdateSelfSignedData(selfSignedData common.SignedData)
+
 	// Close coordinator, shuts down coordinator service
 	Close()
 }
@@ -117,7 +126,8 @@ type Support struct {
 }
 
 type coordinator struct {
-	selfSignedData common.SignedData
+	selfSignedData     common.SignedData
+	selfSignedDataLock sync.RWMutex
 	Support
 	transientBlockRetention uint64
 }
@@ -238,6 +248,14 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 		}
 	}
 
+	// the purge markers are populated by the ledger upon commit, remove the purged
+	// keys from the private write sets of the transactions yet to be committed
+	if len(blockAndPvtData.PurgeMarkers) > 0 {
+		if err := c.PurgeByKeys(block.Header.Number, blockAndPvtData.PurgeMarkers); err != nil {
+			logger.Error("Purging private data keys purged at block", block.Header.Number, "failed:", err)
+		}
+	}
+
 	seq := block.Header.Number
 	if seq%c.transientBlockRetention == 0 && seq > c.transientBlockRetention {
 		err := c.PurgeByHeight(seq - c.transientBlockRetention)
@@ -751,10 +769,20 @@ func (c *coordinator) accessPolicyForCollection(chdr *common.ChannelHeader, name
 	return sp
 }
 
+// UpdateSelfSignedData replaces the signed data the eligibility of the peer
+// to private data is evaluated with, after the identity of the peer was renewed
+func (c *coordinator) UpdateSelfSignedData(selfSignedData common.SignedData) {
+	c.selfSignedDataLock.Lock()
+	defer c.selfSignedDataLock.Unlock()
+	c.selfSignedData = selfSignedData
+}
+
 // isEligible checks if this peer is eligible for a given CollectionAccessPolicy
 func (c *coordinator) isEligible(ap privdata.CollectionAccessPolicy, namespace string, col string) bool {
 	filt := ap.AccessFilter()
+	c.selfSignedDataLock.RLock()
 	eligible := filt(c.selfSignedData)
+	c.selfSignedDataLock.RUnlock()
 	if !eligible {
 		logger.Debug("Skipping namespace", namespace, "collection", col, "because we're not eligible for the private data")
 	}
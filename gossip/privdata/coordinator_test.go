@@ -29,6 +29,7 @@ import (
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/msp"
+	mspeer "github.com/hyperledger/fabric/protos/peer"
 	transientstore2 "github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -126,6 +127,10 @@ func (store *mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error
 	return store.Called(maxBlockNumToRetain).Error(0)
 }
 
+func (store *mockTransientStore) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	return store.Called(blockNum, purgeMarkers).Error(0)
+}
+
 func (store *mockTransientStore) GetTxPvtRWSetByTxid(txid string, filter ledger.PvtNsCollFilter) (transientstore.RWSetScanner, error) {
 	store.lastReqTxID = txid
 	store.lastReqFilter = filter
@@ -427,6 +432,10 @@ func (cs *collectionStore) AccessFilter(channelName string, collectionPolicyConf
 	panic("implement me")
 }
 
+func (cs *collectionStore) RetrieveReadWritePermission(common.CollectionCriteria, *mspeer.SignedProposal) (bool, bool, error) {
+	panic("implement me")
+}
+
 type collectionAccessPolicy struct {
 	cs *collectionStore
 	n  uint64
@@ -444,6 +453,14 @@ func (cap *collectionAccessPolicy) MaximumPeerCount() int {
 	return 2
 }
 
+func (cap *collectionAccessPolicy) IsMemberOnlyRead() bool {
+	return false
+}
+
+func (cap *collectionAccessPolicy) IsMemberOnlyWrite() bool {
+	return false
+}
+
 func (cap *collectionAccessPolicy) AccessFilter() privdata.Filter {
 	return func(sd common.SignedData) bool {
 		that, _ := asn1.Marshal(sd)
@@ -1365,6 +1382,41 @@ func TestPurgeByHeight(t *testing.T) {
 	}
 }
 
+func TestPurgeByKeys(t *testing.T) {
+	// Scenario: the ledger reports purge markers upon the commit of a block,
+	// and the purged keys are removed from the transient store
+	peerSelfSignedData := common.SignedData{}
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+
+	purgeMarkers := []*ledger.PurgeMarker{
+		{SeqInBlock: 0, Namespace: "ns1", Collection: "c1", KeyHash: []byte("keyHash")},
+	}
+	committer := &committerMock{}
+	committer.On("CommitWithPvtData", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*ledger.BlockAndPvtData).PurgeMarkers = purgeMarkers
+	}).Return(nil)
+	store := &mockTransientStore{t: t}
+	store.On("PurgeByKeys", uint64(7), purgeMarkers).Return(errors.New("failed purging")).Once()
+	fetcher := &fetcherMock{t: t}
+
+	bf := &blockFactory{
+		channelID: "test",
+	}
+	coordinator := NewCoordinator(Support{
+		CollectionStore: cs,
+		Committer:       committer,
+		Fetcher:         fetcher,
+		TransientStore:  store,
+		Validator:       &validatorMock{},
+	}, peerSelfSignedData)
+
+	block := bf.create()
+	block.Header.Number = 7
+	// a failure to purge the transient store does not fail the commit
+	assert.NoError(t, coordinator.StoreBlock(block, nil))
+	store.AssertExpectations(t)
+}
+
 func TestCoordinatorStorePvtData(t *testing.T) {
 	cs := createcollectionStore(common.SignedData{}).thatAcceptsAll()
 	committer := &committerMock{}
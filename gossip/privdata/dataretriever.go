@@ -10,10 +10,12 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/transientstore"
 	"github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/gossip/util"
+	fcommon "github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 )
@@ -134,26 +136,9 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 			pvtRWSetWithConfig.RWSet = append(pvtRWSetWithConfig.RWSet, pvtRWSet...)
 		}
 
-		confHistoryRetriever, err := dr.store.GetConfigHistoryRetriever()
+		configs, err := dr.collectionConfig(dig)
 		if err != nil {
-			return nil, errors.New(fmt.Sprint("cannot obtain configuration history retriever, for collection, ", dig.Collection,
-				" txID ", dig.TxId, " block sequence number ", dig.BlockSeq, " due to", err))
-		}
-
-		configInfo, err := confHistoryRetriever.MostRecentCollectionConfigBelow(dig.BlockSeq, dig.Namespace)
-		if err != nil {
-			return nil, errors.New(fmt.Sprint("cannot find recent collection config update below block sequence = ", dig.BlockSeq,
-				" collection name = ", dig.Collection, " for chaincode ", dig.Namespace))
-		}
-
-		if configInfo == nil {
-			return nil, errors.New(fmt.Sprint("no collection config update below block sequence = ", dig.BlockSeq,
-				" collection name = ", dig.Collection, " for chaincode ", dig.Namespace, " is available "))
-		}
-		configs := extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
-		if configs == nil {
-			return nil, errors.New(fmt.Sprint("no collection config was found for collection ", dig.Collection,
-				" namespace ", dig.Namespace, " txID ", dig.TxId))
+			return nil, err
 		}
 		pvtRWSetWithConfig.CollectionConfig = configs
 		results[common.DigKey{
@@ -168,6 +153,39 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 	return results, nil
 }
 
+func (dr *dataRetriever) collectionConfig(dig *gossip2.PvtDataDigest) (*fcommon.CollectionConfig, error) {
+	confHistoryRetriever, err := dr.store.GetConfigHistoryRetriever()
+	if err != nil {
+		return nil, errors.New(fmt.Sprint("cannot obtain configuration history retriever, for collection, ", dig.Collection,
+			" txID ", dig.TxId, " block sequence number ", dig.BlockSeq, " due to", err))
+	}
+
+	configInfo, err := confHistoryRetriever.MostRecentCollectionConfigBelow(dig.BlockSeq, dig.Namespace)
+	if err != nil {
+		return nil, errors.New(fmt.Sprint("cannot find recent collection config update below block sequence = ", dig.BlockSeq,
+			" collection name = ", dig.Collection, " for chaincode ", dig.Namespace))
+	}
+
+	var configs *fcommon.CollectionConfig
+	if configInfo != nil {
+		configs = extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
+	}
+	if configs == nil {
+		// implicit collections are not part of the collection config history,
+		// unless a collection with the same name is defined explicitly
+		configs = privdata.ImplicitCollectionConfig(dig.Collection)
+	}
+	if configs == nil && configInfo == nil {
+		return nil, errors.New(fmt.Sprint("no collection config update below block sequence = ", dig.BlockSeq,
+			" collection name = ", dig.Collection, " for chaincode ", dig.Namespace, " is available "))
+	}
+	if configs == nil {
+		return nil, errors.New(fmt.Sprint("no collection config was found for collection ", dig.Collection,
+			" namespace ", dig.Namespace, " txID ", dig.TxId))
+	}
+	return configs, nil
+}
+
 func (dr *dataRetriever) fromTransientStore(dig *gossip2.PvtDataDigest, filter map[string]ledger.PvtCollFilter) (*util.PrivateRWSetWithConfig, error) {
 	results := &util.PrivateRWSetWithConfig{}
 	it, err := dr.store.GetTxPvtRWSetByTxid(dig.TxId, filter)
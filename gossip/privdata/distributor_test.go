@@ -56,6 +56,14 @@ func (mock *collectionAccessPolicyMock) MemberOrgs() []string {
 	return args.Get(0).([]string)
 }
 
+func (mock *collectionAccessPolicyMock) IsMemberOnlyRead() bool {
+	return false
+}
+
+func (mock *collectionAccessPolicyMock) IsMemberOnlyWrite() bool {
+	return false
+}
+
 func (mock *collectionAccessPolicyMock) Setup(requiredPeerCount int, maxPeerCount int,
 	accessFilter privdata.Filter, orgs []string) {
 	mock.On("AccessFilter").Return(accessFilter)
@@ -31,3 +31,26 @@ func (_m *MissingPvtDataTracker) GetMissingPvtDataInfoForMostRecentBlocks(maxBlo
 
 	return r0, r1
 }
+
+// GetMissingPvtDataStats provides a mock function with given fields:
+func (_m *MissingPvtDataTracker) GetMissingPvtDataStats() (*ledger.MissingPvtDataStats, error) {
+	ret := _m.Called()
+
+	var r0 *ledger.MissingPvtDataStats
+	if rf, ok := ret.Get(0).(func() *ledger.MissingPvtDataStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ledger.MissingPvtDataStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -73,6 +73,7 @@ type puller struct {
 	channel       string
 	cs            privdata.CollectionStore
 	btlPullMargin uint64
+	peerBackoff   *peerBackoff
 	gossip
 	PrivateDataRetriever
 	CollectionAccessFactory
@@ -86,6 +87,7 @@ func NewPuller(cs privdata.CollectionStore, g gossip, dataRetriever PrivateDataR
 		channel:                 channel,
 		cs:                      cs,
 		btlPullMargin:           getBtlPullMargin(),
+		peerBackoff:             getPeerBackoff(),
 		gossip:                  g,
 		PrivateDataRetriever:    dataRetriever,
 		CollectionAccessFactory: factory,
@@ -214,19 +216,23 @@ func (p *puller) fetch(dig2src dig2sources) (*privdatacommon.FetchedPvtDataConta
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return p.fetchPrivateData(dig2Filter)
+	return p.fetchPrivateData(dig2Filter, nil)
 }
 
+// FetchReconciledItems fetches the private data of the given digests from remote peers,
+// while skipping peers that recently failed to provide private data for reconciliation
 func (p *puller) FetchReconciledItems(dig2collectionConfig privdatacommon.Dig2CollectionConfig) (*privdatacommon.FetchedPvtDataContainer, error) {
 	// computeFilters returns a map from a digest to a routing filter
 	dig2Filter, err := p.computeReconciliationFilters(dig2collectionConfig)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	return p.fetchPrivateData(dig2Filter)
+	return p.fetchPrivateData(dig2Filter, p.peerBackoff)
 }
 
-func (p *puller) fetchPrivateData(dig2Filter digestToFilterMapping) (*privdatacommon.FetchedPvtDataContainer, error) {
+// fetchPrivateData pulls the private data of the given digests from the peers that match their filters.
+// If backoff is not nil, peers that are backed off aren't asked, and the outcome of each request is recorded
+func (p *puller) fetchPrivateData(dig2Filter digestToFilterMapping, backoff *peerBackoff) (*privdatacommon.FetchedPvtDataContainer, error) {
 	// Get a list of peers per channel
 	allFilters := dig2Filter.flattenFilterValues()
 	members := p.waitForMembership()
@@ -237,6 +243,13 @@ func (p *puller) fetchPrivateData(dig2Filter digestToFilterMapping) (*privdataco
 		logger.Warning("Do not know any peer in the channel(", p.channel, ") that matches the policies , aborting")
 		return nil, errors.New("Empty membership")
 	}
+	if backoff != nil {
+		members = backoff.filter(members)
+		if len(members) == 0 {
+			logger.Warning("All peers in the channel(", p.channel, ") that match the policies are backed off, aborting")
+			return nil, errors.New("All matching peers are backed off")
+		}
+	}
 	members = randomizeMemberList(members)
 	res := &privdatacommon.FetchedPvtDataContainer{}
 	// Distribute requests to peers, and obtain subscriptions for all their messages
@@ -277,20 +290,20 @@ func (p *puller) fetchPrivateData(dig2Filter digestToFilterMapping) (*privdataco
 		logger.Debug("Matched", len(dig2Filter), "digests to", len(peer2digests), "peer(s)")
 		subscriptions := p.scatterRequests(peer2digests)
 		responses := p.gatherResponses(subscriptions)
+		answered := make(map[privdatacommon.DigKey]struct{})
 		for _, resp := range responses {
 			if len(resp.Payload) == 0 {
 				logger.Debug("Got empty response for", resp.Digest)
 				continue
 			}
-			delete(dig2Filter, privdatacommon.DigKey{
-				TxId:       resp.Digest.TxId,
-				BlockSeq:   resp.Digest.BlockSeq,
-				SeqInBlock: resp.Digest.SeqInBlock,
-				Namespace:  resp.Digest.Namespace,
-				Collection: resp.Digest.Collection,
-			})
+			digKey := digKeyOf(*resp.Digest)
+			answered[digKey] = struct{}{}
+			delete(dig2Filter, digKey)
 			itemsLeftToCollect--
 		}
+		if backoff != nil {
+			backoff.update(peer2digests, answered)
+		}
 		res.AvailableElements = append(res.AvailableElements, responses...)
 	}
 	return res, nil
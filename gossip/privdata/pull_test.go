@@ -26,6 +26,7 @@ import (
 	fcommon "github.com/hyperledger/fabric/protos/common"
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	mspeer "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -102,6 +103,10 @@ func (cs mockCollectionStore) AccessFilter(channelName string, collectionPolicyC
 	panic("implement me")
 }
 
+func (cs mockCollectionStore) RetrieveReadWritePermission(fcommon.CollectionCriteria, *mspeer.SignedProposal) (bool, bool, error) {
+	panic("implement me")
+}
+
 type mockCollectionAccess struct {
 	cs  *mockCollectionStore
 	btl uint64
@@ -143,6 +148,14 @@ func (mc *mockCollectionAccess) MaximumPeerCount() int {
 	return 0
 }
 
+func (mc *mockCollectionAccess) IsMemberOnlyRead() bool {
+	return false
+}
+
+func (mc *mockCollectionAccess) IsMemberOnlyWrite() bool {
+	return false
+}
+
 type dataRetrieverMock struct {
 	mock.Mock
 }
@@ -914,6 +927,67 @@ func TestPullerFetchReconciledItemsPreferPeersFromOriginalConfig(t *testing.T) {
 	assert.Contains(t, fetched, p2TransientStore.RWSet[1])
 }
 
+func TestPullerFetchReconciledItemsBackOffFailingPeers(t *testing.T) {
+	t.Parallel()
+	// Scenario: p1 reconciles from p2, which doesn't have the data.
+	// p2 should then be backed off for the subsequent reconciliation attempts,
+	// but still be asked for private data upon commit
+	gn := &gossipNetwork{}
+	factoryMock := &collectionAccessFactoryMock{}
+	accessPolicyMock := &collectionAccessPolicyMock{}
+	accessPolicyMock.Setup(1, 2, func(data fcommon.SignedData) bool {
+		return bytes.Equal(data.Identity, []byte("p2")) || bytes.Equal(data.Identity, []byte("p1"))
+	}, []string{"org1", "org2"})
+	factoryMock.On("AccessPolicy", mock.Anything, mock.Anything).Return(accessPolicyMock, nil)
+	policyStore := newCollectionStore().
+		withPolicy("col1", uint64(100)).
+		thatMapsTo("p1", "p2").
+		withAccessFilter(func(data fcommon.SignedData) bool {
+			return bytes.Equal(data.Identity, []byte("p2"))
+		})
+
+	p1 := gn.newPuller("p1", policyStore, factoryMock, membership(peerData{"p2", uint64(1)})...)
+	p2 := gn.newPuller("p2", policyStore, factoryMock)
+
+	dig := &proto.PvtDataDigest{
+		TxId:       "txID1",
+		Collection: "col1",
+		Namespace:  "ns1",
+	}
+	store := Dig2PvtRWSetWithConfig{
+		privdatacommon.DigKey{
+			TxId:       "txID1",
+			Collection: "col1",
+			Namespace:  "ns1",
+		}: &util.PrivateRWSetWithConfig{
+			RWSet: []util.PrivateRWSet{},
+		},
+	}
+	p2.PrivateDataRetriever.(*dataRetrieverMock).On("CollectionRWSet", mock.MatchedBy(protoMatcher(dig)), mock.Anything).Return(store, nil)
+
+	d2cc := func() privdatacommon.Dig2CollectionConfig {
+		return privdatacommon.Dig2CollectionConfig{
+			*toDigKey(dig): &fcommon.StaticCollectionConfig{
+				Name: "col1",
+			},
+		}
+	}
+
+	fetchedMessages, err := p1.FetchReconciledItems(d2cc())
+	assert.NoError(t, err)
+	assert.Empty(t, fetchedMessages.AvailableElements)
+
+	fetchedMessages, err = p1.FetchReconciledItems(d2cc())
+	assert.EqualError(t, err, "All matching peers are backed off")
+	assert.Nil(t, fetchedMessages)
+
+	dasf := &digestsAndSourceFactory{}
+	fetchedMessages, err = p1.fetch(dasf.mapDigest(toDigKey(dig)).toSources().create())
+	assert.NoError(t, err)
+	assert.Empty(t, fetchedMessages.AvailableElements)
+	p2.PrivateDataRetriever.(*dataRetrieverMock).AssertNumberOfCalls(t, "CollectionRWSet", 2)
+}
+
 func TestPullerAvoidPullingPurgedData(t *testing.T) {
 	// Scenario: p1 missing private data for col1
 	// p2 and p3 is suppose to have it, while p3 has more advanced
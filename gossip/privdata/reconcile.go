@@ -9,11 +9,14 @@ package privdata
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	util2 "github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/committer"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/protos/common"
@@ -23,10 +26,11 @@ import (
 )
 
 const (
-	reconcileSleepIntervalConfigKey = "peer.gossip.pvtData.sleepInterval"
+	reconcileSleepIntervalConfigKey = "peer.gossip.pvtData.reconcileSleepInterval"
 	reconcileSleepIntervalDefault   = time.Minute * 5
-	reconcileBatchSizeConfigKey     = "peer.gossip.pvtData.batchSize"
+	reconcileBatchSizeConfigKey     = "peer.gossip.pvtData.reconcileBatchSize"
 	reconcileBatchSizeDefault       = 10
+	reconciliationEnabledConfigKey  = "peer.gossip.pvtData.reconciliationEnabled"
 )
 
 // ReconciliationFetcher interface which defines API to fetch
@@ -46,10 +50,22 @@ type Reconciler interface {
 	Start()
 	// Stop function stops reconciler
 	Stop()
+	// Status returns the progress of the reconciler
+	Status() ReconcilerStatus
+}
+
+// ReconcilerStatus describes the progress of a reconciler
+type ReconcilerStatus struct {
+	// Enabled indicates whether missing private data is being reconciled
+	Enabled bool
+	// Reconciled is the number of private write sets that were reconciled
+	// and committed since the reconciler was created
+	Reconciled uint64
 }
 
 type reconciler struct {
-	config ReconcilerConfig
+	reconciled uint64 // accessed atomically
+	config     ReconcilerConfig
 	ReconciliationFetcher
 	committer.Committer
 	stopChan  chan struct{}
@@ -71,6 +87,10 @@ func (*NoOpReconciler) Stop() {
 	// do nothing
 }
 
+func (*NoOpReconciler) Status() ReconcilerStatus {
+	return ReconcilerStatus{}
+}
+
 // ReconcilerConfig holds config flags that are read from core.yaml
 type ReconcilerConfig struct {
 	sleepInterval time.Duration
@@ -92,6 +112,11 @@ func GetReconcilerConfig() ReconcilerConfig {
 	return ReconcilerConfig{sleepInterval: reconcileSleepInterval, batchSize: reconcileBatchSize}
 }
 
+// IsReconciliationEnabled returns whether reconciliation of missing private data is enabled in core.yaml
+func IsReconciliationEnabled() bool {
+	return viper.GetBool(reconciliationEnabledConfigKey)
+}
+
 // NewReconciler creates a new instance of reconciler
 func NewReconciler(c committer.Committer, fetcher ReconciliationFetcher, config ReconcilerConfig) Reconciler {
 	return &reconciler{
@@ -108,6 +133,13 @@ func (r *reconciler) Stop() {
 	})
 }
 
+func (r *reconciler) Status() ReconcilerStatus {
+	return ReconcilerStatus{
+		Enabled:    true,
+		Reconciled: atomic.LoadUint64(&r.reconciled),
+	}
+}
+
 func (r *reconciler) Start() {
 	r.startOnce.Do(func() {
 		go r.run()
@@ -150,22 +182,106 @@ func (r *reconciler) reconcile() error {
 		logger.Debug("No missing private data to reconcile, exiting...")
 		return nil
 	}
-	dig2collectionCfg := r.getDig2CollectionConfig(missingPvtDataInfo)
+	// The most recent blocks are reconciled first, each one on its own, so that
+	// the private data of recent blocks is made available as soon as possible
+	var reconciled uint64
+	for _, blockNum := range blocksInDescendingOrder(missingPvtDataInfo) {
+		n, err := r.reconcileBlock(blockNum, missingPvtDataInfo[blockNum])
+		reconciled += n
+		if err != nil {
+			return err
+		}
+	}
+	if reconciled > 0 {
+		logger.Infof("Reconciled %d private write set(s) of %d block(s)", reconciled, len(missingPvtDataInfo))
+	}
+	return nil
+}
+
+// reconcileBlock fetches and commits the missing private data of a single block,
+// and returns the number of private write sets that were reconciled
+func (r *reconciler) reconcileBlock(blockNum uint64, blockPvtDataInfo ledger.MissingBlockPvtdataInfo) (uint64, error) {
+	dig2collectionCfg := r.getDig2CollectionConfig(ledger.MissingPvtDataInfo{blockNum: blockPvtDataInfo})
 	fetchedData, err := r.FetchReconciledItems(dig2collectionCfg)
 	if err != nil {
 		logger.Debug("reconciliation error:", err)
-		return err
+		return 0, err
 	}
 
 	pvtDataToCommit := r.preparePvtDataToCommit(fetchedData.AvailableElements)
+	// the ledger skips the private data that is no longer missing or has expired, hence
+	// the reconciled write sets are the ones that are present only after the commit
+	presentBefore, err := r.presentPvtData(blockNum, pvtDataToCommit)
+	if err != nil {
+		return 0, err
+	}
 	// commit missing private data that was reconciled and log mismatched
 	pvtdataHashMismatch, err := r.CommitPvtData(pvtDataToCommit)
 	r.logMismatched(pvtdataHashMismatch)
 	if err != nil {
-		return errors.Wrap(err, "failed to commit private data")
+		return 0, errors.Wrap(err, "failed to commit private data")
+	}
+	presentAfter, err := r.presentPvtData(blockNum, pvtDataToCommit)
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	var reconciled uint64
+	for key := range presentAfter {
+		if _, exists := presentBefore[key]; !exists {
+			reconciled++
+		}
+	}
+	atomic.AddUint64(&r.reconciled, reconciled)
+	return reconciled, nil
+}
+
+type pvtDataKey struct {
+	seqInBlock            uint64
+	namespace, collection string
+}
+
+// presentPvtData returns the keys of the given private write sets of a block that are present in the ledger
+func (r *reconciler) presentPvtData(blockNum uint64, pvtData []*ledger.BlockPvtData) (map[pvtDataKey]struct{}, error) {
+	expected := make(map[pvtDataKey]struct{})
+	filter := ledger.NewPvtNsCollFilter()
+	for _, blockPvtData := range pvtData {
+		for seqInBlock, txPvtData := range blockPvtData.WriteSets {
+			for _, nsPvtRwset := range txPvtData.WriteSet.NsPvtRwset {
+				for _, collPvtRwset := range nsPvtRwset.CollectionPvtRwset {
+					filter.Add(nsPvtRwset.Namespace, collPvtRwset.CollectionName)
+					expected[pvtDataKey{seqInBlock, nsPvtRwset.Namespace, collPvtRwset.CollectionName}] = struct{}{}
+				}
+			}
+		}
+	}
+	present := make(map[pvtDataKey]struct{})
+	if len(expected) == 0 {
+		return present, nil
+	}
+	txsPvtData, err := r.GetPvtDataByNum(blockNum, filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve private data of block %d", blockNum)
+	}
+	for _, txPvtData := range txsPvtData {
+		for key := range expected {
+			if key.seqInBlock == txPvtData.SeqInBlock && txPvtData.Has(key.namespace, key.collection) {
+				present[key] = struct{}{}
+			}
+		}
+	}
+	return present, nil
+}
+
+func blocksInDescendingOrder(missingPvtDataInfo ledger.MissingPvtDataInfo) []uint64 {
+	blocks := make([]uint64, 0, len(missingPvtDataInfo))
+	for blockNum := range missingPvtDataInfo {
+		blocks = append(blocks, blockNum)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i] > blocks[j]
+	})
+	return blocks
 }
 
 type collectionConfigKey struct {
@@ -215,11 +331,18 @@ func (r *reconciler) getMostRecentCollectionConfig(chaincodeName string, collect
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("cannot find recent collection config update below block sequence = %d for chaincode %s", blockNum, chaincodeName))
 	}
-	if configInfo == nil {
+	var collectionConfig *common.CollectionConfig
+	if configInfo != nil {
+		collectionConfig = extractCollectionConfig(configInfo.CollectionConfig, collectionName)
+	}
+	if collectionConfig == nil {
+		// implicit collections are not part of the collection config history,
+		// unless a collection with the same name is defined explicitly
+		collectionConfig = privdata.ImplicitCollectionConfig(collectionName)
+	}
+	if collectionConfig == nil && configInfo == nil {
 		return nil, errors.New(fmt.Sprintf("no collection config update below block sequence = %d for chaincode %s is available", blockNum, chaincodeName))
 	}
-
-	collectionConfig := extractCollectionConfig(configInfo.CollectionConfig, collectionName)
 	if collectionConfig == nil {
 		return nil, errors.New(fmt.Sprintf("no collection config was found for collection %s for chaincode %s", collectionName, chaincodeName))
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/privdata/mocks"
 	"github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -146,12 +147,17 @@ func TestReconciliationHappyPathWithoutScheduler(t *testing.T) {
 		assert.Equal(t, "col1", blockPvtData[0].WriteSets[1].WriteSet.NsPvtRwset[0].CollectionPvtRwset[0].CollectionName)
 		commitPvtDataHappened = true
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
+	committer.On("GetPvtDataByNum", blockNum, mock.Anything).Return([]*ledger.TxPvtData{}, nil).Once()
+	committer.On("GetPvtDataByNum", blockNum, mock.Anything).Return([]*ledger.TxPvtData{
+		testTxPvtData(seqInBlock, "ns1", "col1"),
+	}, nil).Once()
 
 	r := &reconciler{config: ReconcilerConfig{sleepInterval: time.Minute, batchSize: 1}, ReconciliationFetcher: fetcher, Committer: committer}
 	err := r.reconcile()
 
 	assert.NoError(t, err)
 	assert.True(t, commitPvtDataHappened)
+	assert.Equal(t, ReconcilerStatus{Enabled: true, Reconciled: 1}, r.Status())
 }
 
 func TestReconciliationHappyPathWithScheduler(t *testing.T) {
@@ -223,6 +229,7 @@ func TestReconciliationHappyPathWithScheduler(t *testing.T) {
 		commitPvtDataHappened = true
 		wg.Done()
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
+	committer.On("GetPvtDataByNum", blockNum, mock.Anything).Return([]*ledger.TxPvtData{}, nil)
 
 	r := NewReconciler(committer, fetcher, ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1})
 	r.Start()
@@ -231,3 +238,106 @@ func TestReconciliationHappyPathWithScheduler(t *testing.T) {
 
 	assert.True(t, commitPvtDataHappened)
 }
+
+func TestReconciliationMostRecentBlocksFirst(t *testing.T) {
+	// Scenario: missing private data of several blocks is reconciled.
+	// The most recent blocks should be fetched and committed first, each block on its own,
+	// and the reconciler should report the number of reconciled private write sets,
+	// excluding the ones that the ledger did not commit, either due to hash mismatches
+	// or because they were no longer missing.
+	committer := &committerMock{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	configHistoryRetriever := &mocks.ConfigHistoryRetriever{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+
+	missingInfo := ledger.MissingPvtDataInfo{
+		2: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "col1", Namespace: "ns1"}},
+		},
+		7: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "col1", Namespace: "ns1"}},
+			2: {{Collection: "col1", Namespace: "ns1"}},
+		},
+		4: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			3: {{Collection: "col1", Namespace: "ns1"}},
+		},
+	}
+
+	collectionConfigInfo := ledger.CollectionConfigInfo{
+		CollectionConfig: &common.CollectionConfigPackage{
+			Config: []*common.CollectionConfig{
+				{Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "col1",
+					},
+				}},
+			},
+		},
+		CommittingBlockNum: 1,
+	}
+
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything).Return(missingInfo, nil)
+	configHistoryRetriever.On("MostRecentCollectionConfigBelow", mock.Anything, mock.Anything).Return(&collectionConfigInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	committer.On("GetConfigHistoryRetriever").Return(configHistoryRetriever, nil)
+
+	var fetchedBlocks []uint64
+	fetcher.On("FetchReconciledItems", mock.Anything).Return(func(dig2CollectionConfig privdatacommon.Dig2CollectionConfig) *privdatacommon.FetchedPvtDataContainer {
+		result := &privdatacommon.FetchedPvtDataContainer{}
+		for digest := range dig2CollectionConfig {
+			fetchedBlocks = append(fetchedBlocks, digest.BlockSeq)
+			result.AvailableElements = append(result.AvailableElements, &gossip2.PvtDataElement{
+				Digest: &gossip2.PvtDataDigest{
+					BlockSeq:   digest.BlockSeq,
+					Collection: digest.Collection,
+					Namespace:  digest.Namespace,
+					SeqInBlock: digest.SeqInBlock,
+				},
+				Payload: [][]byte{[]byte("rws-pre-image")},
+			})
+		}
+		return result
+	}, nil)
+
+	var committedBlocks []uint64
+	committer.On("CommitPvtData", mock.Anything).Run(func(args mock.Arguments) {
+		var blockPvtData = args.Get(0).([]*ledger.BlockPvtData)
+		assert.Equal(t, 1, len(blockPvtData))
+		committedBlocks = append(committedBlocks, blockPvtData[0].BlockNum)
+	}).Return([]*ledger.PvtdataHashMismatch{}, nil).Twice()
+	committer.On("CommitPvtData", mock.Anything).Return([]*ledger.PvtdataHashMismatch{
+		{BlockNum: 2, TxNum: 1, ChaincodeName: "ns1", CollectionName: "col1"},
+	}, nil).Once()
+	// block 7: both write sets are committed
+	committer.On("GetPvtDataByNum", uint64(7), mock.Anything).Return([]*ledger.TxPvtData{}, nil).Once()
+	committer.On("GetPvtDataByNum", uint64(7), mock.Anything).Return([]*ledger.TxPvtData{
+		testTxPvtData(1, "ns1", "col1"), testTxPvtData(2, "ns1", "col1"),
+	}, nil).Once()
+	// block 4: the write set was committed meanwhile by another path, so the ledger skips it
+	committer.On("GetPvtDataByNum", uint64(4), mock.Anything).Return([]*ledger.TxPvtData{
+		testTxPvtData(3, "ns1", "col1"),
+	}, nil).Twice()
+	// block 2: the write set does not match the hash in the block
+	committer.On("GetPvtDataByNum", uint64(2), mock.Anything).Return([]*ledger.TxPvtData{}, nil).Twice()
+
+	r := &reconciler{config: ReconcilerConfig{sleepInterval: time.Minute, batchSize: 1}, ReconciliationFetcher: fetcher, Committer: committer}
+	assert.Equal(t, ReconcilerStatus{Enabled: true}, r.Status())
+	err := r.reconcile()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{7, 7, 4, 2}, fetchedBlocks)
+	assert.Equal(t, []uint64{7, 4}, committedBlocks)
+	assert.Equal(t, ReconcilerStatus{Enabled: true, Reconciled: 2}, r.Status())
+	assert.Equal(t, ReconcilerStatus{}, (&NoOpReconciler{}).Status())
+}
+
+func testTxPvtData(seqInBlock uint64, ns, coll string) *ledger.TxPvtData {
+	return &ledger.TxPvtData{
+		SeqInBlock: seqInBlock,
+		WriteSet: &rwset.TxPvtReadWriteSet{
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				{Namespace: ns, CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{{CollectionName: coll}}},
+			},
+		},
+	}
+}
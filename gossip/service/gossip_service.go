@@ -7,8 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package service
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/committer"
 	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/privdata"
@@ -16,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/gossip/api"
 	gossipCommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
 	"github.com/hyperledger/fabric/gossip/election"
 	"github.com/hyperledger/fabric/gossip/gossip"
 	"github.com/hyperledger/fabric/gossip/integration"
@@ -24,6 +28,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/protos/common"
 	gproto "github.com/hyperledger/fabric/protos/gossip"
+	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -50,6 +55,14 @@ type GossipService interface {
 	InitializeChannel(chainID string, endpoints []string, support Support)
 	// AddPayload appends message payload to for given chain
 	AddPayload(chainID string, payload *gproto.Payload) error
+	// PvtDataReconciliationStatus returns the progress of the reconciliation of the missing private data of the given chain
+	PvtDataReconciliationStatus(chainID string) (*pb.PvtDataReconciliationStatus, error)
+	// LeaderElectionStatus returns the state of the election of the peers that pull blocks
+	// of the given chain from the ordering service
+	LeaderElectionStatus(chainID string) (*election.Status, error)
+	// GossipStatus returns the gossip membership the peer sees, and the state of the given chain,
+	// or of all chains the peer has joined if chainID is empty
+	GossipStatus(chainID string) (*pb.GossipStatus, error)
 }
 
 // DeliveryServiceFactory factory to create and initialize delivery service instance
@@ -194,6 +207,161 @@ func (g *gossipServiceImpl) DistributePrivateData(chainID string, txID string, p
 	return nil
 }
 
+// PvtDataReconciliationStatus returns the progress of the reconciliation of the missing private data of the given chain
+func (g *gossipServiceImpl) PvtDataReconciliationStatus(chainID string) (*pb.PvtDataReconciliationStatus, error) {
+	g.lock.RLock()
+	handler, exists := g.privateHandlers[chainID]
+	g.lock.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("No private data handler for %s", chainID)
+	}
+
+	missingPvtDataTracker, err := handler.support.Committer.GetMissingPvtDataTracker()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed obtaining missing private data tracker")
+	}
+	stats, err := missingPvtDataTracker.GetMissingPvtDataStats()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed obtaining missing private data statistics")
+	}
+	reconcilerStatus := handler.reconciler.Status()
+	return &pb.PvtDataReconciliationStatus{
+		ChannelId:             chainID,
+		ReconciliationEnabled: reconcilerStatus.Enabled,
+		Missing:               stats.Eligible,
+		Ineligible:            stats.Ineligible,
+		Reconciled:            reconcilerStatus.Reconciled,
+	}, nil
+}
+
+// LeaderElectionStatus returns the state of the election of the peers that pull blocks
+// of the given chain from the ordering service
+func (g *gossipServiceImpl) LeaderElectionStatus(chainID string) (*election.Status, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	if _, exists := g.chains[chainID]; !exists {
+		return nil, errors.Errorf("Channel %s hasn't been initialized", chainID)
+	}
+	if le, exists := g.leaderElection[chainID]; exists {
+		status := le.Status()
+		return &status, nil
+	}
+	// Leader election isn't used, so the leadership is either statically configured,
+	// or the delivery service couldn't be created
+	isStaticOrgLeader := viper.GetBool("peer.gossip.orgLeader") && g.deliveryService[chainID] != nil
+	status := &election.Status{
+		IsLeader: isStaticOrgLeader,
+		Reason:   "leadership is statically configured",
+	}
+	if isStaticOrgLeader {
+		status.Leaders = []gossipCommon.PKIidType{g.mcs.GetPKIidOfCert(g.peerIdentity)}
+	}
+	return status, nil
+}
+
+// GossipStatus returns the gossip membership the peer sees, and the state of the given chain,
+// or of all chains the peer has joined if chainID is empty
+func (g *gossipServiceImpl) GossipStatus(chainID string) (*pb.GossipStatus, error) {
+	var chains []string
+	g.lock.RLock()
+	if chainID != "" {
+		if _, exists := g.chains[chainID]; !exists {
+			g.lock.RUnlock()
+			return nil, errors.Errorf("Channel %s hasn't been initialized", chainID)
+		}
+		chains = append(chains, chainID)
+	} else {
+		for chain := range g.chains {
+			chains = append(chains, chain)
+		}
+	}
+	g.lock.RUnlock()
+	sort.Strings(chains)
+
+	status := &pb.GossipStatus{
+		Self:  toGossipMember(g.SelfMembershipInfo()),
+		Alive: toGossipMembers(g.Peers()),
+		Dead:  toGossipMembers(g.DeadPeers()),
+	}
+	for _, chain := range chains {
+		channelStatus, err := g.channelStatus(chain)
+		if err != nil {
+			return nil, err
+		}
+		status.Channels = append(status.Channels, channelStatus)
+	}
+	return status, nil
+}
+
+// channelStatus returns the state of the given chain, as advertised by its peers
+func (g *gossipServiceImpl) channelStatus(chainID string) (*pb.GossipChannelStatus, error) {
+	electionStatus, err := g.LeaderElectionStatus(chainID)
+	if err != nil {
+		return nil, err
+	}
+	leaders := make(map[string]struct{})
+	for _, leader := range electionStatus.Leaders {
+		leaders[string(leader)] = struct{}{}
+	}
+
+	self := g.SelfMembershipInfo()
+	var selfProperties *gproto.Properties
+	if stateInfo := g.SelfChannelInfo(gossipCommon.ChainID(chainID)); stateInfo != nil && stateInfo.GetStateInfo() != nil {
+		selfProperties = stateInfo.GetStateInfo().Properties
+	}
+	status := &pb.GossipChannelStatus{
+		ChannelId:   chainID,
+		Self:        toGossipChannelMember(self.Endpoint, self.PKIid, selfProperties, electionStatus.IsLeader),
+		LeaderCount: uint32(electionStatus.LeaderCount),
+	}
+	for _, member := range g.PeersOfChannel(gossipCommon.ChainID(chainID)) {
+		_, isLeader := leaders[string(member.PKIid)]
+		status.Peers = append(status.Peers, toGossipChannelMember(member.Endpoint, member.PKIid, member.Properties, isLeader))
+	}
+	sort.Slice(status.Peers, func(i, j int) bool {
+		return status.Peers[i].Endpoint < status.Peers[j].Endpoint
+	})
+	return status, nil
+}
+
+func toGossipMember(member discovery.NetworkMember) *pb.GossipMember {
+	return &pb.GossipMember{
+		Endpoint:         member.Endpoint,
+		InternalEndpoint: member.InternalEndpoint,
+		PkiId:            member.PKIid,
+	}
+}
+
+func toGossipMembers(members []discovery.NetworkMember) []*pb.GossipMember {
+	res := make([]*pb.GossipMember, 0, len(members))
+	for _, member := range members {
+		res = append(res, toGossipMember(member))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Endpoint < res[j].Endpoint
+	})
+	return res
+}
+
+func toGossipChannelMember(endpoint string, pkiID gossipCommon.PKIidType, properties *gproto.Properties, isLeader bool) *pb.GossipChannelMember {
+	member := &pb.GossipChannelMember{
+		Endpoint: endpoint,
+		PkiId:    pkiID,
+		Leader:   isLeader,
+	}
+	if properties == nil {
+		return member
+	}
+	member.LedgerHeight = properties.LedgerHeight
+	for _, cc := range properties.Chaincodes {
+		member.Chaincodes = append(member.Chaincodes, &pb.GossipChaincode{
+			Name:    cc.Name,
+			Version: cc.Version,
+		})
+	}
+	return member
+}
+
 // NewConfigEventer creates a ConfigProcessor which the channelconfig.BundleSource can ultimately route config updates to
 func (g *gossipServiceImpl) NewConfigEventer() ConfigProcessor {
 	return newConfigEventer(g)
@@ -245,11 +413,16 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 		Fetcher:         fetcher,
 	}, g.createSelfSignedData())
 
+	var reconciler privdata2.Reconciler = &privdata2.NoOpReconciler{}
+	if privdata2.IsReconciliationEnabled() {
+		reconciler = privdata2.NewReconciler(support.Committer, fetcher, privdata2.GetReconcilerConfig())
+	}
+
 	g.privateHandlers[chainID] = privateHandler{
 		support:     support,
 		coordinator: coordinator,
 		distributor: privdata2.NewDistributor(chainID, g, collectionAccessFactory),
-		reconciler:  &privdata2.NoOpReconciler{},
+		reconciler:  reconciler,
 	}
 	g.privateHandlers[chainID].reconciler.Start()
 
@@ -370,6 +543,45 @@ func (g *gossipServiceImpl) Stop() {
 	g.gossipSvc.Stop()
 }
 
+// UpdateIdentity replaces the identity of the peer with a renewed identity of its organization
+func (g *gossipServiceImpl) UpdateIdentity(identity api.PeerIdentityType) error {
+	g.lock.Lock()
+	if bytes.Equal(g.peerIdentity, identity) {
+		g.lock.Unlock()
+		return nil
+	}
+	if err := g.gossipSvc.UpdateIdentity(identity); err != nil {
+		g.lock.Unlock()
+		return err
+	}
+	g.peerIdentity = identity
+	selfSignedData := g.createSelfSignedData()
+	for _, handler := range g.privateHandlers {
+		handler.coordinator.UpdateSelfSignedData(selfSignedData)
+	}
+	leaderElections := make(map[string]election.LeaderElectionService, len(g.leaderElection))
+	for chainID, le := range g.leaderElection {
+		leaderElections[chainID] = le
+	}
+	g.lock.Unlock()
+
+	// Leader election identifies the peer by its PKI-ID, so it is restarted with the renewed one.
+	// The peer renounces the leadership it has, as it was elected under the previous PKI-ID.
+	for chainID, le := range leaderElections {
+		le.Stop()
+		g.lock.RLock()
+		onStatusChange := g.onStatusChangeFactory(chainID, g.privateHandlers[chainID].support.Committer)
+		g.lock.RUnlock()
+		if le.IsLeader() {
+			onStatusChange(false)
+		}
+		g.lock.Lock()
+		g.leaderElection[chainID] = g.newLeaderElectionComponent(chainID, onStatusChange)
+		g.lock.Unlock()
+	}
+	return nil
+}
+
 func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback func(bool)) election.LeaderElectionService {
 	PKIid := g.mcs.GetPKIidOfCert(g.peerIdentity)
 	adapter := election.NewAdapter(g, PKIid, gossipCommon.ChainID(chainID))
@@ -387,15 +599,23 @@ func (g *gossipServiceImpl) amIinChannel(myOrg string, config Config) bool {
 
 func (g *gossipServiceImpl) onStatusChangeFactory(chainID string, committer blocksprovider.LedgerInfo) func(bool) {
 	return func(isLeader bool) {
+		reportLeadershipChange(chainID, isLeader)
 		if isLeader {
+			g.lock.RLock()
+			le := g.leaderElection[chainID]
+			peerIdentity := g.peerIdentity
+			g.lock.RUnlock()
 			yield := func() {
-				g.lock.RLock()
-				le := g.leaderElection[chainID]
-				g.lock.RUnlock()
 				le.Yield()
 			}
+			// When several leaders are elected, each leader connects to a different ordering service
+			// endpoint first, according to its position among the leaders
+			endpointOffset := -1
+			if status := le.Status(); status.LeaderCount > 1 {
+				endpointOffset = leaderIndex(status.Leaders, g.mcs.GetPKIidOfCert(peerIdentity))
+			}
 			logger.Info("Elected as a leader, starting delivery service for channel", chainID)
-			if err := g.deliveryService[chainID].StartDeliverForChannel(chainID, committer, yield); err != nil {
+			if err := g.deliveryService[chainID].StartStaggeredDeliverForChannel(chainID, committer, endpointOffset, yield); err != nil {
 				logger.Errorf("Delivery service is not able to start blocks delivery for chain, due to %+v", errors.WithStack(err))
 			}
 		} else {
@@ -409,6 +629,32 @@ func (g *gossipServiceImpl) onStatusChangeFactory(chainID string, committer bloc
 	}
 }
 
+// leaderIndex returns the index of the given peer among the given leaders, or -1 if it isn't one of them
+func leaderIndex(leaders []gossipCommon.PKIidType, pkiID gossipCommon.PKIidType) int {
+	for i, leader := range leaders {
+		if bytes.Equal(leader, pkiID) {
+			return i
+		}
+	}
+	return -1
+}
+
+// reportLeadershipChange reports a change in the leadership of the peer in the given channel
+// to the metrics system, if it is enabled
+func reportLeadershipChange(chainID string, isLeader bool) {
+	if metrics.RootScope == nil {
+		return
+	}
+	scope := metrics.RootScope.SubScope("gossip_election").Tagged(map[string]string{"channel": chainID})
+	if isLeader {
+		scope.Counter("leadership_acquired").Inc(1)
+		scope.Gauge("is_leader").Update(1)
+		return
+	}
+	scope.Counter("leadership_lost").Inc(1)
+	scope.Gauge("is_leader").Update(0)
+}
+
 func orgListFromConfig(config Config) []string {
 	var orgList []string
 	for _, appOrg := range config.Organizations() {
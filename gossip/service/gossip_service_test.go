@@ -27,6 +27,8 @@ import (
 	gossipCommon "github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/election"
 	"github.com/hyperledger/fabric/gossip/gossip"
+	privdata2 "github.com/hyperledger/fabric/gossip/privdata"
+	privdatamocks "github.com/hyperledger/fabric/gossip/privdata/mocks"
 	"github.com/hyperledger/fabric/gossip/state"
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/msp/mgmt"
@@ -34,7 +36,9 @@ import (
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/gossip/mocks"
 	"github.com/hyperledger/fabric/protos/common"
+	gproto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -51,6 +55,10 @@ func (*mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*mockTransientStore) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	return nil
+}
+
 func (*mockTransientStore) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
@@ -166,6 +174,58 @@ func TestLeaderElectionWithDeliverClient(t *testing.T) {
 
 	assert.Equal(t, 1, startsNum, "Only for one peer delivery client should start")
 
+	leadersNum := 0
+	for i := 0; i < n; i++ {
+		status, err := gossips[i].LeaderElectionStatus(channelName)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, status.LeaderCount)
+		if status.IsLeader {
+			leadersNum++
+			assert.Equal(t, "elected as a leader", status.Reason)
+		}
+	}
+	assert.Equal(t, 1, leadersNum, "Only one peer should report being a leader")
+
+	_, err := gossips[0].LeaderElectionStatus("chanZ")
+	assert.EqualError(t, err, "Channel chanZ hasn't been initialized")
+
+	gossips[1].UpdateChaincodes([]*gproto.Chaincode{{Name: "mycc", Version: "1.0"}}, gossipCommon.ChainID(channelName))
+	gossips[1].UpdateLedgerHeight(5, gossipCommon.ChainID(channelName))
+	var status *peer.GossipStatus
+	advertised := func() bool {
+		status, err = gossips[0].GossipStatus("")
+		assert.NoError(t, err)
+		peers := status.Channels[0].Peers
+		return len(peers) == n-1 && peers[0].LedgerHeight == 5 && len(peers[0].Chaincodes) == 1
+	}
+	for start := time.Now(); !advertised(); time.Sleep(time.Millisecond * 100) {
+		if time.Since(start) > time.Second*20 {
+			t.Fatalf("Peer 1 didn't advertise its ledger height and chaincodes in time: %v", status)
+		}
+	}
+	assert.Equal(t, "1.2.3.4:20100", status.Self.Endpoint)
+	assert.Equal(t, "localhost:20100", status.Self.InternalEndpoint)
+	assert.Len(t, status.Alive, n-1)
+	assert.Equal(t, "1.2.3.4:20101", status.Alive[0].Endpoint)
+	assert.Empty(t, status.Dead)
+	assert.Len(t, status.Channels, 1)
+	channelStatus := status.Channels[0]
+	assert.Equal(t, channelName, channelStatus.ChannelId)
+	assert.Equal(t, uint32(1), channelStatus.LeaderCount)
+	assert.Equal(t, "1.2.3.4:20100", channelStatus.Self.Endpoint)
+	assert.Equal(t, "1.2.3.4:20101", channelStatus.Peers[0].Endpoint)
+	assert.Equal(t, []*peer.GossipChaincode{{Name: "mycc", Version: "1.0"}}, channelStatus.Peers[0].Chaincodes)
+	leadersNum = 0
+	for _, member := range append(channelStatus.Peers, channelStatus.Self) {
+		if member.Leader {
+			leadersNum++
+		}
+	}
+	assert.Equal(t, 1, leadersNum, "Exactly one peer should be reported as a leader")
+
+	_, err = gossips[0].GossipStatus("chanZ")
+	assert.EqualError(t, err, "Channel chanZ hasn't been initialized")
+
 	stopPeers(gossips)
 }
 
@@ -210,6 +270,11 @@ func TestWithStaticDeliverClientLeader(t *testing.T) {
 	for i := 0; i < n; i++ {
 		assert.NotNil(t, gossips[i].(*gossipServiceImpl).deliveryService[channelName], "Delivery service for channel %s not initiated in peer %d", channelName, i)
 		assert.True(t, gossips[i].(*gossipServiceImpl).deliveryService[channelName].(*mockDeliverService).running[channelName], "Block deliverer not started for peer %d", i)
+		status, err := gossips[i].LeaderElectionStatus(channelName)
+		assert.NoError(t, err)
+		assert.True(t, status.IsLeader)
+		assert.Equal(t, "leadership is statically configured", status.Reason)
+		assert.Len(t, status.Leaders, 1)
 	}
 
 	channelName = "chanB"
@@ -326,6 +391,11 @@ func (ds *mockDeliverService) StartDeliverForChannel(chainID string, ledgerInfo
 	return nil
 }
 
+func (ds *mockDeliverService) StartStaggeredDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, endpointOffset int, finalizer func()) error {
+	ds.running[chainID] = true
+	return nil
+}
+
 func (ds *mockDeliverService) StopDeliverForChannel(chainID string) error {
 	ds.running[chainID] = false
 	return nil
@@ -381,6 +451,53 @@ func (li *mockLedgerInfo) GetBlocks(blockSeqs []uint64) []*common.Block {
 func (li *mockLedgerInfo) Close() {
 }
 
+type missingPvtDataLedger struct {
+	mockLedgerInfo
+	tracker ledger.MissingPvtDataTracker
+}
+
+func (l *missingPvtDataLedger) GetMissingPvtDataTracker() (ledger.MissingPvtDataTracker, error) {
+	return l.tracker, nil
+}
+
+func TestPvtDataReconciliationStatus(t *testing.T) {
+	tracker := &privdatamocks.MissingPvtDataTracker{}
+	tracker.On("GetMissingPvtDataStats").Return(&ledger.MissingPvtDataStats{Eligible: 5, Ineligible: 2}, nil).Once()
+	tracker.On("GetMissingPvtDataStats").Return(nil, errors.New("leveldb: closed")).Once()
+
+	g := &gossipServiceImpl{
+		privateHandlers: map[string]privateHandler{
+			"A": {
+				support:    Support{Committer: &missingPvtDataLedger{tracker: tracker}},
+				reconciler: &privdata2.NoOpReconciler{},
+			},
+		},
+	}
+
+	status, err := g.PvtDataReconciliationStatus("A")
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.PvtDataReconciliationStatus{
+		ChannelId:  "A",
+		Missing:    5,
+		Ineligible: 2,
+	}, status)
+
+	status, err = g.PvtDataReconciliationStatus("A")
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "failed obtaining missing private data statistics: leveldb: closed")
+
+	status, err = g.PvtDataReconciliationStatus("B")
+	assert.Nil(t, status)
+	assert.EqualError(t, err, "No private data handler for B")
+}
+
+func TestLeaderIndex(t *testing.T) {
+	leaders := []gossipCommon.PKIidType{gossipCommon.PKIidType("p0"), gossipCommon.PKIidType("p1")}
+	assert.Equal(t, 0, leaderIndex(leaders, gossipCommon.PKIidType("p0")))
+	assert.Equal(t, 1, leaderIndex(leaders, gossipCommon.PKIidType("p1")))
+	assert.Equal(t, -1, leaderIndex(leaders, gossipCommon.PKIidType("p2")))
+}
+
 func TestLeaderElectionWithRealGossip(t *testing.T) {
 	t.Parallel()
 	// Spawn 10 gossip instances with single channel and inside same organization
@@ -30,6 +30,10 @@ func (*transientStoreMock) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*transientStoreMock) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	return nil
+}
+
 func (*transientStoreMock) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
@@ -78,6 +82,13 @@ func (eds *embeddingDeliveryService) StartDeliverForChannel(chainID string, ledg
 	return eds.DeliverService.StartDeliverForChannel(chainID, ledgerInfo, finalizer)
 }
 
+func (eds *embeddingDeliveryService) StartStaggeredDeliverForChannel(chainID string, ledgerInfo blocksprovider.LedgerInfo, endpointOffset int, finalizer func()) error {
+	eds.startOnce.Do(func() {
+		eds.startSignal.Done()
+	})
+	return eds.DeliverService.StartStaggeredDeliverForChannel(chainID, ledgerInfo, endpointOffset, finalizer)
+}
+
 func (eds *embeddingDeliveryService) StopDeliverForChannel(chainID string) error {
 	eds.stopOnce.Do(func() {
 		eds.stopSignal.Done()
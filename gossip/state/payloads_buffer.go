@@ -19,8 +19,9 @@ import (
 // sequence numbers. It also will provide the capability
 // to signal whenever expected block has arrived.
 type PayloadsBuffer interface {
-	// Adds new block into the buffer
-	Push(payload *proto.Payload)
+	// Adds new block into the buffer, and returns whether it was added,
+	// or discarded because a block with the same sequence number was already added
+	Push(payload *proto.Payload) bool
 
 	// Returns next expected sequence number
 	Next() uint64
@@ -70,10 +71,12 @@ func (b *PayloadsBufferImpl) Ready() chan struct{} {
 }
 
 // Push new payload into the buffer structure in case new arrived payload
-// sequence number is below the expected next block number payload will be
-// thrown away.
-// TODO return bool to indicate if payload was added or not, so that caller can log result.
-func (b *PayloadsBufferImpl) Push(payload *proto.Payload) {
+// sequence number is below the expected next block number, or a payload with
+// the same sequence number is already in the buffer, payload will be
+// thrown away. This deduplicates blocks that arrive from several sources,
+// e.g. when several peers pull blocks from the ordering service.
+// Returns whether the payload was added.
+func (b *PayloadsBufferImpl) Push(payload *proto.Payload) bool {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
@@ -81,7 +84,7 @@ func (b *PayloadsBufferImpl) Push(payload *proto.Payload) {
 
 	if seqNum < b.next || b.buf[seqNum] != nil {
 		logger.Debugf("Payload with sequence number = %d has been already processed", payload.SeqNum)
-		return
+		return false
 	}
 
 	b.buf[seqNum] = payload
@@ -90,6 +93,7 @@ func (b *PayloadsBufferImpl) Push(payload *proto.Payload) {
 	if seqNum == b.next && len(b.readyChan) == 0 {
 		b.readyChan <- struct{}{}
 	}
+	return true
 }
 
 // Next function provides the number of the next expected block
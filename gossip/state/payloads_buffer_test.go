@@ -49,7 +49,7 @@ func TestPayloadsBufferImpl_Push(t *testing.T) {
 	}
 
 	t.Log("Pushing new payload into buffer")
-	buffer.Push(payload)
+	assert.False(t, buffer.Push(payload))
 
 	// Payloads with sequence number less than buffer top
 	// index should not be accepted
@@ -66,11 +66,21 @@ func TestPayloadsBufferImpl_Push(t *testing.T) {
 	}
 
 	t.Log("Pushing new payload into buffer")
-	buffer.Push(payload)
+	assert.True(t, buffer.Push(payload))
 	t.Log("Getting next block sequence number")
 	assert.Equal(t, buffer.Next(), uint64(5))
 	t.Log("Check block buffer size")
 	assert.Equal(t, buffer.Size(), 1)
+
+	// Payloads with a sequence number that is already in the buffer,
+	// e.g. blocks pulled by several leaders, should not be accepted
+	payload, err = randomPayloadWithSeqNum(5)
+	if err != nil {
+		t.Fatal("Wasn't able to generate random payload for test")
+	}
+	assert.False(t, buffer.Push(payload))
+	assert.Equal(t, buffer.Size(), 1)
+	assert.Len(t, buffer.Ready(), 1)
 }
 
 func TestPayloadsBufferImpl_Ready(t *testing.T) {
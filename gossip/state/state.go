@@ -8,6 +8,7 @@ package state
 
 import (
 	"bytes"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -47,6 +48,10 @@ const (
 
 	defMaxBlockDistance = 100
 
+	defStateTransferParallelism = 4
+
+	defGapDetectionInterval = 2 * time.Second
+
 	blocking    = true
 	nonBlocking = false
 
@@ -136,7 +141,10 @@ type GossipStateProviderImpl struct {
 
 	ledger ledgerResources
 
-	stateResponseCh chan proto.ReceivedMessage
+	// stateResponses maps nonces of state requests in flight
+	// to the channels their responses are sent to
+	stateResponses     map[uint64]chan proto.ReceivedMessage
+	stateResponsesLock sync.Mutex
 
 	stateRequestCh chan proto.ReceivedMessage
 
@@ -151,6 +159,18 @@ type GossipStateProviderImpl struct {
 
 var logger = util.GetLogger(util.LoggingStateModule, "")
 
+var errStopped = errors.New("state provider stopped")
+
+// getStateTransferParallelism returns the maximum number of peers
+// blocks are requested from in parallel
+func getStateTransferParallelism() int {
+	parallelism := util.GetIntOrDefault("peer.gossip.state.parallelism", defStateTransferParallelism)
+	if parallelism < 1 {
+		return 1
+	}
+	return parallelism
+}
+
 // NewGossipStateProvider creates state provider with coordinator instance
 // to orchestrate arrival of private rwsets and blocks before committing them into the ledger.
 func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger ledgerResources) GossipStateProvider {
@@ -215,7 +235,7 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 
 		ledger: ledger,
 
-		stateResponseCh: make(chan proto.ReceivedMessage, defChannelBufferSize),
+		stateResponses: make(map[uint64]chan proto.ReceivedMessage),
 
 		stateRequestCh: make(chan proto.ReceivedMessage, defChannelBufferSize),
 
@@ -242,6 +262,12 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 	// Taking care of state request messages
 	go s.processStateRequests()
 
+	if viper.GetBool("peer.gossip.blockTree.enabled") {
+		s.done.Add(1)
+		// Pull blocks missed due to a broken dissemination tree, without waiting for anti entropy
+		go s.detectGaps()
+	}
+
 	return s
 }
 
@@ -354,8 +380,17 @@ func (s *GossipStateProviderImpl) directMessage(msg proto.ReceivedMessage) {
 		// If no state transfer procedure activate there is
 		// no reason to process the message
 		if atomic.LoadInt32(&s.stateTransferActive) == 1 {
-			// Send signal of state response message
-			s.stateResponseCh <- msg
+			// Send signal of state response message to the request with the same nonce
+			s.stateResponsesLock.Lock()
+			responseCh, exists := s.stateResponses[incoming.Nonce]
+			s.stateResponsesLock.Unlock()
+			if !exists {
+				return
+			}
+			select {
+			case responseCh <- msg:
+			default:
+			}
 		}
 	}
 }
@@ -461,31 +496,31 @@ func (s *GossipStateProviderImpl) handleStateRequest(msg proto.ReceivedMessage)
 	})
 }
 
-func (s *GossipStateProviderImpl) handleStateResponse(msg proto.ReceivedMessage) (uint64, error) {
-	max := uint64(0)
-	// Send signal that response for given nonce has been received
+// handleStateResponse verifies the payloads of a state response to a request for the given batch,
+// and returns them sorted by their sequence numbers
+func (s *GossipStateProviderImpl) handleStateResponse(msg proto.ReceivedMessage, b batch) ([]*proto.Payload, error) {
 	response := msg.GetGossipMessage().GetStateResponse()
-	// Extract payloads, verify and push into buffer
+	// Extract payloads and verify them
 	if len(response.GetPayloads()) == 0 {
-		return uint64(0), errors.New("Received state transfer response without payload")
+		return nil, errors.New("Received state transfer response without payload")
 	}
+	payloads := make([]*proto.Payload, 0, len(response.GetPayloads()))
 	for _, payload := range response.GetPayloads() {
 		logger.Debugf("Received payload with sequence number %d.", payload.SeqNum)
+		if payload.SeqNum < b.start || payload.SeqNum > b.end {
+			return nil, errors.Errorf("Received block with sequence number %d, which is out of the requested range [%d...%d]", payload.SeqNum, b.start, b.end)
+		}
 		if err := s.mediator.VerifyBlock(common2.ChainID(s.chainID), payload.SeqNum, payload.Data); err != nil {
 			err = errors.WithStack(err)
 			logger.Warningf("Error verifying block with sequence number %d, due to %+v", payload.SeqNum, err)
-			return uint64(0), err
-		}
-		if max < payload.SeqNum {
-			max = payload.SeqNum
-		}
-
-		err := s.addPayload(payload, blocking)
-		if err != nil {
-			logger.Warningf("Block [%d] received from block transfer wasn't added to payload buffer: %v", payload.SeqNum, err)
+			return nil, err
 		}
+		payloads = append(payloads, payload)
 	}
-	return max, nil
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].SeqNum < payloads[j].SeqNum
+	})
+	return payloads, nil
 }
 
 // Stop function send halting signal to all go routines
@@ -499,7 +534,6 @@ func (s *GossipStateProviderImpl) Stop() {
 		// Close all resources
 		s.ledger.Close()
 		close(s.stateRequestCh)
-		close(s.stateResponseCh)
 		close(s.stopCh)
 	})
 }
@@ -601,6 +635,39 @@ func (s *GossipStateProviderImpl) antiEntropy() {
 	}
 }
 
+// detectGaps periodically checks whether blocks are stuck in the payload buffer
+// because a preceding block is missing, and if so - pulls the missing blocks from peers.
+// This is needed when blocks are disseminated via an epidemic broadcast tree,
+// since a block that is lost when the tree breaks isn't pushed to the peer again
+func (s *GossipStateProviderImpl) detectGaps() {
+	defer s.done.Done()
+	defer logger.Debug("State Provider stopped, stopping gap detection.")
+
+	prevNext := s.payloads.Next()
+	for {
+		select {
+		case <-s.stopCh:
+			s.stopCh <- struct{}{}
+			return
+		case <-time.After(defGapDetectionInterval):
+			next := s.payloads.Next()
+			// A gap is detected when there are buffered blocks, but the
+			// next block hasn't arrived since the previous check
+			stuck := next == prevNext && s.payloads.Size() > 0
+			prevNext = next
+			if !stuck || atomic.LoadInt32(&s.stateTransferActive) == 1 {
+				continue
+			}
+			maxHeight := s.maxAvailableLedgerHeight()
+			if next >= maxHeight {
+				continue
+			}
+			logger.Infof("[%s] Block [%d] is missing, pulling blocks up to [%d]", s.chainID, next, maxHeight-1)
+			s.requestBlocksInRange(next, maxHeight-1)
+		}
+	}
+}
+
 // Iterate over all available peers and check advertised meta state to
 // find maximum available ledger height across peers
 func (s *GossipStateProviderImpl) maxAvailableLedgerHeight() uint64 {
@@ -618,62 +685,149 @@ func (s *GossipStateProviderImpl) maxAvailableLedgerHeight() uint64 {
 	return max
 }
 
-// GetBlocksInRange capable to acquire blocks with sequence
-// numbers in the range [start...end).
+// requestBlocksInRange acquires blocks with sequence numbers in the range [start...end].
+// The range is split into batches that are requested in parallel from different peers,
+// and the blocks of each batch are added to the payloads buffer in order.
 func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64) {
 	atomic.StoreInt32(&s.stateTransferActive, 1)
 	defer atomic.StoreInt32(&s.stateTransferActive, 0)
 
 	for prev := start; prev <= end; {
-		next := min(end, prev+defAntiEntropyBatchSize)
-
-		gossipMsg := s.stateRequestMessage(prev, next)
+		batches := s.nextBatches(prev, end)
+		if len(batches) == 0 {
+			logger.Warningf("Cannot send state request for blocks in range [%d...%d], "+
+				"there are no peers to ask for missing blocks from", prev, end)
+			return
+		}
 
-		responseReceived := false
-		tryCounts := 0
+		results := make([][]*proto.Payload, len(batches))
+		errs := make([]error, len(batches))
+		busy := &busyPeers{peers: make(map[string]struct{})}
+		var wg sync.WaitGroup
+		for i, b := range batches {
+			wg.Add(1)
+			go func(i int, b batch) {
+				defer wg.Done()
+				results[i], errs[i] = s.requestBatch(b, busy)
+			}(i, b)
+		}
+		wg.Wait()
 
-		for !responseReceived {
-			if tryCounts > defAntiEntropyMaxRetries {
-				logger.Warningf("Wasn't  able to get blocks in range [%d...%d), after %d retries",
-					prev, next, tryCounts)
+		for i, b := range batches {
+			if errs[i] == errStopped {
 				return
 			}
-			// Select peers to ask for blocks
-			peer, err := s.selectPeerToRequestFrom(next)
-			if err != nil {
-				logger.Warningf("Cannot send state request for blocks in range [%d...%d), due to %+v",
-					prev, next, errors.WithStack(err))
+			if errs[i] != nil {
+				logger.Warningf("Wasn't able to get blocks in range [%d...%d], due to %+v", b.start, b.end, errs[i])
 				return
 			}
+			for _, payload := range results[i] {
+				if err := s.addPayload(payload, blocking); err != nil {
+					logger.Warningf("Block [%d] received from block transfer wasn't added to payload buffer: %v", payload.SeqNum, err)
+				}
+			}
+			prev = results[i][len(results[i])-1].SeqNum + 1
+			// The peer may have sent only some of the blocks of the batch, in which
+			// case the rest of the blocks are requested again before the next batches
+			// are added to the payloads buffer, to keep adding blocks in order
+			if prev <= b.end {
+				break
+			}
+		}
+	}
+}
+
+// batch is a range [start...end] of blocks requested in a single state request
+type batch struct {
+	start uint64
+	end   uint64
+}
 
-			logger.Debugf("State transfer, with peer %s, requesting blocks in range [%d...%d), "+
-				"for chainID %s", peer.Endpoint, prev, next, s.chainID)
+// nextBatches splits the blocks of the range [start...end] into as many batches as the blocks
+// can be requested in parallel, which is bounded by the number of peers that have the first batch
+func (s *GossipStateProviderImpl) nextBatches(start uint64, end uint64) []batch {
+	parallelism := getStateTransferParallelism()
+	if peersNum := len(s.filterPeers(s.hasRequiredHeight(min(end, start+defAntiEntropyBatchSize)))); peersNum < parallelism {
+		parallelism = peersNum
+	}
+	var batches []batch
+	for prev := start; prev <= end && len(batches) < parallelism; {
+		next := min(end, prev+defAntiEntropyBatchSize)
+		batches = append(batches, batch{start: prev, end: next})
+		prev = next + 1
+	}
+	return batches
+}
 
-			s.mediator.Send(gossipMsg, peer)
-			tryCounts++
+// requestBatch requests the blocks of the given batch from a peer, and returns them verified and sorted.
+// It retries with other peers if the peer doesn't respond, or responds with invalid blocks.
+func (s *GossipStateProviderImpl) requestBatch(b batch, busy *busyPeers) ([]*proto.Payload, error) {
+	gossipMsg := s.stateRequestMessage(b.start, b.end)
+	responseCh := s.expectStateResponse(gossipMsg.Nonce)
+	defer s.forgetStateResponse(gossipMsg.Nonce)
+
+	var lastErr error
+	for tryCounts := 0; tryCounts <= defAntiEntropyMaxRetries; tryCounts++ {
+		// Select peers to ask for blocks
+		peer, err := s.selectPeerToRequestFrom(b.end, busy)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
 
-			// Wait until timeout or response arrival
-			select {
-			case msg := <-s.stateResponseCh:
-				if msg.GetGossipMessage().Nonce != gossipMsg.Nonce {
-					continue
-				}
-				// Got corresponding response for state request, can continue
-				index, err := s.handleStateResponse(msg)
-				if err != nil {
-					logger.Warningf("Wasn't able to process state response for "+
-						"blocks [%d...%d], due to %+v", prev, next, errors.WithStack(err))
-					continue
-				}
-				prev = index + 1
-				responseReceived = true
-			case <-time.After(defAntiEntropyStateResponseTimeout):
-			case <-s.stopCh:
-				s.stopCh <- struct{}{}
-				return
+		logger.Debugf("State transfer, with peer %s, requesting blocks in range [%d...%d], "+
+			"for chainID %s", peer.Endpoint, b.start, b.end, s.chainID)
+
+		s.mediator.Send(gossipMsg, peer)
+
+		// Wait until timeout or response arrival
+		select {
+		case msg := <-responseCh:
+			busy.release(peer)
+			payloads, err := s.handleStateResponse(msg, b)
+			if err != nil {
+				logger.Warningf("Wasn't able to process state response for "+
+					"blocks [%d...%d], due to %+v", b.start, b.end, errors.WithStack(err))
+				lastErr = err
+				continue
 			}
+			return payloads, nil
+		case <-time.After(defAntiEntropyStateResponseTimeout):
+			busy.release(peer)
+			lastErr = errors.Errorf("peer %s didn't respond in time", peer.Endpoint)
+		case <-s.stopCh:
+			s.stopCh <- struct{}{}
+			return nil, errStopped
 		}
 	}
+	return nil, errors.Wrapf(lastErr, "failed after %d retries", defAntiEntropyMaxRetries+1)
+}
+
+// expectStateResponse returns a channel the state response with the given nonce is sent to
+func (s *GossipStateProviderImpl) expectStateResponse(nonce uint64) <-chan proto.ReceivedMessage {
+	s.stateResponsesLock.Lock()
+	defer s.stateResponsesLock.Unlock()
+	ch := make(chan proto.ReceivedMessage, 1)
+	s.stateResponses[nonce] = ch
+	return ch
+}
+
+func (s *GossipStateProviderImpl) forgetStateResponse(nonce uint64) {
+	s.stateResponsesLock.Lock()
+	defer s.stateResponsesLock.Unlock()
+	delete(s.stateResponses, nonce)
+}
+
+// busyPeers tracks the peers blocks are currently requested from,
+// in order to request different batches from different peers
+type busyPeers struct {
+	sync.Mutex
+	peers map[string]struct{}
+}
+
+func (bp *busyPeers) release(peer *comm.RemotePeer) {
+	bp.Lock()
+	defer bp.Unlock()
+	delete(bp.peers, string(peer.PKIID))
 }
 
 // Generate state request message for given blocks in range [beginSeq...endSeq]
@@ -691,8 +845,9 @@ func (s *GossipStateProviderImpl) stateRequestMessage(beginSeq uint64, endSeq ui
 	}
 }
 
-// Select peer which has required blocks to ask missing blocks from
-func (s *GossipStateProviderImpl) selectPeerToRequestFrom(height uint64) (*comm.RemotePeer, error) {
+// Select peer which has required blocks to ask missing blocks from,
+// preferring peers that other blocks aren't requested from
+func (s *GossipStateProviderImpl) selectPeerToRequestFrom(height uint64, busy *busyPeers) (*comm.RemotePeer, error) {
 	// Filter peers which posses required range of missing blocks
 	peers := s.filterPeers(s.hasRequiredHeight(height))
 
@@ -701,8 +856,22 @@ func (s *GossipStateProviderImpl) selectPeerToRequestFrom(height uint64) (*comm.
 		return nil, errors.New("there are no peers to ask for missing blocks from")
 	}
 
+	busy.Lock()
+	defer busy.Unlock()
+	var idlePeers []*comm.RemotePeer
+	for _, peer := range peers {
+		if _, isBusy := busy.peers[string(peer.PKIID)]; !isBusy {
+			idlePeers = append(idlePeers, peer)
+		}
+	}
+	if len(idlePeers) > 0 {
+		peers = idlePeers
+	}
+
 	// Select peer to ask for blocks
-	return peers[util.RandomInt(n)], nil
+	peer := peers[util.RandomInt(len(peers))]
+	busy.peers[string(peer.PKIID)] = struct{}{}
+	return peer, nil
 }
 
 // filterPeers return list of peers which aligns the predicate provided
@@ -761,7 +930,9 @@ func (s *GossipStateProviderImpl) addPayload(payload *proto.Payload, blockingMod
 		time.Sleep(enqueueRetryInterval)
 	}
 
-	s.payloads.Push(payload)
+	if !s.payloads.Push(payload) {
+		logger.Debugf("[%s] Block [%d] was already received, discarding it", s.chainID, payload.SeqNum)
+	}
 	return nil
 }
 
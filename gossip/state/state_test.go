@@ -39,6 +39,7 @@ import (
 	proto "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	transientstore2 "github.com/hyperledger/fabric/protos/transientstore"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -175,6 +176,10 @@ func (*mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*mockTransientStore) PurgeByKeys(blockNum uint64, purgeMarkers []*ledger.PurgeMarker) error {
+	return nil
+}
+
 func (*mockTransientStore) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
@@ -535,6 +540,127 @@ func TestLargeBlockGap(t *testing.T) {
 	}
 }
 
+func TestParallelStateTransfer(t *testing.T) {
+	// Scenario: the peer knows of 3 peers who have a ledger height higher than itself.
+	// The peers respond to state requests after a delay that is shorter for later blocks,
+	// and one of the peers responds with blocks that weren't requested.
+	// The peer needs to request batches of blocks from several peers in parallel,
+	// ignore the blocks that weren't requested, and commit the blocks in order.
+	t.Parallel()
+	mc := &mockCommitter{Mock: &mock.Mock{}}
+	blocksPassedToLedger := make(chan uint64, 200)
+	mc.On("CommitWithPvtData", mock.Anything).Run(func(arg mock.Arguments) {
+		blocksPassedToLedger <- arg.Get(0).(*pcomm.Block).Header.Number
+	})
+	msgsFromPeer := make(chan proto.ReceivedMessage)
+	mc.On("LedgerHeight", mock.Anything).Return(uint64(1), nil)
+	g := &mocks.GossipMock{}
+	var membership []discovery.NetworkMember
+	for _, endpoint := range []string{"a", "b", "c"} {
+		membership = append(membership, discovery.NetworkMember{
+			PKIid:    common.PKIidType(endpoint),
+			Endpoint: endpoint,
+			Properties: &proto.Properties{
+				LedgerHeight: 100,
+			},
+		})
+	}
+	g.On("PeersOfChannel", mock.Anything).Return(membership)
+	g.On("Accept", mock.Anything, false).Return(make(<-chan *proto.GossipMessage), nil)
+	g.On("Accept", mock.Anything, true).Return(nil, msgsFromPeer)
+
+	var lock sync.Mutex
+	requestedPeers := make(map[string]struct{})
+	var inFlight, maxInFlight int
+	g.On("Send", mock.Anything, mock.Anything).Run(func(arguments mock.Arguments) {
+		msg := arguments.Get(0).(*proto.GossipMessage)
+		peer := arguments.Get(1).([]*comm.RemotePeer)[0]
+		req := msg.GetStateRequest()
+		lock.Lock()
+		requestedPeers[peer.Endpoint] = struct{}{}
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+
+		res := &proto.GossipMessage{
+			Nonce:   msg.Nonce,
+			Channel: []byte(util.GetTestChainID()),
+			Content: &proto.GossipMessage_StateResponse{
+				StateResponse: &proto.RemoteStateResponse{},
+			},
+		}
+		start, end := req.StartSeqNum, req.EndSeqNum
+		if peer.Endpoint == "c" {
+			// Peer c sends blocks that weren't requested
+			start, end = end+1, end+1
+		}
+		for seq := start; seq <= end; seq++ {
+			rawblock := pcomm.NewBlock(seq, []byte{})
+			b, _ := pb.Marshal(rawblock)
+			res.GetStateResponse().Payloads = append(res.GetStateResponse().Payloads, &proto.Payload{
+				SeqNum: seq,
+				Data:   b,
+			})
+		}
+		sMsg, _ := res.NoopSign()
+		go func() {
+			time.Sleep(time.Millisecond * time.Duration(200-2*req.StartSeqNum%200))
+			lock.Lock()
+			inFlight--
+			lock.Unlock()
+			msgsFromPeer <- &comm.ReceivedMessageImpl{
+				SignedGossipMessage: sMsg,
+			}
+		}()
+	})
+	portPrefix := portStartRange + 800
+	p := newPeerNodeWithGossip(newGossipConfig(portPrefix, 0), mc, noopPeerIdentityAcceptor, g)
+	defer p.shutdown()
+
+	for expectedSequence := 1; expectedSequence < 100; expectedSequence++ {
+		select {
+		case blockSeq := <-blocksPassedToLedger:
+			assert.Equal(t, expectedSequence, int(blockSeq))
+		case <-time.After(defAntiEntropyInterval * 3):
+			t.Fatalf("Didn't commit block %d in time", expectedSequence)
+		}
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.True(t, len(requestedPeers) > 1, "Blocks were requested only from %v", requestedPeers)
+	assert.True(t, maxInFlight > 1, "Blocks weren't requested in parallel")
+}
+
+func TestNextBatches(t *testing.T) {
+	g := &mocks.GossipMock{}
+	var membership []discovery.NetworkMember
+	for i, height := range []uint64{100, 100, 15} {
+		endpoint := fmt.Sprintf("p%d", i)
+		membership = append(membership, discovery.NetworkMember{
+			PKIid:      common.PKIidType(endpoint),
+			Endpoint:   endpoint,
+			Properties: &proto.Properties{LedgerHeight: height},
+		})
+	}
+	g.On("PeersOfChannel", mock.Anything).Return(membership)
+	s := &GossipStateProviderImpl{
+		chainID:  util.GetTestChainID(),
+		mediator: &ServicesMediator{GossipAdapter: g},
+	}
+
+	// All 3 peers have the first batch
+	assert.Equal(t, []batch{{start: 1, end: 11}, {start: 12, end: 22}, {start: 23, end: 33}}, s.nextBatches(1, 99))
+	// Only 2 peers have the first batch
+	assert.Equal(t, []batch{{start: 20, end: 30}, {start: 31, end: 35}}, s.nextBatches(20, 35))
+
+	viper.Set("peer.gossip.state.parallelism", 1)
+	defer viper.Set("peer.gossip.state.parallelism", nil)
+	assert.Equal(t, []batch{{start: 1, end: 11}}, s.nextBatches(1, 99))
+}
+
 func TestOverPopulation(t *testing.T) {
 	// Scenario: Add to the state provider blocks
 	// with a gap in between, and ensure that the payload buffer
@@ -1250,6 +1376,10 @@ func (mock *coordinatorMock) Close() {
 	mock.Called()
 }
 
+func (mock *coordinatorMock) UpdateSelfSignedData(selfSignedData pcomm.SignedData) {
+	mock.Called(selfSignedData)
+}
+
 // StorePvtData used to persist private date into transient store
 func (mock *coordinatorMock) StorePvtData(txid string, privData *transientstore2.TxPvtReadWriteSetWithConfigInfo, blkHeight uint64) error {
 	return mock.Called().Error(0)
@@ -32,7 +32,7 @@ func (m *ECP) Reset()         { *m = ECP{} }
 func (m *ECP) String() string { return proto.CompactTextString(m) }
 func (*ECP) ProtoMessage()    {}
 func (*ECP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{0}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{0}
 }
 func (m *ECP) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ECP.Unmarshal(m, b)
@@ -82,7 +82,7 @@ func (m *ECP2) Reset()         { *m = ECP2{} }
 func (m *ECP2) String() string { return proto.CompactTextString(m) }
 func (*ECP2) ProtoMessage()    {}
 func (*ECP2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{1}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{1}
 }
 func (m *ECP2) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ECP2.Unmarshal(m, b)
@@ -155,7 +155,7 @@ func (m *IssuerPublicKey) Reset()         { *m = IssuerPublicKey{} }
 func (m *IssuerPublicKey) String() string { return proto.CompactTextString(m) }
 func (*IssuerPublicKey) ProtoMessage()    {}
 func (*IssuerPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{2}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{2}
 }
 func (m *IssuerPublicKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssuerPublicKey.Unmarshal(m, b)
@@ -260,7 +260,7 @@ func (m *IssuerKey) Reset()         { *m = IssuerKey{} }
 func (m *IssuerKey) String() string { return proto.CompactTextString(m) }
 func (*IssuerKey) ProtoMessage()    {}
 func (*IssuerKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{3}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{3}
 }
 func (m *IssuerKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssuerKey.Unmarshal(m, b)
@@ -312,7 +312,7 @@ func (m *Credential) Reset()         { *m = Credential{} }
 func (m *Credential) String() string { return proto.CompactTextString(m) }
 func (*Credential) ProtoMessage()    {}
 func (*Credential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{4}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{4}
 }
 func (m *Credential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Credential.Unmarshal(m, b)
@@ -386,7 +386,7 @@ func (m *CredRequest) Reset()         { *m = CredRequest{} }
 func (m *CredRequest) String() string { return proto.CompactTextString(m) }
 func (*CredRequest) ProtoMessage()    {}
 func (*CredRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{5}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{5}
 }
 func (m *CredRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CredRequest.Unmarshal(m, b)
@@ -467,7 +467,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{6}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{6}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Signature.Unmarshal(m, b)
@@ -619,7 +619,7 @@ func (m *NonRevocationProof) Reset()         { *m = NonRevocationProof{} }
 func (m *NonRevocationProof) String() string { return proto.CompactTextString(m) }
 func (*NonRevocationProof) ProtoMessage()    {}
 func (*NonRevocationProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{7}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{7}
 }
 func (m *NonRevocationProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NonRevocationProof.Unmarshal(m, b)
@@ -676,7 +676,7 @@ func (m *NymSignature) Reset()         { *m = NymSignature{} }
 func (m *NymSignature) String() string { return proto.CompactTextString(m) }
 func (*NymSignature) ProtoMessage()    {}
 func (*NymSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{8}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{8}
 }
 func (m *NymSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NymSignature.Unmarshal(m, b)
@@ -744,7 +744,7 @@ func (m *CredentialRevocationInformation) Reset()         { *m = CredentialRevoc
 func (m *CredentialRevocationInformation) String() string { return proto.CompactTextString(m) }
 func (*CredentialRevocationInformation) ProtoMessage()    {}
 func (*CredentialRevocationInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{9}
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{9}
 }
 func (m *CredentialRevocationInformation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CredentialRevocationInformation.Unmarshal(m, b)
@@ -799,6 +799,174 @@ func (m *CredentialRevocationInformation) GetRevocationData() []byte {
 	return nil
 }
 
+// AccumulatorRevocationData is the revocation data of a CRI that uses the
+// accumulator based revocation algorithm
+type AccumulatorRevocationData struct {
+	// accumulator accumulates the revocation handles that are not revoked in this epoch
+	Accumulator *ECP `protobuf:"bytes,1,opt,name=accumulator" json:"accumulator,omitempty"`
+	// witnesses contains a witness for every revocation handle that is not revoked
+	Witnesses            []*AccumulatorWitness `protobuf:"bytes,2,rep,name=witnesses" json:"witnesses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AccumulatorRevocationData) Reset()         { *m = AccumulatorRevocationData{} }
+func (m *AccumulatorRevocationData) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorRevocationData) ProtoMessage()    {}
+func (*AccumulatorRevocationData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{10}
+}
+func (m *AccumulatorRevocationData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccumulatorRevocationData.Unmarshal(m, b)
+}
+func (m *AccumulatorRevocationData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccumulatorRevocationData.Marshal(b, m, deterministic)
+}
+func (dst *AccumulatorRevocationData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorRevocationData.Merge(dst, src)
+}
+func (m *AccumulatorRevocationData) XXX_Size() int {
+	return xxx_messageInfo_AccumulatorRevocationData.Size(m)
+}
+func (m *AccumulatorRevocationData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorRevocationData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorRevocationData proto.InternalMessageInfo
+
+func (m *AccumulatorRevocationData) GetAccumulator() *ECP {
+	if m != nil {
+		return m.Accumulator
+	}
+	return nil
+}
+
+func (m *AccumulatorRevocationData) GetWitnesses() []*AccumulatorWitness {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
+}
+
+// AccumulatorWitness proves that a revocation handle is contained in the accumulator
+type AccumulatorWitness struct {
+	// revocation_handle is the revocation handle this witness belongs to
+	RevocationHandle []byte `protobuf:"bytes,1,opt,name=revocation_handle,json=revocationHandle,proto3" json:"revocation_handle,omitempty"`
+	// witness is the accumulator value with the revocation handle removed
+	Witness              *ECP     `protobuf:"bytes,2,opt,name=witness" json:"witness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccumulatorWitness) Reset()         { *m = AccumulatorWitness{} }
+func (m *AccumulatorWitness) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorWitness) ProtoMessage()    {}
+func (*AccumulatorWitness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{11}
+}
+func (m *AccumulatorWitness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccumulatorWitness.Unmarshal(m, b)
+}
+func (m *AccumulatorWitness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccumulatorWitness.Marshal(b, m, deterministic)
+}
+func (dst *AccumulatorWitness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorWitness.Merge(dst, src)
+}
+func (m *AccumulatorWitness) XXX_Size() int {
+	return xxx_messageInfo_AccumulatorWitness.Size(m)
+}
+func (m *AccumulatorWitness) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorWitness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorWitness proto.InternalMessageInfo
+
+func (m *AccumulatorWitness) GetRevocationHandle() []byte {
+	if m != nil {
+		return m.RevocationHandle
+	}
+	return nil
+}
+
+func (m *AccumulatorWitness) GetWitness() *ECP {
+	if m != nil {
+		return m.Witness
+	}
+	return nil
+}
+
+// AccumulatorNonRevocationProof is the non-revocation proof of the accumulator
+// based revocation algorithm. It proves, in zero-knowledge, knowledge of a witness
+// for the (hidden) revocation handle of the credential
+type AccumulatorNonRevocationProof struct {
+	// accumulator is the accumulator the proof is computed against
+	Accumulator *ECP `protobuf:"bytes,1,opt,name=accumulator" json:"accumulator,omitempty"`
+	// w_prime is the randomized witness
+	WPrime *ECP `protobuf:"bytes,2,opt,name=w_prime,json=wPrime" json:"w_prime,omitempty"`
+	// w_bar is the randomized witness raised to the epoch secret key
+	WBar *ECP `protobuf:"bytes,3,opt,name=w_bar,json=wBar" json:"w_bar,omitempty"`
+	// proof_s_rho is the s-value proving knowledge of the randomness of w_prime
+	ProofSRho            []byte   `protobuf:"bytes,4,opt,name=proof_s_rho,json=proofSRho,proto3" json:"proof_s_rho,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccumulatorNonRevocationProof) Reset()         { *m = AccumulatorNonRevocationProof{} }
+func (m *AccumulatorNonRevocationProof) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorNonRevocationProof) ProtoMessage()    {}
+func (*AccumulatorNonRevocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_0233fcb676989b2a, []int{12}
+}
+func (m *AccumulatorNonRevocationProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccumulatorNonRevocationProof.Unmarshal(m, b)
+}
+func (m *AccumulatorNonRevocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccumulatorNonRevocationProof.Marshal(b, m, deterministic)
+}
+func (dst *AccumulatorNonRevocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorNonRevocationProof.Merge(dst, src)
+}
+func (m *AccumulatorNonRevocationProof) XXX_Size() int {
+	return xxx_messageInfo_AccumulatorNonRevocationProof.Size(m)
+}
+func (m *AccumulatorNonRevocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorNonRevocationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorNonRevocationProof proto.InternalMessageInfo
+
+func (m *AccumulatorNonRevocationProof) GetAccumulator() *ECP {
+	if m != nil {
+		return m.Accumulator
+	}
+	return nil
+}
+
+func (m *AccumulatorNonRevocationProof) GetWPrime() *ECP {
+	if m != nil {
+		return m.WPrime
+	}
+	return nil
+}
+
+func (m *AccumulatorNonRevocationProof) GetWBar() *ECP {
+	if m != nil {
+		return m.WBar
+	}
+	return nil
+}
+
+func (m *AccumulatorNonRevocationProof) GetProofSRho() []byte {
+	if m != nil {
+		return m.ProofSRho
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ECP)(nil), "ECP")
 	proto.RegisterType((*ECP2)(nil), "ECP2")
@@ -810,61 +978,72 @@ func init() {
 	proto.RegisterType((*NonRevocationProof)(nil), "NonRevocationProof")
 	proto.RegisterType((*NymSignature)(nil), "NymSignature")
 	proto.RegisterType((*CredentialRevocationInformation)(nil), "CredentialRevocationInformation")
-}
-
-func init() { proto.RegisterFile("idemix/idemix.proto", fileDescriptor_idemix_ea623f6980eee47e) }
-
-var fileDescriptor_idemix_ea623f6980eee47e = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0xd6, 0x60, 0x9b, 0x84, 0x83, 0x13, 0xb2, 0x93, 0xa8, 0x3b, 0xfd, 0x53, 0x59, 0xab, 0xdb,
-	0x8d, 0x7a, 0x41, 0xba, 0x44, 0x7d, 0x80, 0x2c, 0xa5, 0xd5, 0xaa, 0x12, 0x42, 0xe6, 0xae, 0x37,
-	0xa3, 0x19, 0x18, 0xb0, 0x05, 0xb6, 0xe9, 0xd8, 0x74, 0x71, 0x2f, 0xfa, 0x34, 0x7d, 0x9b, 0x5e,
-	0xf4, 0x95, 0xaa, 0xf9, 0x01, 0x0f, 0x61, 0xb7, 0x57, 0xf1, 0x39, 0xdf, 0xf9, 0xe3, 0xfb, 0x3e,
-	0xc7, 0x70, 0x9b, 0x2e, 0x44, 0x96, 0xee, 0x1f, 0xcc, 0x9f, 0xc1, 0x56, 0x16, 0x55, 0x11, 0xbd,
-	0x02, 0x6f, 0x3c, 0x9a, 0xe2, 0x10, 0xd0, 0x9e, 0xa0, 0x3e, 0xba, 0x0f, 0x63, 0xb4, 0x57, 0x51,
-	0x4d, 0x5a, 0x26, 0xaa, 0xa3, 0x9f, 0xc1, 0x1f, 0x8f, 0xa6, 0x43, 0x7c, 0x0d, 0xad, 0x3d, 0xb3,
-	0x45, 0xad, 0x3d, 0xd3, 0x31, 0xb7, 0x65, 0xad, 0x3d, 0x57, 0x71, 0xcd, 0x88, 0x67, 0xe2, 0x5a,
-	0xe3, 0x35, 0x27, 0xbe, 0x8d, 0x79, 0xf4, 0x77, 0x0b, 0x7a, 0xef, 0xcb, 0x72, 0x27, 0xe4, 0x74,
-	0xc7, 0x37, 0xe9, 0xfc, 0x57, 0x51, 0xe3, 0x37, 0xd0, 0x63, 0x55, 0x25, 0x53, 0xbe, 0xab, 0x04,
-	0xcd, 0x59, 0x26, 0x4a, 0x82, 0xfa, 0xde, 0x7d, 0x27, 0xbe, 0x3e, 0xa6, 0x27, 0x2a, 0x8b, 0x5f,
-	0x82, 0x9f, 0xd0, 0x72, 0xad, 0xd7, 0x75, 0x87, 0xfe, 0x60, 0x3c, 0x9a, 0xc6, 0x5e, 0x32, 0x5b,
-	0xe3, 0x2f, 0xa1, 0x9d, 0x50, 0xc9, 0xf2, 0x85, 0xde, 0x7c, 0x80, 0x82, 0x24, 0x66, 0xf9, 0x02,
-	0x7f, 0x0d, 0x17, 0x09, 0x55, 0x93, 0x4a, 0xe2, 0xf7, 0xbd, 0x23, 0xda, 0x4e, 0x9e, 0x54, 0x0e,
-	0xdf, 0x02, 0xfa, 0x40, 0x02, 0xdd, 0x16, 0x28, 0x60, 0x18, 0xa3, 0x0f, 0x6a, 0x20, 0x67, 0x92,
-	0xae, 0xde, 0x92, 0xb6, 0x3b, 0x90, 0x33, 0xf9, 0xcb, 0xdb, 0x23, 0x38, 0x24, 0x17, 0xcf, 0xc1,
-	0x21, 0x7e, 0x09, 0x17, 0x5b, 0x59, 0x14, 0x4b, 0x3a, 0x27, 0x97, 0xfa, 0x57, 0xb7, 0x75, 0x38,
-	0x6a, 0x80, 0x92, 0x74, 0x1c, 0x60, 0x86, 0x31, 0xf8, 0x09, 0x2b, 0x13, 0x02, 0x3a, 0xab, 0x9f,
-	0xa3, 0x27, 0xe8, 0x18, 0x96, 0x14, 0x3f, 0x37, 0xe0, 0xa5, 0xe5, 0xda, 0x92, 0xae, 0x1e, 0x71,
-	0x04, 0x5e, 0xba, 0x3d, 0xf0, 0x70, 0x33, 0x78, 0x46, 0x68, 0xac, 0xc0, 0x68, 0x09, 0x30, 0x92,
-	0x62, 0x21, 0xf2, 0x2a, 0x65, 0x1b, 0x8c, 0x01, 0x19, 0xd9, 0x0e, 0xe7, 0x22, 0xa6, 0x72, 0xfc,
-	0x84, 0x4b, 0xc4, 0x95, 0xea, 0xc2, 0xca, 0x87, 0x84, 0x8a, 0x4a, 0x2b, 0x1e, 0x2a, 0xf1, 0x1d,
-	0x04, 0x86, 0xc6, 0xa0, 0xef, 0xdd, 0x87, 0xb1, 0x09, 0xa2, 0x3f, 0xa1, 0xab, 0xf6, 0xc4, 0xe2,
-	0xf7, 0x9d, 0x28, 0x2b, 0xfc, 0x19, 0x78, 0x79, 0x9d, 0x9d, 0xac, 0x52, 0x09, 0xfc, 0x0a, 0xc2,
-	0x54, 0x9f, 0x49, 0xf3, 0x22, 0x9f, 0x0b, 0x6b, 0x99, 0xae, 0xc9, 0x4d, 0x54, 0xca, 0xa5, 0xce,
-	0xfb, 0x14, 0x75, 0xbe, 0x4b, 0x5d, 0xf4, 0xaf, 0x0f, 0x9d, 0x59, 0xba, 0xca, 0x59, 0xb5, 0x93,
-	0x42, 0x09, 0xcd, 0xe8, 0x56, 0xa6, 0x99, 0x38, 0x59, 0xdf, 0x66, 0x53, 0x95, 0xc3, 0x9f, 0x43,
-	0xc0, 0x28, 0x67, 0xf2, 0xe4, 0x27, 0xfb, 0xec, 0x1d, 0x93, 0xaa, 0x93, 0xdb, 0x4e, 0xd7, 0x40,
-	0x6d, 0x6e, 0x3a, 0x9d, 0xc3, 0xfc, 0x93, 0xc3, 0xbe, 0x02, 0xb0, 0x87, 0x29, 0x5b, 0x06, 0x1a,
-	0xbb, 0x34, 0xb7, 0xcd, 0xd6, 0xf8, 0x0b, 0xe8, 0x1c, 0x50, 0xa1, 0x7d, 0x14, 0xc6, 0x66, 0xce,
-	0x6c, 0xec, 0x76, 0x4a, 0xe3, 0xa3, 0x63, 0x67, 0x3c, 0x3c, 0x41, 0x1f, 0xad, 0x8f, 0x0e, 0xe8,
-	0x23, 0x7e, 0x0d, 0xbd, 0xe3, 0x56, 0x7b, 0xb5, 0x71, 0x54, 0x68, 0x57, 0x9b, 0xab, 0x23, 0xb8,
-	0x3a, 0x94, 0x19, 0xd9, 0x40, 0xcb, 0xd6, 0x35, 0x45, 0xc6, 0xfc, 0x77, 0x10, 0x18, 0x39, 0xba,
-	0x7a, 0x80, 0x09, 0x0e, 0x1a, 0x86, 0xe7, 0x1a, 0x1e, 0x27, 0x4a, 0xaa, 0x2a, 0xae, 0x74, 0x17,
-	0xd8, 0xcb, 0x26, 0x75, 0x86, 0x7f, 0x84, 0x5b, 0x29, 0xfe, 0x28, 0xe6, 0xac, 0x4a, 0x8b, 0x9c,
-	0x8a, 0x6d, 0x31, 0x4f, 0xe8, 0x76, 0x4d, 0xae, 0xdd, 0xf7, 0xeb, 0x45, 0x53, 0x31, 0x56, 0x05,
-	0xd3, 0x35, 0xfe, 0x1e, 0x9c, 0x24, 0xdd, 0xae, 0x69, 0x99, 0xae, 0x48, 0x4f, 0x4f, 0xef, 0x35,
-	0xc0, 0x74, 0x3d, 0x4b, 0x57, 0xea, 0x66, 0x3d, 0x97, 0xdc, 0xf4, 0xd1, 0xbd, 0x17, 0x9b, 0x00,
-	0x8f, 0xe1, 0x2e, 0x2f, 0x72, 0xea, 0x4e, 0x51, 0x57, 0x91, 0x17, 0x7a, 0xf3, 0xed, 0x60, 0x52,
-	0xe4, 0x71, 0x33, 0x48, 0x41, 0x31, 0xce, 0xcf, 0x72, 0x51, 0x06, 0xf8, 0xbc, 0x12, 0xbf, 0x86,
-	0x6b, 0x67, 0x30, 0xdb, 0xac, 0xb4, 0xc1, 0x82, 0xf8, 0xaa, 0xc9, 0x3e, 0x6d, 0x56, 0xf8, 0x87,
-	0x4f, 0xdc, 0x60, 0xbc, 0xfe, 0xb1, 0x75, 0x7f, 0x41, 0x38, 0xa9, 0xb3, 0xc6, 0xc2, 0x8e, 0xd3,
-	0xd0, 0xff, 0x38, 0xad, 0xf5, 0xcc, 0x69, 0x67, 0xc2, 0x78, 0x67, 0xc2, 0x1c, 0x95, 0xf6, 0x1d,
-	0xa5, 0xa3, 0x7f, 0x10, 0x7c, 0xd3, 0xfc, 0x97, 0x68, 0xae, 0x7b, 0x9f, 0x2f, 0x0b, 0x99, 0xe9,
-	0xc7, 0x86, 0x6f, 0xe4, 0xf2, 0xdd, 0x87, 0xcb, 0xa3, 0xba, 0x2d, 0x57, 0xdd, 0x0b, 0x61, 0x35,
-	0xed, 0x43, 0x78, 0xa8, 0xd0, 0x72, 0xda, 0x9b, 0x2c, 0xac, 0x94, 0x3c, 0xa7, 0xd5, 0xff, 0x18,
-	0xad, 0x6f, 0xc0, 0xf1, 0x00, 0x5d, 0xb0, 0x8a, 0xd9, 0x57, 0xcd, 0xe9, 0xfe, 0x89, 0x55, 0xec,
-	0xdd, 0x77, 0xbf, 0x7d, 0xbb, 0x4a, 0xab, 0x64, 0xc7, 0x07, 0xf3, 0x22, 0x7b, 0x48, 0xea, 0xad,
-	0x90, 0x1b, 0xb1, 0x58, 0x09, 0xf9, 0xb0, 0x64, 0x5c, 0xa6, 0x73, 0xfb, 0xd5, 0xe3, 0x6d, 0xfd,
-	0xd9, 0x7b, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xcc, 0x9c, 0x10, 0x0d, 0x07, 0x00, 0x00,
+	proto.RegisterType((*AccumulatorRevocationData)(nil), "AccumulatorRevocationData")
+	proto.RegisterType((*AccumulatorWitness)(nil), "AccumulatorWitness")
+	proto.RegisterType((*AccumulatorNonRevocationProof)(nil), "AccumulatorNonRevocationProof")
+}
+
+func init() { proto.RegisterFile("idemix/idemix.proto", fileDescriptor_idemix_0233fcb676989b2a) }
+
+var fileDescriptor_idemix_0233fcb676989b2a = []byte{
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x63, 0x3b, 0x69, 0x4e, 0xdc, 0x9f, 0x9d, 0x54, 0xec, 0x2c, 0xd0, 0x25, 0x6b, 0xb1,
+	0xbb, 0x15, 0x48, 0x29, 0x4d, 0xc5, 0x03, 0x74, 0x43, 0x80, 0x15, 0x52, 0x14, 0x39, 0x17, 0x48,
+	0xdc, 0x58, 0x63, 0x67, 0x1a, 0x5b, 0x89, 0xed, 0x30, 0x76, 0x36, 0x31, 0x17, 0xbc, 0x0b, 0x12,
+	0x6f, 0xc3, 0x05, 0xaf, 0x84, 0xe6, 0x27, 0xf6, 0xb8, 0xe9, 0xa2, 0xbd, 0xaa, 0xcf, 0xf9, 0xce,
+	0x9f, 0xcf, 0xf7, 0xe5, 0xd4, 0xd0, 0x8f, 0x17, 0x34, 0x89, 0xf7, 0x37, 0xf2, 0xcf, 0x70, 0xc3,
+	0xb2, 0x22, 0x73, 0x5f, 0x81, 0x39, 0x19, 0xcf, 0x90, 0x03, 0xc6, 0x1e, 0x1b, 0x03, 0xe3, 0xda,
+	0xf1, 0x8c, 0x3d, 0xb7, 0x4a, 0xdc, 0x92, 0x56, 0xe9, 0xfe, 0x08, 0xd6, 0x64, 0x3c, 0x1b, 0xa1,
+	0x33, 0x68, 0xed, 0x89, 0x0a, 0x6a, 0xed, 0x89, 0xb0, 0x03, 0x15, 0xd6, 0xda, 0x07, 0xdc, 0x2e,
+	0x09, 0x36, 0xa5, 0x5d, 0x0a, 0xbc, 0x0c, 0xb0, 0xa5, 0xec, 0xc0, 0xfd, 0xbb, 0x05, 0xe7, 0xef,
+	0xf3, 0x7c, 0x4b, 0xd9, 0x6c, 0x1b, 0xac, 0xe3, 0xf0, 0x17, 0x5a, 0xa2, 0xb7, 0x70, 0x4e, 0x8a,
+	0x82, 0xc5, 0xc1, 0xb6, 0xa0, 0x7e, 0x4a, 0x12, 0x9a, 0x63, 0x63, 0x60, 0x5e, 0x77, 0xbd, 0xb3,
+	0xca, 0x3d, 0xe5, 0x5e, 0xf4, 0x1c, 0xac, 0xc8, 0xcf, 0x57, 0xa2, 0x5d, 0x6f, 0x64, 0x0d, 0x27,
+	0xe3, 0x99, 0x67, 0x46, 0xf3, 0x15, 0xfa, 0x02, 0xda, 0x91, 0xcf, 0x48, 0xba, 0xc0, 0xa6, 0x06,
+	0xd9, 0x91, 0x47, 0xd2, 0x05, 0xba, 0x82, 0x4e, 0xe4, 0xf3, 0x4a, 0x39, 0xb6, 0x06, 0x66, 0x85,
+	0xb6, 0xa3, 0x7b, 0xee, 0x43, 0x7d, 0x30, 0x76, 0xd8, 0x16, 0x69, 0x36, 0x07, 0x46, 0x9e, 0xb1,
+	0xe3, 0x05, 0x03, 0xc2, 0xfc, 0xe5, 0x2d, 0x6e, 0xeb, 0x05, 0x03, 0xc2, 0x7e, 0xba, 0xad, 0xc0,
+	0x11, 0xee, 0x3c, 0x06, 0x47, 0xe8, 0x39, 0x74, 0x36, 0x2c, 0xcb, 0x1e, 0xfc, 0x10, 0x9f, 0x88,
+	0xb7, 0x6e, 0x0b, 0x73, 0x5c, 0x03, 0x39, 0xee, 0x6a, 0xc0, 0x1c, 0x21, 0xb0, 0x22, 0x92, 0x47,
+	0x18, 0x84, 0x57, 0x3c, 0xbb, 0xf7, 0xd0, 0x95, 0x5b, 0xe2, 0xfb, 0xb9, 0x00, 0x33, 0xce, 0x57,
+	0x6a, 0xe9, 0xfc, 0x11, 0xb9, 0x60, 0xc6, 0x9b, 0xc3, 0x1e, 0x2e, 0x86, 0x8f, 0x16, 0xea, 0x71,
+	0xd0, 0x7d, 0x00, 0x18, 0x33, 0xba, 0xa0, 0x69, 0x11, 0x93, 0x35, 0x42, 0x60, 0x48, 0xda, 0x0e,
+	0xe3, 0x1a, 0x84, 0xfb, 0x82, 0xc6, 0x2e, 0x8d, 0x80, 0xb3, 0x4e, 0x15, 0x7d, 0x06, 0xe5, 0x56,
+	0xae, 0xc8, 0x33, 0x72, 0x74, 0x09, 0xb6, 0x5c, 0xa3, 0x3d, 0x30, 0xaf, 0x1d, 0x4f, 0x1a, 0xee,
+	0x1f, 0xd0, 0xe3, 0x7d, 0x3c, 0xfa, 0xfb, 0x96, 0xe6, 0x05, 0xfa, 0x0c, 0xcc, 0xb4, 0x4c, 0x1a,
+	0xad, 0xb8, 0x03, 0xbd, 0x02, 0x27, 0x16, 0x63, 0xfa, 0x69, 0x96, 0x86, 0x54, 0x49, 0xa6, 0x27,
+	0x7d, 0x53, 0xee, 0xd2, 0x57, 0x67, 0x7e, 0x6c, 0x75, 0x96, 0xbe, 0x3a, 0xf7, 0x5f, 0x0b, 0xba,
+	0xf3, 0x78, 0x99, 0x92, 0x62, 0xcb, 0x28, 0x27, 0x9a, 0xf8, 0x1b, 0x16, 0x27, 0xb4, 0xd1, 0xbe,
+	0x4d, 0x66, 0xdc, 0x87, 0x5e, 0x80, 0x4d, 0xfc, 0x80, 0xb0, 0xc6, 0x2b, 0x5b, 0xe4, 0x1d, 0x61,
+	0x3c, 0x33, 0x50, 0x99, 0xba, 0x80, 0xda, 0x81, 0xcc, 0xd4, 0x06, 0xb3, 0x1a, 0x83, 0x7d, 0x09,
+	0xa0, 0x06, 0xe3, 0xb2, 0xb4, 0x05, 0x76, 0x22, 0x67, 0x9b, 0xaf, 0xd0, 0xe7, 0xd0, 0x3d, 0xa0,
+	0x54, 0xe8, 0xc8, 0xf1, 0x64, 0x9d, 0xf9, 0x44, 0xcf, 0x64, 0x52, 0x47, 0x55, 0xa6, 0x37, 0x6a,
+	0xa0, 0x77, 0xf8, 0xa4, 0x81, 0xde, 0xa1, 0xd7, 0x70, 0x5e, 0x75, 0x55, 0x53, 0x4b, 0x45, 0x39,
+	0xaa, 0xb5, 0x9c, 0xda, 0x85, 0xd3, 0x43, 0x98, 0xa4, 0x0d, 0x04, 0x6d, 0x3d, 0x19, 0x24, 0xc5,
+	0x7f, 0x09, 0xb6, 0xa4, 0xa3, 0x27, 0x0a, 0x48, 0xe3, 0xc0, 0xa1, 0x73, 0xcc, 0x61, 0x55, 0x91,
+	0xf9, 0x3c, 0xe2, 0x54, 0x64, 0x81, 0x9a, 0x6c, 0x5a, 0x26, 0xe8, 0x7b, 0xe8, 0x33, 0xfa, 0x21,
+	0x0b, 0x49, 0x11, 0x67, 0xa9, 0x4f, 0x37, 0x59, 0x18, 0xf9, 0x9b, 0x15, 0x3e, 0xd3, 0x7f, 0x5f,
+	0xcf, 0xea, 0x88, 0x09, 0x0f, 0x98, 0xad, 0xd0, 0x37, 0xa0, 0x39, 0xfd, 0xcd, 0xca, 0xcf, 0xe3,
+	0x25, 0x3e, 0x17, 0xd5, 0xcf, 0x6b, 0x60, 0xb6, 0x9a, 0xc7, 0x4b, 0x3e, 0xb3, 0xa8, 0x8b, 0x2f,
+	0x06, 0xc6, 0xb5, 0xe9, 0x49, 0x03, 0x4d, 0xe0, 0x32, 0xcd, 0x52, 0x5f, 0xaf, 0xc2, 0xa7, 0xc2,
+	0xcf, 0x44, 0xe7, 0xfe, 0x70, 0x9a, 0xa5, 0x5e, 0x5d, 0x88, 0x43, 0x1e, 0x4a, 0x8f, 0x7c, 0x6e,
+	0x02, 0xe8, 0x38, 0x12, 0xbd, 0x86, 0x33, 0xad, 0x30, 0x59, 0x2f, 0x85, 0xc0, 0x6c, 0xef, 0xb4,
+	0xf6, 0xde, 0xaf, 0x97, 0xe8, 0xbb, 0x8f, 0xcc, 0x20, 0xb5, 0xfe, 0x54, 0xbb, 0x3f, 0xc1, 0x99,
+	0x96, 0x49, 0x2d, 0x61, 0x4d, 0x69, 0xc6, 0xff, 0x28, 0xad, 0xf5, 0x48, 0x69, 0x47, 0xc4, 0x98,
+	0x47, 0xc4, 0x54, 0x4c, 0x5b, 0x1a, 0xd3, 0xee, 0x3f, 0x06, 0x7c, 0x55, 0x5f, 0x89, 0x7a, 0xba,
+	0xf7, 0xe9, 0x43, 0xc6, 0x12, 0xf1, 0x58, 0xef, 0xdb, 0xd0, 0xf7, 0x3d, 0x80, 0x93, 0x8a, 0xdd,
+	0x96, 0xce, 0x6e, 0x87, 0x2a, 0x4e, 0x07, 0xe0, 0x1c, 0x22, 0x04, 0x9d, 0x6a, 0x26, 0x05, 0x73,
+	0x26, 0x8f, 0xd7, 0x6a, 0x3d, 0xb5, 0xd6, 0xb7, 0xa0, 0x69, 0xc0, 0x5f, 0x90, 0x82, 0xa8, 0x9f,
+	0x9a, 0x96, 0xfd, 0x03, 0x29, 0x88, 0xfb, 0x01, 0x5e, 0xdc, 0x87, 0xe1, 0x36, 0xd9, 0xae, 0x49,
+	0x91, 0x31, 0xaf, 0x01, 0xa2, 0x37, 0xd0, 0x23, 0x35, 0xd8, 0xb8, 0x10, 0x3a, 0x80, 0x6e, 0xa1,
+	0xbb, 0x8b, 0x8b, 0x94, 0xe6, 0x39, 0xcd, 0x71, 0x4b, 0xfc, 0xc3, 0xe8, 0x0f, 0xb5, 0xb2, 0xbf,
+	0x4a, 0xd0, 0xab, 0xa3, 0x5c, 0x02, 0xe8, 0x38, 0x00, 0x7d, 0xdb, 0xd0, 0x74, 0x44, 0xd2, 0xc5,
+	0x9a, 0x2a, 0x56, 0x2f, 0x6a, 0xe0, 0x67, 0xe1, 0x47, 0x2f, 0xa1, 0xa3, 0xea, 0x35, 0xce, 0xd3,
+	0xc1, 0xe9, 0xfe, 0x65, 0xc0, 0x95, 0xd6, 0xe3, 0x09, 0x8d, 0x7e, 0xea, 0xfb, 0x5d, 0x41, 0x67,
+	0xa7, 0xae, 0x86, 0xde, 0xa9, 0xbd, 0xab, 0xae, 0xe4, 0x4e, 0x5c, 0x49, 0xfd, 0x10, 0x5a, 0x3b,
+	0x7e, 0x25, 0x5f, 0x42, 0xaf, 0x52, 0x59, 0x94, 0x29, 0x21, 0x75, 0x95, 0xc6, 0xa2, 0xec, 0xdd,
+	0x9b, 0xdf, 0xbe, 0x5e, 0xc6, 0x45, 0xb4, 0x0d, 0x86, 0x61, 0x96, 0xdc, 0x44, 0xe5, 0x86, 0xb2,
+	0x35, 0x5d, 0x2c, 0x29, 0xbb, 0x79, 0x20, 0x01, 0x8b, 0x43, 0xf5, 0xd1, 0x11, 0xb4, 0xc5, 0x57,
+	0xc7, 0xdd, 0x7f, 0x03, 0x00, 0xe2, 0x83, 0xbf, 0x3f, 0x8c, 0x08, 0x00, 0x00,
 }
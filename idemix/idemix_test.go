@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/stretchr/testify/assert"
 )
@@ -152,6 +153,14 @@ func TestIdemix(t *testing.T) {
 	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
 	assert.NoError(t, err)
 
+	// Signatures for another epoch or with a CRI not signed by the revocation authority should be invalid
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch+1)
+	assert.Error(t, err, "signature for a past epoch should be invalid")
+	otherRevocationKey, err := GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &otherRevocationKey.PublicKey, epoch)
+	assert.Error(t, err, "signature with a CRI of another revocation authority should be invalid")
+
 	// Test NymSignatures
 	nymsig, err := NewNymSignature(sk, Nym, RandNym, key.Ipk, []byte("testing"), rng)
 	assert.NoError(t, err)
@@ -162,3 +171,90 @@ func TestIdemix(t *testing.T) {
 		return
 	}
 }
+
+func TestAccumulatorRevocation(t *testing.T) {
+	rng, err := GetRand()
+	assert.NoError(t, err)
+
+	AttributeNames := []string{"Attr1", "Attr2", "Attr3", "RevocationHandle"}
+	rhIndex := 3
+	key, err := NewIssuerKey(AttributeNames, rng)
+	assert.NoError(t, err)
+	revocationKey, err := GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+
+	// issue two credentials with different revocation handles
+	issue := func(rh int) (*Credential, *FP256BN.BIG) {
+		sk := RandModOrder(rng)
+		m := NewCredRequest(sk, RandModOrder(rng), key.Ipk, rng)
+		attrs := []*FP256BN.BIG{FP256BN.NewBIGint(1), FP256BN.NewBIGint(2), FP256BN.NewBIGint(3), FP256BN.NewBIGint(rh)}
+		cred, err := NewCredential(key, m, attrs, rng)
+		assert.NoError(t, err)
+		return cred, sk
+	}
+	cred1, sk1 := issue(1)
+	cred2, sk2 := issue(2)
+
+	// duplicate handles are rejected
+	_, err = CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(1), FP256BN.NewBIGint(1)}, 1, ALG_ACCUMULATOR, rng)
+	assert.Error(t, err)
+
+	// in epoch 1 nobody is revoked
+	cri, err := CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(1), FP256BN.NewBIGint(2)}, 1, ALG_ACCUMULATOR, rng)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyCRI(&revocationKey.PublicKey, cri))
+
+	disclosure := []byte{0, 1, 0, 0}
+	msg := []byte("message")
+	values := []*FP256BN.BIG{nil, FP256BN.NewBIGint(2), nil, nil}
+	sign := func(cred *Credential, sk *FP256BN.BIG, cri *CredentialRevocationInformation) (*Signature, error) {
+		nym, randNym := MakeNym(sk, key.Ipk, rng)
+		return NewSignature(cred, sk, nym, randNym, key.Ipk, disclosure, msg, rhIndex, cri, rng)
+	}
+
+	sig, err := sign(cred1, sk1, cri)
+	assert.NoError(t, err)
+	assert.NoError(t, sig.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 1))
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, []byte("another message"), values, rhIndex, &revocationKey.PublicKey, 1))
+	assert.Error(t, sig.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 2))
+
+	sig2, err := sign(cred2, sk2, cri)
+	assert.NoError(t, err)
+	assert.NoError(t, sig2.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 1))
+
+	// the revocation handle must remain hidden
+	nym, randNym := MakeNym(sk1, key.Ipk, rng)
+	_, err = NewSignature(cred1, sk1, nym, randNym, key.Ipk, []byte{0, 1, 0, 1}, msg, rhIndex, cri, rng)
+	assert.Error(t, err)
+
+	// in epoch 2 the second credential is revoked
+	cri2, err := CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(1)}, 2, ALG_ACCUMULATOR, rng)
+	assert.NoError(t, err)
+	sig, err = sign(cred1, sk1, cri2)
+	assert.NoError(t, err)
+	assert.NoError(t, sig.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 2))
+	_, err = sign(cred2, sk2, cri2)
+	assert.Error(t, err, "a revoked credential should not be able to prove non-revocation")
+
+	// a revoked user cannot reuse the witness of the previous epoch
+	criData := &AccumulatorRevocationData{}
+	assert.NoError(t, proto.Unmarshal(cri.RevocationData, criData))
+	cri2Data := &AccumulatorRevocationData{}
+	assert.NoError(t, proto.Unmarshal(cri2.RevocationData, cri2Data))
+	cri2Data.Witnesses = append(cri2Data.Witnesses, criData.Witnesses[1])
+	forgedCri := *cri2
+	forgedCri.RevocationData, err = proto.Marshal(cri2Data)
+	assert.NoError(t, err)
+	sig2, err = sign(cred2, sk2, &forgedCri)
+	assert.NoError(t, err)
+	assert.Error(t, sig2.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 2))
+
+	// nor can it use an accumulator that is not signed by the revocation authority
+	cri2Data.Accumulator = criData.Accumulator
+	forgedCri.RevocationData, err = proto.Marshal(cri2Data)
+	assert.NoError(t, err)
+	assert.Error(t, VerifyCRI(&revocationKey.PublicKey, &forgedCri))
+	sig2, err = sign(cred2, sk2, &forgedCri)
+	assert.NoError(t, err)
+	assert.Error(t, sig2.Ver(disclosure, key.Ipk, msg, values, rhIndex, &revocationKey.PublicKey, 2))
+}
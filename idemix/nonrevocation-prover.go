@@ -7,6 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
@@ -27,10 +30,83 @@ func (prover *nopNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRe
 	return ret, nil
 }
 
+// accumulatorNonRevokedProver proves that the revocation handle rh is contained in the
+// accumulator V of the epoch, using the witness W = V^{1/(sk + rh)} published in the CRI.
+// The witness is randomized as W' = W^rho and the prover shows knowledge of rh and rho
+// such that Wbar = W'^{-rh} * V^rho, where the verifier checks e(W', epochPK) = e(Wbar, g2).
+type accumulatorNonRevokedProver struct {
+	accumulator *FP256BN.ECP
+	wPrime      *FP256BN.ECP
+	wBar        *FP256BN.ECP
+	rho         *FP256BN.BIG
+	rRho        *FP256BN.BIG
+}
+
+func (prover *accumulatorNonRevokedProver) getFSContribution(rh *FP256BN.BIG, rRh *FP256BN.BIG, cri *CredentialRevocationInformation, rng *amcl.RAND) ([]byte, error) {
+	revocationData := &AccumulatorRevocationData{}
+	err := proto.Unmarshal(cri.RevocationData, revocationData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revocation data")
+	}
+	if revocationData.Accumulator == nil {
+		return nil, errors.Errorf("CRI does not contain an accumulator")
+	}
+
+	var witness *FP256BN.ECP
+	rhBytes := BigToBytes(rh)
+	for _, w := range revocationData.Witnesses {
+		if w.Witness != nil && bytes.Equal(w.RevocationHandle, rhBytes) {
+			witness = EcpFromProto(w.Witness)
+			break
+		}
+	}
+	if witness == nil {
+		return nil, errors.Errorf("CRI of epoch %d does not contain a witness for the revocation handle, the credential is revoked", cri.Epoch)
+	}
+
+	prover.accumulator = EcpFromProto(revocationData.Accumulator)
+	prover.rho = RandModOrder(rng)
+	prover.rRho = RandModOrder(rng)
+
+	// W' = W^rho, Wbar = W'^{-rh} * V^rho
+	prover.wPrime = FP256BN.G1mul(witness, prover.rho)
+	prover.wBar = FP256BN.G1mul(prover.accumulator, prover.rho)
+	prover.wBar.Sub(FP256BN.G1mul(prover.wPrime, rh))
+
+	// t = W'^{-rRh} * V^rRho
+	t := FP256BN.G1mul(prover.accumulator, prover.rRho)
+	t.Sub(FP256BN.G1mul(prover.wPrime, rRh))
+
+	fsBytes := make([]byte, ProofBytes[ALG_ACCUMULATOR])
+	index := appendBytesG1(fsBytes, 0, prover.accumulator)
+	index = appendBytesG1(fsBytes, index, prover.wPrime)
+	index = appendBytesG1(fsBytes, index, prover.wBar)
+	appendBytesG1(fsBytes, index, t)
+	return fsBytes, nil
+}
+
+func (prover *accumulatorNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRevocationProof, error) {
+	proofBytes, err := proto.Marshal(&AccumulatorNonRevocationProof{
+		Accumulator: EcpToProto(prover.accumulator),
+		WPrime:      EcpToProto(prover.wPrime),
+		WBar:        EcpToProto(prover.wBar),
+		ProofSRho:   BigToBytes(Modadd(prover.rRho, FP256BN.Modmul(chal, prover.rho, GroupOrder), GroupOrder)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal non-revocation proof")
+	}
+	return &NonRevocationProof{
+		RevocationAlg:      int32(ALG_ACCUMULATOR),
+		NonRevocationProof: proofBytes,
+	}, nil
+}
+
 func getNonRevocationProver(algorithm RevocationAlgorithm) (nonRevokedProver, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevokedProver{}, nil
+	case ALG_ACCUMULATOR:
+		return &accumulatorNonRevokedProver{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
@@ -7,12 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
 )
 
 type nonRevocationVerifier interface {
 	recomputeFSContribution(proof *NonRevocationProof, chal *FP256BN.BIG, epochPK *FP256BN.ECP2, proofSRh *FP256BN.BIG) ([]byte, error)
+	// signedRevocationData returns the revocation data the proof relies on that must be
+	// signed by the revocation authority together with the epoch PK
+	signedRevocationData(proof *NonRevocationProof) ([]byte, error)
 }
 type nopNonRevocationVerifier struct{}
 
@@ -20,10 +24,79 @@ func (verifier *nopNonRevocationVerifier) recomputeFSContribution(proof *NonRevo
 	return nil, nil
 }
 
+func (verifier *nopNonRevocationVerifier) signedRevocationData(proof *NonRevocationProof) ([]byte, error) {
+	return nil, nil
+}
+
+// accumulatorNonRevocationVerifier verifies the proofs of accumulatorNonRevokedProver
+type accumulatorNonRevocationVerifier struct{}
+
+func (verifier *accumulatorNonRevocationVerifier) recomputeFSContribution(proof *NonRevocationProof, chal *FP256BN.BIG, epochPK *FP256BN.ECP2, proofSRh *FP256BN.BIG) ([]byte, error) {
+	accProof, err := unmarshalAccumulatorProof(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	accumulator := EcpFromProto(accProof.Accumulator)
+	wPrime := EcpFromProto(accProof.WPrime)
+	wBar := EcpFromProto(accProof.WBar)
+	proofSRho := FP256BN.FromBytes(accProof.ProofSRho)
+
+	// check that Wbar = W'^sk, i.e., that W' is a randomized witness for some handle
+	if wPrime.Is_infinity() {
+		return nil, errors.Errorf("non-revocation proof invalid: WPrime = 1")
+	}
+	temp1 := FP256BN.Ate(epochPK, wPrime)
+	temp2 := FP256BN.Ate(GenG2, wBar)
+	temp2.Inverse()
+	temp1.Mul(temp2)
+	if !FP256BN.Fexp(temp1).Isunity() {
+		return nil, errors.Errorf("non-revocation proof invalid: WPrime and WBar don't have the expected structure")
+	}
+
+	// t = W'^{-sRh} * V^sRho * Wbar^{-c}
+	t := FP256BN.G1mul(accumulator, proofSRho)
+	t.Sub(FP256BN.G1mul(wPrime, proofSRh))
+	t.Sub(FP256BN.G1mul(wBar, chal))
+
+	fsBytes := make([]byte, ProofBytes[ALG_ACCUMULATOR])
+	index := appendBytesG1(fsBytes, 0, accumulator)
+	index = appendBytesG1(fsBytes, index, wPrime)
+	index = appendBytesG1(fsBytes, index, wBar)
+	appendBytesG1(fsBytes, index, t)
+	return fsBytes, nil
+}
+
+func (verifier *accumulatorNonRevocationVerifier) signedRevocationData(proof *NonRevocationProof) ([]byte, error) {
+	accProof, err := unmarshalAccumulatorProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	signedData, err := proto.Marshal(&AccumulatorRevocationData{Accumulator: accProof.Accumulator})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal accumulator")
+	}
+	return signedData, nil
+}
+
+func unmarshalAccumulatorProof(proof *NonRevocationProof) (*AccumulatorNonRevocationProof, error) {
+	accProof := &AccumulatorNonRevocationProof{}
+	err := proto.Unmarshal(proof.NonRevocationProof, accProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal non-revocation proof")
+	}
+	if accProof.Accumulator == nil || accProof.WPrime == nil || accProof.WBar == nil {
+		return nil, errors.Errorf("non-revocation proof invalid: received nil input")
+	}
+	return accProof, nil
+}
+
 func getNonRevocationVerifier(algorithm RevocationAlgorithm) (nonRevocationVerifier, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevocationVerifier{}, nil
+	case ALG_ACCUMULATOR:
+		return &accumulatorNonRevocationVerifier{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
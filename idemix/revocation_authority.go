@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/ecdsa"
 
 	"crypto/rand"
@@ -25,10 +27,13 @@ type RevocationAlgorithm int32
 
 const (
 	ALG_NO_REVOCATION RevocationAlgorithm = iota
+	ALG_ACCUMULATOR
 )
 
 var ProofBytes = map[RevocationAlgorithm]int{
 	ALG_NO_REVOCATION: 0,
+	// the accumulator and three elements of G1 computed by the prover
+	ALG_ACCUMULATOR: 4 * (2*FieldBytes + 1),
 }
 
 // GenerateLongTermRevocationKey generates a long term signing key that will be used for revocation
@@ -40,7 +45,10 @@ func GenerateLongTermRevocationKey() (*ecdsa.PrivateKey, error) {
 // Users can use the CRI to prove that they are not revoked.
 // Note that when not using revocation (i.e., alg = ALG_NO_REVOCATION), the entered unrevokedHandles are not used,
 // and the resulting CRI can be used by any signer.
-func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int, alg RevocationAlgorithm, rng *amcl.RAND) (*CredentialRevocationInformation, error) {
+// When using ALG_ACCUMULATOR, the CRI contains an accumulator of the unrevoked handles that is signed
+// together with the epoch key, and a witness for each unrevoked handle that lets its owner prove non-revocation.
+// The key is the long term revocation key, which can be kept in an HSM by passing a signer backed by it.
+func CreateCRI(key crypto.Signer, unrevokedHandles []*FP256BN.BIG, epoch int, alg RevocationAlgorithm, rng *amcl.RAND) (*CredentialRevocationInformation, error) {
 	if key == nil || rng == nil {
 		return nil, errors.Errorf("CreateCRI received nil input")
 	}
@@ -48,16 +56,31 @@ func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int
 	cri.RevocationAlg = int32(alg)
 	cri.Epoch = int64(epoch)
 
-	if alg == ALG_NO_REVOCATION {
+	var revocationData *AccumulatorRevocationData
+	switch alg {
+	case ALG_NO_REVOCATION:
 		// put a dummy PK in the proto
 		cri.EpochPk = Ecp2ToProto(GenG2)
-	} else {
+	case ALG_ACCUMULATOR:
 		// create epoch key
-		_, epochPk := WBBKeyGen(rng)
+		epochSk, epochPk := WBBKeyGen(rng)
 		cri.EpochPk = Ecp2ToProto(epochPk)
+
+		var err error
+		revocationData, err = accumulate(epochSk, unrevokedHandles)
+		if err != nil {
+			return nil, err
+		}
+		// only the accumulator is signed, the witnesses can be checked against it
+		cri.RevocationData, err = proto.Marshal(&AccumulatorRevocationData{Accumulator: revocationData.Accumulator})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal accumulator")
+		}
+	default:
+		return nil, errors.Errorf("the specified revocation algorithm is not supported.")
 	}
 
-	// sign epoch + epoch key with long term key
+	// sign epoch + epoch key (+ accumulator) with long term key
 	bytesToSign, err := proto.Marshal(cri)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal CRI")
@@ -70,11 +93,48 @@ func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int
 		return nil, err
 	}
 
-	if alg == ALG_NO_REVOCATION {
-		return cri, nil
-	} else {
-		return nil, errors.Errorf("the specified revocation algorithm is not supported.")
+	if alg == ALG_ACCUMULATOR {
+		cri.RevocationData, err = proto.Marshal(revocationData)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal revocation data")
+		}
+	}
+
+	return cri, nil
+}
+
+// accumulate computes the accumulator V = g1^{prod_i (sk + rh_i)} of the unrevoked handles rh_i
+// and, for every handle, the witness W_i = V^{1/(sk + rh_i)}
+func accumulate(sk *FP256BN.BIG, unrevokedHandles []*FP256BN.BIG) (*AccumulatorRevocationData, error) {
+	exponents := make([]*FP256BN.BIG, len(unrevokedHandles))
+	handles := make([][]byte, len(unrevokedHandles))
+	acc := FP256BN.NewBIGint(1)
+	for i, rh := range unrevokedHandles {
+		if rh == nil {
+			return nil, errors.Errorf("revocation handle %d is nil", i)
+		}
+		handles[i] = BigToBytes(rh)
+		for j := 0; j < i; j++ {
+			if bytes.Equal(handles[i], handles[j]) {
+				return nil, errors.Errorf("revocation handle %d is a duplicate", i)
+			}
+		}
+		exponents[i] = Modadd(sk, rh, GroupOrder)
+		acc = FP256BN.Modmul(acc, exponents[i], GroupOrder)
+	}
+
+	data := &AccumulatorRevocationData{
+		Accumulator: EcpToProto(GenG1.Mul(acc)),
+		Witnesses:   make([]*AccumulatorWitness, len(unrevokedHandles)),
+	}
+	for i := range unrevokedHandles {
+		exponents[i].Invmodp(GroupOrder)
+		data.Witnesses[i] = &AccumulatorWitness{
+			RevocationHandle: handles[i],
+			Witness:          EcpToProto(GenG1.Mul(FP256BN.Modmul(acc, exponents[i], GroupOrder))),
+		}
 	}
+	return data, nil
 }
 
 // VerifyEpochPK verifies that the revocation PK for a certain epoch is valid,
@@ -82,7 +142,35 @@ func CreateCRI(key *ecdsa.PrivateKey, unrevokedHandles []*FP256BN.BIG, epoch int
 // Note that even if we use no revocation (i.e., alg = ALG_NO_REVOCATION), we need
 // to verify the signature to make sure the issuer indeed signed that no revocation
 // is used in this epoch.
+// For ALG_ACCUMULATOR the signature also covers the accumulator, use VerifyCRI instead.
 func VerifyEpochPK(pk *ecdsa.PublicKey, epochPK *ECP2, epochPkSig []byte, epoch int, alg RevocationAlgorithm) error {
+	return verifyEpochPK(pk, epochPK, epochPkSig, epoch, alg, nil)
+}
+
+// VerifyCRI verifies that a CRI was signed with the long term revocation key.
+// For ALG_ACCUMULATOR, this also checks that the signed accumulator is the one the witnesses refer to.
+func VerifyCRI(pk *ecdsa.PublicKey, cri *CredentialRevocationInformation) error {
+	if cri == nil {
+		return errors.Errorf("CRI invalid: received nil input")
+	}
+	var signedData []byte
+	if RevocationAlgorithm(cri.RevocationAlg) == ALG_ACCUMULATOR {
+		revocationData := &AccumulatorRevocationData{}
+		err := proto.Unmarshal(cri.RevocationData, revocationData)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal revocation data")
+		}
+		signedData, err = proto.Marshal(&AccumulatorRevocationData{Accumulator: revocationData.Accumulator})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal accumulator")
+		}
+	}
+	return verifyEpochPK(pk, cri.EpochPk, cri.EpochPkSig, int(cri.Epoch), RevocationAlgorithm(cri.RevocationAlg), signedData)
+}
+
+// verifyEpochPK verifies the signature of the revocation authority on the epoch, the epoch PK
+// and the signed part of the revocation data
+func verifyEpochPK(pk *ecdsa.PublicKey, epochPK *ECP2, epochPkSig []byte, epoch int, alg RevocationAlgorithm, signedData []byte) error {
 	if pk == nil || epochPK == nil {
 		return errors.Errorf("EpochPK invalid: received nil input")
 	}
@@ -90,6 +178,7 @@ func VerifyEpochPK(pk *ecdsa.PublicKey, epochPK *ECP2, epochPkSig []byte, epoch
 	cri.RevocationAlg = int32(alg)
 	cri.EpochPk = epochPK
 	cri.Epoch = int64(epoch)
+	cri.RevocationData = signedData
 	bytesToSign, err := proto.Marshal(cri)
 	if err != nil {
 		return err
@@ -282,6 +282,20 @@ func (sig *Signature) Ver(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, a
 		return err
 	}
 
+	// the epoch PK, and the revocation data the non-revocation proof relies on,
+	// must be signed by the revocation authority for the current epoch
+	if sig.Epoch != int64(epoch) {
+		return errors.Errorf("signature invalid: signature is for epoch %d, but the current epoch is %d", sig.Epoch, epoch)
+	}
+	signedRevocationData, err := nonRevokedVer.signedRevocationData(sig.NonRevocationProof)
+	if err != nil {
+		return err
+	}
+	err = verifyEpochPK(revPk, sig.RevocationEpochPk, sig.RevocationPkSig, epoch, RevocationAlgorithm(sig.NonRevocationProof.RevocationAlg), signedRevocationData)
+	if err != nil {
+		return errors.WithMessage(err, "signature invalid: epoch PK is not valid")
+	}
+
 	i := sort.SearchInts(HiddenIndices, rhIndex)
 	proofSRh := ProofSAttrs[i]
 	nonRevokedProofBytes, err := nonRevokedVer.recomputeFSContribution(sig.NonRevocationProof, ProofC, Ecp2FromProto(sig.RevocationEpochPk), proofSRh)
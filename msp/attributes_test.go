@@ -0,0 +1,119 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/attrmgr"
+	m "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAttributesTestMSP(t *testing.T, ca *x509.Certificate, version MSPVersion) MSP {
+	theMsp, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: version}})
+	require.NoError(t, err)
+	fmspconf := &m.FabricMSPConfig{
+		Name:      "AttributesMSP",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})},
+		CryptoConfig: &m.FabricCryptoConfig{
+			SignatureHashFamily:            "SHA2",
+			IdentityIdentifierHashFunction: "SHA256",
+		},
+	}
+	fmspconfBytes, err := proto.Marshal(fmspconf)
+	require.NoError(t, err)
+	require.NoError(t, theMsp.Setup(&m.MSPConfig{Type: int32(FABRIC), Config: fmspconfBytes}))
+	return theMsp
+}
+
+// newAttributesTestIdentity returns an identity of theMsp whose certificate carries attrs
+func newAttributesTestIdentity(t *testing.T, theMsp MSP, ca *x509.Certificate, caKey crypto.Signer, attrs map[string]string) Identity {
+	template := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "user1.example.com"},
+		KeyUsage: x509.KeyUsageDigitalSignature,
+	}
+	if attrs != nil {
+		withAttrs := &x509.Certificate{}
+		require.NoError(t, attrmgr.New().AddAttributesToCert(&attrmgr.Attributes{Attrs: attrs}, withAttrs))
+		template.ExtraExtensions = withAttrs.Extensions
+	}
+	cert, _ := newTestCert(t, template, ca, caKey)
+
+	sID, err := proto.Marshal(&m.SerializedIdentity{
+		Mspid:   "AttributesMSP",
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+	})
+	require.NoError(t, err)
+	id, err := theMsp.DeserializeIdentity(sID)
+	require.NoError(t, err)
+	return id
+}
+
+func attributePrincipal(mspID, name, value string) *m.MSPPrincipal {
+	return &m.MSPPrincipal{
+		PrincipalClassification: m.MSPPrincipal_ATTRIBUTE,
+		Principal:               mustMarshal(&m.MSPAttribute{MspIdentifier: mspID, Name: name, Value: value}),
+	}
+}
+
+func mustMarshal(msg proto.Message) []byte {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+func TestSatisfiesAttributePrincipal(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	theMsp := newAttributesTestMSP(t, ca, MSPv1_4)
+	auditor := newAttributesTestIdentity(t, theMsp, ca, caKey, map[string]string{"role": "auditor", "dept": "finance"})
+	noAttrs := newAttributesTestIdentity(t, theMsp, ca, caKey, nil)
+
+	assert.NoError(t, auditor.SatisfiesPrincipal(attributePrincipal("AttributesMSP", "role", "auditor")))
+
+	err := auditor.SatisfiesPrincipal(attributePrincipal("AttributesMSP", "role", "admin"))
+	assert.EqualError(t, err, "the identity has value auditor for attribute role, expected admin")
+	err = noAttrs.SatisfiesPrincipal(attributePrincipal("AttributesMSP", "role", "auditor"))
+	assert.EqualError(t, err, "the identity does not have attribute role")
+	err = auditor.SatisfiesPrincipal(attributePrincipal("OtherMSP", "role", "auditor"))
+	assert.EqualError(t, err, "the identity is a member of a different MSP (expected OtherMSP, got AttributesMSP)")
+	err = auditor.SatisfiesPrincipal(&m.MSPPrincipal{PrincipalClassification: m.MSPPrincipal_ATTRIBUTE, Principal: []byte("garbage")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not unmarshal MSPAttribute from principal")
+
+	// combined with a role, as built by the policy parser
+	member := &m.MSPPrincipal{
+		PrincipalClassification: m.MSPPrincipal_ROLE,
+		Principal:               mustMarshal(&m.MSPRole{MspIdentifier: "AttributesMSP", Role: m.MSPRole_MEMBER}),
+	}
+	combined := &m.MSPPrincipal{
+		PrincipalClassification: m.MSPPrincipal_COMBINED,
+		Principal: mustMarshal(&m.CombinedPrincipal{Principals: []*m.MSPPrincipal{
+			member,
+			attributePrincipal("AttributesMSP", "role", "auditor"),
+			attributePrincipal("AttributesMSP", "dept", "finance"),
+		}}),
+	}
+	assert.NoError(t, auditor.SatisfiesPrincipal(combined))
+	assert.Error(t, noAttrs.SatisfiesPrincipal(combined))
+
+	// attribute principals are not supported before MSP v1.4
+	for _, version := range []MSPVersion{MSPv1_1, MSPv1_3} {
+		theMsp = newAttributesTestMSP(t, ca, version)
+		auditor = newAttributesTestIdentity(t, theMsp, ca, caKey, map[string]string{"role": "auditor"})
+		err = auditor.SatisfiesPrincipal(attributePrincipal("AttributesMSP", "role", "auditor"))
+		assert.EqualError(t, err, "invalid principal type 5")
+	}
+}
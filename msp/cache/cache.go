@@ -7,7 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package cache
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/msp"
 	pmsp "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
@@ -21,16 +25,59 @@ const (
 
 var mspLogger = flogging.MustGetLogger("msp")
 
+// Config holds the configuration of the caches of an MSP
+type Config struct {
+	// DeserializeIdentityCacheSize is the number of deserialized identities
+	// cached. If zero, a default size is used.
+	DeserializeIdentityCacheSize int
+	// ValidateIdentityCacheSize is the number of identity validation
+	// outcomes cached. If zero, a default size is used.
+	ValidateIdentityCacheSize int
+	// SatisfiesPrincipalCacheSize is the number of principal satisfaction
+	// outcomes cached. If zero, a default size is used.
+	SatisfiesPrincipalCacheSize int
+	// TTL is how long entries are cached. Entries never outlive the
+	// certificate of their identity. If zero, entries do not expire.
+	TTL time.Duration
+	// DisableValidationCache makes the identity validation and principal
+	// satisfaction outcomes, which depend on the validity of the identity,
+	// be determined by the underlying MSP every time, e.g. because it checks
+	// revocation online.
+	DisableValidationCache bool
+}
+
+// New returns a cached version of the given MSP, with the default cache sizes
+// and no expiry of cached entries.
 func New(o msp.MSP) (msp.MSP, error) {
+	return NewWithConfig(o, Config{})
+}
+
+// NewWithConfig returns a cached version of the given MSP, whose caches are
+// configured by conf.
+func NewWithConfig(o msp.MSP, conf Config) (msp.MSP, error) {
 	mspLogger.Debugf("Creating Cache-MSP instance")
 	if o == nil {
 		return nil, errors.Errorf("Invalid passed MSP. It must be different from nil.")
 	}
+	if conf.DeserializeIdentityCacheSize < 0 || conf.ValidateIdentityCacheSize < 0 || conf.SatisfiesPrincipalCacheSize < 0 {
+		return nil, errors.Errorf("Invalid cache sizes %d, %d and %d. They must not be negative.",
+			conf.DeserializeIdentityCacheSize, conf.ValidateIdentityCacheSize, conf.SatisfiesPrincipalCacheSize)
+	}
+	if conf.TTL < 0 {
+		return nil, errors.Errorf("Invalid cache TTL %s. It must not be negative.", conf.TTL)
+	}
+	if conf.DeserializeIdentityCacheSize == 0 {
+		conf.DeserializeIdentityCacheSize = deserializeIdentityCacheSize
+	}
+	if conf.ValidateIdentityCacheSize == 0 {
+		conf.ValidateIdentityCacheSize = validateIdentityCacheSize
+	}
+	if conf.SatisfiesPrincipalCacheSize == 0 {
+		conf.SatisfiesPrincipalCacheSize = satisfiesPrincipalCacheSize
+	}
 
-	theMsp := &cachedMSP{MSP: o}
-	theMsp.deserializeIdentityCache = newSecondChanceCache(deserializeIdentityCacheSize)
-	theMsp.satisfiesPrincipalCache = newSecondChanceCache(satisfiesPrincipalCacheSize)
-	theMsp.validateIdentityCache = newSecondChanceCache(validateIdentityCacheSize)
+	theMsp := &cachedMSP{MSP: o, conf: conf, now: time.Now}
+	theMsp.cleanCash()
 
 	return theMsp, nil
 }
@@ -38,15 +85,96 @@ func New(o msp.MSP) (msp.MSP, error) {
 type cachedMSP struct {
 	msp.MSP
 
+	conf Config
+	now  func() time.Time
+
 	// cache for DeserializeIdentity.
-	deserializeIdentityCache *secondChanceCache
+	deserializeIdentityCache *statsCache
 
 	// cache for validateIdentity
-	validateIdentityCache *secondChanceCache
+	validateIdentityCache *statsCache
 
 	// basically a map of principals=>identities=>stringified to booleans
 	// specifying whether this identity satisfies this principal
-	satisfiesPrincipalCache *secondChanceCache
+	satisfiesPrincipalCache *statsCache
+}
+
+// statsCache is a secondChanceCache whose entries may expire, and which
+// counts its hits, misses and evictions
+type statsCache struct {
+	*secondChanceCache
+	now func() time.Time
+
+	hits      int64
+	misses    int64
+	evictions int64
+
+	// counters of the metrics system, nil if it is not enabled
+	hitsCounter      metrics.Counter
+	missesCounter    metrics.Counter
+	evictionsCounter metrics.Counter
+}
+
+type cacheEntry struct {
+	value interface{}
+	// zero if the entry does not expire
+	expires time.Time
+}
+
+func (c *cachedMSP) newStatsCache(name string, size int) *statsCache {
+	cache := &statsCache{secondChanceCache: newSecondChanceCache(size), now: func() time.Time { return c.now() }}
+	if metrics.RootScope != nil {
+		mspID, _ := c.MSP.GetIdentifier()
+		scope := metrics.RootScope.SubScope("msp_cache").Tagged(map[string]string{
+			"msp":   mspID,
+			"cache": name,
+		})
+		cache.hitsCounter = scope.Counter("hits")
+		cache.missesCounter = scope.Counter("misses")
+		cache.evictionsCounter = scope.Counter("evictions")
+	}
+	return cache
+}
+
+// expiry returns the time at which the cached entries about the given
+// identity expire, or the zero time if they do not expire
+func (c *cachedMSP) expiry(id msp.Identity) time.Time {
+	if c.conf.TTL == 0 {
+		return time.Time{}
+	}
+	expires := c.now().Add(c.conf.TTL)
+	if notAfter := id.ExpiresAt(); !notAfter.IsZero() && notAfter.Before(expires) {
+		expires = notAfter
+	}
+	return expires
+}
+
+func (cache *statsCache) get(key string) (interface{}, bool) {
+	v, ok := cache.secondChanceCache.get(key)
+	if ok {
+		entry := v.(*cacheEntry)
+		if entry.expires.IsZero() || cache.now().Before(entry.expires) {
+			atomic.AddInt64(&cache.hits, 1)
+			if cache.hitsCounter != nil {
+				cache.hitsCounter.Inc(1)
+			}
+			return entry.value, true
+		}
+	}
+	atomic.AddInt64(&cache.misses, 1)
+	if cache.missesCounter != nil {
+		cache.missesCounter.Inc(1)
+	}
+	return nil, false
+}
+
+func (cache *statsCache) add(key string, value interface{}, expires time.Time) {
+	if cache.secondChanceCache.add(key, &cacheEntry{value: value, expires: expires}) {
+		atomic.AddInt64(&cache.evictions, 1)
+		if cache.evictionsCounter != nil {
+			cache.evictionsCounter.Inc(1)
+		}
+	}
 }
 
 type cachedIdentity struct {
@@ -62,6 +190,16 @@ func (id *cachedIdentity) Validate() error {
 	return id.cache.Validate(id.Identity)
 }
 
+// CheckRevocationOnline checks the revocation status of the cached identity,
+// if it supports online revocation checks. The outcome is never cached.
+func (id *cachedIdentity) CheckRevocationOnline(checker msp.RevocationChecker, failOpen bool) error {
+	rc, ok := id.Identity.(msp.RevocationCheckable)
+	if !ok {
+		return nil
+	}
+	return rc.CheckRevocationOnline(checker, failOpen)
+}
+
 func (c *cachedMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
 	id, ok := c.deserializeIdentityCache.get(string(serializedIdentity))
 	if ok {
@@ -73,7 +211,7 @@ func (c *cachedMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity
 
 	id, err := c.MSP.DeserializeIdentity(serializedIdentity)
 	if err == nil {
-		c.deserializeIdentityCache.add(string(serializedIdentity), id)
+		c.deserializeIdentityCache.add(string(serializedIdentity), id, c.expiry(id.(msp.Identity)))
 		return &cachedIdentity{
 			cache:    c,
 			Identity: id.(msp.Identity),
@@ -83,12 +221,20 @@ func (c *cachedMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity
 }
 
 func (c *cachedMSP) Setup(config *pmsp.MSPConfig) error {
+	err := c.MSP.Setup(config)
+
+	// the caches are re-created once the MSP knows its identifier,
+	// which tags their metrics
 	c.cleanCash()
 
-	return c.MSP.Setup(config)
+	return err
 }
 
 func (c *cachedMSP) Validate(id msp.Identity) error {
+	if c.conf.DisableValidationCache {
+		return c.MSP.Validate(id)
+	}
+
 	identifier := id.GetIdentifier()
 	key := string(identifier.Mspid + ":" + identifier.Id)
 
@@ -100,13 +246,17 @@ func (c *cachedMSP) Validate(id msp.Identity) error {
 
 	err := c.MSP.Validate(id)
 	if err == nil {
-		c.validateIdentityCache.add(key, true)
+		c.validateIdentityCache.add(key, true, c.expiry(id))
 	}
 
 	return err
 }
 
 func (c *cachedMSP) SatisfiesPrincipal(id msp.Identity, principal *pmsp.MSPPrincipal) error {
+	if c.conf.DisableValidationCache {
+		return c.MSP.SatisfiesPrincipal(id, principal)
+	}
+
 	identifier := id.GetIdentifier()
 	identityKey := string(identifier.Mspid + ":" + identifier.Id)
 	principalKey := string(principal.PrincipalClassification) + string(principal.Principal)
@@ -123,14 +273,14 @@ func (c *cachedMSP) SatisfiesPrincipal(id msp.Identity, principal *pmsp.MSPPrinc
 
 	err := c.MSP.SatisfiesPrincipal(id, principal)
 
-	c.satisfiesPrincipalCache.add(key, err)
+	c.satisfiesPrincipalCache.add(key, err, c.expiry(id))
 	return err
 }
 
 func (c *cachedMSP) cleanCash() error {
-	c.deserializeIdentityCache = newSecondChanceCache(deserializeIdentityCacheSize)
-	c.satisfiesPrincipalCache = newSecondChanceCache(satisfiesPrincipalCacheSize)
-	c.validateIdentityCache = newSecondChanceCache(validateIdentityCacheSize)
+	c.deserializeIdentityCache = c.newStatsCache("deserialize_identity", c.conf.DeserializeIdentityCacheSize)
+	c.satisfiesPrincipalCache = c.newStatsCache("satisfies_principal", c.conf.SatisfiesPrincipalCacheSize)
+	c.validateIdentityCache = c.newStatsCache("validate_identity", c.conf.ValidateIdentityCacheSize)
 
 	return nil
 }
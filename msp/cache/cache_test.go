@@ -9,7 +9,9 @@ package cache
 import (
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/mocks"
 	msp2 "github.com/hyperledger/fabric/protos/msp"
@@ -321,3 +323,225 @@ func TestSatisfiesPrincipal(t *testing.T) {
 	assert.NotNil(t, v)
 	assert.Contains(t, "Invalid", v.(error).Error())
 }
+
+func TestDisableValidationCache(t *testing.T) {
+	mockMSP := &mocks.MockMSP{}
+	i, err := NewWithConfig(mockMSP, Config{DisableValidationCache: true})
+	assert.NoError(t, err)
+
+	mockIdentity := &mocks.MockIdentity{ID: "Alice"}
+	mockIdentity.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	principal := &msp2.MSPPrincipal{PrincipalClassification: msp2.MSPPrincipal_ROLE, Principal: []byte("member")}
+
+	// the identity is valid at first, and is revoked afterwards
+	mockMSP.On("Validate", mockIdentity).Return(nil).Once()
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal).Return(nil).Once()
+	assert.NoError(t, i.Validate(mockIdentity))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal))
+
+	mockMSP.On("Validate", mockIdentity).Return(errors.New("The certificate has been revoked")).Once()
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal).Return(errors.New("The certificate has been revoked")).Once()
+	assert.EqualError(t, i.Validate(mockIdentity), "The certificate has been revoked")
+	assert.EqualError(t, i.SatisfiesPrincipal(mockIdentity, principal), "The certificate has been revoked")
+	mockMSP.AssertExpectations(t)
+}
+
+type revocationCheckableIdentity struct {
+	*mocks.MockIdentity
+	err error
+}
+
+func (id *revocationCheckableIdentity) CheckRevocationOnline(checker msp.RevocationChecker, failOpen bool) error {
+	return id.err
+}
+
+func TestCheckRevocationOnline(t *testing.T) {
+	mockMSP := &mocks.MockMSP{}
+	i, err := New(mockMSP)
+	assert.NoError(t, err)
+
+	revokedIdentity := &revocationCheckableIdentity{MockIdentity: &mocks.MockIdentity{ID: "Alice"}, err: errors.New("The certificate has been revoked")}
+	mockMSP.On("DeserializeIdentity", []byte{1}).Return(revokedIdentity, nil)
+	mockMSP.On("DeserializeIdentity", []byte{2}).Return(&mocks.MockIdentity{ID: "Bob"}, nil)
+
+	// the check is forwarded to the cached identity, every time
+	for n := 0; n < 2; n++ {
+		id, err := i.DeserializeIdentity([]byte{1})
+		assert.NoError(t, err)
+		assert.EqualError(t, id.(msp.RevocationCheckable).CheckRevocationOnline(nil, false), "The certificate has been revoked")
+	}
+
+	// identities that cannot be checked online are not
+	id, err := i.DeserializeIdentity([]byte{2})
+	assert.NoError(t, err)
+	assert.NoError(t, id.(msp.RevocationCheckable).CheckRevocationOnline(nil, false))
+}
+
+func TestNewWithConfig(t *testing.T) {
+	_, err := NewWithConfig(&mocks.MockMSP{}, Config{ValidateIdentityCacheSize: -1})
+	assert.EqualError(t, err, "Invalid cache sizes 0, -1 and 0. They must not be negative.")
+	_, err = NewWithConfig(&mocks.MockMSP{}, Config{TTL: -time.Second})
+	assert.EqualError(t, err, "Invalid cache TTL -1s. It must not be negative.")
+
+	i, err := NewWithConfig(&mocks.MockMSP{}, Config{DeserializeIdentityCacheSize: 10, SatisfiesPrincipalCacheSize: 1000})
+	assert.NoError(t, err)
+	assert.Len(t, i.(*cachedMSP).deserializeIdentityCache.items, 10)
+	assert.Len(t, i.(*cachedMSP).validateIdentityCache.items, validateIdentityCacheSize)
+	assert.Len(t, i.(*cachedMSP).satisfiesPrincipalCache.items, 1000)
+
+	// the sizes are preserved when the MSP is set up again
+	i.(*cachedMSP).MSP.(*mocks.MockMSP).On("Setup", (*msp2.MSPConfig)(nil)).Return(nil)
+	assert.NoError(t, i.Setup(nil))
+	assert.Len(t, i.(*cachedMSP).deserializeIdentityCache.items, 10)
+}
+
+func TestExpiry(t *testing.T) {
+	now := time.Now()
+	mockMSP := &mocks.MockMSP{}
+	i, err := NewWithConfig(mockMSP, Config{TTL: time.Minute})
+	assert.NoError(t, err)
+	i.(*cachedMSP).now = func() time.Time { return now }
+
+	alice := &mocks.MockIdentity{ID: "Alice"}
+	alice.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	alice.On("ExpiresAt").Return(now.Add(time.Hour))
+	bob := &mocks.MockIdentity{ID: "Bob"}
+	bob.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Bob"})
+	bob.On("ExpiresAt").Return(now.Add(time.Second))
+	mockMSP.On("Validate", alice).Return(nil)
+	mockMSP.On("Validate", bob).Return(nil)
+
+	assert.NoError(t, i.Validate(alice))
+	assert.NoError(t, i.Validate(bob))
+	assert.NoError(t, i.Validate(alice))
+	assert.NoError(t, i.Validate(bob))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 2)
+
+	// the entry of bob expires with its certificate
+	now = now.Add(2 * time.Second)
+	assert.NoError(t, i.Validate(alice))
+	assert.NoError(t, i.Validate(bob))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 3)
+
+	// the entry of alice expires with the TTL
+	now = now.Add(time.Minute)
+	assert.NoError(t, i.Validate(alice))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 4)
+
+	// without TTL, entries do not expire
+	i, err = New(mockMSP)
+	assert.NoError(t, err)
+	mockMSP.On("DeserializeIdentity", []byte{1, 2, 3}).Return(bob, nil)
+	_, err = i.DeserializeIdentity([]byte{1, 2, 3})
+	assert.NoError(t, err)
+	i.(*cachedMSP).now = func() time.Time { return now.Add(time.Hour) }
+	_, err = i.DeserializeIdentity([]byte{1, 2, 3})
+	assert.NoError(t, err)
+	mockMSP.AssertNumberOfCalls(t, "DeserializeIdentity", 1)
+}
+
+func TestCacheStats(t *testing.T) {
+	mockMSP := &mocks.MockMSP{}
+	i, err := NewWithConfig(mockMSP, Config{SatisfiesPrincipalCacheSize: 1})
+	assert.NoError(t, err)
+	c := i.(*cachedMSP).satisfiesPrincipalCache
+
+	mockIdentity := &mocks.MockIdentity{ID: "Alice"}
+	mockIdentity.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	principal1 := &msp2.MSPPrincipal{PrincipalClassification: msp2.MSPPrincipal_IDENTITY, Principal: []byte{1}}
+	principal2 := &msp2.MSPPrincipal{PrincipalClassification: msp2.MSPPrincipal_IDENTITY, Principal: []byte{2}}
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal1).Return(nil)
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal2).Return(nil)
+
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal1))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal1))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal2))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal2))
+
+	assert.Equal(t, int64(2), c.hits)
+	assert.Equal(t, int64(2), c.misses)
+	assert.Equal(t, int64(1), c.evictions)
+}
+
+func TestCacheMetrics(t *testing.T) {
+	scope := &fakeScope{counters: map[string]*fakeCounter{}}
+	metrics.RootScope = scope
+	defer func() { metrics.RootScope = nil }()
+
+	mockMSP := &mocks.MockMSP{}
+	mockMSP.On("GetIdentifier").Return("MSP", nil)
+	mockMSP.On("Setup", (*msp2.MSPConfig)(nil)).Return(nil)
+	i, err := NewWithConfig(mockMSP, Config{SatisfiesPrincipalCacheSize: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, i.Setup(nil))
+
+	mockIdentity := &mocks.MockIdentity{ID: "Alice"}
+	mockIdentity.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	principal1 := &msp2.MSPPrincipal{PrincipalClassification: msp2.MSPPrincipal_IDENTITY, Principal: []byte{1}}
+	principal2 := &msp2.MSPPrincipal{PrincipalClassification: msp2.MSPPrincipal_IDENTITY, Principal: []byte{2}}
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal1).Return(nil)
+	mockMSP.On("SatisfiesPrincipal", mockIdentity, principal2).Return(nil)
+
+	taggedScopes := scope.tagged
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal1))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal1))
+	assert.NoError(t, i.SatisfiesPrincipal(mockIdentity, principal2))
+
+	// the counters are created along with the caches, not on every hit or miss
+	assert.Equal(t, taggedScopes, scope.tagged)
+	assert.Equal(t, int64(1), scope.counters["msp_cache.MSP.satisfies_principal.hits"].value)
+	assert.Equal(t, int64(2), scope.counters["msp_cache.MSP.satisfies_principal.misses"].value)
+	assert.Equal(t, int64(1), scope.counters["msp_cache.MSP.satisfies_principal.evictions"].value)
+}
+
+type fakeCounter struct {
+	value int64
+}
+
+func (c *fakeCounter) Inc(delta int64) {
+	c.value += delta
+}
+
+type fakeScope struct {
+	prefix   string
+	tagged   int
+	counters map[string]*fakeCounter
+	root     *fakeScope
+}
+
+func (s *fakeScope) getRoot() *fakeScope {
+	if s.root == nil {
+		return s
+	}
+	return s.root
+}
+
+func (s *fakeScope) Counter(name string) metrics.Counter {
+	c, exists := s.getRoot().counters[s.prefix+name]
+	if !exists {
+		c = &fakeCounter{}
+		s.getRoot().counters[s.prefix+name] = c
+	}
+	return c
+}
+
+func (s *fakeScope) Gauge(name string) metrics.Gauge {
+	return nil
+}
+
+func (s *fakeScope) Tagged(tags map[string]string) metrics.Scope {
+	s.getRoot().tagged++
+	return &fakeScope{prefix: s.prefix + tags["msp"] + "." + tags["cache"] + ".", root: s.getRoot()}
+}
+
+func (s *fakeScope) SubScope(name string) metrics.Scope {
+	return &fakeScope{prefix: s.prefix + name + ".", root: s.getRoot()}
+}
+
+func (s *fakeScope) Start() error {
+	return nil
+}
+
+func (s *fakeScope) Close() error {
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"time"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
+)
+
+const revocationStatusCacheSize = 1000
+
+// NewRevocationChecker returns a msp.RevocationChecker that caches, for the given ttl,
+// the revocation statuses determined by checker. Failures to determine the status
+// are not cached.
+func NewRevocationChecker(checker msp.RevocationChecker, ttl time.Duration) (msp.RevocationChecker, error) {
+	if checker == nil {
+		return nil, errors.Errorf("Invalid passed RevocationChecker. It must be different from nil.")
+	}
+
+	return &cachedRevocationChecker{
+		RevocationChecker: checker,
+		ttl:               ttl,
+		cache:             newSecondChanceCache(revocationStatusCacheSize),
+		now:               time.Now,
+	}, nil
+}
+
+type cachedRevocationChecker struct {
+	msp.RevocationChecker
+
+	ttl   time.Duration
+	cache *secondChanceCache
+	now   func() time.Time
+}
+
+type cachedRevocationStatus struct {
+	status  msp.RevocationStatus
+	expires time.Time
+}
+
+func (c *cachedRevocationChecker) CheckRevocation(cert, issuer *x509.Certificate) (msp.RevocationStatus, error) {
+	// certificates are identified by their issuer and serial number
+	issuerHash := sha256.Sum256(issuer.Raw)
+	key := string(issuerHash[:]) + cert.SerialNumber.String()
+
+	v, ok := c.cache.get(key)
+	if ok && c.now().Before(v.(*cachedRevocationStatus).expires) {
+		return v.(*cachedRevocationStatus).status, nil
+	}
+
+	status, err := c.RevocationChecker.CheckRevocation(cert, issuer)
+	if err == nil {
+		c.cache.add(key, &cachedRevocationStatus{status: status, expires: c.now().Add(c.ttl)})
+	}
+	return status, err
+}
@@ -0,0 +1,75 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockRevocationChecker struct {
+	mock.Mock
+}
+
+func (m *mockRevocationChecker) CheckRevocation(cert, issuer *x509.Certificate) (msp.RevocationStatus, error) {
+	args := m.Called(cert, issuer)
+	return args.Get(0).(msp.RevocationStatus), args.Error(1)
+}
+
+func TestNewRevocationChecker(t *testing.T) {
+	checker, err := NewRevocationChecker(nil, time.Minute)
+	assert.Error(t, err)
+	assert.Nil(t, checker)
+	assert.Contains(t, err.Error(), "Invalid passed RevocationChecker. It must be different from nil.")
+}
+
+func TestCachedRevocationChecker(t *testing.T) {
+	issuer := &x509.Certificate{Raw: []byte("issuer")}
+	cert1 := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	cert2 := &x509.Certificate{SerialNumber: big.NewInt(2)}
+
+	mockChecker := &mockRevocationChecker{}
+	mockChecker.On("CheckRevocation", cert1, issuer).Return(msp.RevocationStatusGood, nil)
+	mockChecker.On("CheckRevocation", cert2, issuer).Return(msp.RevocationStatusUnknown, errors.New("responder unavailable")).Once()
+	mockChecker.On("CheckRevocation", cert2, issuer).Return(msp.RevocationStatusRevoked, nil)
+
+	checker, err := NewRevocationChecker(mockChecker, time.Minute)
+	assert.NoError(t, err)
+	now := time.Now()
+	checker.(*cachedRevocationChecker).now = func() time.Time { return now }
+
+	// statuses are cached
+	for i := 0; i < 2; i++ {
+		status, err := checker.CheckRevocation(cert1, issuer)
+		assert.NoError(t, err)
+		assert.Equal(t, msp.RevocationStatusGood, status)
+	}
+	mockChecker.AssertNumberOfCalls(t, "CheckRevocation", 1)
+
+	// failures are not cached
+	status, err := checker.CheckRevocation(cert2, issuer)
+	assert.Error(t, err)
+	assert.Equal(t, msp.RevocationStatusUnknown, status)
+	status, err = checker.CheckRevocation(cert2, issuer)
+	assert.NoError(t, err)
+	assert.Equal(t, msp.RevocationStatusRevoked, status)
+	mockChecker.AssertNumberOfCalls(t, "CheckRevocation", 3)
+
+	// statuses expire after the TTL
+	now = now.Add(2 * time.Minute)
+	status, err = checker.CheckRevocation(cert1, issuer)
+	assert.NoError(t, err)
+	assert.Equal(t, msp.RevocationStatusGood, status)
+	mockChecker.AssertNumberOfCalls(t, "CheckRevocation", 4)
+}
@@ -69,14 +69,16 @@ func (cache *secondChanceCache) get(key string) (interface{}, bool) {
 	return item.value, true
 }
 
-func (cache *secondChanceCache) add(key string, value interface{}) {
+// add stores value under key, and returns true if another item
+// was purged to make room for it.
+func (cache *secondChanceCache) add(key string, value interface{}) bool {
 	cache.rwlock.Lock()
 	defer cache.rwlock.Unlock()
 
 	if old, ok := cache.table[key]; ok {
 		old.value = value
 		atomic.StoreInt32(&old.referenced, 1)
-		return
+		return false
 	}
 
 	var item cacheItem
@@ -90,7 +92,7 @@ func (cache *secondChanceCache) add(key string, value interface{}) {
 		// cache is not full, so just store the new item at the end of the list
 		cache.table[key] = &item
 		cache.items[num] = &item
-		return
+		return false
 	}
 
 	// starts victim scan since cache is full
@@ -103,7 +105,7 @@ func (cache *secondChanceCache) add(key string, value interface{}) {
 			cache.table[key] = &item
 			cache.items[cache.position] = &item
 			cache.position = (cache.position + 1) % size
-			return
+			return true
 		}
 
 		// referenced bit is set to false so that this item will be get purged
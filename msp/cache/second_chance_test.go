@@ -18,19 +18,19 @@ func TestSecondChanceCache(t *testing.T) {
 	cache := newSecondChanceCache(2)
 	assert.NotNil(t, cache)
 
-	cache.add("a", "xyz")
+	assert.False(t, cache.add("a", "xyz"))
 
 	obj, ok := cache.get("a")
 	assert.True(t, ok)
 	assert.Equal(t, "xyz", obj.(string))
 
-	cache.add("b", "123")
+	assert.False(t, cache.add("b", "123"))
 
 	obj, ok = cache.get("b")
 	assert.True(t, ok)
 	assert.Equal(t, "123", obj.(string))
 
-	cache.add("c", "777")
+	assert.True(t, cache.add("c", "777"))
 
 	obj, ok = cache.get("c")
 	assert.True(t, ok)
@@ -42,7 +42,7 @@ func TestSecondChanceCache(t *testing.T) {
 	_, ok = cache.get("b")
 	assert.True(t, ok)
 
-	cache.add("b", "456")
+	assert.False(t, cache.add("b", "456"))
 
 	obj, ok = cache.get("b")
 	assert.True(t, ok)
@@ -19,6 +19,7 @@ package msp
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -69,6 +70,14 @@ func isECDSASignedCert(cert *x509.Certificate) bool {
 		cert.SignatureAlgorithm == x509.ECDSAWithSHA512
 }
 
+// isED25519Cert returns true if the public key certified by cert is an
+// Ed25519 key. Ed25519 signatures are computed over the message itself,
+// hence messages signed by such identities are not hashed beforehand.
+func isED25519Cert(cert *x509.Certificate) bool {
+	_, ok := cert.PublicKey.(ed25519.PublicKey)
+	return ok
+}
+
 // sanitizeECDSASignedCert checks that the signatures signing a cert
 // is in low-S. This is checked against the public key of parentCert.
 // If the signature is not in low-S, then a new certificate is generated
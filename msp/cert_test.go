@@ -18,6 +18,7 @@ package msp
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
@@ -28,7 +29,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/signer"
 	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,6 +48,86 @@ func TestSanitizeCertWithRSA(t *testing.T) {
 	assert.True(t, result)
 }
 
+func TestED25519Identity(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ed25519"},
+		NotBefore:    time.Now().Add(-1 * time.Hour),
+		NotAfter:     time.Now().Add(1 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+	assert.True(t, isED25519Cert(cert))
+	assert.False(t, isECDSASignedCert(cert))
+
+	csp := factory.GetDefault()
+	mspInst := &bccspmsp{
+		bccsp: csp,
+		cryptoConfig: &msp.FabricCryptoConfig{
+			SignatureHashFamily:            bccsp.SHA2,
+			IdentityIdentifierHashFunction: bccsp.SHA256,
+		},
+	}
+	pk, err := csp.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	skDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	sk, err := csp.KeyImport(skDER, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	cryptoSigner, err := signer.New(csp, sk)
+	assert.NoError(t, err)
+
+	id, err := newSigningIdentity(cert, pk, cryptoSigner, mspInst)
+	assert.NoError(t, err)
+
+	// Ed25519 identities sign the message, not its digest
+	msg := []byte("hello world")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.True(t, ed25519.Verify(pub, msg, sig))
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.EqualError(t, id.Verify([]byte("hello world!"), sig), "The signature is invalid")
+}
+
+func TestED25519RequiresMSPv14(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ed25519"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+
+	opts := x509.VerifyOptions{Roots: x509.NewCertPool()}
+	opts.Roots.AddCert(cert)
+
+	for _, version := range []MSPVersion{MSPv1_0, MSPv1_1, MSPv1_3} {
+		mspInst := &bccspmsp{version: version, opts: &opts}
+		_, err = mspInst.getUniqueValidationChain(cert, opts)
+		assert.EqualError(t, err, "Ed25519 certificates require MSP version 1.4 or later")
+	}
+
+	mspInst := &bccspmsp{version: MSPv1_4, opts: &opts}
+	chain, err := mspInst.getUniqueValidationChain(cert, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []*x509.Certificate{cert}, chain)
+}
+
 func TestSanitizeCertInvalidInput(t *testing.T) {
 	_, err := sanitizeECDSASignedCert(nil, nil)
 	assert.Error(t, err)
@@ -16,6 +16,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -30,10 +31,10 @@ type OrganizationalUnitIdentifiersConfiguration struct {
 	OrganizationalUnitIdentifier string `yaml:"OrganizationalUnitIdentifier,omitempty"`
 }
 
-// NodeOUs contains information on how to tell apart clients, peers and orderers
+// NodeOUs contains information on how to tell apart clients, peers, admins and orderers
 // based on OUs. If the check is enforced, by setting Enabled to true,
-// the MSP will consider an identity valid if it is an identity of a client, a peer or
-// an orderer. An identity should have only one of these special OUs.
+// the MSP will consider an identity valid if it is an identity of a client, a peer,
+// an admin or an orderer. An identity should have only one of these special OUs.
 type NodeOUs struct {
 	// Enable activates the OU enforcement
 	Enable bool `yaml:"Enable,omitempty"`
@@ -41,6 +42,11 @@ type NodeOUs struct {
 	ClientOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"ClientOUIdentifier,omitempty"`
 	// PeerOUIdentifier specifies how to recognize peers by OU
 	PeerOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"PeerOUIdentifier,omitempty"`
+	// AdminOUIdentifier specifies how to recognize admins by OU. If set,
+	// the admincerts folder may be empty. It requires MSP v1.4
+	AdminOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"AdminOUIdentifier,omitempty"`
+	// OrdererOUIdentifier specifies how to recognize orderers by OU. It requires MSP v1.4
+	OrdererOUIdentifier *OrganizationalUnitIdentifiersConfiguration `yaml:"OrdererOUIdentifier,omitempty"`
 }
 
 // Configuration represents the accessory configuration an MSP can be equipped with.
@@ -52,6 +58,28 @@ type Configuration struct {
 	// NodeOUs enables the MSP to tell apart clients, peers and orderers based
 	// on the identity's OU.
 	NodeOUs *NodeOUs `yaml:"NodeOUs,omitempty"`
+	// HybridPublicKeys enables the MSP to accept identities whose certificates
+	// carry hybrid public keys. All the nodes of the network are then required
+	// to use a BCCSP that supports hybrid keys.
+	HybridPublicKeys bool `yaml:"HybridPublicKeys,omitempty"`
+}
+
+// loadNodeOU returns the FabricOUIdentifier of the given node OU configuration,
+// or nil if the configuration is not set
+func loadNodeOU(dir, name string, conf *OrganizationalUnitIdentifiersConfiguration) *msp.FabricOUIdentifier {
+	if conf == nil || len(conf.OrganizationalUnitIdentifier) == 0 {
+		return nil
+	}
+
+	nodeOU := &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: conf.OrganizationalUnitIdentifier}
+	f := filepath.Join(dir, conf.Certificate)
+	raw, err := readFile(f)
+	if err != nil {
+		mspLogger.Debugf("Failed loading %s certificate at [%s]: [%s]", name, f, err)
+	} else {
+		nodeOU.Certificate = raw
+	}
+	return nodeOU
 }
 
 func readFile(file string) ([]byte, error) {
@@ -216,10 +244,9 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 		return nil, errors.WithMessage(err, fmt.Sprintf("could not load a valid ca certificate from directory %s", cacertDir))
 	}
 
-	admincert, err := getPemMaterialFromDir(admincertDir)
-	if err != nil || len(admincert) == 0 {
-		return nil, errors.WithMessage(err, fmt.Sprintf("could not load a valid admin certificate from directory %s", admincertDir))
-	}
+	// the admin certificates are checked once the configuration
+	// file is loaded, since admins may be told apart by OU
+	admincert, admincertErr := getPemMaterialFromDir(admincertDir)
 
 	intermediatecerts, err := getPemMaterialFromDir(intermediatecertsDir)
 	if os.IsNotExist(err) {
@@ -257,6 +284,7 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 	// otherwise skip it
 	var ouis []*msp.FabricOUIdentifier
 	var nodeOUs *msp.FabricNodeOUs
+	var hybridPublicKeys bool
 	_, err = os.Stat(configFile)
 	if err == nil {
 		// load the file, if there is a failure in loading it then
@@ -272,6 +300,8 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 			return nil, errors.Wrapf(err, "failed unmarshalling configuration file at [%s]", configFile)
 		}
 
+		hybridPublicKeys = configuration.HybridPublicKeys
+
 		// Prepare OrganizationalUnitIdentifiers
 		if len(configuration.OrganizationalUnitIdentifiers) > 0 {
 			for _, ouID := range configuration.OrganizationalUnitIdentifiers {
@@ -324,15 +354,30 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 			} else {
 				nodeOUs.PeerOuIdentifier.Certificate = raw
 			}
+
+			// AdminOU and OrdererOU are optional
+			nodeOUs.AdminOuIdentifier = loadNodeOU(dir, "AdminOU", configuration.NodeOUs.AdminOUIdentifier)
+			nodeOUs.OrdererOuIdentifier = loadNodeOU(dir, "OrdererOU", configuration.NodeOUs.OrdererOUIdentifier)
 		}
 	} else {
 		mspLogger.Debugf("MSP configuration file not found at [%s]: [%s]", configFile, err)
 	}
 
+	if admincertErr != nil || len(admincert) == 0 {
+		if nodeOUs == nil || nodeOUs.AdminOuIdentifier == nil {
+			if admincertErr == nil {
+				return nil, errors.Errorf("could not load a valid admin certificate from directory %s", admincertDir)
+			}
+			return nil, errors.WithMessage(admincertErr, fmt.Sprintf("could not load a valid admin certificate from directory %s", admincertDir))
+		}
+		mspLogger.Debugf("No admin certificate loaded from [%s], admins are told apart by OU: [%v]", admincertDir, admincertErr)
+	}
+
 	// Set FabricCryptoConfig
 	cryptoConfig := &msp.FabricCryptoConfig{
 		SignatureHashFamily:            bccsp.SHA2,
 		IdentityIdentifierHashFunction: bccsp.SHA256,
+		HybridPublicKeys:               hybridPublicKeys,
 	}
 
 	// Compose FabricMSPConfig
@@ -363,6 +408,9 @@ const (
 	IdemixConfigFileIssuerPublicKey     = "IssuerPublicKey"
 	IdemixConfigFileRevocationPublicKey = "RevocationPublicKey"
 	IdemixConfigFileSigner              = "SignerConfig"
+	// IdemixConfigFileCredentialRevocationInformation contains the CRI of the current epoch;
+	// when present, it sets the epoch of the MSP and replaces the CRI of the signer config
+	IdemixConfigFileCredentialRevocationInformation = "CredentialRevocationInformation"
 )
 
 // GetIdemixMspConfig returns the configuration for the Idemix MSP
@@ -393,6 +441,19 @@ func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
 		idemixConfig.Signer = signerConfig
 	}
 
+	criBytes, err := readFile(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigFileCredentialRevocationInformation))
+	if err == nil {
+		cri := &idemix.CredentialRevocationInformation{}
+		err = proto.Unmarshal(criBytes, cri)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal credential revocation information")
+		}
+		idemixConfig.Epoch = cri.Epoch
+		if idemixConfig.Signer != nil {
+			idemixConfig.Signer.CredentialRevocationInformation = criBytes
+		}
+	}
+
 	confBytes, err := proto.Marshal(idemixConfig)
 	if err != nil {
 		return nil, err
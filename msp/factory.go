@@ -16,6 +16,7 @@ const (
 	MSPv1_0 = iota
 	MSPv1_1
 	MSPv1_3
+	MSPv1_4
 )
 
 // NewOpts represent
@@ -36,6 +37,14 @@ func (o *NewBaseOpts) GetVersion() MSPVersion {
 // BCCSPNewOpts contains the options to instantiate a new BCCSP-based (X509) MSP
 type BCCSPNewOpts struct {
 	NewBaseOpts
+
+	// RevocationChecker, if not nil, is used to check online whether the
+	// identities validated by the MSP have been revoked
+	RevocationChecker RevocationChecker
+
+	// RevocationCheckFailOpen makes the MSP accept identities whose revocation
+	// status cannot be determined by the RevocationChecker, instead of rejecting them
+	RevocationCheckFailOpen bool
 }
 
 // IdemixNewOpts contains the options to instantiate a new Idemix-based MSP
@@ -47,18 +56,31 @@ type IdemixNewOpts struct {
 func New(opts NewOpts) (MSP, error) {
 	switch opts.(type) {
 	case *BCCSPNewOpts:
+		var theMsp MSP
+		var err error
 		switch opts.GetVersion() {
 		case MSPv1_0:
-			return newBccspMsp(MSPv1_0)
+			theMsp, err = newBccspMsp(MSPv1_0)
 		case MSPv1_1:
-			return newBccspMsp(MSPv1_1)
+			theMsp, err = newBccspMsp(MSPv1_1)
 		case MSPv1_3:
-			return newBccspMsp(MSPv1_3)
+			theMsp, err = newBccspMsp(MSPv1_3)
+		case MSPv1_4:
+			theMsp, err = newBccspMsp(MSPv1_4)
 		default:
 			return nil, errors.Errorf("Invalid *BCCSPNewOpts. Version not recognized [%v]", opts.GetVersion())
 		}
+		if err != nil {
+			return nil, err
+		}
+		bccspOpts := opts.(*BCCSPNewOpts)
+		theMsp.(*bccspmsp).revocationChecker = bccspOpts.RevocationChecker
+		theMsp.(*bccspmsp).revocationCheckFailOpen = bccspOpts.RevocationCheckFailOpen
+		return theMsp, nil
 	case *IdemixNewOpts:
 		switch opts.GetVersion() {
+		case MSPv1_4:
+			fallthrough
 		case MSPv1_3:
 			return newIdemixMsp(MSPv1_3)
 		case MSPv1_1:
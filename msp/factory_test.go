@@ -21,7 +21,7 @@ func TestNewInvalidOpts(t *testing.T) {
 	assert.Contains(t, err.Error(), "Invalid msp.NewOpts instance. It must be either *BCCSPNewOpts or *IdemixNewOpts. It was [<nil>]")
 	assert.Nil(t, i)
 
-	i, err = New(&BCCSPNewOpts{NewBaseOpts{Version: -1}})
+	i, err = New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: -1}})
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Invalid *BCCSPNewOpts. Version not recognized [-1]")
 	assert.Nil(t, i)
@@ -33,7 +33,7 @@ func TestNewInvalidOpts(t *testing.T) {
 }
 
 func TestNew(t *testing.T) {
-	i, err := New(&BCCSPNewOpts{NewBaseOpts{Version: MSPv1_0}})
+	i, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}})
 	assert.NoError(t, err)
 	assert.NotNil(t, i)
 	assert.Equal(t, MSPVersion(MSPv1_0), i.(*bccspmsp).version)
@@ -46,7 +46,7 @@ func TestNew(t *testing.T) {
 		runtime.FuncForPC(reflect.ValueOf(i.(*bccspmsp).validateIdentityOUsV1).Pointer()).Name(),
 	)
 
-	i, err = New(&BCCSPNewOpts{NewBaseOpts{Version: MSPv1_1}})
+	i, err = New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_1}})
 	assert.NoError(t, err)
 	assert.NotNil(t, i)
 	assert.Equal(t, MSPVersion(MSPv1_1), i.(*bccspmsp).version)
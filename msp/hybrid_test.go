@@ -0,0 +1,120 @@
+// +build go1.27
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/hybrid"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	m "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHybridTestMSP(t *testing.T, ca *x509.Certificate, csp bccsp.BCCSP, hybridPublicKeys bool) (MSP, error) {
+	theMsp, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_1}})
+	require.NoError(t, err)
+	if csp != nil {
+		theMsp.(*bccspmsp).bccsp = csp
+	}
+	fmspconf := &m.FabricMSPConfig{
+		Name:      "HybridMSP",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})},
+		CryptoConfig: &m.FabricCryptoConfig{
+			SignatureHashFamily:            "SHA2",
+			IdentityIdentifierHashFunction: "SHA256",
+			HybridPublicKeys:               hybridPublicKeys,
+		},
+	}
+	fmspconfBytes, err := proto.Marshal(fmspconf)
+	require.NoError(t, err)
+	return theMsp, theMsp.Setup(&m.MSPConfig{Type: int32(FABRIC), Config: fmspconfBytes})
+}
+
+func TestHybridIdentity(t *testing.T) {
+	inner, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewDummyKeyStore())
+	require.NoError(t, err)
+	csp, err := hybrid.New(inner, "")
+	require.NoError(t, err)
+
+	// the leaf certificate carries the ML-DSA public key of a hybrid key
+	key, err := csp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	pqKey, err := hybrid.PostQuantumPublicKey(key)
+	require.NoError(t, err)
+	ext, err := hybrid.AltPublicKeyExtension(pqKey)
+	require.NoError(t, err)
+	pub, err := key.PublicKey()
+	require.NoError(t, err)
+	raw, err := pub.Bytes()
+	require.NoError(t, err)
+	classical, err := x509.ParsePKIXPublicKey(raw)
+	require.NoError(t, err)
+
+	ca, caKey := newTestCA(t)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: "peer0.example.com"},
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(time.Hour),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtraExtensions: []pkix.Extension{ext},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, classical, caKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	sID, err := proto.Marshal(&m.SerializedIdentity{
+		Mspid:   "HybridMSP",
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}),
+	})
+	require.NoError(t, err)
+
+	// an MSP accepting hybrid keys validates the identity and
+	// checks both its signatures
+	theMsp, err := newHybridTestMSP(t, ca, csp, true)
+	require.NoError(t, err)
+	id, err := theMsp.DeserializeIdentity(sID)
+	require.NoError(t, err)
+	assert.NoError(t, theMsp.Validate(id))
+
+	msg := []byte("hello world")
+	digest := sha256.Sum256(msg)
+	sig, err := csp.Sign(key, digest[:], nil)
+	require.NoError(t, err)
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.Error(t, id.Verify([]byte("hello world!"), sig))
+
+	// an MSP which does not accept hybrid keys rejects the identity,
+	// whether its BCCSP supports hybrid keys or not
+	for _, c := range []bccsp.BCCSP{csp, inner} {
+		theMsp, err = newHybridTestMSP(t, ca, c, false)
+		require.NoError(t, err)
+		_, err = theMsp.DeserializeIdentity(sID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the supplied identity has a hybrid public key, which this MSP does not accept")
+	}
+
+	// an MSP accepting hybrid keys cannot be set up if its BCCSP
+	// does not support them
+	_, err = newHybridTestMSP(t, ca, inner, true)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "hybrid public keys are enabled, but the BCCSP does not support them")
+}
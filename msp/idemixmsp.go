@@ -148,6 +148,7 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		return errors.Errorf("key is of type %v, not of type ECDSA", reflect.TypeOf(revocationPk))
 	}
 	msp.revocationPK = ecdsaPublicKey
+	msp.epoch = int(conf.Epoch)
 
 	if conf.Signer == nil {
 		// No credential in config, so we don't setup a default signer
@@ -214,6 +215,13 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to unmarshal credential revocation information")
 	}
+	if cri.Epoch != conf.Epoch {
+		return errors.Errorf("credential revocation information is for epoch %d, but the current epoch is %d", cri.Epoch, conf.Epoch)
+	}
+	err = idemix.VerifyCRI(msp.revocationPK, cri)
+	if err != nil {
+		return errors.WithMessage(err, "credential revocation information is not valid")
+	}
 
 	// Create the cryptographic evidence that this identity is valid
 	proof, err := idemix.NewSignature(cred, sk, Nym, RandNym, ipk, discloseFlags, nil, rhIndex, cri, rng)
@@ -186,6 +186,47 @@ func TestIdentitySerialization(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIdentityRevocationEpoch(t *testing.T) {
+	signerMsp, err := setup("testdata/idemix/MSP1OU1", "MSP1OU1")
+	assert.NoError(t, err)
+	id, err := getDefaultSigner(signerMsp)
+	assert.NoError(t, err)
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+
+	// A verifier that moved on to the next epoch rejects proofs of the previous epoch
+	conf, err := GetIdemixMspConfig("testdata/idemix/MSP1Verifier", "MSP1OU1")
+	assert.NoError(t, err)
+	idemixConf := &msp.IdemixMSPConfig{}
+	assert.NoError(t, proto.Unmarshal(conf.Config, idemixConf))
+	idemixConf.Epoch = 1
+	conf.Config, err = proto.Marshal(idemixConf)
+	assert.NoError(t, err)
+	verifierMsp, err := newIdemixMsp(MSPv1_3)
+	assert.NoError(t, err)
+	assert.NoError(t, verifierMsp.Setup(conf))
+
+	verID, err := verifierMsp.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	err = verID.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signature is for epoch 0, but the current epoch is 1")
+
+	// A signer whose CRI is not for the current epoch cannot be set up
+	conf, err = GetIdemixMspConfig("testdata/idemix/MSP1OU1", "MSP1OU1")
+	assert.NoError(t, err)
+	idemixConf = &msp.IdemixMSPConfig{}
+	assert.NoError(t, proto.Unmarshal(conf.Config, idemixConf))
+	idemixConf.Epoch = 1
+	conf.Config, err = proto.Marshal(idemixConf)
+	assert.NoError(t, err)
+	signerMsp, err = newIdemixMsp(MSPv1_3)
+	assert.NoError(t, err)
+	err = signerMsp.Setup(conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "credential revocation information is for epoch 0, but the current epoch is 1")
+}
+
 func TestIdentitySerializationBad(t *testing.T) {
 	msp, err := setup("testdata/idemix/MSP1OU1", "MSP1OU1")
 	assert.NoError(t, err)
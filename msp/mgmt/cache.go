@@ -0,0 +1,28 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/cache"
+	"github.com/spf13/viper"
+)
+
+// NewCachedMSP adds a cache layer on top of the given MSP, configured
+// in peer.mspCache
+func NewCachedMSP(mspInst msp.MSP) (msp.MSP, error) {
+	return cache.NewWithConfig(mspInst, cacheConfig())
+}
+
+func cacheConfig() cache.Config {
+	return cache.Config{
+		DeserializeIdentityCacheSize: viper.GetInt("peer.mspCache.deserializeIdentitySize"),
+		ValidateIdentityCacheSize:    viper.GetInt("peer.mspCache.validateIdentitySize"),
+		SatisfiesPrincipalCacheSize:  viper.GetInt("peer.mspCache.satisfiesPrincipalSize"),
+		TTL:                          viper.GetDuration("peer.mspCache.ttl"),
+	}
+}
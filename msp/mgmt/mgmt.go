@@ -46,6 +46,46 @@ func LoadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) er
 	return GetLocalMSP().Setup(conf)
 }
 
+// ReloadLocalMspWithType reloads the local MSP with the specified type from the
+// specified directory, in order to pick up a renewed signing certificate and key.
+// The local MSP is replaced only if the reloaded one is set up successfully and
+// accepted by verify, if not nil, otherwise the current local MSP remains in place
+func ReloadLocalMspWithType(dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string, verify func(msp.MSP) error) error {
+	if mspID == "" {
+		return errors.New("the local MSP must have an ID")
+	}
+
+	conf, err := msp.GetLocalMspConfigWithType(dir, bccspConfig, mspID, mspType)
+	if err != nil {
+		return err
+	}
+
+	mspInst, err := newLocalMSP(mspType)
+	if err != nil {
+		return err
+	}
+	if err := mspInst.Setup(conf); err != nil {
+		return err
+	}
+	if verify != nil {
+		if err := verify(mspInst); err != nil {
+			return err
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	localMsp = mspInst
+	mspLogger.Infof("Reloaded local MSP %s from %s", mspID, dir)
+
+	return nil
+}
+
+// ReloadLocalMsp reloads the local MSP from the specified directory
+func ReloadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) error {
+	return ReloadLocalMspWithType(dir, bccspConfig, mspID, msp.ProviderTypeToString(msp.FABRIC), nil)
+}
+
 // FIXME: AS SOON AS THE CHAIN MANAGEMENT CODE IS COMPLETE,
 // THESE MAPS AND HELPSER FUNCTIONS SHOULD DISAPPEAR BECAUSE
 // OWNERSHIP OF PER-CHAIN MSP MANAGERS WILL BE HANDLED BY IT;
@@ -150,34 +190,94 @@ func loadLocaMSP() msp.MSP {
 		mspType = msp.ProviderTypeToString(msp.FABRIC)
 	}
 
+	mspInst, err := newLocalMSP(mspType)
+	if err != nil {
+		mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
+	}
+
+	mspLogger.Debugf("Created new local MSP")
+
+	return mspInst
+}
+
+// newLocalMSP creates a local MSP of the given type, which still needs to be set up.
+// Local FABRIC MSPs are of the version returned by localMspVersion.
+func newLocalMSP(mspType string) (msp.MSP, error) {
+	version, err := localMspVersion()
+	if err != nil {
+		return nil, err
+	}
+
 	var mspOpts = map[string]msp.NewOpts{
-		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_0}},
+		msp.ProviderTypeToString(msp.FABRIC): newLocalBCCSPOpts(version),
 		msp.ProviderTypeToString(msp.IDEMIX): &msp.IdemixNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_1}},
 	}
 	newOpts, found := mspOpts[mspType]
 	if !found {
-		mspLogger.Panicf("msp type " + mspType + " unknown")
+		return nil, errors.Errorf("msp type %s unknown", mspType)
 	}
 
 	mspInst, err := msp.New(newOpts)
 	if err != nil {
-		mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
+		return nil, err
 	}
-	switch mspType {
-	case msp.ProviderTypeToString(msp.FABRIC):
-		mspInst, err = cache.New(mspInst)
-		if err != nil {
-			mspLogger.Fatalf("Failed to initialize local MSP, received err %+v", err)
-		}
-	case msp.ProviderTypeToString(msp.IDEMIX):
-		// Do nothing
-	default:
-		panic("msp type " + mspType + " unknown")
+	if mspType == msp.ProviderTypeToString(msp.FABRIC) {
+		conf := cacheConfig()
+		// the revocation checker caches the revocation statuses for a bounded
+		// time, which a cached validation outcome would outlive
+		conf.DisableValidationCache = getRevocationChecker() != nil
+		return cache.NewWithConfig(mspInst, conf)
+	}
+	return mspInst, nil
+}
+
+var localMspVersionLock sync.RWMutex
+var localMspVersionConfig string
+
+// SetLocalMspVersion sets the version of the local FABRIC MSPs created from then
+// on, for processes that do not configure it in peer.localMspVersion
+func SetLocalMspVersion(version string) error {
+	if _, err := parseLocalMspVersion(version); err != nil {
+		return err
 	}
 
-	mspLogger.Debugf("Created new local MSP")
+	localMspVersionLock.Lock()
+	defer localMspVersionLock.Unlock()
+	localMspVersionConfig = version
+	return nil
+}
 
-	return mspInst
+// localMspVersion returns the version of local FABRIC MSPs, which is MSPv1_0
+// unless another version is set by SetLocalMspVersion or configured in
+// peer.localMspVersion
+func localMspVersion() (msp.MSPVersion, error) {
+	localMspVersionLock.RLock()
+	version := localMspVersionConfig
+	localMspVersionLock.RUnlock()
+
+	if version == "" {
+		version = viper.GetString("peer.localMspVersion")
+	}
+	return parseLocalMspVersion(version)
+}
+
+// parseLocalMspVersion parses a local MSP version. The versions are named after
+// the channel capabilities that enable the same MSP version in channels, e.g.
+// V1_4 makes the local MSP honor the admin and orderer OUs of its NodeOUs and
+// accept Ed25519 certificates.
+func parseLocalMspVersion(version string) (msp.MSPVersion, error) {
+	switch version {
+	case "", "V1_0":
+		return msp.MSPv1_0, nil
+	case "V1_1":
+		return msp.MSPv1_1, nil
+	case "V1_3":
+		return msp.MSPv1_3, nil
+	case "V1_4":
+		return msp.MSPv1_4, nil
+	default:
+		return 0, errors.Errorf("unknown local MSP version '%s'", version)
+	}
 }
 
 // GetIdentityDeserializer returns the IdentityDeserializer for the given chain
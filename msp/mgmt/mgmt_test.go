@@ -7,11 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package mgmt
 
 import (
+	"crypto/x509"
 	"testing"
 
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,6 +77,43 @@ func TestUpdateLocalMspCache(t *testing.T) {
 	}
 }
 
+func TestReloadLocalMsp(t *testing.T) {
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+
+	before := GetLocalMSP()
+	defer func() {
+		m.Lock()
+		localMsp = before
+		m.Unlock()
+	}()
+
+	err = ReloadLocalMsp(mspDir, nil, "")
+	assert.EqualError(t, err, "the local MSP must have an ID")
+	err = ReloadLocalMsp("/does/not/exist", nil, "SampleOrg")
+	assert.Error(t, err)
+	err = ReloadLocalMspWithType(mspDir, nil, "SampleOrg", "foo", nil)
+	assert.EqualError(t, err, "unknown MSP type 'foo'")
+	err = ReloadLocalMspWithType(mspDir, nil, "SampleOrg", msp.ProviderTypeToString(msp.FABRIC), func(msp.MSP) error {
+		return errors.New("rejected")
+	})
+	assert.EqualError(t, err, "rejected")
+	assert.True(t, before == GetLocalMSP(), "the local MSP should not be replaced if the reload fails")
+
+	err = ReloadLocalMsp(mspDir, nil, "SampleOrg")
+	assert.NoError(t, err)
+	after := GetLocalMSP()
+	assert.False(t, before == after, "the local MSP should have been replaced")
+	mspID, err := after.GetIdentifier()
+	assert.NoError(t, err)
+	assert.Equal(t, "SampleOrg", mspID)
+
+	sid := GetLocalSigningIdentityOrPanic()
+	sig, err := sid.Sign([]byte("msg"))
+	assert.NoError(t, err)
+	assert.NoError(t, sid.Verify([]byte("msg"), sig))
+}
+
 func TestNewMSPMgmtMgr(t *testing.T) {
 	err := LoadMSPSetupForTesting()
 	assert.Nil(t, err)
@@ -134,3 +174,84 @@ func LoadMSPSetupForTesting() error {
 
 	return nil
 }
+
+func TestNewLocalBCCSPOpts(t *testing.T) {
+	viper.Set("peer.mspRevocationCheck.localMspFailOpen", true)
+	defer viper.Set("peer.mspRevocationCheck.localMspFailOpen", nil)
+
+	opts := newLocalBCCSPOpts(msp.MSPv1_1)
+	assert.Equal(t, msp.MSPVersion(msp.MSPv1_1), opts.GetVersion())
+	assert.Nil(t, opts.RevocationChecker, "online revocation checking is disabled by default")
+	assert.True(t, opts.RevocationCheckFailOpen)
+}
+
+type mockRevocationChecker struct {
+	status msp.RevocationStatus
+}
+
+func (c *mockRevocationChecker) CheckRevocation(cert, issuer *x509.Certificate) (msp.RevocationStatus, error) {
+	if c.status == msp.RevocationStatusUnknown {
+		return c.status, errors.New("no responder answered")
+	}
+	return c.status, nil
+}
+
+func TestCheckRevocationOnline(t *testing.T) {
+	sid := GetLocalSigningIdentityOrPanic()
+	serializedID, err := sid.Serialize()
+	assert.NoError(t, err)
+	id, err := GetLocalMSP().DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+
+	// online revocation checking is disabled by default
+	assert.NoError(t, CheckRevocationOnline(id))
+
+	checker := &mockRevocationChecker{}
+	defer func() { revocationChecker = nil }()
+	revocationChecker = checker
+
+	checker.status = msp.RevocationStatusGood
+	assert.NoError(t, CheckRevocationOnline(id))
+	checker.status = msp.RevocationStatusRevoked
+	assert.EqualError(t, CheckRevocationOnline(id), "The certificate has been revoked")
+
+	checker.status = msp.RevocationStatusUnknown
+	assert.EqualError(t, CheckRevocationOnline(id), "could not check whether the certificate has been revoked: no responder answered")
+	viper.Set("peer.mspRevocationCheck.channelMspFailOpen", true)
+	defer viper.Set("peer.mspRevocationCheck.channelMspFailOpen", nil)
+	assert.NoError(t, CheckRevocationOnline(id))
+}
+
+func TestLocalMspVersion(t *testing.T) {
+	defer viper.Set("peer.localMspVersion", nil)
+
+	version, err := localMspVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, msp.MSPVersion(msp.MSPv1_0), version, "the local MSP is of version 1.0 by default")
+
+	for value, expected := range map[string]msp.MSPVersion{
+		"V1_0": msp.MSPv1_0,
+		"V1_1": msp.MSPv1_1,
+		"V1_3": msp.MSPv1_3,
+		"V1_4": msp.MSPv1_4,
+	} {
+		viper.Set("peer.localMspVersion", value)
+		version, err := localMspVersion()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, version)
+	}
+
+	viper.Set("peer.localMspVersion", "V2_0")
+	_, err = localMspVersion()
+	assert.EqualError(t, err, "unknown local MSP version 'V2_0'")
+	_, err = newLocalMSP(msp.ProviderTypeToString(msp.FABRIC))
+	assert.EqualError(t, err, "unknown local MSP version 'V2_0'")
+
+	// the version set by processes other than the peer takes precedence
+	defer SetLocalMspVersion("")
+	assert.EqualError(t, SetLocalMspVersion("V2_0"), "unknown local MSP version 'V2_0'")
+	assert.NoError(t, SetLocalMspVersion("V1_4"))
+	version, err = localMspVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, msp.MSPVersion(msp.MSPv1_4), version)
+}
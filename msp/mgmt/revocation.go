@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/cache"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultRevocationCheckTimeout  = 5 * time.Second
+	defaultRevocationCheckCacheTTL = 10 * time.Minute
+)
+
+var (
+	revocationCheckerOnce sync.Once
+	revocationChecker     msp.RevocationChecker
+)
+
+// getRevocationChecker returns the online revocation checker of the local MSP,
+// or nil if online revocation checking is disabled
+func getRevocationChecker() msp.RevocationChecker {
+	revocationCheckerOnce.Do(func() {
+		if !viper.GetBool("peer.mspRevocationCheck.enabled") {
+			return
+		}
+
+		timeout := viper.GetDuration("peer.mspRevocationCheck.timeout")
+		if timeout <= 0 {
+			timeout = defaultRevocationCheckTimeout
+		}
+		ttl := viper.GetDuration("peer.mspRevocationCheck.cacheTTL")
+		if ttl <= 0 {
+			ttl = defaultRevocationCheckCacheTTL
+		}
+
+		checker, err := cache.NewRevocationChecker(msp.NewOnlineRevocationChecker(timeout), ttl)
+		if err != nil {
+			mspLogger.Panicf("Failed creating the MSP revocation checker: %s", err)
+		}
+		mspLogger.Infof("Online revocation checking of MSP identities enabled (timeout %s, cache TTL %s)", timeout, ttl)
+		revocationChecker = checker
+	})
+	return revocationChecker
+}
+
+// newLocalBCCSPOpts returns the options to instantiate a local BCCSP-based MSP of the
+// given version, with the online revocation checking configured in peer.mspRevocationCheck.
+// Channel MSPs never check revocation online when validating identities, as the outcome
+// of online checks may differ between peers, which would then validate the same
+// transactions differently; see CheckRevocationOnline instead.
+func newLocalBCCSPOpts(version msp.MSPVersion) *msp.BCCSPNewOpts {
+	opts := &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: version}}
+	opts.RevocationChecker = getRevocationChecker()
+	opts.RevocationCheckFailOpen = viper.GetBool("peer.mspRevocationCheck.localMspFailOpen")
+	return opts
+}
+
+// CheckRevocationOnline checks online, if enabled in peer.mspRevocationCheck, whether
+// the given identity of a channel MSP has been revoked. Identities whose revocation
+// status cannot be determined are accepted or rejected as configured in
+// peer.mspRevocationCheck.channelMspFailOpen. It is meant for checks whose outcome
+// only matters to this peer, such as the check of the creator of a proposal to
+// endorse, and must not be used when validating transactions.
+func CheckRevocationOnline(id msp.Identity) error {
+	checker := getRevocationChecker()
+	if checker == nil {
+		return nil
+	}
+	rc, ok := id.(msp.RevocationCheckable)
+	if !ok {
+		return nil
+	}
+	return rc.CheckRevocationOnline(checker, viper.GetBool("peer.mspRevocationCheck.channelMspFailOpen"))
+}
@@ -88,7 +88,7 @@ func (m *MockIdentity) Anonymous() bool {
 }
 
 func (m *MockIdentity) ExpiresAt() time.Time {
-	panic("implement me")
+	return m.Called().Get(0).(time.Time)
 }
 
 func (m *MockIdentity) GetIdentifier() *msp.IdentityIdentifier {
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/common/attrmgr"
 	m "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 )
@@ -83,6 +84,13 @@ type bccspmsp struct {
 	// list of certificate revocation lists
 	CRL []*pkix.CertificateList
 
+	// revocationChecker, if not nil, checks online whether identities are revoked
+	revocationChecker RevocationChecker
+
+	// revocationCheckFailOpen tells whether identities whose revocation status
+	// cannot be determined online are considered valid
+	revocationCheckFailOpen bool
+
 	// list of OUs
 	ouIdentifiers map[string][][]byte
 
@@ -91,9 +99,9 @@ type bccspmsp struct {
 
 	// NodeOUs configuration
 	ouEnforcement bool
-	// These are the OUIdentifiers of the clients, peers and orderers.
+	// These are the OUIdentifiers of the clients, peers, admins and orderers.
 	// They are used to tell apart these entities
-	clientOU, peerOU *OUIdentifier
+	clientOU, peerOU, adminOU, ordererOU *OUIdentifier
 }
 
 // newBccspMsp returns an MSP instance backed up by a BCCSP
@@ -120,6 +128,10 @@ func newBccspMsp(version MSPVersion) (MSP, error) {
 		theMsp.internalSetupFunc = theMsp.setupV11
 		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV11
 		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalV13
+	case MSPv1_4:
+		theMsp.internalSetupFunc = theMsp.setupV14
+		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV14
+		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalV14
 	default:
 		return nil, errors.Errorf("Invalid MSP version [%v]", version)
 	}
@@ -187,9 +199,16 @@ func (msp *bccspmsp) getSigningIdentityFromConf(sidInfo *m.SigningIdentityInfo)
 		}
 
 		pemKey, _ := pem.Decode(sidInfo.PrivateSigner.KeyMaterial)
-		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
-		if err != nil {
-			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+		if isED25519Cert(idPub.(*identity).cert) {
+			privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+			if err != nil {
+				return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import Ed25519 private key")
+			}
+		} else {
+			privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+			if err != nil {
+				return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+			}
 		}
 	}
 
@@ -312,18 +331,25 @@ func (msp *bccspmsp) hasOURole(id Identity, mspRole m.MSPRole_MSPRoleType) error
 }
 
 func (msp *bccspmsp) hasOURoleInternal(id *identity, mspRole m.MSPRole_MSPRoleType) error {
-	var nodeOUValue string
+	var nodeOU *OUIdentifier
 	switch mspRole {
 	case m.MSPRole_CLIENT:
-		nodeOUValue = msp.clientOU.OrganizationalUnitIdentifier
+		nodeOU = msp.clientOU
 	case m.MSPRole_PEER:
-		nodeOUValue = msp.peerOU.OrganizationalUnitIdentifier
+		nodeOU = msp.peerOU
+	case m.MSPRole_ADMIN:
+		nodeOU = msp.adminOU
+	case m.MSPRole_ORDERER:
+		nodeOU = msp.ordererOU
 	default:
-		return fmt.Errorf("Invalid MSPRoleType. It must be CLIENT, PEER or ORDERER")
+		return fmt.Errorf("Invalid MSPRoleType. It must be CLIENT, PEER, ADMIN or ORDERER")
+	}
+	if nodeOU == nil {
+		return errors.Errorf("No OU is configured for role [%s], MSP: [%s]", mspRole, msp.name)
 	}
 
 	for _, OU := range id.GetOrganizationalUnits() {
-		if OU.OrganizationalUnitIdentifier == nodeOUValue {
+		if OU.OrganizationalUnitIdentifier == nodeOU.OrganizationalUnitIdentifier {
 			return nil
 		}
 	}
@@ -459,13 +485,11 @@ func (msp *bccspmsp) satisfiesPrincipalInternalPreV13(id Identity, principal *m.
 			mspLogger.Debugf("Checking if identity satisfies ADMIN role for %s", msp.name)
 			// in the case of admin, we check that the
 			// id is exactly one of our admins
-			for _, admincert := range msp.admins {
-				if bytes.Equal(id.(*identity).cert.Raw, admincert.(*identity).cert.Raw) {
-					// we do not need to check whether the admin is a valid identity
-					// according to this MSP, since we already check this at Setup time
-					// if there is a match, we can just return
-					return nil
-				}
+			if msp.isInAdmins(id.(*identity)) {
+				// we do not need to check whether the admin is a valid identity
+				// according to this MSP, since we already check this at Setup time
+				// if there is a match, we can just return
+				return nil
 			}
 			return errors.New("This identity is not an admin")
 		case m.MSPRole_CLIENT:
@@ -554,13 +578,122 @@ func (msp *bccspmsp) satisfiesPrincipalInternalV13(id Identity, principal *m.MSP
 		default:
 			return errors.Errorf("Unknown principal anonymity type: %d", anon.AnonymityType)
 		}
-
 	default:
 		// Use the pre-v1.3 function to check other principal types
 		return msp.satisfiesPrincipalInternalPreV13(id, principal)
 	}
 }
 
+// satisfiesPrincipalInternalV14 takes as arguments the identity and the principal.
+// The function returns an error if one occurred.
+// The function implements the additional behavior expected of an MSP starting from v1.4,
+// where admins and orderers can be told apart by OU and principals can require
+// certificate attributes.
+// For pre-v1.4 functionality, the function calls the satisfiesPrincipalInternalV13.
+func (msp *bccspmsp) satisfiesPrincipalInternalV14(id Identity, principal *m.MSPPrincipal) error {
+	if principal.PrincipalClassification == m.MSPPrincipal_ATTRIBUTE {
+		return msp.satisfiesAttributePrincipal(id, principal)
+	}
+	if principal.PrincipalClassification != m.MSPPrincipal_ROLE {
+		return msp.satisfiesPrincipalInternalV13(id, principal)
+	}
+
+	// Principal contains the msp role
+	mspRole := &m.MSPRole{}
+	err := proto.Unmarshal(principal.Principal, mspRole)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal MSPRole from principal")
+	}
+
+	// at first, we check whether the MSP
+	// identifier is the same as that of the identity
+	if mspRole.MspIdentifier != msp.name {
+		return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", mspRole.MspIdentifier, id.GetMSPIdentifier())
+	}
+
+	switch mspRole.Role {
+	case m.MSPRole_ADMIN:
+		mspLogger.Debugf("Checking if identity satisfies ADMIN role for %s", msp.name)
+		// the admins listed in the configuration are admins
+		// regardless of their OUs
+		if msp.isInAdmins(id.(*identity)) {
+			return nil
+		}
+
+		// otherwise, if NodeOUs are enabled, admins
+		// are told apart by the admin OU
+		if !msp.ouEnforcement || msp.adminOU == nil {
+			return errors.New("This identity is not an admin")
+		}
+		fallthrough
+	case m.MSPRole_ORDERER:
+		mspLogger.Debugf("Checking if identity satisfies role [%s] for %s", m.MSPRole_MSPRoleType_name[int32(mspRole.Role)], msp.name)
+		if err := msp.Validate(id); err != nil {
+			return errors.Wrapf(err, "The identity is not valid under this MSP [%s]", msp.name)
+		}
+
+		if err := msp.hasOURole(id, mspRole.Role); err != nil {
+			return errors.Wrapf(err, "The identity is not a [%s] under this MSP [%s]", m.MSPRole_MSPRoleType_name[int32(mspRole.Role)], msp.name)
+		}
+		return nil
+	default:
+		// Use the pre-v1.4 function to check other roles
+		return msp.satisfiesPrincipalInternalV13(id, principal)
+	}
+}
+
+// satisfiesAttributePrincipal checks whether the identity has the
+// certificate attribute required by the given ATTRIBUTE principal
+func (msp *bccspmsp) satisfiesAttributePrincipal(id Identity, principal *m.MSPPrincipal) error {
+	// Principal contains the attribute
+	attr := &m.MSPAttribute{}
+	err := proto.Unmarshal(principal.Principal, attr)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal MSPAttribute from principal")
+	}
+
+	// at first, we check whether the MSP
+	// identifier is the same as that of the identity
+	if attr.MspIdentifier != msp.name {
+		return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", attr.MspIdentifier, id.GetMSPIdentifier())
+	}
+
+	// we then check if the identity is valid with this MSP
+	// and fail if it is not
+	err = msp.Validate(id)
+	if err != nil {
+		return err
+	}
+
+	// now we check the attribute in the attribute extension of the certificate
+	attrs, err := attrmgr.New().GetAttributesFromCert(id.(*identity).cert)
+	if err != nil {
+		return errors.WithMessage(err, "could not get the attributes of the identity")
+	}
+	value, found, err := attrs.Value(attr.Name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Errorf("the identity does not have attribute %s", attr.Name)
+	}
+	if value != attr.Value {
+		return errors.Errorf("the identity has value %s for attribute %s, expected %s", value, attr.Name, attr.Value)
+	}
+	return nil
+}
+
+// isInAdmins returns true if the identity is one of the admins
+// listed in the configuration of this MSP
+func (msp *bccspmsp) isInAdmins(id *identity) bool {
+	for _, admincert := range msp.admins {
+		if bytes.Equal(id.cert.Raw, admincert.(*identity).cert.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
 // getCertificationChain returns the certification chain of the passed identity within this msp
 func (msp *bccspmsp) getCertificationChain(id Identity) ([]*x509.Certificate, error) {
 	mspLogger.Debugf("MSP %s getting certification chain", msp.name)
@@ -601,6 +734,18 @@ func (msp *bccspmsp) getUniqueValidationChain(cert *x509.Certificate, opts x509.
 	if msp.opts == nil {
 		return nil, errors.New("the supplied identity has no verify options")
 	}
+	// the post-quantum public key of hybrid certificates is carried by a critical
+	// extension, which is handled only if the configuration of this MSP enables
+	// hybrid keys, regardless of the BCCSP of the node
+	if hasHybridPublicKey(cert) {
+		if !msp.cryptoConfig.HybridPublicKeys {
+			return nil, errors.New("the supplied identity has a hybrid public key, which this MSP does not accept")
+		}
+		if _, err := msp.bccsp.KeyImport(cert, &bccsp.HybridX509PublicKeyImportOpts{Temporary: true}); err != nil {
+			return nil, errors.WithMessage(err, "invalid hybrid public key of the supplied identity")
+		}
+		cert = handleHybridPublicKey(cert)
+	}
 	validationChains, err := cert.Verify(opts)
 	if err != nil {
 		return nil, errors.WithMessage(err, "the supplied identity is not valid")
@@ -613,9 +758,44 @@ func (msp *bccspmsp) getUniqueValidationChain(cert *x509.Certificate, opts x509.
 		return nil, errors.Errorf("this MSP only supports a single validation chain, got %d", len(validationChains))
 	}
 
+	// Ed25519 certificates cannot be validated by peers and orderers
+	// built before MSP version 1.4, so they are accepted only from
+	// that version on, which channels enable with the V1_4 capability
+	if msp.version < MSPv1_4 {
+		for _, c := range validationChains[0] {
+			if isED25519Cert(c) {
+				return nil, errors.New("Ed25519 certificates require MSP version 1.4 or later")
+			}
+		}
+	}
+
 	return validationChains[0], nil
 }
 
+// hasHybridPublicKey returns true if cert carries the post-quantum
+// public key of a hybrid key
+func hasHybridPublicKey(cert *x509.Certificate) bool {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(bccsp.HybridAltPublicKeyInfoOID) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleHybridPublicKey returns a copy of cert in which the extension carrying
+// the post-quantum public key is not an unhandled critical extension anymore
+func handleHybridPublicKey(cert *x509.Certificate) *x509.Certificate {
+	handled := *cert
+	handled.UnhandledCriticalExtensions = nil
+	for _, oid := range cert.UnhandledCriticalExtensions {
+		if !oid.Equal(bccsp.HybridAltPublicKeyInfoOID) {
+			handled.UnhandledCriticalExtensions = append(handled.UnhandledCriticalExtensions, oid)
+		}
+	}
+	return &handled
+}
+
 func (msp *bccspmsp) getValidationChain(cert *x509.Certificate, isIntermediateChain bool) ([]*x509.Certificate, error) {
 	validationChain, err := msp.getUniqueValidationChain(cert, msp.getValidityOptsForCert(cert))
 	if err != nil {
@@ -96,6 +96,13 @@ func (msp *bccspmsp) setupCrypto(conf *m.FabricMSPConfig) error {
 		msp.cryptoConfig.IdentityIdentifierHashFunction = bccsp.SHA256
 		mspLogger.Debugf("CryptoConfig.IdentityIdentifierHashFunction was nil. Move to defaults.")
 	}
+	// the setup fails if the BCCSP of this node cannot handle the hybrid keys
+	// of the identities, so that all the nodes agree on their validity
+	if msp.cryptoConfig.HybridPublicKeys {
+		if _, err := msp.bccsp.KeyGen(&bccsp.HybridKeyGenOpts{Temporary: true}); err != nil {
+			return errors.WithMessage(err, "hybrid public keys are enabled, but the BCCSP does not support them")
+		}
+	}
 
 	return nil
 }
@@ -259,6 +266,46 @@ func (msp *bccspmsp) setupNodeOUs(config *m.FabricMSPConfig) error {
 	return nil
 }
 
+func (msp *bccspmsp) setupNodeOUsV14(config *m.FabricMSPConfig) error {
+	if err := msp.setupNodeOUs(config); err != nil {
+		return err
+	}
+	if config.FabricNodeOus == nil {
+		return nil
+	}
+
+	// AdminOU and OrdererOU are optional
+	var err error
+	msp.adminOU, err = msp.getNodeOU(config.FabricNodeOus.AdminOuIdentifier)
+	if err != nil {
+		return err
+	}
+	msp.ordererOU, err = msp.getNodeOU(config.FabricNodeOus.OrdererOuIdentifier)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getNodeOU returns the OUIdentifier corresponding to the given
+// FabricOUIdentifier, or nil if the latter is not set
+func (msp *bccspmsp) getNodeOU(ouIdentifier *m.FabricOUIdentifier) (*OUIdentifier, error) {
+	if ouIdentifier == nil || len(ouIdentifier.OrganizationalUnitIdentifier) == 0 {
+		return nil, nil
+	}
+
+	nodeOU := &OUIdentifier{OrganizationalUnitIdentifier: ouIdentifier.OrganizationalUnitIdentifier}
+	if len(ouIdentifier.Certificate) != 0 {
+		certifiersIdentifier, err := msp.getCertifiersIdentifier(ouIdentifier.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		nodeOU.CertifiersIdentifier = certifiersIdentifier
+	}
+	return nodeOU, nil
+}
+
 func (msp *bccspmsp) setupSigningIdentity(conf *m.FabricMSPConfig) error {
 	if conf.SigningIdentity != nil {
 		sid, err := msp.getSigningIdentityFromConf(conf.SigningIdentity)
@@ -454,6 +501,49 @@ func (msp *bccspmsp) setupV11(conf *m.FabricMSPConfig) error {
 	return nil
 }
 
+func (msp *bccspmsp) setupV14(conf *m.FabricMSPConfig) error {
+	err := msp.preSetupV1(conf)
+	if err != nil {
+		return err
+	}
+
+	// setup NodeOUs
+	if err := msp.setupNodeOUsV14(conf); err != nil {
+		return err
+	}
+
+	err = msp.postSetupV14(conf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (msp *bccspmsp) postSetupV14(conf *m.FabricMSPConfig) error {
+	// Check for OU enforcement
+	if !msp.ouEnforcement {
+		// No enforcement required. Call post setup as per V1
+		return msp.postSetupV1(conf)
+	}
+
+	// Check that admins are valid clients or admins
+	for i, admin := range msp.admins {
+		err := admin.Validate()
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("admin %d is invalid", i))
+		}
+
+		err1 := msp.hasOURole(admin, m.MSPRole_CLIENT)
+		err2 := msp.hasOURole(admin, m.MSPRole_ADMIN)
+		if err1 != nil && err2 != nil {
+			return errors.Errorf("admin %d is invalid, it is neither a client nor an admin: [%s], [%s]", i, err1, err2)
+		}
+	}
+
+	return nil
+}
+
 func (msp *bccspmsp) postSetupV11(conf *m.FabricMSPConfig) error {
 	// Check for OU enforcement
 	if !msp.ouEnforcement {
@@ -31,6 +31,11 @@ func (msp *bccspmsp) validateIdentity(id *identity) error {
 		return errors.WithMessage(err, "could not validate identity against certification chain")
 	}
 
+	err = msp.checkRevocationOnline(id.cert, validationChain[1])
+	if err != nil {
+		return err
+	}
+
 	err = msp.internalValidateIdentityOusFunc(id)
 	if err != nil {
 		return errors.WithMessage(err, "could not validate identity's OUs")
@@ -128,6 +133,16 @@ func (msp *bccspmsp) validateCertAgainstChain(cert *x509.Certificate, validation
 	return nil
 }
 
+// checkRevocationOnline consults the revocation checker of this MSP, if any,
+// about the revocation status of cert, issued by issuer
+func (msp *bccspmsp) checkRevocationOnline(cert, issuer *x509.Certificate) error {
+	if msp.revocationChecker == nil {
+		return nil
+	}
+
+	return checkRevocationStatus(msp.revocationChecker, cert, issuer, msp.revocationCheckFailOpen)
+}
+
 func (msp *bccspmsp) validateIdentityOUsV1(id *identity) error {
 	// Check that the identity's OUs are compatible with those recognized by this MSP,
 	// meaning that the intersection is not empty.
@@ -173,18 +188,43 @@ func (msp *bccspmsp) validateIdentityOUsV11(id *identity) error {
 		return nil
 	}
 
+	return msp.validateIdentityNodeOUs(id, msp.clientOU, msp.peerOU)
+}
+
+func (msp *bccspmsp) validateIdentityOUsV14(id *identity) error {
+	// Run the same checks as per V1
+	err := msp.validateIdentityOUsV1(id)
+	if err != nil {
+		return err
+	}
+
+	// Perform V1_4 additional checks:
+	//
+	// -- Check for OU enforcement
+	if !msp.ouEnforcement {
+		// No enforcement required
+		return nil
+	}
+
+	return msp.validateIdentityNodeOUs(id, msp.clientOU, msp.peerOU, msp.adminOU, msp.ordererOU)
+}
+
+// validateIdentityNodeOUs checks that the identity has exactly one of the
+// given special OUs, certified as the special OU requires. Nil OUs are ignored.
+func (msp *bccspmsp) validateIdentityNodeOUs(id *identity, nodeOUs ...*OUIdentifier) error {
 	// Make sure that the identity has only one of the special OUs
-	// used to tell apart clients, peers and orderers.
+	// used to tell apart clients, peers, admins and orderers.
 	counter := 0
 	for _, OU := range id.GetOrganizationalUnits() {
 		// Is OU.OrganizationalUnitIdentifier one of the special OUs?
 		var nodeOU *OUIdentifier
-		switch OU.OrganizationalUnitIdentifier {
-		case msp.clientOU.OrganizationalUnitIdentifier:
-			nodeOU = msp.clientOU
-		case msp.peerOU.OrganizationalUnitIdentifier:
-			nodeOU = msp.peerOU
-		default:
+		for _, candidate := range nodeOUs {
+			if candidate != nil && candidate.OrganizationalUnitIdentifier == OU.OrganizationalUnitIdentifier {
+				nodeOU = candidate
+				break
+			}
+		}
+		if nodeOU == nil {
 			continue
 		}
 
@@ -199,7 +239,7 @@ func (msp *bccspmsp) validateIdentityOUsV11(id *identity) error {
 		}
 	}
 	if counter != 1 {
-		return errors.Errorf("the identity must be a client, a peer or an orderer identity to be valid, not a combination of them. OUs: [%v], MSP: [%s]", id.GetOrganizationalUnits(), msp.name)
+		return errors.Errorf("the identity must be a client, a peer, an admin or an orderer identity to be valid, not a combination of them. OUs: [%v], MSP: [%s]", id.GetOrganizationalUnits(), msp.name)
 	}
 
 	return nil
@@ -17,11 +17,16 @@ limitations under the License.
 package msp
 
 import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidAdminNodeOU(t *testing.T) {
@@ -237,3 +242,123 @@ func TestSatisfiesPrincipalClient(t *testing.T) {
 		assert.Contains(t, err.Error(), "The identity is not a [PEER] under this MSP [SampleOrg]")
 	}))
 }
+
+// newNodeOUsTestCert returns a certificate issued by ca carrying the given OUs
+func newNodeOUsTestCert(t *testing.T, ca *x509.Certificate, caKey crypto.Signer, ous ...string) []byte {
+	cert, _ := newTestCert(t, &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "node.example.com", OrganizationalUnit: ous},
+		KeyUsage: x509.KeyUsageDigitalSignature,
+	}, ca, caKey)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// newNodeOUsTestMSP returns an MSP trusting ca which tells apart
+// clients, peers, admins and orderers by OU
+func newNodeOUsTestMSP(t *testing.T, ca *x509.Certificate, version MSPVersion, admins ...[]byte) (MSP, error) {
+	theMsp, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: version}})
+	require.NoError(t, err)
+	fmspconf := &msp.FabricMSPConfig{
+		Name:      "NodeOUsMSP",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})},
+		Admins:    admins,
+		CryptoConfig: &msp.FabricCryptoConfig{
+			SignatureHashFamily:            "SHA2",
+			IdentityIdentifierHashFunction: "SHA256",
+		},
+		FabricNodeOus: &msp.FabricNodeOUs{
+			Enable:              true,
+			ClientOuIdentifier:  &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "client"},
+			PeerOuIdentifier:    &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "peer"},
+			AdminOuIdentifier:   &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "admin"},
+			OrdererOuIdentifier: &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "orderer"},
+		},
+	}
+	fmspconfBytes, err := proto.Marshal(fmspconf)
+	require.NoError(t, err)
+	return theMsp, theMsp.Setup(&msp.MSPConfig{Type: int32(FABRIC), Config: fmspconfBytes})
+}
+
+func nodeOUsTestIdentity(t *testing.T, theMsp MSP, cert []byte) Identity {
+	sID, err := proto.Marshal(&msp.SerializedIdentity{Mspid: "NodeOUsMSP", IdBytes: cert})
+	require.NoError(t, err)
+	id, err := theMsp.DeserializeIdentity(sID)
+	require.NoError(t, err)
+	return id
+}
+
+func rolePrincipal(mspID string, role msp.MSPRole_MSPRoleType) *msp.MSPPrincipal {
+	return &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               mustMarshal(&msp.MSPRole{MspIdentifier: mspID, Role: role}),
+	}
+}
+
+func TestAdminAndOrdererNodeOUs(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	listedAdmin := newNodeOUsTestCert(t, ca, caKey, "client")
+	thisMSP, err := newNodeOUsTestMSP(t, ca, MSPv1_4, listedAdmin)
+	require.NoError(t, err)
+
+	admin := nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "admin"))
+	orderer := nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "orderer"))
+	client := nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "client"))
+	listed := nodeOUsTestIdentity(t, thisMSP, listedAdmin)
+
+	for _, id := range []Identity{admin, orderer, client, listed} {
+		assert.NoError(t, id.Validate())
+	}
+	assert.Error(t, nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "admin", "orderer")).Validate())
+
+	// admins are told apart by OU, or listed in the configuration
+	assert.NoError(t, admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ADMIN)))
+	assert.NoError(t, listed.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ADMIN)))
+	err = client.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ADMIN))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The identity is not a [ADMIN] under this MSP [NodeOUsMSP]")
+	err = admin.SatisfiesPrincipal(rolePrincipal("OtherMSP", msp.MSPRole_ADMIN))
+	assert.EqualError(t, err, "the identity is a member of a different MSP (expected OtherMSP, got NodeOUsMSP)")
+
+	assert.NoError(t, orderer.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ORDERER)))
+	err = admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ORDERER))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The identity is not a [ORDERER] under this MSP [NodeOUsMSP]")
+
+	// the other roles are checked as before
+	assert.NoError(t, client.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_CLIENT)))
+	assert.NoError(t, admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_MEMBER)))
+	assert.Error(t, admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_CLIENT)))
+}
+
+func TestAdminAndOrdererNodeOUsSetup(t *testing.T) {
+	ca, caKey := newTestCA(t)
+
+	// admins need not be listed in the configuration
+	_, err := newNodeOUsTestMSP(t, ca, MSPv1_4)
+	assert.NoError(t, err)
+	// listed admins can be admins
+	_, err = newNodeOUsTestMSP(t, ca, MSPv1_4, newNodeOUsTestCert(t, ca, caKey, "admin"))
+	assert.NoError(t, err)
+	// but they can be neither peers nor orderers
+	_, err = newNodeOUsTestMSP(t, ca, MSPv1_4, newNodeOUsTestCert(t, ca, caKey, "orderer"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "admin 0 is invalid, it is neither a client nor an admin")
+	_, err = newNodeOUsTestMSP(t, ca, MSPv1_4, newNodeOUsTestCert(t, ca, caKey, "peer"))
+	assert.Error(t, err)
+}
+
+func TestAdminAndOrdererNodeOUsPreV14(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	thisMSP, err := newNodeOUsTestMSP(t, ca, MSPv1_3)
+	require.NoError(t, err)
+	assert.Nil(t, thisMSP.(*bccspmsp).adminOU)
+	assert.Nil(t, thisMSP.(*bccspmsp).ordererOU)
+
+	// admin and orderer OUs are not recognized before MSP v1.4
+	admin := nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "admin", "client"))
+	assert.NoError(t, admin.Validate())
+	err = admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ADMIN))
+	assert.EqualError(t, err, "This identity is not an admin")
+	err = admin.SatisfiesPrincipal(rolePrincipal("NodeOUsMSP", msp.MSPRole_ORDERER))
+	assert.EqualError(t, err, "invalid MSP role type 4")
+	assert.Error(t, nodeOUsTestIdentity(t, thisMSP, newNodeOUsTestCert(t, ca, caKey, "orderer")).Validate())
+}
@@ -0,0 +1,244 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// This file implements the subset of the Online Certificate Status Protocol
+// (RFC 6960) that is needed to query the status of a single certificate.
+
+var (
+	oidSHA1              = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidOCSPBasicResponse = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+
+	ocspSignatureAlgorithms = []struct {
+		oid asn1.ObjectIdentifier
+		alg x509.SignatureAlgorithm
+	}{
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}, x509.ECDSAWithSHA256},
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}, x509.ECDSAWithSHA384},
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}, x509.ECDSAWithSHA512},
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}, x509.SHA256WithRSA},
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}, x509.SHA384WithRSA},
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}, x509.SHA512WithRSA},
+		{asn1.ObjectIdentifier{1, 3, 101, 112}, x509.PureEd25519},
+	}
+)
+
+const (
+	ocspResponseSuccessful = 0
+	// maximum clock skew tolerated when checking the validity period of an OCSP response
+	ocspMaxClockSkew = 5 * time.Minute
+)
+
+type ocspCertID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+type ocspSingleRequest struct {
+	Cert ocspCertID
+}
+
+type ocspTBSRequest struct {
+	Version     int `asn1:"explicit,tag:0,default:0,optional"`
+	RequestList []ocspSingleRequest
+}
+
+type ocspRequest struct {
+	TBSRequest ocspTBSRequest
+}
+
+type ocspResponse struct {
+	Status   asn1.Enumerated
+	Response ocspResponseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    ocspResponseData
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseData struct {
+	Raw            asn1.RawContent
+	Version        int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID asn1.RawValue
+	ProducedAt     time.Time `asn1:"generalized"`
+	Responses      []ocspSingleResponse
+}
+
+type ocspSingleResponse struct {
+	CertID     ocspCertID
+	Good       asn1.Flag       `asn1:"tag:0,optional"`
+	Revoked    ocspRevokedInfo `asn1:"tag:1,optional"`
+	Unknown    asn1.Flag       `asn1:"tag:2,optional"`
+	ThisUpdate time.Time       `asn1:"generalized"`
+	NextUpdate time.Time       `asn1:"generalized,explicit,tag:0,optional"`
+}
+
+type ocspRevokedInfo struct {
+	RevocationTime time.Time       `asn1:"generalized"`
+	Reason         asn1.Enumerated `asn1:"explicit,tag:0,optional"`
+}
+
+// newOCSPCertID returns the identifier OCSP uses for cert, issued by issuer
+func newOCSPCertID(cert, issuer *x509.Certificate) (ocspCertID, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return ocspCertID{}, errors.Wrap(err, "failed to parse the public key of the issuer")
+	}
+
+	nameHash := sha1.Sum(issuer.RawSubject)
+	keyHash := sha1.Sum(spki.PublicKey.RightAlign())
+	return ocspCertID{
+		HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+		NameHash:      nameHash[:],
+		IssuerKeyHash: keyHash[:],
+		SerialNumber:  cert.SerialNumber,
+	}, nil
+}
+
+// newOCSPRequest returns the DER encoded OCSP request for the status of cert
+func newOCSPRequest(cert, issuer *x509.Certificate) ([]byte, error) {
+	id, err := newOCSPCertID(cert, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(ocspRequest{
+		TBSRequest: ocspTBSRequest{RequestList: []ocspSingleRequest{{Cert: id}}},
+	})
+}
+
+// parseOCSPResponse parses the DER encoded OCSP response der, checks that it
+// was signed by issuer (or by a responder issuer delegated to) and returns
+// the status it contains for cert
+func parseOCSPResponse(der []byte, cert, issuer *x509.Certificate, now time.Time) (RevocationStatus, error) {
+	resp := &ocspResponse{}
+	rest, err := asn1.Unmarshal(der, resp)
+	if err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "failed to parse OCSP response")
+	}
+	if len(rest) > 0 {
+		return RevocationStatusUnknown, errors.New("trailing data in OCSP response")
+	}
+	if resp.Status != ocspResponseSuccessful {
+		return RevocationStatusUnknown, errors.Errorf("OCSP responder returned status %d", resp.Status)
+	}
+	if !resp.Response.ResponseType.Equal(oidOCSPBasicResponse) {
+		return RevocationStatusUnknown, errors.Errorf("unsupported OCSP response type %s", resp.Response.ResponseType)
+	}
+
+	basicResp := &ocspBasicResponse{}
+	rest, err = asn1.Unmarshal(resp.Response.Response, basicResp)
+	if err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "failed to parse basic OCSP response")
+	}
+	if len(rest) > 0 {
+		return RevocationStatusUnknown, errors.New("trailing data in basic OCSP response")
+	}
+
+	err = checkOCSPResponseSignature(basicResp, issuer)
+	if err != nil {
+		return RevocationStatusUnknown, err
+	}
+
+	id, err := newOCSPCertID(cert, issuer)
+	if err != nil {
+		return RevocationStatusUnknown, err
+	}
+	for _, r := range basicResp.TBSResponseData.Responses {
+		if r.CertID.SerialNumber == nil || r.CertID.SerialNumber.Cmp(id.SerialNumber) != 0 ||
+			!r.CertID.HashAlgorithm.Algorithm.Equal(oidSHA1) ||
+			!bytes.Equal(r.CertID.NameHash, id.NameHash) || !bytes.Equal(r.CertID.IssuerKeyHash, id.IssuerKeyHash) {
+			continue
+		}
+
+		if r.ThisUpdate.After(now.Add(ocspMaxClockSkew)) {
+			return RevocationStatusUnknown, errors.New("OCSP response is not yet valid")
+		}
+		if !r.NextUpdate.IsZero() && r.NextUpdate.Add(ocspMaxClockSkew).Before(now) {
+			return RevocationStatusUnknown, errors.New("OCSP response has expired")
+		}
+
+		switch {
+		case bool(r.Good):
+			return RevocationStatusGood, nil
+		case bool(r.Unknown):
+			return RevocationStatusUnknown, errors.New("OCSP responder does not know the certificate")
+		default:
+			return RevocationStatusRevoked, nil
+		}
+	}
+
+	return RevocationStatusUnknown, errors.New("OCSP response does not contain the status of the certificate")
+}
+
+// checkOCSPResponseSignature checks that the OCSP response was signed either
+// by issuer, or by a responder certificate issued by issuer for OCSP signing
+func checkOCSPResponseSignature(resp *ocspBasicResponse, issuer *x509.Certificate) error {
+	var alg x509.SignatureAlgorithm
+	for _, sa := range ocspSignatureAlgorithms {
+		if resp.SignatureAlgorithm.Algorithm.Equal(sa.oid) {
+			alg = sa.alg
+			break
+		}
+	}
+	if alg == x509.UnknownSignatureAlgorithm {
+		return errors.Errorf("unsupported OCSP response signature algorithm %s", resp.SignatureAlgorithm.Algorithm)
+	}
+
+	signer := issuer
+	if len(resp.Certificates) > 0 {
+		responder, err := x509.ParseCertificate(resp.Certificates[0].FullBytes)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse OCSP responder certificate")
+		}
+		if !bytes.Equal(responder.Raw, issuer.Raw) {
+			if err := responder.CheckSignatureFrom(issuer); err != nil {
+				return errors.Wrap(err, "OCSP responder certificate is not issued by the issuer of the certificate")
+			}
+			delegated := false
+			for _, eku := range responder.ExtKeyUsage {
+				if eku == x509.ExtKeyUsageOCSPSigning {
+					delegated = true
+				}
+			}
+			if !delegated {
+				return errors.New("OCSP responder certificate is not authorized to sign OCSP responses")
+			}
+			signer = responder
+		}
+	}
+
+	err := signer.CheckSignature(alg, resp.TBSResponseData.Raw, resp.Signature.RightAlign())
+	if err != nil {
+		return errors.Wrap(err, "invalid OCSP response signature")
+	}
+	return nil
+}
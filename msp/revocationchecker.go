@@ -0,0 +1,187 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RevocationStatus is the revocation status of a certificate
+type RevocationStatus int
+
+const (
+	// RevocationStatusUnknown means that the revocation status could not be determined
+	RevocationStatusUnknown RevocationStatus = iota
+	// RevocationStatusGood means that the certificate is not revoked
+	RevocationStatusGood
+	// RevocationStatusRevoked means that the certificate is revoked
+	RevocationStatusRevoked
+)
+
+// RevocationChecker checks online whether a certificate has been revoked,
+// in addition to the CRLs that are part of the MSP configuration
+type RevocationChecker interface {
+	// CheckRevocation returns the revocation status of cert, issued by issuer.
+	// An error is returned along with RevocationStatusUnknown when the status
+	// could not be determined.
+	CheckRevocation(cert, issuer *x509.Certificate) (RevocationStatus, error)
+}
+
+// maximum size of the OCSP responses and CRLs that are downloaded
+const maxRevocationResponseSize = 10 * 1024 * 1024
+
+// onlineRevocationChecker checks the status of certificates with the OCSP
+// responders they point to, and falls back to their CRL distribution points.
+// Certificates that have neither are considered not revoked.
+type onlineRevocationChecker struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewOnlineRevocationChecker returns a RevocationChecker that queries the OCSP
+// responders and CRL distribution points listed in the certificates, giving up
+// on a responder or distribution point after the given timeout
+func NewOnlineRevocationChecker(timeout time.Duration) RevocationChecker {
+	return &onlineRevocationChecker{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+func (c *onlineRevocationChecker) CheckRevocation(cert, issuer *x509.Certificate) (RevocationStatus, error) {
+	if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+		return RevocationStatusGood, nil
+	}
+
+	var errs []string
+	for _, server := range cert.OCSPServer {
+		status, err := c.checkOCSP(server, cert, issuer)
+		if err == nil {
+			return status, nil
+		}
+		mspLogger.Debugf("OCSP request to %s failed: %s", server, err)
+		errs = append(errs, err.Error())
+	}
+
+	for _, dp := range cert.CRLDistributionPoints {
+		status, err := c.checkCRL(dp, cert, issuer)
+		if err == nil {
+			return status, nil
+		}
+		mspLogger.Debugf("Checking CRL distribution point %s failed: %s", dp, err)
+		errs = append(errs, err.Error())
+	}
+
+	return RevocationStatusUnknown, errors.Errorf("could not determine the revocation status of the certificate: %v", errs)
+}
+
+func (c *onlineRevocationChecker) checkOCSP(server string, cert, issuer *x509.Certificate) (RevocationStatus, error) {
+	req, err := newOCSPRequest(cert, issuer)
+	if err != nil {
+		return RevocationStatusUnknown, err
+	}
+
+	resp, err := c.client.Post(server, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "OCSP request failed")
+	}
+	body, err := readRevocationResponse(resp)
+	if err != nil {
+		return RevocationStatusUnknown, err
+	}
+
+	return parseOCSPResponse(body, cert, issuer, c.now())
+}
+
+func (c *onlineRevocationChecker) checkCRL(dp string, cert, issuer *x509.Certificate) (RevocationStatus, error) {
+	resp, err := c.client.Get(dp)
+	if err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "CRL download failed")
+	}
+	body, err := readRevocationResponse(resp)
+	if err != nil {
+		return RevocationStatusUnknown, err
+	}
+
+	crl, err := x509.ParseCRL(body)
+	if err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "failed to parse CRL")
+	}
+	if err := issuer.CheckCRLSignature(crl); err != nil {
+		return RevocationStatusUnknown, errors.Wrap(err, "CRL is not signed by the issuer of the certificate")
+	}
+	if crl.HasExpired(c.now()) {
+		return RevocationStatusUnknown, errors.New("CRL has expired")
+	}
+
+	for _, rc := range crl.TBSCertList.RevokedCertificates {
+		if rc.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return RevocationStatusRevoked, nil
+		}
+	}
+	return RevocationStatusGood, nil
+}
+
+func readRevocationResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("server returned %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(&io.LimitedReader{R: resp.Body, N: maxRevocationResponseSize})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	return body, nil
+}
+
+// checkRevocationStatus consults the given revocation checker about the
+// revocation status of cert, issued by issuer. failOpen tells whether a
+// certificate whose status cannot be determined is accepted.
+func checkRevocationStatus(checker RevocationChecker, cert, issuer *x509.Certificate, failOpen bool) error {
+	status, err := checker.CheckRevocation(cert, issuer)
+	switch status {
+	case RevocationStatusGood:
+		return nil
+	case RevocationStatusRevoked:
+		return errors.New("The certificate has been revoked")
+	default:
+		if failOpen {
+			mspLogger.Warningf("Accepting certificate [%s] whose revocation status is unknown: %s", cert.Subject, err)
+			return nil
+		}
+		return errors.WithMessage(err, "could not check whether the certificate has been revoked")
+	}
+}
+
+// RevocationCheckable is implemented by the identities whose revocation status
+// can be checked online on demand. Unlike the revocation checker of an MSP,
+// which is consulted whenever the MSP validates an identity, this is meant for
+// checks whose outcome may differ between peers, such as the check of the
+// creator of a proposal to endorse.
+type RevocationCheckable interface {
+	// CheckRevocationOnline consults the given revocation checker about the
+	// revocation status of the identity, which must have been validated.
+	// failOpen tells whether the identity is accepted if its revocation status
+	// cannot be determined.
+	CheckRevocationOnline(checker RevocationChecker, failOpen bool) error
+}
+
+// CheckRevocationOnline implements RevocationCheckable for X.509 identities
+func (id *identity) CheckRevocationOnline(checker RevocationChecker, failOpen bool) error {
+	chain, err := id.msp.getCertificationChainForBCCSPIdentity(id)
+	if err != nil {
+		return errors.WithMessage(err, "could not obtain certification chain")
+	}
+	return checkRevocationStatus(checker, id.cert, chain[1], failOpen)
+}
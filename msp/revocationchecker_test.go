@@ -0,0 +1,347 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	m "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testOCSPResponder is a local OCSP responder that also serves a CRL
+type testOCSPResponder struct {
+	sync.Mutex
+	server *httptest.Server
+	// issuer is the CA the responder answers for
+	issuer    *x509.Certificate
+	issuerKey crypto.Signer
+	// responder and responderKey sign the responses; they are the issuer's unless delegated
+	responder    *x509.Certificate
+	responderKey crypto.Signer
+	revoked      map[string]bool
+	requests     int
+}
+
+func newTestOCSPResponder(issuer *x509.Certificate, issuerKey crypto.Signer) *testOCSPResponder {
+	r := &testOCSPResponder{
+		issuer:       issuer,
+		issuerKey:    issuerKey,
+		responder:    issuer,
+		responderKey: issuerKey,
+		revoked:      map[string]bool{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ocsp", r.serveOCSP)
+	mux.HandleFunc("/crl", r.serveCRL)
+	r.server = httptest.NewServer(mux)
+	return r
+}
+
+func (r *testOCSPResponder) revoke(serial *big.Int) {
+	r.Lock()
+	defer r.Unlock()
+	r.revoked[serial.String()] = true
+}
+
+func (r *testOCSPResponder) serveOCSP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	r.requests++
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ocspReq := &ocspRequest{}
+	if _, err := asn1.Unmarshal(body, ocspReq); err != nil || len(ocspReq.TBSRequest.RequestList) != 1 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	certID := ocspReq.TBSRequest.RequestList[0].Cert
+	single := ocspSingleResponse{CertID: certID, ThisUpdate: now, NextUpdate: now.Add(time.Hour)}
+	if r.revoked[certID.SerialNumber.String()] {
+		single.Revoked = ocspRevokedInfo{RevocationTime: now}
+	} else {
+		single.Good = true
+	}
+
+	keyHash, err := asn1.Marshal(certID.IssuerKeyHash)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	tbs, err := asn1.Marshal(ocspResponseData{
+		RawResponderID: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: keyHash},
+		ProducedAt:     now.UTC().Truncate(time.Second),
+		Responses:      []ocspSingleResponse{single},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	digest := crypto.SHA256.New()
+	digest.Write(tbs)
+	signature, err := r.responderKey.Sign(rand.Reader, digest.Sum(nil), crypto.SHA256)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	basicResp := ocspBasicResponse{
+		TBSResponseData:    ocspResponseData{Raw: tbs},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+		Signature:          asn1.BitString{Bytes: signature, BitLength: 8 * len(signature)},
+	}
+	if r.responder != r.issuer {
+		basicResp.Certificates = []asn1.RawValue{{FullBytes: r.responder.Raw}}
+	}
+	basicRespBytes, err := asn1.Marshal(basicResp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp, err := asn1.Marshal(ocspResponse{
+		Status:   ocspResponseSuccessful,
+		Response: ocspResponseBytes{ResponseType: oidOCSPBasicResponse, Response: basicRespBytes},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	w.Write(resp)
+}
+
+func (r *testOCSPResponder) serveCRL(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+
+	var revoked []pkix.RevokedCertificate
+	for serial := range r.revoked {
+		n, _ := new(big.Int).SetString(serial, 10)
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: n, RevocationTime: time.Now()})
+	}
+	crl, err := r.issuer.CreateCRL(rand.Reader, r.issuerKey, revoked, time.Now(), time.Now().Add(time.Hour))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(crl)
+}
+
+var testCertSerial int64
+
+func newTestCert(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	testCertSerial++
+	template.SerialNumber = big.NewInt(testCertSerial)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func newTestCA(t *testing.T) (*x509.Certificate, crypto.Signer) {
+	return newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "ca.example.com", Organization: []string{"example.com"}},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}, nil, nil)
+}
+
+func newTestLeaf(t *testing.T, ca *x509.Certificate, caKey crypto.Signer, ocspServers, crlDPs []string) *x509.Certificate {
+	cert, _ := newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "peer0.example.com"},
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		OCSPServer:            ocspServers,
+		CRLDistributionPoints: crlDPs,
+	}, ca, caKey)
+	return cert
+}
+
+// newRevocationTestMSP returns an MSP trusting ca that uses the given revocation checker
+func newRevocationTestMSP(t *testing.T, ca *x509.Certificate, checker RevocationChecker, failOpen bool) MSP {
+	theMsp, err := New(&BCCSPNewOpts{
+		NewBaseOpts:             NewBaseOpts{Version: MSPv1_1},
+		RevocationChecker:       checker,
+		RevocationCheckFailOpen: failOpen,
+	})
+	require.NoError(t, err)
+	fmspconf := &m.FabricMSPConfig{
+		Name:      "RevocationMSP",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})},
+		CryptoConfig: &m.FabricCryptoConfig{
+			SignatureHashFamily:            "SHA2",
+			IdentityIdentifierHashFunction: "SHA256",
+		},
+	}
+	fmspconfBytes, err := proto.Marshal(fmspconf)
+	require.NoError(t, err)
+	require.NoError(t, theMsp.Setup(&m.MSPConfig{Type: int32(FABRIC), Config: fmspconfBytes}))
+	return theMsp
+}
+
+func validateTestCert(theMsp MSP, cert *x509.Certificate) error {
+	sID, _ := proto.Marshal(&m.SerializedIdentity{
+		Mspid:   "RevocationMSP",
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+	})
+	id, err := theMsp.DeserializeIdentity(sID)
+	if err != nil {
+		return err
+	}
+	return theMsp.Validate(id)
+}
+
+func TestOnlineRevocationCheckOCSP(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	responder := newTestOCSPResponder(ca, caKey)
+	defer responder.server.Close()
+
+	theMsp := newRevocationTestMSP(t, ca, NewOnlineRevocationChecker(time.Second), false)
+
+	cert := newTestLeaf(t, ca, caKey, []string{responder.server.URL + "/ocsp"}, nil)
+	assert.NoError(t, validateTestCert(theMsp, cert))
+	assert.Equal(t, 1, responder.requests)
+
+	responder.revoke(cert.SerialNumber)
+	err := validateTestCert(theMsp, cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The certificate has been revoked")
+
+	// certificates that do not point to a responder are not checked
+	assert.NoError(t, validateTestCert(theMsp, newTestLeaf(t, ca, caKey, nil, nil)))
+	assert.Equal(t, 2, responder.requests)
+}
+
+func TestOnlineRevocationCheckDelegatedResponder(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	responder := newTestOCSPResponder(ca, caKey)
+	defer responder.server.Close()
+	checker := NewOnlineRevocationChecker(time.Second)
+	cert := newTestLeaf(t, ca, caKey, []string{responder.server.URL + "/ocsp"}, nil)
+
+	// a responder the CA delegated OCSP signing to
+	responder.responder, responder.responderKey = newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "ocsp.example.com"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+	}, ca, caKey)
+	status, err := checker.CheckRevocation(cert, ca)
+	assert.NoError(t, err)
+	assert.Equal(t, RevocationStatusGood, status)
+
+	// a certificate of the CA that is not meant for OCSP signing
+	responder.responder, responder.responderKey = newTestCert(t, &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "notocsp.example.com"},
+		KeyUsage: x509.KeyUsageDigitalSignature,
+	}, ca, caKey)
+	status, err = checker.CheckRevocation(cert, ca)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not authorized to sign OCSP responses")
+	assert.Equal(t, RevocationStatusUnknown, status)
+
+	// a responder of another CA
+	otherCA, otherCAKey := newTestCA(t)
+	responder.responder, responder.responderKey = otherCA, otherCAKey
+	status, err = checker.CheckRevocation(cert, ca)
+	assert.Error(t, err)
+	assert.Equal(t, RevocationStatusUnknown, status)
+}
+
+func TestOnlineRevocationCheckCRL(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	responder := newTestOCSPResponder(ca, caKey)
+	defer responder.server.Close()
+
+	theMsp := newRevocationTestMSP(t, ca, NewOnlineRevocationChecker(time.Second), false)
+
+	// the OCSP responder is down, so the CRL distribution point is used
+	cert := newTestLeaf(t, ca, caKey, []string{responder.server.URL + "/notfound"}, []string{responder.server.URL + "/crl"})
+	assert.NoError(t, validateTestCert(theMsp, cert))
+
+	responder.revoke(cert.SerialNumber)
+	err := validateTestCert(theMsp, cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The certificate has been revoked")
+}
+
+func TestOnlineRevocationCheckFailMode(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	responder := newTestOCSPResponder(ca, caKey)
+	url := responder.server.URL
+	responder.server.Close()
+	cert := newTestLeaf(t, ca, caKey, []string{url + "/ocsp"}, []string{url + "/crl"})
+
+	failClosedMsp := newRevocationTestMSP(t, ca, NewOnlineRevocationChecker(time.Second), false)
+	err := validateTestCert(failClosedMsp, cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not check whether the certificate has been revoked")
+
+	failOpenMsp := newRevocationTestMSP(t, ca, NewOnlineRevocationChecker(time.Second), true)
+	assert.NoError(t, validateTestCert(failOpenMsp, cert))
+}
+
+func TestCheckRevocationOnline(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	responder := newTestOCSPResponder(ca, caKey)
+	defer responder.server.Close()
+	checker := NewOnlineRevocationChecker(time.Second)
+
+	// an MSP that does not check revocation online when validating identities
+	theMsp := newRevocationTestMSP(t, ca, nil, false)
+	cert := newTestLeaf(t, ca, caKey, []string{responder.server.URL + "/ocsp"}, nil)
+	sID, err := proto.Marshal(&m.SerializedIdentity{
+		Mspid:   "RevocationMSP",
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+	})
+	require.NoError(t, err)
+	id, err := theMsp.DeserializeIdentity(sID)
+	require.NoError(t, err)
+	rc, ok := id.(RevocationCheckable)
+	require.True(t, ok, "X.509 identities can be checked online")
+
+	assert.NoError(t, rc.CheckRevocationOnline(checker, false))
+	assert.Equal(t, 1, responder.requests)
+
+	responder.revoke(cert.SerialNumber)
+	assert.NoError(t, theMsp.Validate(id))
+	assert.EqualError(t, rc.CheckRevocationOnline(checker, false), "The certificate has been revoked")
+
+	responder.server.Close()
+	assert.Error(t, rc.CheckRevocationOnline(checker, false))
+	assert.NoError(t, rc.CheckRevocationOnline(checker, true))
+}
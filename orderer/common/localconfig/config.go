@@ -39,22 +39,24 @@ type TopLevel struct {
 
 // General contains config which should be common among all orderer types.
 type General struct {
-	LedgerType     string
-	ListenAddress  string
-	ListenPort     uint16
-	TLS            TLS
-	Keepalive      Keepalive
-	GenesisMethod  string
-	GenesisProfile string
-	SystemChannel  string
-	GenesisFile    string
-	Profile        Profile
-	LogLevel       string
-	LogFormat      string
-	LocalMSPDir    string
-	LocalMSPID     string
-	BCCSP          *bccsp.FactoryOpts
-	Authentication Authentication
+	LedgerType       string
+	ListenAddress    string
+	ListenPort       uint16
+	TLS              TLS
+	Keepalive        Keepalive
+	GenesisMethod    string
+	GenesisProfile   string
+	SystemChannel    string
+	GenesisFile      string
+	Profile          Profile
+	LogLevel         string
+	LogFormat        string
+	LocalMSPDir      string
+	LocalMSPID       string
+	LocalMSPVersion  string
+	BCCSP            *bccsp.FactoryOpts
+	Authentication   Authentication
+	ThresholdSigning ThresholdSigning
 }
 
 // Keepalive contains configuration for gRPC servers.
@@ -87,6 +89,14 @@ type Authentication struct {
 	TimeWindow time.Duration
 }
 
+// ThresholdSigning contains configuration for signing blocks with a
+// share of a threshold signing key held jointly with other orderers.
+type ThresholdSigning struct {
+	Enabled  bool
+	KeyShare string
+	Timeout  time.Duration
+}
+
 // Profile contains configuration for Go pprof profiling.
 type Profile struct {
 	Enabled bool
@@ -196,6 +206,10 @@ var Defaults = TopLevel{
 		Authentication: Authentication{
 			TimeWindow: time.Duration(15 * time.Minute),
 		},
+		ThresholdSigning: ThresholdSigning{
+			Enabled: false,
+			Timeout: 5 * time.Second,
+		},
 	},
 	RAMLedger: RAMLedger{
 		HistorySize: 10000,
@@ -279,6 +293,7 @@ func (c *TopLevel) completeInitialization(configDir string) {
 		coreconfig.TranslatePathInPlace(configDir, &c.General.TLS.Certificate)
 		coreconfig.TranslatePathInPlace(configDir, &c.General.GenesisFile)
 		coreconfig.TranslatePathInPlace(configDir, &c.General.LocalMSPDir)
+		coreconfig.TranslatePathInPlace(configDir, &c.General.ThresholdSigning.KeyShare)
 	}()
 
 	for {
@@ -337,6 +352,12 @@ func (c *TopLevel) completeInitialization(configDir string) {
 			logger.Infof("General.Authentication.TimeWindow unset, setting to %s", Defaults.General.Authentication.TimeWindow)
 			c.General.Authentication.TimeWindow = Defaults.General.Authentication.TimeWindow
 
+		case c.General.ThresholdSigning.Enabled && c.General.ThresholdSigning.KeyShare == "":
+			logger.Panic("General.ThresholdSigning.KeyShare must be set if General.ThresholdSigning.Enabled is set to true.")
+		case c.General.ThresholdSigning.Timeout == 0:
+			logger.Infof("General.ThresholdSigning.Timeout unset, setting to %s", Defaults.General.ThresholdSigning.Timeout)
+			c.General.ThresholdSigning.Timeout = Defaults.General.ThresholdSigning.Timeout
+
 		case c.FileLedger.Prefix == "":
 			logger.Infof("FileLedger.Prefix unset, setting to %s", Defaults.FileLedger.Prefix)
 			c.FileLedger.Prefix = Defaults.FileLedger.Prefix
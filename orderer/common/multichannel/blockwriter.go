@@ -7,7 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package multichannel
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	newchannelconfig "github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/configtx"
@@ -18,8 +20,17 @@ import (
 	"github.com/hyperledger/fabric/protos/utils"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 )
 
+// blockSignerRetryInterval is the time waited before retrying to
+// compute the additional signature of a block
+var blockSignerRetryInterval = time.Second
+
+// blockSignerMaxAttempts is the number of times the additional signature
+// of a block is attempted before giving up on writing the block
+var blockSignerMaxAttempts = 10
+
 type blockWriterSupport interface {
 	crypto.LocalSigner
 	blockledger.ReadWriter
@@ -35,6 +46,7 @@ type blockWriterSupport interface {
 type BlockWriter struct {
 	support            blockWriterSupport
 	registrar          *Registrar
+	blockSigner        BlockSigner
 	lastConfigBlockNum uint64
 	lastConfigSeq      uint64
 	lastBlock          *cb.Block
@@ -47,6 +59,7 @@ func newBlockWriter(lastBlock *cb.Block, r *Registrar, support blockWriterSuppor
 		lastConfigSeq: support.Sequence(),
 		lastBlock:     lastBlock,
 		registrar:     r,
+		blockSigner:   r.blockSigner,
 	}
 
 	// If this is the genesis block, the lastconfig field may be empty, and, the last config block is necessarily block 0
@@ -75,6 +88,9 @@ func (bw *BlockWriter) AppendBlock(block *cb.Block) error {
 	bw.lastBlock = block
 
 	defer bw.committingBlock.Unlock()
+	if err := bw.addBlockSignerSignature(block); err != nil {
+		return err
+	}
 	return bw.support.Append(block)
 }
 
@@ -187,6 +203,9 @@ func (bw *BlockWriter) commitBlock(encodedMetadataValue []byte) {
 		bw.lastBlock.Metadata.Metadata[cb.BlockMetadataIndex_ORDERER] = utils.MarshalOrPanic(&cb.Metadata{Value: encodedMetadataValue})
 	}
 	bw.addBlockSignature(bw.lastBlock)
+	if err := bw.addBlockSignerSignature(bw.lastBlock); err != nil {
+		logger.Panicf("[channel: %s] Could not sign block: %s", bw.support.ChainID(), err)
+	}
 	bw.addLastConfigSignature(bw.lastBlock)
 
 	err := bw.support.Append(bw.lastBlock)
@@ -215,6 +234,43 @@ func (bw *BlockWriter) addBlockSignature(block *cb.Block) {
 	})
 }
 
+// addBlockSignerSignature appends the signature computed by the block signer, if any,
+// to the signatures of the block. Since blocks lacking it may not satisfy the block
+// validation policy of the channel, it is retried up to blockSignerMaxAttempts times,
+// after which an error is returned rather than writing the block without it.
+func (bw *BlockWriter) addBlockSignerSignature(block *cb.Block) error {
+	if bw.blockSigner == nil {
+		return nil
+	}
+
+	md, err := utils.GetMetadataFromBlock(block, cb.BlockMetadataIndex_SIGNATURES)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("could not extract the signatures of block %d", block.Header.Number))
+	}
+	if len(md.Value) != 0 {
+		return errors.Errorf("signatures metadata of block %d has a value, it cannot be signed by the block signer", block.Header.Number)
+	}
+
+	blockSignature := &cb.MetadataSignature{
+		SignatureHeader: utils.MarshalOrPanic(utils.NewSignatureHeaderOrPanic(bw.support)),
+	}
+	for attempt := 1; ; attempt++ {
+		blockSignature.Signature, err = bw.blockSigner.SignBlock(bw.support.ChainID(), blockSignature.SignatureHeader, block.Header)
+		if err == nil {
+			break
+		}
+		if attempt == blockSignerMaxAttempts {
+			return errors.WithMessage(err, fmt.Sprintf("failed signing block %d with the block signer after %d attempts", block.Header.Number, attempt))
+		}
+		logger.Warningf("[channel: %s] Failed signing block %d with the block signer, retrying in %s: %s", bw.support.ChainID(), block.Header.Number, blockSignerRetryInterval, err)
+		time.Sleep(blockSignerRetryInterval)
+	}
+
+	md.Signatures = append(md.Signatures, blockSignature)
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = utils.MarshalOrPanic(md)
+	return nil
+}
+
 func (bw *BlockWriter) addLastConfigSignature(block *cb.Block) {
 	configSeq := bw.support.Sequence()
 	if configSeq > bw.lastConfigSeq {
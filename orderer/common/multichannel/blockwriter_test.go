@@ -18,14 +18,17 @@ package multichannel
 
 import (
 	"testing"
+	"time"
 
 	newchannelconfig "github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	mockconfigtx "github.com/hyperledger/fabric/common/mocks/configtx"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/util"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,6 +73,83 @@ func TestBlockSignature(t *testing.T) {
 	assert.NotNil(t, md.Signatures, "Should have signature")
 }
 
+type mockBlockSigner struct {
+	failures int
+	calls    int
+}
+
+func (mbs *mockBlockSigner) SignBlock(chainID string, signatureHeader []byte, header *cb.BlockHeader) ([]byte, error) {
+	mbs.calls++
+	if mbs.calls <= mbs.failures {
+		return nil, errors.New("not enough partial signatures")
+	}
+	return util.ConcatenateBytes([]byte(chainID), signatureHeader, header.Bytes()), nil
+}
+
+func TestBlockSignerSignature(t *testing.T) {
+	defer func(interval time.Duration) { blockSignerRetryInterval = interval }(blockSignerRetryInterval)
+	blockSignerRetryInterval = time.Millisecond
+
+	blockSigner := &mockBlockSigner{failures: 2}
+	bw := &BlockWriter{
+		support: &mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+			Validator: &mockconfigtx.Validator{
+				ChainIDVal: "testchannel",
+			},
+		},
+		blockSigner: blockSigner,
+	}
+
+	block := cb.NewBlock(7, []byte("foo"))
+	bw.addBlockSignature(block)
+	assert.NoError(t, bw.addBlockSignerSignature(block))
+	assert.Equal(t, 3, blockSigner.calls, "Failed signatures should be retried")
+
+	md := utils.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_SIGNATURES)
+	assert.Nil(t, md.Value, "Value is empty in this case")
+	assert.Len(t, md.Signatures, 2, "The block signer signature should be appended")
+	blockSignature := md.Signatures[1]
+	assert.Equal(t, util.ConcatenateBytes([]byte("testchannel"), blockSignature.SignatureHeader, block.Header.Bytes()), blockSignature.Signature)
+
+	// without a block signer, no signature is added
+	bw.blockSigner = nil
+	assert.NoError(t, bw.addBlockSignerSignature(block))
+	assert.Len(t, utils.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_SIGNATURES).Signatures, 2)
+}
+
+func TestBlockSignerSignatureFailure(t *testing.T) {
+	defer func(interval time.Duration, attempts int) {
+		blockSignerRetryInterval, blockSignerMaxAttempts = interval, attempts
+	}(blockSignerRetryInterval, blockSignerMaxAttempts)
+	blockSignerRetryInterval = time.Millisecond
+	blockSignerMaxAttempts = 3
+
+	blockSigner := &mockBlockSigner{failures: 5}
+	bw := &BlockWriter{
+		support: &mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+			Validator: &mockconfigtx.Validator{
+				ChainIDVal: "testchannel",
+			},
+		},
+		blockSigner: blockSigner,
+	}
+
+	block := cb.NewBlock(7, []byte("foo"))
+	bw.addBlockSignature(block)
+	err := bw.addBlockSignerSignature(block)
+	assert.EqualError(t, err, "failed signing block 7 with the block signer after 3 attempts: not enough partial signatures")
+	assert.Equal(t, 3, blockSigner.calls, "Signing should give up after the maximum number of attempts")
+	assert.Len(t, utils.GetMetadataFromBlockOrPanic(block, cb.BlockMetadataIndex_SIGNATURES).Signatures, 1)
+
+	// a block whose signatures metadata has a value cannot be signed
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = utils.MarshalOrPanic(&cb.Metadata{Value: []byte("value")})
+	err = bw.addBlockSignerSignature(block)
+	assert.EqualError(t, err, "signatures metadata of block 7 has a value, it cannot be signed by the block signer")
+	assert.Equal(t, 3, blockSigner.calls)
+}
+
 func TestBlockLastConfig(t *testing.T) {
 	lastConfigSeq := uint64(6)
 	newConfigSeq := lastConfigSeq + 1
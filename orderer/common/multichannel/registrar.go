@@ -90,6 +90,14 @@ type ledgerResources struct {
 	blockledger.ReadWriter
 }
 
+// BlockSigner computes an additional signature of the blocks written by the
+// orderer, such as a threshold signature computed with other orderers.
+type BlockSigner interface {
+	// SignBlock returns a signature of the concatenation of the signature
+	// header and of the header of a block of the given channel.
+	SignBlock(chainID string, signatureHeader []byte, header *cb.BlockHeader) ([]byte, error)
+}
+
 // Registrar serves as a point of access and control for the individual channel resources.
 type Registrar struct {
 	lock   sync.RWMutex
@@ -98,6 +106,7 @@ type Registrar struct {
 	consenters      map[string]consensus.Consenter
 	ledgerFactory   blockledger.Factory
 	signer          crypto.LocalSigner
+	blockSigner     BlockSigner
 	systemChannelID string
 	systemChannel   *ChainSupport
 	templator       msgprocessor.ChannelConfigTemplator
@@ -120,12 +129,13 @@ func getConfigTx(reader blockledger.Reader) *cb.Envelope {
 
 // NewRegistrar produces an instance of a *Registrar.
 func NewRegistrar(ledgerFactory blockledger.Factory, consenters map[string]consensus.Consenter,
-	signer crypto.LocalSigner, callbacks ...func(bundle *channelconfig.Bundle)) *Registrar {
+	signer crypto.LocalSigner, blockSigner BlockSigner, callbacks ...func(bundle *channelconfig.Bundle)) *Registrar {
 	r := &Registrar{
 		chains:        make(map[string]*ChainSupport),
 		ledgerFactory: ledgerFactory,
 		consenters:    consenters,
 		signer:        signer,
+		blockSigner:   blockSigner,
 		callbacks:     callbacks,
 	}
 
@@ -5,6 +5,7 @@ package server
 
 import (
 	"bytes"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -12,8 +13,11 @@ import (
 	_ "net/http/pprof" // This is essentially the main package for the orderer
 
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp/threshold"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
@@ -26,6 +30,7 @@ import (
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/metadata"
 	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	"github.com/hyperledger/fabric/orderer/common/thresholdsign"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	"github.com/hyperledger/fabric/orderer/consensus/bftsmart" //JCS: import my package
 	"github.com/hyperledger/fabric/orderer/consensus/kafka"
@@ -38,6 +43,7 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/orderer/common/performance"
+	"github.com/pkg/errors"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
 
@@ -94,7 +100,15 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		}
 	}
 
-	manager := initializeMultichannelRegistrar(conf, signer, tlsCallback)
+	callbacks := []func(bundle *channelconfig.Bundle){tlsCallback}
+	var blockSigner multichannel.BlockSigner
+	thresholdSigner := initializeThresholdSigning(conf, serverConfig)
+	if thresholdSigner != nil {
+		blockSigner = thresholdSigner
+		callbacks = append(callbacks, thresholdSigner.UpdateEndpoints)
+	}
+
+	manager := initializeMultichannelRegistrar(conf, signer, blockSigner, callbacks...)
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	server := NewServer(manager, signer, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS)
 
@@ -102,7 +116,11 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	case start.FullCommand(): // "start" command
 		logger.Infof("Starting %s", metadata.GetVersionInfo())
 		initializeProfilingService(conf)
+		go handleReloadSignal(conf, grpcServer)
 		ab.RegisterAtomicBroadcastServer(grpcServer.Server(), server)
+		if thresholdSigner != nil {
+			ab.RegisterBlockSignerServer(grpcServer.Server(), thresholdSigner)
+		}
 		logger.Info("Beginning to serve requests")
 		grpcServer.Start()
 	case benchmark.FullCommand(): // "benchmark" command
@@ -235,6 +253,9 @@ func initializeGrpcServer(conf *localconfig.TopLevel, serverConfig comm.ServerCo
 }
 
 func initializeLocalMsp(conf *localconfig.TopLevel) {
+	if err := mspmgmt.SetLocalMspVersion(conf.General.LocalMSPVersion); err != nil {
+		logger.Fatal("Failed to initialize local MSP:", err)
+	}
 	// Load local MSP
 	err := mspmgmt.LoadLocalMsp(conf.General.LocalMSPDir, conf.General.BCCSP, conf.General.LocalMSPID)
 	if err != nil { // Handle errors reading the config file
@@ -242,8 +263,40 @@ func initializeLocalMsp(conf *localconfig.TopLevel) {
 	}
 }
 
+// reloadIdentities reloads the local MSP and the TLS server key pair of the orderer
+// from the files they were loaded from at startup, in order to pick up renewed
+// certificates without restarting the orderer
+func reloadIdentities(conf *localconfig.TopLevel, grpcServer *comm.GRPCServer) error {
+	err := mspmgmt.ReloadLocalMsp(conf.General.LocalMSPDir, conf.General.BCCSP, conf.General.LocalMSPID)
+	if err != nil {
+		return errors.WithMessage(err, "failed reloading local MSP")
+	}
+	if !grpcServer.TLSEnabled() {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(conf.General.TLS.Certificate, conf.General.TLS.PrivateKey)
+	if err != nil {
+		return errors.Wrap(err, "failed loading TLS server key pair")
+	}
+	grpcServer.SetServerCertificate(cert)
+	logger.Info("Reloaded TLS server certificate from", conf.General.TLS.Certificate)
+	return nil
+}
+
+// handleReloadSignal reloads the identities of the orderer whenever it receives a SIGHUP
+func handleReloadSignal(conf *localconfig.TopLevel, grpcServer *comm.GRPCServer) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	for range sigs {
+		logger.Info("Received SIGHUP, reloading identities")
+		if err := reloadIdentities(conf, grpcServer); err != nil {
+			logger.Errorf("Failed reloading identities: %+v", err)
+		}
+	}
+}
+
 func initializeMultichannelRegistrar(conf *localconfig.TopLevel, signer crypto.LocalSigner,
-	callbacks ...func(bundle *channelconfig.Bundle)) *multichannel.Registrar {
+	blockSigner multichannel.BlockSigner, callbacks ...func(bundle *channelconfig.Bundle)) *multichannel.Registrar {
 	lf, _ := createLedgerFactory(conf)
 	// Are we bootstrapping?
 	if len(lf.ChainIDs()) == 0 {
@@ -257,7 +310,49 @@ func initializeMultichannelRegistrar(conf *localconfig.TopLevel, signer crypto.L
 	consenters["kafka"] = kafka.New(conf.Kafka)
 	consenters["bftsmart"] = bftsmart.New(conf.BFTsmart) //JCS: create my own consenter
 
-	return multichannel.NewRegistrar(lf, consenters, signer, callbacks...)
+	return multichannel.NewRegistrar(lf, consenters, signer, blockSigner, callbacks...)
+}
+
+// initializeThresholdSigning returns the service signing blocks with the key share
+// of the orderer, connecting to the other orderers with the TLS settings of the
+// server, or nil if threshold signing is disabled
+func initializeThresholdSigning(conf *localconfig.TopLevel, serverConfig comm.ServerConfig) *thresholdsign.Service {
+	if !conf.General.ThresholdSigning.Enabled {
+		return nil
+	}
+
+	raw, err := ioutil.ReadFile(conf.General.ThresholdSigning.KeyShare)
+	if err != nil {
+		logger.Fatalf("Failed to load threshold key share file '%s' (%s)", conf.General.ThresholdSigning.KeyShare, err)
+	}
+	share, err := threshold.PEMtoKeyShare(raw)
+	if err != nil {
+		logger.Fatalf("Failed to parse threshold key share file '%s' (%s)", conf.General.ThresholdSigning.KeyShare, err)
+	}
+
+	secOpts := &comm.SecureOptions{
+		UseTLS:            serverConfig.SecOpts.UseTLS,
+		RequireClientCert: serverConfig.SecOpts.RequireClientCert,
+		ServerRootCAs:     serverConfig.SecOpts.ServerRootCAs,
+	}
+	if secOpts.RequireClientCert {
+		secOpts.Certificate = serverConfig.SecOpts.Certificate
+		secOpts.Key = serverConfig.SecOpts.Key
+	}
+	client, err := comm.NewGRPCClient(comm.ClientConfig{
+		SecOpts: secOpts,
+		Timeout: conf.General.ThresholdSigning.Timeout,
+	})
+	if err != nil {
+		logger.Fatalf("Failed to create the threshold signing client (%s)", err)
+	}
+
+	service, err := thresholdsign.NewService(share, thresholdsign.NewDialer(client), conf.General.ThresholdSigning.Timeout)
+	if err != nil {
+		logger.Fatalf("Failed to create the threshold signing service (%s)", err)
+	}
+	logger.Infof("Signing blocks with threshold key share %d, %d of %d shares are needed", share.Index, share.Threshold, len(share.SharePublicKeys))
+	return service
 }
 
 func updateTrustedRoots(srv *comm.GRPCServer, rootCASupport *comm.CASupport,
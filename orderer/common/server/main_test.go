@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -14,13 +15,16 @@ import (
 	"time"
 
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/threshold"
 	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/flogging/floggingtest"
 	"github.com/hyperledger/fabric/common/localmsp"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/stretchr/testify/assert"
 )
@@ -227,13 +231,65 @@ func TestInitializeLocalMsp(t *testing.T) {
 	})
 }
 
+func TestReloadIdentities(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	oldKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+	newKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "reload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	assert.NoError(t, ioutil.WriteFile(certFile, newKeyPair.Cert, 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, newKeyPair.Key, 0600))
+
+	grpcServer, err := comm.NewGRPCServer("127.0.0.1:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:      true,
+			Certificate: oldKeyPair.Cert,
+			Key:         oldKeyPair.Key,
+		},
+	})
+	assert.NoError(t, err)
+	defer grpcServer.Listener().Close()
+
+	localMSPDir, _ := configtest.GetDevMspDir()
+	conf := &localconfig.TopLevel{
+		General: localconfig.General{
+			LocalMSPDir: localMSPDir,
+			LocalMSPID:  "SampleOrg",
+			TLS: localconfig.TLS{
+				Enabled:     true,
+				Certificate: certFile,
+				PrivateKey:  keyFile,
+			},
+		},
+	}
+	err = reloadIdentities(conf, grpcServer)
+	assert.NoError(t, err)
+	assert.Equal(t, newKeyPair.TLSCert.Raw, grpcServer.ServerCertificate().Certificate[0])
+
+	conf.General.TLS.PrivateKey = "does_not_exist"
+	err = reloadIdentities(conf, grpcServer)
+	assert.Contains(t, err.Error(), "failed loading TLS server key pair")
+	assert.Equal(t, newKeyPair.TLSCert.Raw, grpcServer.ServerCertificate().Certificate[0])
+
+	conf.General.LocalMSPID = ""
+	err = reloadIdentities(conf, grpcServer)
+	assert.EqualError(t, err, "failed reloading local MSP: the local MSP must have an ID")
+}
+
 func TestInitializeMultiChainManager(t *testing.T) {
 	cleanup := configtest.SetDevFabricConfigPath(t)
 	defer cleanup()
 	conf := genesisConfig(t)
 	assert.NotPanics(t, func() {
 		initializeLocalMsp(conf)
-		initializeMultichannelRegistrar(conf, localmsp.NewSigner())
+		initializeMultichannelRegistrar(conf, localmsp.NewSigner(), nil)
 	})
 }
 
@@ -262,6 +318,30 @@ func TestInitializeGrpcServer(t *testing.T) {
 	})
 }
 
+func TestInitializeThresholdSigning(t *testing.T) {
+	conf := &localconfig.TopLevel{}
+	assert.Nil(t, initializeThresholdSigning(conf, initializeServerConfig(conf)))
+
+	rng, err := idemix.GetRand()
+	assert.NoError(t, err)
+	_, shares, err := threshold.Deal(2, 3, rng)
+	assert.NoError(t, err)
+	raw, err := threshold.KeyShareToPEM(shares[1])
+	assert.NoError(t, err)
+	dir, err := ioutil.TempDir("", "threshold")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	shareFile := filepath.Join(dir, "share-2.pem")
+	assert.NoError(t, ioutil.WriteFile(shareFile, raw, 0600))
+
+	conf.General.ThresholdSigning = localconfig.ThresholdSigning{
+		Enabled:  true,
+		KeyShare: shareFile,
+		Timeout:  time.Second,
+	}
+	assert.NotNil(t, initializeThresholdSigning(conf, initializeServerConfig(conf)))
+}
+
 func TestUpdateTrustedRoots(t *testing.T) {
 	cleanup := configtest.SetDevFabricConfigPath(t)
 	defer cleanup()
@@ -294,7 +374,7 @@ func TestUpdateTrustedRoots(t *testing.T) {
 			updateTrustedRoots(grpcServer, caSupport, bundle)
 		}
 	}
-	initializeMultichannelRegistrar(genesisConfig(t), localmsp.NewSigner(), callback)
+	initializeMultichannelRegistrar(genesisConfig(t), localmsp.NewSigner(), nil, callback)
 	t.Logf("# app CAs: %d", len(caSupport.AppRootCAsByChain[genesisconfig.TestChainID]))
 	t.Logf("# orderer CAs: %d", len(caSupport.OrdererRootCAsByChain[genesisconfig.TestChainID]))
 	// mutual TLS not required so no updates should have occurred
@@ -325,7 +405,7 @@ func TestUpdateTrustedRoots(t *testing.T) {
 			updateTrustedRoots(grpcServer, caSupport, bundle)
 		}
 	}
-	initializeMultichannelRegistrar(genesisConfig(t), localmsp.NewSigner(), callback)
+	initializeMultichannelRegistrar(genesisConfig(t), localmsp.NewSigner(), nil, callback)
 	t.Logf("# app CAs: %d", len(caSupport.AppRootCAsByChain[genesisconfig.TestChainID]))
 	t.Logf("# orderer CAs: %d", len(caSupport.OrdererRootCAsByChain[genesisconfig.TestChainID]))
 	// mutual TLS is required so updates should have occurred
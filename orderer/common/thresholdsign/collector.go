@@ -0,0 +1,80 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package thresholdsign
+
+import (
+	"github.com/hyperledger/fabric/bccsp/threshold"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// collector collects the partial signatures of a block
+// from the orderers of the channel of the block
+type collector struct {
+	service *Service
+	request *ab.PartialSignRequest
+}
+
+// CollectShares requests partial signatures from all the orderers of the
+// channel, and returns once needed valid partial signatures of other key
+// shares are gathered or once all the orderers replied or timed out.
+func (c *collector) CollectShares(message []byte, needed int) ([]*threshold.PartialSignature, error) {
+	endpoints := c.service.channelEndpoints(c.request.Channel)
+	ctx, cancel := context.WithTimeout(context.Background(), c.service.timeout)
+	defer cancel()
+
+	responses := make(chan *threshold.PartialSignature, len(endpoints))
+	for _, endpoint := range endpoints {
+		go func(endpoint string) {
+			responses <- c.requestPartialSignature(ctx, endpoint, message)
+		}(endpoint)
+	}
+
+	var partials []*threshold.PartialSignature
+	indexes := map[int]bool{c.service.share.Index: true}
+	for range endpoints {
+		partial := <-responses
+		if partial == nil || indexes[partial.Index] {
+			continue
+		}
+		indexes[partial.Index] = true
+		partials = append(partials, partial)
+		if len(partials) == needed {
+			return partials, nil
+		}
+	}
+	return nil, errors.Errorf("collected %d of the %d needed partial signatures from %d orderers", len(partials), needed, len(endpoints))
+}
+
+// requestPartialSignature returns the partial signature of the orderer
+// at endpoint, or nil if it could not be obtained or is invalid
+func (c *collector) requestPartialSignature(ctx context.Context, endpoint string, message []byte) *threshold.PartialSignature {
+	client, err := c.service.dial(endpoint)
+	if err != nil {
+		logger.Warningf("[channel: %s] Failed connecting to orderer %s: %s", c.request.Channel, endpoint, err)
+		return nil
+	}
+	response, err := client.PartialSign(ctx, c.request)
+	if err != nil {
+		logger.Warningf("[channel: %s] Orderer %s did not sign block %d: %s", c.request.Channel, endpoint, c.request.Header.Number, err)
+		return nil
+	}
+
+	partial := &threshold.PartialSignature{
+		Index:     int(response.Index),
+		Signature: response.Signature,
+	}
+	if partial.Index == c.service.share.Index {
+		return partial
+	}
+	if err := c.service.share.VerifyPartial(message, partial); err != nil {
+		logger.Warningf("[channel: %s] Orderer %s sent an invalid partial signature of block %d: %s", c.request.Channel, endpoint, c.request.Header.Number, err)
+		return nil
+	}
+	return partial
+}
@@ -0,0 +1,40 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package thresholdsign
+
+import (
+	"sync"
+
+	"github.com/hyperledger/fabric/core/comm"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"google.golang.org/grpc"
+)
+
+// NewDialer returns a Dialer which connects to orderers with client,
+// and reuses the connection to each endpoint once it is established.
+func NewDialer(client *comm.GRPCClient) Dialer {
+	var lock sync.Mutex
+	connections := make(map[string]*grpc.ClientConn)
+
+	return func(endpoint string) (ab.BlockSignerClient, error) {
+		// NewConnection is not safe for concurrent use with TLS,
+		// hence connections are established with the lock held
+		lock.Lock()
+		defer lock.Unlock()
+
+		conn, ok := connections[endpoint]
+		if !ok {
+			var err error
+			conn, err = client.NewConnection(endpoint, "")
+			if err != nil {
+				return nil, err
+			}
+			connections[endpoint] = conn
+		}
+		return ab.NewBlockSignerClient(conn), nil
+	}
+}
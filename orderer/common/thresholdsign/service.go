@@ -0,0 +1,177 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package thresholdsign
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/threshold"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/util"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+var logger = flogging.MustGetLogger("orderer.common.thresholdsign")
+
+// producedBlocksWindow is the number of block headers kept per
+// channel to answer the partial signature requests of other orderers
+const producedBlocksWindow = 100
+
+// Dialer returns a client of the BlockSigner service of the orderer at endpoint
+type Dialer func(endpoint string) (ab.BlockSignerClient, error)
+
+// Service holds a share of the threshold signing key of the ordering service.
+// It signs the blocks produced by the orderer jointly with the orderers holding
+// the other key shares, and serves their requests for partial signatures of the
+// blocks it produced itself.
+type Service struct {
+	share   *threshold.KeyShare
+	signer  *threshold.Signer
+	dial    Dialer
+	timeout time.Duration
+
+	lock      sync.Mutex
+	produced  map[string]map[uint64][]byte
+	updated   chan struct{}
+	endpoints map[string][]string
+}
+
+// NewService creates a new Service signing with share, which collects partial
+// signatures from the orderers it dials, waiting for each of them at most timeout.
+func NewService(share *threshold.KeyShare, dial Dialer, timeout time.Duration) (*Service, error) {
+	signer, err := threshold.NewSigner(share)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		share:     share,
+		signer:    signer,
+		dial:      dial,
+		timeout:   timeout,
+		produced:  make(map[string]map[uint64][]byte),
+		updated:   make(chan struct{}),
+		endpoints: make(map[string][]string),
+	}, nil
+}
+
+// UpdateEndpoints records the orderer addresses of the channel of the bundle,
+// it is meant to be registered as a callback of the registrar.
+func (s *Service) UpdateEndpoints(bundle *channelconfig.Bundle) {
+	chainID := bundle.ConfigtxValidator().ChainID()
+	addresses := bundle.ChannelConfig().OrdererAddresses()
+	logger.Debugf("[channel: %s] Orderer endpoints for threshold signing are %v", chainID, addresses)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.endpoints[chainID] = addresses
+}
+
+// SignBlock computes the threshold signature of the concatenation of the
+// signature header and of the block header, collecting the partial signatures
+// of the other orderers. It implements multichannel.BlockSigner.
+func (s *Service) SignBlock(chainID string, signatureHeader []byte, header *cb.BlockHeader) ([]byte, error) {
+	s.addProduced(chainID, header)
+
+	collector := &collector{
+		service: s,
+		request: &ab.PartialSignRequest{
+			Channel:         chainID,
+			SignatureHeader: signatureHeader,
+			Header:          header,
+		},
+	}
+	message := util.ConcatenateBytes(signatureHeader, header.Bytes())
+	return s.signer.Sign(nil, message, &threshold.SignerOpts{Collector: collector})
+}
+
+// PartialSign returns a partial signature of a block, provided that the
+// orderer produced the very same block.
+func (s *Service) PartialSign(ctx context.Context, request *ab.PartialSignRequest) (*ab.PartialSignResponse, error) {
+	if request.Header == nil {
+		return nil, errors.New("missing block header")
+	}
+	signatureHeader := &cb.SignatureHeader{}
+	if err := proto.Unmarshal(request.SignatureHeader, signatureHeader); err != nil {
+		return nil, errors.Wrap(err, "invalid signature header")
+	}
+	if len(signatureHeader.Creator) == 0 || len(signatureHeader.Nonce) == 0 {
+		return nil, errors.New("signature header must have a creator and a nonce")
+	}
+
+	number := request.Header.Number
+	produced, err := s.waitProduced(ctx, request.Channel, number)
+	if err != nil {
+		return nil, err
+	}
+	header := request.Header.Bytes()
+	if !bytes.Equal(produced, header) {
+		logger.Warningf("[channel: %s] Refusing to sign block %d which differs from the one produced by this orderer", request.Channel, number)
+		return nil, errors.Errorf("block %d of channel %s differs from the one produced by this orderer", number, request.Channel)
+	}
+
+	partial := s.share.Sign(util.ConcatenateBytes(request.SignatureHeader, header))
+	return &ab.PartialSignResponse{
+		Index:     int32(partial.Index),
+		Signature: partial.Signature,
+	}, nil
+}
+
+func (s *Service) addProduced(chainID string, header *cb.BlockHeader) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	headers, ok := s.produced[chainID]
+	if !ok {
+		headers = make(map[uint64][]byte)
+		s.produced[chainID] = headers
+	}
+	headers[header.Number] = header.Bytes()
+	if header.Number >= producedBlocksWindow {
+		delete(headers, header.Number-producedBlocksWindow)
+	}
+
+	close(s.updated)
+	s.updated = make(chan struct{})
+}
+
+// waitProduced returns the header of the block of the channel produced by
+// the orderer, waiting for it to be produced at most the timeout of s.
+func (s *Service) waitProduced(ctx context.Context, chainID string, number uint64) ([]byte, error) {
+	timeout := time.NewTimer(s.timeout)
+	defer timeout.Stop()
+
+	for {
+		s.lock.Lock()
+		header, ok := s.produced[chainID][number]
+		updated := s.updated
+		s.lock.Unlock()
+		if ok {
+			return header, nil
+		}
+
+		select {
+		case <-updated:
+		case <-timeout.C:
+			return nil, errors.Errorf("block %d of channel %s was not produced by this orderer", number, chainID)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (s *Service) channelEndpoints(chainID string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.endpoints[chainID]
+}
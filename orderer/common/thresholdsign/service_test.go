@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package thresholdsign
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp/threshold"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/idemix"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type localClient struct {
+	service *Service
+}
+
+func (lc *localClient) PartialSign(ctx context.Context, in *ab.PartialSignRequest, opts ...grpc.CallOption) (*ab.PartialSignResponse, error) {
+	return lc.service.PartialSign(ctx, in)
+}
+
+// newServices creates services holding the shares of a threshold key,
+// which dial each other directly. The endpoint of service i is orderer<i>.
+func newServices(t *testing.T, thresholdValue, n int, timeout time.Duration) (*threshold.PublicKey, []*Service) {
+	rng, err := idemix.GetRand()
+	require.NoError(t, err)
+	pk, shares, err := threshold.Deal(thresholdValue, n, rng)
+	require.NoError(t, err)
+
+	services := make([]*Service, n)
+	var endpoints []string
+	dial := func(endpoint string) (ab.BlockSignerClient, error) {
+		for i, e := range endpoints {
+			if e == endpoint && services[i] != nil {
+				return &localClient{service: services[i]}, nil
+			}
+		}
+		return nil, errors.Errorf("unknown endpoint %s", endpoint)
+	}
+	for i := range shares {
+		endpoints = append(endpoints, fmt.Sprintf("orderer%d", i))
+	}
+	for i, share := range shares {
+		services[i], err = NewService(share, dial, timeout)
+		require.NoError(t, err)
+		services[i].endpoints["testchannel"] = endpoints
+	}
+	return pk, services
+}
+
+func signatureHeader() []byte {
+	return utils.MarshalOrPanic(&cb.SignatureHeader{Creator: []byte("orderer"), Nonce: []byte("nonce")})
+}
+
+func TestSignBlock(t *testing.T) {
+	pk, services := newServices(t, 3, 4, time.Second)
+	header := cb.NewBlock(5, []byte("previous")).Header
+	sigHeader := signatureHeader()
+
+	// the other orderers produce the same block concurrently
+	go services[1].addProduced("testchannel", header)
+	go services[3].addProduced("testchannel", header)
+
+	signature, err := services[0].SignBlock("testchannel", sigHeader, header)
+	require.NoError(t, err)
+	assert.NoError(t, threshold.Verify(pk, util.ConcatenateBytes(sigHeader, header.Bytes()), signature))
+}
+
+func TestSignBlockNotEnoughShares(t *testing.T) {
+	_, services := newServices(t, 3, 4, 100*time.Millisecond)
+	header := cb.NewBlock(5, []byte("previous")).Header
+
+	// one orderer produced a different block, and one is unreachable
+	services[1].addProduced("testchannel", cb.NewBlock(5, []byte("other previous")).Header)
+	services[2].addProduced("testchannel", header)
+	services[3] = nil
+
+	_, err := services[0].SignBlock("testchannel", signatureHeader(), header)
+	assert.EqualError(t, err, "failed collecting partial signatures: collected 1 of the 2 needed partial signatures from 4 orderers")
+}
+
+func TestPartialSign(t *testing.T) {
+	_, services := newServices(t, 2, 2, 50*time.Millisecond)
+	header := cb.NewBlock(5, []byte("previous")).Header
+	request := &ab.PartialSignRequest{Channel: "testchannel", SignatureHeader: signatureHeader(), Header: header}
+
+	_, err := services[0].PartialSign(context.Background(), &ab.PartialSignRequest{Channel: "testchannel"})
+	assert.EqualError(t, err, "missing block header")
+	_, err = services[0].PartialSign(context.Background(), &ab.PartialSignRequest{Channel: "testchannel", SignatureHeader: []byte("bad"), Header: header})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid signature header")
+	_, err = services[0].PartialSign(context.Background(), &ab.PartialSignRequest{Channel: "testchannel", SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{}), Header: header})
+	assert.EqualError(t, err, "signature header must have a creator and a nonce")
+
+	_, err = services[0].PartialSign(context.Background(), request)
+	assert.EqualError(t, err, "block 5 of channel testchannel was not produced by this orderer")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = services[0].PartialSign(ctx, request)
+	assert.Equal(t, context.Canceled, err)
+
+	services[0].addProduced("testchannel", cb.NewBlock(5, []byte("other previous")).Header)
+	_, err = services[0].PartialSign(context.Background(), request)
+	assert.EqualError(t, err, "block 5 of channel testchannel differs from the one produced by this orderer")
+
+	services[1].addProduced("testchannel", header)
+	response, err := services[1].PartialSign(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), response.Index)
+	assert.NoError(t, services[0].share.VerifyPartial(util.ConcatenateBytes(request.SignatureHeader, header.Bytes()), &threshold.PartialSignature{
+		Index:     int(response.Index),
+		Signature: response.Signature,
+	}))
+}
+
+func TestProducedBlocksWindow(t *testing.T) {
+	_, services := newServices(t, 1, 1, time.Millisecond)
+	for i := uint64(0); i <= producedBlocksWindow; i++ {
+		services[0].addProduced("testchannel", cb.NewBlock(i, nil).Header)
+	}
+	assert.Len(t, services[0].produced["testchannel"], producedBlocksWindow)
+	assert.NotContains(t, services[0].produced["testchannel"], uint64(0))
+}
@@ -0,0 +1,63 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric/core/chaincode/persistence"
+)
+
+// calculatePackageIDCmd returns the cobra command for calculating the
+// package ID of a chaincode package
+func calculatePackageIDCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	cpiCmd := &cobra.Command{
+		Use:       "calculatepackageid",
+		Short:     "Calculate the package ID of the specified chaincode package",
+		Long:      "Calculate the package ID of the specified chaincode package. Organizations that installed packages with the same ID installed byte-identical chaincode.",
+		ValidArgs: []string{"1"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("peer chaincode calculatepackageid <package>")
+			}
+			// Parsing of the command line is done so silence cmd usage
+			cmd.SilenceUsage = true
+
+			packageID, err := calculatePackageID(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(packageID)
+			return nil
+		},
+	}
+
+	return cpiCmd
+}
+
+// calculatePackageID parses the versioned chaincode package stored in the
+// given file and returns its package ID
+func calculatePackageID(packageFile string) (string, error) {
+	pkgBytes, err := ioutil.ReadFile(packageFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading chaincode package %s: %s", packageFile, err)
+	}
+
+	ccPackage, err := persistence.ChaincodePackageParser{}.Parse(pkgBytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing chaincode package %s: %s", packageFile, err)
+	}
+
+	if ccPackage.Metadata.Label == "" {
+		return "", fmt.Errorf("chaincode package %s has no label, only packages created with --label have a package ID", packageFile)
+	}
+
+	return persistence.PackageID(ccPackage.Metadata.Label, pkgBytes), nil
+}
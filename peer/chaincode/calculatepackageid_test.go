@@ -0,0 +1,48 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculatePackageIDCmd(t *testing.T) {
+	pdir := newTempDir()
+	defer os.RemoveAll(pdir)
+
+	cmd := calculatePackageIDCmd(nil)
+	cmd.SetArgs([]string{})
+	assert.EqualError(t, cmd.Execute(), "peer chaincode calculatepackageid <package>")
+
+	cmd = calculatePackageIDCmd(nil)
+	cmd.SetArgs([]string{pdir + "/missing"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error reading chaincode package")
+}
+
+func TestCalculatePackageIDLegacyPackage(t *testing.T) {
+	pdir := newTempDir()
+	defer os.RemoveAll(pdir)
+
+	data, err := ioutil.ReadFile("../../core/chaincode/persistence/testdata/good-package.tar.gz")
+	assert.NoError(t, err)
+	ccpackfile := pdir + "/good-package.tar.gz"
+	assert.NoError(t, ioutil.WriteFile(ccpackfile, data, 0600))
+
+	_, err = calculatePackageID(ccpackfile)
+	assert.EqualError(t, err, "chaincode package "+ccpackfile+" has no label, only packages created with --label have a package ID")
+
+	notAPackage := pdir + "/ccpack.file"
+	assert.NoError(t, ioutil.WriteFile(notAPackage, []byte("garbage"), 0600))
+	_, err = calculatePackageID(notAPackage)
+	assert.Contains(t, err.Error(), "error parsing chaincode package")
+}
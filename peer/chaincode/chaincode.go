@@ -23,7 +23,7 @@ import (
 
 const (
 	chainFuncName = "chaincode"
-	chainCmdDes   = "Operate a chaincode: install|instantiate|invoke|package|query|signpackage|upgrade|list."
+	chainCmdDes   = "Operate a chaincode: install|instantiate|invoke|package|calculatepackageid|query|signpackage|upgrade|updatecollections|list."
 )
 
 var logger = flogging.MustGetLogger("chaincodeCmd")
@@ -53,9 +53,11 @@ func Cmd(cf *ChaincodeCmdFactory) *cobra.Command {
 	chaincodeCmd.AddCommand(instantiateCmd(cf))
 	chaincodeCmd.AddCommand(invokeCmd(cf))
 	chaincodeCmd.AddCommand(packageCmd(cf, nil))
+	chaincodeCmd.AddCommand(calculatePackageIDCmd(cf))
 	chaincodeCmd.AddCommand(queryCmd(cf))
 	chaincodeCmd.AddCommand(signpackageCmd(cf))
 	chaincodeCmd.AddCommand(upgradeCmd(cf))
+	chaincodeCmd.AddCommand(updateCollectionsCmd(cf))
 	chaincodeCmd.AddCommand(listCmd(cf))
 
 	return chaincodeCmd
@@ -79,11 +81,16 @@ var (
 	transient             string
 	collectionsConfigFile string
 	collectionConfigBytes []byte
+	channelV14            bool
 	peerAddresses         []string
 	tlsRootCertFiles      []string
 	connectionProfile     string
 	waitForEvent          bool
 	waitForEventTimeout   time.Duration
+	executeTimeout        time.Duration
+	maxConcurrency        uint32
+	milliCPUs             uint64
+	memoryBytes           uint64
 )
 
 var chaincodeCmd = &cobra.Command{
@@ -132,6 +139,8 @@ func resetFlags() {
 		"Get the instantiated chaincodes on a channel")
 	flags.StringVar(&collectionsConfigFile, "collections-config", common.UndefinedParamValue,
 		fmt.Sprint("The fully qualified path to the collection JSON file including the file name"))
+	flags.BoolVar(&channelV14, "channelV1_4", false,
+		fmt.Sprint("Whether the channel has the V1_4 channel capability, which allows principals with certificate attributes in the endorsement and collection policies"))
 	flags.StringArrayVarP(&peerAddresses, "peerAddresses", "", []string{common.UndefinedParamValue},
 		fmt.Sprint("The addresses of the peers to connect to"))
 	flags.StringArrayVarP(&tlsRootCertFiles, "tlsRootCertFiles", "", []string{common.UndefinedParamValue},
@@ -142,6 +151,14 @@ func resetFlags() {
 		fmt.Sprint("Whether to wait for the event from each peer's deliver filtered service signifying that the 'invoke' transaction has been committed successfully"))
 	flags.DurationVar(&waitForEventTimeout, "waitForEventTimeout", 30*time.Second,
 		fmt.Sprint("Time to wait for the event from each peer's deliver filtered service signifying that the 'invoke' transaction has been committed successfully"))
+	flags.DurationVar(&executeTimeout, "execute-timeout", 0,
+		fmt.Sprint("Maximum time a single invocation of the chaincode may take, overrides the peer's chaincode.executetimeout"))
+	flags.Uint32Var(&maxConcurrency, "max-concurrency", 0,
+		fmt.Sprint("Maximum number of invocations the peer sends to the chaincode concurrently (0 means unlimited)"))
+	flags.Uint64Var(&milliCPUs, "cpu-limit", 0,
+		fmt.Sprint("CPU available to the chaincode container, in thousandths of a CPU, at least 10 (0 means the peer's vm.docker.hostConfig applies)"))
+	flags.Uint64Var(&memoryBytes, "memory-limit", 0,
+		fmt.Sprint("Memory available to the chaincode container, in bytes (0 means the peer's vm.docker.hostConfig applies)"))
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
@@ -15,6 +15,7 @@ import (
 	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/cauthdsl"
@@ -22,6 +23,7 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/chaincode"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/msp"
 	ccapi "github.com/hyperledger/fabric/peer/chaincode/api"
@@ -61,10 +63,25 @@ func getChaincodeDeploymentSpec(spec *pb.ChaincodeSpec, crtPkg bool) (*pb.Chainc
 			return nil, err
 		}
 	}
-	chaincodeDeploymentSpec := &pb.ChaincodeDeploymentSpec{ChaincodeSpec: spec, CodePackage: codePackageBytes}
+	chaincodeDeploymentSpec := &pb.ChaincodeDeploymentSpec{ChaincodeSpec: spec, CodePackage: codePackageBytes, ResourceLimits: getResourceLimits()}
 	return chaincodeDeploymentSpec, nil
 }
 
+// getResourceLimits returns the resource limits requested on the command
+// line or nil when none were
+func getResourceLimits() *pb.ChaincodeResourceLimits {
+	if executeTimeout == 0 && maxConcurrency == 0 && milliCPUs == 0 && memoryBytes == 0 {
+		return nil
+	}
+
+	return &pb.ChaincodeResourceLimits{
+		ExecuteTimeoutMs: uint64(executeTimeout / time.Millisecond),
+		MaxConcurrency:   maxConcurrency,
+		MilliCpus:        milliCPUs,
+		MemoryBytes:      memoryBytes,
+	}
+}
+
 // getChaincodeSpec get chaincode spec from the cli cmd pramameters
 func getChaincodeSpec(cmd *cobra.Command) (*pb.ChaincodeSpec, error) {
 	spec := &pb.ChaincodeSpec{}
@@ -153,11 +170,14 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 }
 
 type collectionConfigJson struct {
-	Name          string `json:"name"`
-	Policy        string `json:"policy"`
-	RequiredCount int32  `json:"requiredPeerCount"`
-	MaxPeerCount  int32  `json:"maxPeerCount"`
-	BlockToLive   uint64 `json:"blockToLive"`
+	Name              string `json:"name"`
+	Policy            string `json:"policy"`
+	RequiredCount     int32  `json:"requiredPeerCount"`
+	MaxPeerCount      int32  `json:"maxPeerCount"`
+	BlockToLive       uint64 `json:"blockToLive"`
+	MemberOnlyRead    bool   `json:"memberOnlyRead"`
+	MemberOnlyWrite   bool   `json:"memberOnlyWrite"`
+	EndorsementPolicy string `json:"endorsementPolicy"`
 }
 
 // getCollectionConfig retrieves the collection configuration
@@ -184,7 +204,7 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 
 	ccarray := make([]*pcommon.CollectionConfig, 0, len(*cconf))
 	for _, cconfitem := range *cconf {
-		p, err := cauthdsl.FromString(cconfitem.Policy)
+		p, err := policyFromString(cconfitem.Policy)
 		if err != nil {
 			return nil, errors.WithMessage(err, fmt.Sprintf("invalid policy %s", cconfitem.Policy))
 		}
@@ -195,6 +215,19 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 			},
 		}
 
+		var ep *pcommon.CollectionPolicyConfig
+		if cconfitem.EndorsementPolicy != "" {
+			p, err := policyFromString(cconfitem.EndorsementPolicy)
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("invalid endorsement policy %s", cconfitem.EndorsementPolicy))
+			}
+			ep = &pcommon.CollectionPolicyConfig{
+				Payload: &pcommon.CollectionPolicyConfig_SignaturePolicy{
+					SignaturePolicy: p,
+				},
+			}
+		}
+
 		cc := &pcommon.CollectionConfig{
 			Payload: &pcommon.CollectionConfig_StaticCollectionConfig{
 				StaticCollectionConfig: &pcommon.StaticCollectionConfig{
@@ -203,6 +236,9 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 					RequiredPeerCount: cconfitem.RequiredCount,
 					MaximumPeerCount:  cconfitem.MaxPeerCount,
 					BlockToLive:       cconfitem.BlockToLive,
+					MemberOnlyRead:    cconfitem.MemberOnlyRead,
+					MemberOnlyWrite:   cconfitem.MemberOnlyWrite,
+					EndorsementPolicy: ep,
 				},
 			},
 		}
@@ -214,6 +250,16 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 	return proto.Marshal(ccp)
 }
 
+// policyFromString parses the given endorsement or collection policy,
+// allowing the principals that require the V1_4 channel capability
+// only if the channel is declared to have it
+func policyFromString(policy string) (*pcommon.SignaturePolicyEnvelope, error) {
+	if channelV14 {
+		return cauthdsl.FromStringV14(policy)
+	}
+	return cauthdsl.FromString(policy)
+}
+
 func checkChaincodeCmdParams(cmd *cobra.Command) error {
 	// we need chaincode name for everything, including deploy
 	if chaincodeName == common.UndefinedParamValue {
@@ -241,7 +287,7 @@ func checkChaincodeCmdParams(cmd *cobra.Command) error {
 		}
 
 		if policy != common.UndefinedParamValue {
-			p, err := cauthdsl.FromString(policy)
+			p, err := policyFromString(policy)
 			if err != nil {
 				return errors.Errorf("invalid policy %s", policy)
 			}
@@ -255,6 +301,10 @@ func checkChaincodeCmdParams(cmd *cobra.Command) error {
 				return errors.WithMessage(err, fmt.Sprintf("invalid collection configuration in file %s", collectionsConfigFile))
 			}
 		}
+
+		if err := ccprovider.ValidateResourceLimits(getResourceLimits()); err != nil {
+			return err
+		}
 	}
 
 	// Check that non-empty chaincode parameters contain only Args as a key.
@@ -195,6 +195,26 @@ const sampleCollectionConfigBad = `[
 	}
 ]`
 
+const sampleCollectionConfigWithAccess = `[
+	{
+		"name": "foo",
+		"policy": "OR('A.member', 'B.member')",
+		"requiredPeerCount": 1,
+		"maxPeerCount": 2,
+		"memberOnlyRead": true,
+		"memberOnlyWrite": true,
+		"endorsementPolicy": "AND('A.peer', 'B.peer')"
+	}
+]`
+
+const sampleCollectionConfigBadEndorsementPolicy = `[
+	{
+		"name": "foo",
+		"policy": "OR('A.member', 'B.member')",
+		"endorsementPolicy": "barf"
+	}
+]`
+
 func TestCollectionParsing(t *testing.T) {
 	cc, err := getCollectionConfigFromBytes([]byte(sampleCollectionConfigGood))
 	assert.NoError(t, err)
@@ -208,8 +228,26 @@ func TestCollectionParsing(t *testing.T) {
 	assert.Equal(t, "foo", conf.Name)
 	assert.Equal(t, pol, conf.MemberOrgsPolicy.GetSignaturePolicy())
 	assert.Equal(t, 10, int(conf.BlockToLive))
+	assert.False(t, conf.MemberOnlyRead)
+	assert.False(t, conf.MemberOnlyWrite)
+	assert.Nil(t, conf.EndorsementPolicy)
 	t.Logf("conf=%s", conf)
 
+	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigWithAccess))
+	assert.NoError(t, err)
+	ccp = &common2.CollectionConfigPackage{}
+	proto.Unmarshal(cc, ccp)
+	conf = ccp.Config[0].GetStaticCollectionConfig()
+	ep, _ := cauthdsl.FromString("AND('A.peer', 'B.peer')")
+	assert.True(t, conf.MemberOnlyRead)
+	assert.True(t, conf.MemberOnlyWrite)
+	assert.Equal(t, ep, conf.EndorsementPolicy.GetSignaturePolicy())
+
+	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBadEndorsementPolicy))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid endorsement policy barf")
+	assert.Nil(t, cc)
+
 	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBad))
 	assert.Error(t, err)
 	assert.Nil(t, cc)
@@ -219,6 +257,48 @@ func TestCollectionParsing(t *testing.T) {
 	assert.Nil(t, cc)
 }
 
+func TestCollectionParsingWithAttributes(t *testing.T) {
+	defer func() { channelV14 = false }()
+	config := []byte(`[{"name":"foo","policy":"OR('A.member with attr role=auditor', 'B.member')","requiredPeerCount":0,"maxPeerCount":1}]`)
+
+	_, err := getCollectionConfigFromBytes(config)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "attributes require the V1_4 channel capability")
+
+	channelV14 = true
+	cc, err := getCollectionConfigFromBytes(config)
+	assert.NoError(t, err)
+	ccp := &common2.CollectionConfigPackage{}
+	proto.Unmarshal(cc, ccp)
+	pol, _ := cauthdsl.FromStringV14("OR('A.member with attr role=auditor', 'B.member')")
+	assert.Equal(t, pol, ccp.Config[0].GetStaticCollectionConfig().MemberOrgsPolicy.GetSignaturePolicy())
+}
+
+func TestGetResourceLimits(t *testing.T) {
+	defer resetFlags()
+
+	resetFlags()
+	assert.Nil(t, getResourceLimits())
+
+	executeTimeout = 5 * time.Second
+	maxConcurrency = 10
+	milliCPUs = 250
+	memoryBytes = 64 * 1024 * 1024
+	assert.Equal(t, &pb.ChaincodeResourceLimits{
+		ExecuteTimeoutMs: 5000,
+		MaxConcurrency:   10,
+		MilliCpus:        250,
+		MemoryBytes:      64 * 1024 * 1024,
+	}, getResourceLimits())
+
+	cds, err := getChaincodeDeploymentSpec(&pb.ChaincodeSpec{
+		Type:        pb.ChaincodeSpec_GOLANG,
+		ChaincodeId: &pb.ChaincodeID{Name: "mycc", Version: "1.0"},
+	}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), cds.ResourceLimits.GetMaxConcurrency())
+}
+
 func TestValidatePeerConnectionParams(t *testing.T) {
 	defer resetFlags()
 	defer viper.Reset()
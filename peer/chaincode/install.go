@@ -13,6 +13,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"github.com/hyperledger/fabric/core/chaincode/persistence"
 	"github.com/hyperledger/fabric/core/common/ccpackage"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/peer/common"
@@ -53,6 +54,10 @@ func installCmd(cf *ChaincodeCmdFactory) *cobra.Command {
 		"peerAddresses",
 		"tlsRootCertFiles",
 		"connectionProfile",
+		"execute-timeout",
+		"max-concurrency",
+		"cpu-limit",
+		"memory-limit",
 	}
 	attachFlags(chaincodeInstallCmd, flagList)
 
@@ -150,6 +155,43 @@ func getPackageFromFile(ccpackfile string) (proto.Message, *pb.ChaincodeDeployme
 	return o, cds, nil
 }
 
+//getVersionedPackageFromFile gets the ChaincodeDeploymentSpec that installs the code of
+//the versioned chaincode package in the file, along with the package ID of the package.
+//The spec is nil if the file does not hold a versioned chaincode package
+func getVersionedPackageFromFile(ccpackfile string) (*pb.ChaincodeDeploymentSpec, string, error) {
+	b, err := ioutil.ReadFile(ccpackfile)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ccPackage, err := persistence.ChaincodePackageParser{}.Parse(b)
+	if err != nil || ccPackage.Metadata.FormatVersion == 0 {
+		return nil, "", nil
+	}
+
+	//the versioned package format carries neither the name nor the version of the chaincode
+	if chaincodeName == common.UndefinedParamValue || chaincodeVersion == common.UndefinedParamValue {
+		return nil, "", fmt.Errorf("Must supply value for %s name and version parameters to install a versioned chaincode package.", chainFuncName)
+	}
+
+	//the peer looks for the statedb indexes of the chaincode in the code package
+	codePackage, err := ccPackage.CodePackageWithDBArtifacts()
+	if err != nil {
+		return nil, "", fmt.Errorf("error adding statedb artifacts to the code package: %s", err)
+	}
+
+	cds := &pb.ChaincodeDeploymentSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value[ccPackage.Metadata.Type]),
+			ChaincodeId: &pb.ChaincodeID{Path: ccPackage.Metadata.Path, Name: chaincodeName, Version: chaincodeVersion},
+		},
+		CodePackage:    codePackage,
+		ResourceLimits: getResourceLimits(),
+	}
+
+	return cds, persistence.PackageID(ccPackage.Metadata.Label, b), nil
+}
+
 // chaincodeInstall installs the chaincode. If remoteinstall, does it via a lscc call
 func chaincodeInstall(cmd *cobra.Command, ccpackfile string, cf *ChaincodeCmdFactory) error {
 	// Parsing of the command line is done so silence cmd usage
@@ -164,6 +206,7 @@ func chaincodeInstall(cmd *cobra.Command, ccpackfile string, cf *ChaincodeCmdFac
 	}
 
 	var ccpackmsg proto.Message
+	var packageID string
 	if ccpackfile == "" {
 		if chaincodePath == common.UndefinedParamValue || chaincodeVersion == common.UndefinedParamValue || chaincodeName == common.UndefinedParamValue {
 			return fmt.Errorf("Must supply value for %s name, path and version parameters.", chainFuncName)
@@ -173,6 +216,12 @@ func chaincodeInstall(cmd *cobra.Command, ccpackfile string, cf *ChaincodeCmdFac
 		if err != nil {
 			return err
 		}
+	} else if cds, id, err := getVersionedPackageFromFile(ccpackfile); err != nil || cds != nil {
+		//a package generated by the "package" sub-command with a label
+		if err != nil {
+			return err
+		}
+		ccpackmsg, packageID = cds, id
 	} else {
 		//read in a package generated by the "package" sub-command (and perhaps signed
 		//by multiple owners with the "signpackage" sub-command)
@@ -199,6 +248,13 @@ func chaincodeInstall(cmd *cobra.Command, ccpackfile string, cf *ChaincodeCmdFac
 	}
 
 	err = install(ccpackmsg, cf)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if packageID != "" {
+		fmt.Printf("Installed chaincode package %s\n", packageID)
+	}
+
+	return nil
 }
@@ -12,11 +12,13 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hyperledger/fabric/core/chaincode/platforms/ccmetadata"
 	"github.com/hyperledger/fabric/peer/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 )
 
 func initInstallTest(fsPath string, t *testing.T) (*cobra.Command, *ChaincodeCmdFactory) {
@@ -112,6 +114,61 @@ func TestInstallFromPackage(t *testing.T) {
 	}
 }
 
+// TestInstallFromVersionedPackage installs using a package in the versioned package format
+func TestInstallFromVersionedPackage(t *testing.T) {
+	pdir := newTempDir()
+	defer os.RemoveAll(pdir)
+
+	ccpackfile := pdir + "/ccpack.tar.gz"
+	cmd := packageCmd(&ChaincodeCmdFactory{}, mockTargzCDSFactory)
+	addFlags(cmd)
+	cmd.SetArgs([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "--label", "somecc_0", ccpackfile})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("could not create package :%v", err)
+	}
+	packageLabel = ""
+
+	fsPath := "/tmp/installtest"
+
+	cmd, mockCF := initInstallTest(fsPath, t)
+	defer cleanupInstallTest(fsPath)
+
+	mockResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200},
+		Endorsement: &pb.Endorsement{},
+	}
+	mockEndorserClient := common.GetMockEndorserClient(mockResponse, nil)
+	mockCF.EndorserClients = []pb.EndorserClient{mockEndorserClient}
+
+	cds, packageID, err := getVersionedPackageFromFile(ccpackfile)
+	assert.NoError(t, err)
+	expectedPackageID, err := calculatePackageID(ccpackfile)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPackageID, packageID)
+	assert.Equal(t, &pb.ChaincodeID{Path: "some/go/package", Name: "somecc", Version: "0"}, cds.ChaincodeSpec.ChaincodeId)
+	assert.Equal(t, pb.ChaincodeSpec_GOLANG, cds.ChaincodeSpec.Type)
+	mdProvider := &ccmetadata.TargzMetadataProvider{Code: cds.CodePackage}
+	dbArtifacts, err := mdProvider.GetMetadataAsTarEntries()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, dbArtifacts)
+
+	cmd.SetArgs([]string{"-n", "somecc", "-v", "0", ccpackfile})
+	assert.NoError(t, cmd.Execute())
+
+	// the versioned package format carries neither the name nor the version
+	chaincodeName, chaincodeVersion = "", ""
+	_, _, err = getVersionedPackageFromFile(ccpackfile)
+	assert.EqualError(t, err, "Must supply value for chaincode name and version parameters to install a versioned chaincode package.")
+
+	// a deployment spec is not a versioned chaincode package
+	cdsfile := pdir + "/ccpack.file"
+	err = createSignedCDSPackage([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", cdsfile}, false)
+	assert.NoError(t, err)
+	cds, _, err = getVersionedPackageFromFile(cdsfile)
+	assert.NoError(t, err)
+	assert.Nil(t, cds)
+}
+
 // TestInstallFromBadPackage tests bad package failure
 func TestInstallFromBadPackage(t *testing.T) {
 	pdir := newTempDir()
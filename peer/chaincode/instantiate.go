@@ -44,9 +44,14 @@ func instantiateCmd(cf *ChaincodeCmdFactory) *cobra.Command {
 		"escc",
 		"vscc",
 		"collections-config",
+		"channelV1_4",
 		"peerAddresses",
 		"tlsRootCertFiles",
 		"connectionProfile",
+		"execute-timeout",
+		"max-concurrency",
+		"cpu-limit",
+		"memory-limit",
 	}
 	attachFlags(chaincodeInstantiateCmd, flagList)
 
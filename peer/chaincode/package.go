@@ -17,6 +17,7 @@ limitations under the License.
 package chaincode
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"io/ioutil"
@@ -25,6 +26,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/chaincode/persistence"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/ccmetadata"
 	"github.com/hyperledger/fabric/core/common/ccpackage"
 	"github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
@@ -37,6 +41,9 @@ var chaincodePackageCmd *cobra.Command
 var createSignedCCDepSpec bool
 var signCCDepSpec bool
 var instantiationPolicy string
+var packageLabel string
+var packageBuildArgs []string
+var packageLockFile string
 
 const packageCmdName = "package"
 const packageDesc = "Package the specified chaincode into a deployment spec."
@@ -71,12 +78,19 @@ func packageCmd(cf *ChaincodeCmdFactory, cdsFact ccDepSpecFactory) *cobra.Comman
 		"path",
 		"name",
 		"version",
+		"execute-timeout",
+		"max-concurrency",
+		"cpu-limit",
+		"memory-limit",
 	}
 	attachFlags(chaincodePackageCmd, flagList)
 
 	chaincodePackageCmd.Flags().BoolVarP(&createSignedCCDepSpec, "cc-package", "s", false, "create CC deployment spec for owner endorsements instead of raw CC deployment spec")
 	chaincodePackageCmd.Flags().BoolVarP(&signCCDepSpec, "sign", "S", false, "if creating CC deployment spec package for owner endorsements, also sign it with local MSP")
 	chaincodePackageCmd.Flags().StringVarP(&instantiationPolicy, "instantiate-policy", "i", "", "instantiation policy for the chaincode")
+	chaincodePackageCmd.Flags().StringVarP(&packageLabel, "label", "", "", "label of the chaincode package; when set, the chaincode is written in the versioned package format instead of as a deployment spec")
+	chaincodePackageCmd.Flags().StringArrayVarP(&packageBuildArgs, "build-args", "", nil, "arguments passed to the chaincode builder, only used with --label")
+	chaincodePackageCmd.Flags().StringVarP(&packageLockFile, "lock-file", "", "", "dependency lock file of the chaincode whose hash is recorded in the package, only used with --label")
 
 	return chaincodePackageCmd
 }
@@ -146,6 +160,36 @@ func getChaincodeInstallPackage(cds *pb.ChaincodeDeploymentSpec, cf *ChaincodeCm
 	return bytesToWrite, nil
 }
 
+// getVersionedChaincodePackage returns the chaincode code package wrapped in
+// the versioned package format along with its metadata and the statedb
+// indexes it contains
+func getVersionedChaincodePackage(cds *pb.ChaincodeDeploymentSpec) ([]byte, error) {
+	var lockHash string
+	if packageLockFile != "" {
+		lockBytes, err := ioutil.ReadFile(packageLockFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading dependency lock file %s: %s", packageLockFile, err)
+		}
+		lockHash = hex.EncodeToString(util.ComputeSHA256(lockBytes))
+	}
+
+	mdProvider := &ccmetadata.TargzMetadataProvider{Code: cds.CodePackage}
+	dbArtifacts, err := mdProvider.GetMetadataAsTarEntries()
+	if err != nil {
+		return nil, fmt.Errorf("error extracting statedb artifacts from code package: %s", err)
+	}
+
+	metadata := &persistence.ChaincodePackageMetadata{
+		Type:      cds.ChaincodeSpec.Type.String(),
+		Path:      cds.ChaincodeSpec.ChaincodeId.Path,
+		Label:     packageLabel,
+		BuildArgs: packageBuildArgs,
+		LockHash:  lockHash,
+	}
+
+	return persistence.CreateChaincodePackage(metadata, cds.CodePackage, dbArtifacts)
+}
+
 // chaincodePackage creates the chaincode package. On success, the chaincode name
 // (hash) is printed to STDOUT for use by subsequent chaincode-related CLI
 // commands.
@@ -153,6 +197,12 @@ func chaincodePackage(cmd *cobra.Command, args []string, cdsFact ccDepSpecFactor
 	if cdsFact == nil {
 		return fmt.Errorf("Error chaincode deployment spec factory not specified")
 	}
+	if packageLabel != "" && createSignedCCDepSpec {
+		return fmt.Errorf("--label cannot be combined with --cc-package")
+	}
+	if packageLabel == "" && (len(packageBuildArgs) != 0 || packageLockFile != "") {
+		return fmt.Errorf("--build-args and --lock-file require --label")
+	}
 	// Parsing of the command line is done so silence cmd usage
 	cmd.SilenceUsage = true
 
@@ -174,7 +224,12 @@ func chaincodePackage(cmd *cobra.Command, args []string, cdsFact ccDepSpecFactor
 	}
 
 	var bytesToWrite []byte
-	if createSignedCCDepSpec {
+	if packageLabel != "" {
+		bytesToWrite, err = getVersionedChaincodePackage(cds)
+		if err != nil {
+			return err
+		}
+	} else if createSignedCCDepSpec {
 		bytesToWrite, err = getChaincodeInstallPackage(cds, cf)
 		if err != nil {
 			return err
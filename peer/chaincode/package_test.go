@@ -17,13 +17,20 @@
 package chaincode
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/chaincode/persistence"
 	"github.com/hyperledger/fabric/msp"
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	"github.com/hyperledger/fabric/peer/common"
@@ -96,7 +103,7 @@ func TestCDSPackage(t *testing.T) {
 	}
 }
 
-//helper to create a SignedChaincodeDeploymentSpec
+// helper to create a SignedChaincodeDeploymentSpec
 func createSignedCDSPackage(args []string, sign bool) error {
 	var signer msp.SigningIdentity
 	var err error
@@ -211,3 +218,77 @@ func TestInvalidPolicy(t *testing.T) {
 		t.Fatalf("Expected error with nil signer but succeeded")
 	}
 }
+
+func mockTargzCDSFactory(spec *pb.ChaincodeSpec) (*pb.ChaincodeDeploymentSpec, error) {
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	files := map[string]string{
+		"src/some/go/package/chaincode.go":                 "package main",
+		"META-INF/statedb/couchdb/indexes/indexOwner.json": `{"index":{"fields":["owner"]},"name":"indexOwner","type":"json"}`,
+	}
+	for _, name := range []string{"src/some/go/package/chaincode.go", "META-INF/statedb/couchdb/indexes/indexOwner.json"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			return nil, err
+		}
+	}
+	tw.Close()
+	gw.Close()
+	return &pb.ChaincodeDeploymentSpec{ChaincodeSpec: spec, CodePackage: buf.Bytes()}, nil
+}
+
+// TestVersionedPackage generates a package in the versioned package format
+func TestVersionedPackage(t *testing.T) {
+	pdir := newTempDir()
+	defer os.RemoveAll(pdir)
+
+	lockFile := pdir + "/go.sum"
+	err := ioutil.WriteFile(lockFile, []byte("some/dependency v1.0.0 h1:abc="), 0600)
+	assert.NoError(t, err)
+
+	ccpackfile := pdir + "/ccpack.tar.gz"
+	cmd := packageCmd(&ChaincodeCmdFactory{}, mockTargzCDSFactory)
+	addFlags(cmd)
+	cmd.SetArgs([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "--label", "somecc_0", "--build-args", "-tags", "--build-args", "nopkcs11", "--lock-file", lockFile, ccpackfile})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+
+	b, err := ioutil.ReadFile(ccpackfile)
+	assert.NoError(t, err)
+
+	ccPackage, err := persistence.ChaincodePackageParser{}.Parse(b)
+	assert.NoError(t, err)
+	assert.Equal(t, &persistence.ChaincodePackageMetadata{
+		FormatVersion: 1,
+		Type:          "GOLANG",
+		Path:          "some/go/package",
+		Label:         "somecc_0",
+		BuildArgs:     []string{"-tags", "nopkcs11"},
+		LockHash:      hex.EncodeToString(util.ComputeSHA256([]byte("some/dependency v1.0.0 h1:abc="))),
+	}, ccPackage.Metadata)
+	assert.NotEmpty(t, ccPackage.DBArtifacts)
+
+	packageID, err := calculatePackageID(ccpackfile)
+	assert.NoError(t, err)
+	assert.Equal(t, persistence.PackageID("somecc_0", b), packageID)
+}
+
+func TestVersionedPackageFlags(t *testing.T) {
+	pdir := newTempDir()
+	defer os.RemoveAll(pdir)
+
+	err := createSignedCDSPackage([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "-s", "--label", "somecc_0", pdir + "/ccpack.file"}, false)
+	assert.EqualError(t, err, "--label cannot be combined with --cc-package")
+
+	err = createSignedCDSPackage([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "--build-args", "-tags", pdir + "/ccpack.file"}, false)
+	assert.EqualError(t, err, "--build-args and --lock-file require --label")
+
+	err = createSignedCDSPackage([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "--label", "some cc", pdir + "/ccpack.file"}, false)
+	assert.Error(t, err)
+
+	err = createSignedCDSPackage([]string{"-n", "somecc", "-p", "some/go/package", "-v", "0", "--label", "somecc_0", "--lock-file", pdir + "/missing", pdir + "/ccpack.file"}, false)
+	assert.Contains(t, err.Error(), "error reading dependency lock file")
+}
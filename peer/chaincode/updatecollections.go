@@ -0,0 +1,118 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric/peer/common"
+	protcommon "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/cobra"
+)
+
+const updateCollectionsCmdName = "updatecollections"
+
+// updateCollectionsCmd returns the cobra command for Chaincode UpdateCollections
+func updateCollectionsCmd(cf *ChaincodeCmdFactory) *cobra.Command {
+	chaincodeUpdateCollectionsCmd := &cobra.Command{
+		Use:   updateCollectionsCmdName,
+		Short: "Update the collections of a chaincode.",
+		Long:  "Update the collection configuration of an instantiated chaincode without upgrading it. The existing collections must be kept, but their member policy, peer counts and block to live may be changed. The update takes effect upon the transaction committed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return chaincodeUpdateCollections(cmd, cf)
+		},
+	}
+	flagList := []string{
+		"name",
+		"channelID",
+		"collections-config",
+		"peerAddresses",
+		"tlsRootCertFiles",
+		"connectionProfile",
+	}
+	attachFlags(chaincodeUpdateCollectionsCmd, flagList)
+
+	return chaincodeUpdateCollectionsCmd
+}
+
+// updateCollections creates the signed transaction updating the collections via Endorser
+func updateCollections(cf *ChaincodeCmdFactory) (*protcommon.Envelope, error) {
+	collectionConfig, err := getCollectionConfigFromFile(collectionsConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid collection configuration in file %s: %s", collectionsConfigFile, err)
+	}
+
+	creator, err := cf.Signer.Serialize()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing identity for %s: %s", cf.Signer.GetIdentifier(), err)
+	}
+
+	prop, _, err := utils.CreateUpdateCollectionsProposal(channelID, chaincodeName, creator, collectionConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proposal %s: %s", updateCollectionsCmdName, err)
+	}
+
+	signedProp, err := utils.GetSignedProposal(prop, cf.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("error creating signed proposal %s: %s", updateCollectionsCmdName, err)
+	}
+
+	// collect the endorsements of all the peers, the update may have to
+	// satisfy an instantiation policy spanning several organizations
+	var responses []*pb.ProposalResponse
+	for _, endorser := range cf.EndorserClients {
+		proposalResponse, err := endorser.ProcessProposal(context.Background(), signedProp)
+		if err != nil {
+			return nil, fmt.Errorf("error endorsing %s: %s", updateCollectionsCmdName, err)
+		}
+		responses = append(responses, proposalResponse)
+	}
+	if len(responses) == 0 {
+		return nil, errors.New("no proposal responses received")
+	}
+	env, err := utils.CreateSignedTx(prop, cf.Signer, responses...)
+	if err != nil {
+		return nil, fmt.Errorf("could not assemble transaction, err %s", err)
+	}
+	return env, nil
+}
+
+// chaincodeUpdateCollections updates the collection configuration of the chaincode
+func chaincodeUpdateCollections(cmd *cobra.Command, cf *ChaincodeCmdFactory) error {
+	if channelID == "" {
+		return errors.New("The required parameter 'channelID' is empty. Rerun the command with -C flag")
+	}
+	if chaincodeName == common.UndefinedParamValue {
+		return errors.New("must supply the chaincode name")
+	}
+	if collectionsConfigFile == common.UndefinedParamValue {
+		return errors.New("must supply the collection configuration with --collections-config")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	var err error
+	if cf == nil {
+		cf, err = InitCmdFactory(cmd.Name(), true, true)
+		if err != nil {
+			return err
+		}
+	}
+	defer cf.BroadcastClient.Close()
+
+	env, err := updateCollections(cf)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Send signed envelope to orderer")
+	return cf.BroadcastClient.Send(env)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package chaincode
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/peer/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateCollectionsCmd(t *testing.T) {
+	defer resetFlags()
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	tempDir, err := ioutil.TempDir("", "updatecollections")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	collectionsConfig := filepath.Join(tempDir, "collections.json")
+	err = ioutil.WriteFile(collectionsConfig, []byte(sampleCollectionConfigGood), 0644)
+	assert.NoError(t, err)
+
+	newCmd := func(mockResponse *pb.ProposalResponse, sendErr error) *cobra.Command {
+		// the flags of the previous command must not leak into the next one
+		resetFlags()
+		channelID = ""
+		cmd := updateCollectionsCmd(&ChaincodeCmdFactory{
+			EndorserClients: []pb.EndorserClient{common.GetMockEndorserClient(mockResponse, nil)},
+			Signer:          signer,
+			BroadcastClient: common.GetMockBroadcastClient(sendErr),
+		})
+		addFlags(cmd)
+		return cmd
+	}
+	okResponse := &pb.ProposalResponse{
+		Response:    &pb.Response{Status: 200},
+		Endorsement: &pb.Endorsement{},
+	}
+
+	cmd := newCmd(okResponse, nil)
+	cmd.SetArgs([]string{"-n", "example02", "--collections-config", collectionsConfig})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "The required parameter 'channelID' is empty. Rerun the command with -C flag")
+
+	cmd = newCmd(okResponse, nil)
+	cmd.SetArgs([]string{"-C", "mychannel", "-n", "example02"})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "must supply the collection configuration with --collections-config")
+
+	cmd = newCmd(okResponse, nil)
+	cmd.SetArgs([]string{"-C", "mychannel", "-n", "example02", "--collections-config", filepath.Join(tempDir, "missing.json")})
+	err = cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid collection configuration in file")
+
+	cmd = newCmd(okResponse, nil)
+	cmd.SetArgs([]string{"-C", "mychannel", "-n", "example02", "--collections-config", collectionsConfig})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+
+	cmd = newCmd(&pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: "update error"}}, nil)
+	cmd.SetArgs([]string{"-C", "mychannel", "-n", "example02", "--collections-config", collectionsConfig})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "could not assemble transaction, err proposal response was not successful, error code 500, msg update error")
+
+	cmd = newCmd(okResponse, errors.New("send tx failed"))
+	cmd.SetArgs([]string{"-C", "mychannel", "-n", "example02", "--collections-config", collectionsConfig})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "send tx failed")
+}
+
+func TestUpdateCollectionsMultiplePeers(t *testing.T) {
+	defer resetFlags()
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	tempDir, err := ioutil.TempDir("", "updatecollections")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	collectionsConfigFile = filepath.Join(tempDir, "collections.json")
+	err = ioutil.WriteFile(collectionsConfigFile, []byte(sampleCollectionConfigGood), 0644)
+	assert.NoError(t, err)
+	channelID = "mychannel"
+	chaincodeName = "example02"
+
+	okResponse := func(endorser string) *pb.ProposalResponse {
+		return &pb.ProposalResponse{
+			Response:    &pb.Response{Status: 200},
+			Endorsement: &pb.Endorsement{Endorser: []byte(endorser)},
+		}
+	}
+
+	// the endorsements of all the peers end up in the transaction
+	cf := &ChaincodeCmdFactory{
+		EndorserClients: []pb.EndorserClient{
+			common.GetMockEndorserClient(okResponse("peer0"), nil),
+			common.GetMockEndorserClient(okResponse("peer1"), nil),
+		},
+		Signer: signer,
+	}
+	env, err := updateCollections(cf)
+	assert.NoError(t, err)
+	payload, err := utils.GetPayload(env)
+	assert.NoError(t, err)
+	tx, err := utils.GetTransaction(payload.Data)
+	assert.NoError(t, err)
+	cap, err := utils.GetChaincodeActionPayload(tx.Actions[0].Payload)
+	assert.NoError(t, err)
+	assert.Len(t, cap.Action.Endorsements, 2)
+
+	// a failing peer fails the update
+	cf.EndorserClients[1] = common.GetMockEndorserClient(nil, errors.New("unavailable"))
+	_, err = updateCollections(cf)
+	assert.EqualError(t, err, "error endorsing updatecollections: unavailable")
+}
@@ -46,6 +46,11 @@ func upgradeCmd(cf *ChaincodeCmdFactory) *cobra.Command {
 		"tlsRootCertFiles",
 		"connectionProfile",
 		"collections-config",
+		"channelV1_4",
+		"execute-timeout",
+		"max-concurrency",
+		"cpu-limit",
+		"memory-limit",
 	}
 	attachFlags(chaincodeUpgradeCmd, flagList)
 
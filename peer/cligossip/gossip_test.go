@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockCmdFactory(err error) *GossipCmdFactory {
+	return &GossipCmdFactory{
+		AdminClient: common.GetMockAdminClient(err),
+		wrapWithEnvelope: func(msg proto.Message) *common2.Envelope {
+			pl := &common2.Payload{
+				Data: utils.MarshalOrPanic(msg),
+			}
+			env := &common2.Envelope{
+				Payload: utils.MarshalOrPanic(pl),
+			}
+			return env
+		},
+	}
+}
+
+func TestStatus(t *testing.T) {
+	out := &bytes.Buffer{}
+	cmd := statusCmd(newMockCmdFactory(nil))
+	cmd.SetOutput(out)
+	cmd.SetArgs([]string{})
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, "Self: peer0.org1.example.com:7051, PKI-ID: 01\n"+
+		"Alive members (1):\n"+
+		"\tpeer1.org1.example.com:7051, PKI-ID: 02\n"+
+		"Dead members (1):\n"+
+		"\tpeer2.org1.example.com:7051, PKI-ID: 03\n"+
+		"Channel mychannel (leader count: 1):\n"+
+		"\tpeer0.org1.example.com:7051, PKI-ID: 01, ledger height: 10, chaincodes: [], leader (self)\n"+
+		"\tpeer1.org1.example.com:7051, PKI-ID: 02, ledger height: 9, chaincodes: [mycc:1.0]\n", out.String())
+}
+
+func TestStatusOfChannel(t *testing.T) {
+	out := &bytes.Buffer{}
+	cmd := statusCmd(newMockCmdFactory(nil))
+	cmd.SetOutput(out)
+	cmd.SetArgs([]string{"-c", "otherchannel"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Channel otherchannel (leader count: 1):\n")
+}
+
+func TestStatusErrors(t *testing.T) {
+	cmd := statusCmd(newMockCmdFactory(nil))
+	cmd.SetOutput(&bytes.Buffer{})
+	cmd.SetArgs([]string{"extra"})
+	assert.EqualError(t, cmd.Execute(), "more parameters than necessary were provided. Expected 0, received 1")
+
+	cmd = statusCmd(newMockCmdFactory(errors.New("access denied")))
+	cmd.SetOutput(&bytes.Buffer{})
+	cmd.SetArgs([]string{})
+	assert.EqualError(t, cmd.Execute(), "access denied")
+}
@@ -0,0 +1,117 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func statusCmd(cf *GossipCmdFactory) *cobra.Command {
+	var channelID string
+	gossipStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Returns the gossip membership and channel state the peer sees.",
+		Long: `Returns the alive and dead peers in the gossip membership of the peer, and for each channel the peer has joined, ` +
+			`the ledger heights and installed chaincodes the peers of the channel advertise, and which of them are leaders.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return status(cf, cmd, args, channelID)
+		},
+	}
+	gossipStatusCmd.Flags().StringVarP(&channelID, "channelID", "c", "", "The channel to report the state of. All channels the peer has joined are reported if not set")
+
+	return gossipStatusCmd
+}
+
+func status(cf *GossipCmdFactory, cmd *cobra.Command, args []string, channelID string) (err error) {
+	if len(args) > 0 {
+		return errors.Errorf("more parameters than necessary were provided. Expected 0, received %d", len(args))
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	op := &pb.AdminOperation{
+		Content: &pb.AdminOperation_GossipStatusReq{
+			GossipStatusReq: &pb.GossipStatusRequest{
+				ChannelId: channelID,
+			},
+		},
+	}
+	env := cf.wrapWithEnvelope(op)
+	gossipStatus, err := cf.AdminClient.GetGossipStatus(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	printStatus(cmd.OutOrStdout(), gossipStatus)
+	return nil
+}
+
+func printStatus(out io.Writer, status *pb.GossipStatus) {
+	fmt.Fprintf(out, "Self: %s\n", formatMember(status.Self))
+	fmt.Fprintf(out, "Alive members (%d):\n", len(status.Alive))
+	for _, member := range status.Alive {
+		fmt.Fprintf(out, "\t%s\n", formatMember(member))
+	}
+	fmt.Fprintf(out, "Dead members (%d):\n", len(status.Dead))
+	for _, member := range status.Dead {
+		fmt.Fprintf(out, "\t%s\n", formatMember(member))
+	}
+	for _, channel := range status.Channels {
+		fmt.Fprintf(out, "Channel %s (leader count: %d):\n", channel.ChannelId, channel.LeaderCount)
+		fmt.Fprintf(out, "\t%s (self)\n", formatChannelMember(channel.Self))
+		for _, member := range channel.Peers {
+			fmt.Fprintf(out, "\t%s\n", formatChannelMember(member))
+		}
+	}
+}
+
+func formatMember(member *pb.GossipMember) string {
+	if member == nil {
+		return "unknown"
+	}
+	res := fmt.Sprintf("%s, PKI-ID: %s", endpointOf(member.Endpoint), hex.EncodeToString(member.PkiId))
+	if member.InternalEndpoint != "" {
+		res = fmt.Sprintf("%s, internal endpoint: %s", res, member.InternalEndpoint)
+	}
+	return res
+}
+
+func formatChannelMember(member *pb.GossipChannelMember) string {
+	if member == nil {
+		return "unknown"
+	}
+	var chaincodes []string
+	for _, cc := range member.Chaincodes {
+		chaincodes = append(chaincodes, fmt.Sprintf("%s:%s", cc.Name, cc.Version))
+	}
+	res := fmt.Sprintf("%s, PKI-ID: %s, ledger height: %d, chaincodes: [%s]",
+		endpointOf(member.Endpoint), hex.EncodeToString(member.PkiId), member.LedgerHeight, strings.Join(chaincodes, ", "))
+	if member.Leader {
+		res += ", leader"
+	}
+	return res
+}
+
+func endpointOf(endpoint string) string {
+	if endpoint == "" {
+		return "<no external endpoint>"
+	}
+	return endpoint
+}
@@ -103,3 +103,41 @@ func (m *mockAdminClient) SetModuleLogLevel(ctx context.Context, env *cb.Envelop
 func (m *mockAdminClient) RevertLogLevels(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, m.err
 }
+
+func (m *mockAdminClient) ReloadIdentities(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, m.err
+}
+
+func (m *mockAdminClient) GetPvtDataReconciliationStatus(ctx context.Context, env *cb.Envelope, opts ...grpc.CallOption) (*pb.PvtDataReconciliationStatus, error) {
+	op := &pb.AdminOperation{}
+	pl := &cb.Payload{}
+	proto.Unmarshal(env.Payload, pl)
+	proto.Unmarshal(pl.Data, op)
+	response := &pb.PvtDataReconciliationStatus{ChannelId: op.GetReconciliationStatusReq().ChannelId}
+	return response, m.err
+}
+
+func (m *mockAdminClient) GetGossipStatus(ctx context.Context, env *cb.Envelope, opts ...grpc.CallOption) (*pb.GossipStatus, error) {
+	op := &pb.AdminOperation{}
+	pl := &cb.Payload{}
+	proto.Unmarshal(env.Payload, pl)
+	proto.Unmarshal(pl.Data, op)
+	channelID := op.GetGossipStatusReq().ChannelId
+	if channelID == "" {
+		channelID = "mychannel"
+	}
+	response := &pb.GossipStatus{
+		Self:  &pb.GossipMember{Endpoint: "peer0.org1.example.com:7051", PkiId: []byte{1}},
+		Alive: []*pb.GossipMember{{Endpoint: "peer1.org1.example.com:7051", PkiId: []byte{2}}},
+		Dead:  []*pb.GossipMember{{Endpoint: "peer2.org1.example.com:7051", PkiId: []byte{3}}},
+		Channels: []*pb.GossipChannelStatus{
+			{
+				ChannelId:   channelID,
+				Self:        &pb.GossipChannelMember{Endpoint: "peer0.org1.example.com:7051", PkiId: []byte{1}, LedgerHeight: 10, Leader: true},
+				Peers:       []*pb.GossipChannelMember{{Endpoint: "peer1.org1.example.com:7051", PkiId: []byte{2}, LedgerHeight: 9, Chaincodes: []*pb.GossipChaincode{{Name: "mycc", Version: "1.0"}}}},
+				LeaderCount: 1,
+			},
+		},
+	}
+	return response, m.err
+}
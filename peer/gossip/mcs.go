@@ -159,6 +159,37 @@ func (s *mspMessageCryptoService) VerifyBlock(chainID common.ChainID, seqNum uin
 		return fmt.Errorf("Header.DataHash is different from Hash(block.Data) for block with id [%d] on channel [%s]", block.Header.Number, chainID)
 	}
 
+	return s.verifyBlockSignatures(channelID, block, metadata)
+}
+
+// VerifyHeader returns nil if the header of the given block is properly signed,
+// and the claimed seqNum is the sequence number that the block's header contains.
+// The data of the block isn't verified, so the block may be one
+// that only carries its header and metadata.
+func (s *mspMessageCryptoService) VerifyHeader(chainID string, seqNum uint64, block *pcommon.Block) error {
+	if block == nil || block.Header == nil {
+		return fmt.Errorf("Invalid Block on channel [%s]. Header must be different from nil.", chainID)
+	}
+
+	if seqNum != block.Header.Number {
+		return fmt.Errorf("Claimed seqNum is [%d] but actual seqNum inside block is [%d]", seqNum, block.Header.Number)
+	}
+
+	if block.Metadata == nil || len(block.Metadata.Metadata) == 0 {
+		return fmt.Errorf("Block with id [%d] on channel [%s] does not have metadata. Block not valid.", block.Header.Number, chainID)
+	}
+
+	metadata, err := utils.GetMetadataFromBlock(block, pcommon.BlockMetadataIndex_SIGNATURES)
+	if err != nil {
+		return fmt.Errorf("Failed unmarshalling medatata for signatures [%s]", err)
+	}
+
+	return s.verifyBlockSignatures(chainID, block, metadata)
+}
+
+// verifyBlockSignatures evaluates the block validation policy of the given channel
+// against the signatures over the header of the given block
+func (s *mspMessageCryptoService) verifyBlockSignatures(channelID string, block *pcommon.Block, metadata *pcommon.Metadata) error {
 	// - Get Policy for block validation
 
 	// Get the policy manager for channelID
@@ -179,7 +210,7 @@ func (s *mspMessageCryptoService) VerifyBlock(chainID common.ChainID, seqNum uin
 	for _, metadataSignature := range metadata.Signatures {
 		shdr, err := utils.GetSignatureHeader(metadataSignature.SignatureHeader)
 		if err != nil {
-			return fmt.Errorf("Failed unmarshalling signature header for block with id [%d] on channel [%s]: [%s]", block.Header.Number, chainID, err)
+			return fmt.Errorf("Failed unmarshalling signature header for block with id [%d] on channel [%s]: [%s]", block.Header.Number, channelID, err)
 		}
 		signatureSet = append(
 			signatureSet,
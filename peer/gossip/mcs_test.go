@@ -246,6 +246,53 @@ func TestVerifyBlock(t *testing.T) {
 	assert.Error(t, msgCryptoService.VerifyBlock([]byte("C"), 42, nil))
 }
 
+func TestVerifyHeader(t *testing.T) {
+	aliceSigner := &mockscrypto.LocalSigner{Identity: []byte("Alice")}
+	policyManagerGetter := &mocks.ChannelPolicyManagerGetterWithManager{
+		Managers: map[string]policies.Manager{
+			"A": &mocks.ChannelPolicyManager{
+				Policy: &mocks.Policy{Deserializer: &mocks.IdentityDeserializer{Identity: []byte("Bob"), Msg: []byte("msg2"), Mock: mock.Mock{}}},
+			},
+			"C": &mocks.ChannelPolicyManager{
+				Policy: &mocks.Policy{Deserializer: &mocks.IdentityDeserializer{Identity: []byte("Alice"), Msg: []byte("msg1"), Mock: mock.Mock{}}},
+			},
+		},
+	}
+
+	msgCryptoService := NewMCS(
+		policyManagerGetter,
+		aliceSigner,
+		&mocks.DeserializersManager{
+			LocalDeserializer: &mocks.IdentityDeserializer{Identity: []byte("Alice"), Msg: []byte("msg1"), Mock: mock.Mock{}},
+		},
+	)
+
+	// - Prepare a block that carries only its header and metadata, Alice signs it.
+	// The data hash in the header doesn't match the (missing) data of the block.
+	blockRaw, msg := mockBlock(t, "C", 42, aliceSigner, []byte{1, 2, 3})
+	policyManagerGetter.Managers["C"].(*mocks.ChannelPolicyManager).Policy.(*mocks.Policy).Deserializer.(*mocks.IdentityDeserializer).Msg = msg
+	block, err := utils.GetBlockFromBlockBytes(blockRaw)
+	assert.NoError(t, err)
+	block.Data = nil
+
+	// - Verify header
+	assert.NoError(t, msgCryptoService.VerifyHeader("C", 42, block))
+	// Wrong sequence number claimed
+	err = msgCryptoService.VerifyHeader("C", 43, block)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "but actual seqNum inside block is")
+	// Signed by an identity that doesn't satisfy the policy of the channel
+	assert.Error(t, msgCryptoService.VerifyHeader("A", 42, block))
+	// Unknown channel
+	err = msgCryptoService.VerifyHeader("D", 42, block)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Could not acquire policy manager")
+
+	// Check invalid args
+	assert.Error(t, msgCryptoService.VerifyHeader("C", 42, nil))
+	assert.Error(t, msgCryptoService.VerifyHeader("C", 42, &common.Block{Header: block.Header}))
+}
+
 func mockBlock(t *testing.T, channel string, seqNum uint64, localSigner crypto.LocalSigner, dataHash []byte) ([]byte, []byte) {
 	block := common.NewBlock(seqNum, nil)
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hyperledger/fabric/peer/chaincode"
 	"github.com/hyperledger/fabric/peer/channel"
+	"github.com/hyperledger/fabric/peer/cligossip"
 	"github.com/hyperledger/fabric/peer/clilogging"
 	"github.com/hyperledger/fabric/peer/common"
 	"github.com/hyperledger/fabric/peer/node"
@@ -45,6 +46,7 @@ func main() {
 	mainCmd.AddCommand(node.Cmd())
 	mainCmd.AddCommand(chaincode.Cmd(nil))
 	mainCmd.AddCommand(clilogging.Cmd(nil))
+	mainCmd.AddCommand(cligossip.Cmd(nil))
 	mainCmd.AddCommand(channel.Cmd(nil))
 
 	// On failure Cobra prints the usage message and error string, so we only
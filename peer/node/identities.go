@@ -0,0 +1,210 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"bytes"
+	"crypto/tls"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/viperutil"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/config"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/gossip/api"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// identityReloader reloads the local MSP and the TLS key pairs of the peer
+// from the files they were loaded from at startup, so that renewed
+// certificates are picked up without restarting the peer.
+// A renewed signing identity is advertised to the other peers by gossip.
+type identityReloader struct {
+	lock           sync.Mutex
+	servers        []*comm.GRPCServer
+	gossipCerts    *gossipcommon.TLSCertificates
+	gossip         gossipIdentityUpdater
+	gossipSigner   *gossipSigner
+	gossipIdentity []byte
+}
+
+// gossipIdentityUpdater replaces the identity gossip advertises the peer with
+type gossipIdentityUpdater interface {
+	UpdateIdentity(identity api.PeerIdentityType) error
+}
+
+// addServer registers a gRPC server whose TLS server certificate is reloaded
+func (r *identityReloader) addServer(srv *comm.GRPCServer) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.servers = append(r.servers, srv)
+}
+
+// setGossipCertificates registers the TLS certificates gossip authenticates with
+func (r *identityReloader) setGossipCertificates(certs *gossipcommon.TLSCertificates) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.gossipCerts = certs
+}
+
+// setGossip registers gossip, the signer it signs with and the serialized identity it was started with
+func (r *identityReloader) setGossip(gossip gossipIdentityUpdater, signer *gossipSigner, identity []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.gossip = gossip
+	r.gossipSigner = signer
+	r.gossipIdentity = identity
+}
+
+// updateGossipIdentity makes gossip sign with and advertise the default signing
+// identity of the given local MSP, if it isn't the identity gossip already has.
+// It is invoked before the local MSP is replaced, so that the local MSP isn't
+// replaced if gossip doesn't accept the identity.
+func (r *identityReloader) updateGossipIdentity(localMSP msp.MSP) error {
+	if r.gossip == nil {
+		return nil
+	}
+	signingIdentity, err := localMSP.GetDefaultSigningIdentity()
+	if err != nil {
+		return errors.WithMessage(err, "failed obtaining the reloaded signing identity")
+	}
+	identity, err := signingIdentity.Serialize()
+	if err != nil {
+		return errors.WithMessage(err, "failed serializing the reloaded signing identity")
+	}
+	if bytes.Equal(identity, r.gossipIdentity) {
+		return nil
+	}
+
+	// gossip signs its identity message with the renewed signing identity
+	prevSigningIdentity := r.gossipSigner.identity()
+	r.gossipSigner.setIdentity(signingIdentity)
+	if err := r.gossip.UpdateIdentity(identity); err != nil {
+		r.gossipSigner.setIdentity(prevSigningIdentity)
+		return errors.WithMessage(err, "failed updating the identity of gossip")
+	}
+	r.gossipIdentity = identity
+	logger.Info("Updated the signing identity gossip advertises the peer with")
+	return nil
+}
+
+// reload reloads the local MSP and the TLS key pairs of the peer.
+// The TLS key pairs are loaded before anything is replaced, so that
+// a failure leaves the peer with the identities it had before.
+func (r *identityReloader) reload() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	tlsEnabled := viper.GetBool("peer.tls.enabled")
+	var serverCert, clientCert tls.Certificate
+	if tlsEnabled {
+		serverConfig, err := peer.GetServerConfig()
+		if err != nil {
+			return errors.WithMessage(err, "failed loading TLS server key pair")
+		}
+		serverCert, err = tls.X509KeyPair(serverConfig.SecOpts.Certificate, serverConfig.SecOpts.Key)
+		if err != nil {
+			return errors.Wrap(err, "failed loading TLS server key pair")
+		}
+		clientCert, err = peer.GetClientCertificate()
+		if err != nil {
+			return errors.WithMessage(err, "failed loading TLS client key pair")
+		}
+	}
+
+	if err := reloadLocalMSP(r.updateGossipIdentity); err != nil {
+		return errors.WithMessage(err, "failed reloading local MSP")
+	}
+
+	if !tlsEnabled {
+		return nil
+	}
+	for _, srv := range r.servers {
+		srv.SetServerCertificate(serverCert)
+	}
+	comm.GetCredentialSupport().SetClientCertificate(clientCert)
+	if r.gossipCerts != nil {
+		r.gossipCerts.TLSServerCert.Store(&serverCert)
+		r.gossipCerts.TLSClientCert.Store(&clientCert)
+	}
+	logger.Info("Reloaded TLS server and client certificates")
+	return nil
+}
+
+// reloadLocalMSP reloads the local MSP from the configuration it was loaded from at startup,
+// if the reloaded one is accepted by verify
+func reloadLocalMSP(verify func(msp.MSP) error) error {
+	var bccspConfig *factory.FactoryOpts
+	if err := viperutil.EnhancedExactUnmarshalKey("peer.BCCSP", &bccspConfig); err != nil {
+		return errors.WithMessage(err, "could not parse YAML config")
+	}
+	mspType := viper.GetString("peer.localMspType")
+	if mspType == "" {
+		mspType = msp.ProviderTypeToString(msp.FABRIC)
+	}
+	return mgmt.ReloadLocalMspWithType(config.GetPath("peer.mspConfigPath"), bccspConfig, viper.GetString("peer.localMspId"), mspType, verify)
+}
+
+// gossipSigner signs the messages of gossip with the signing identity gossip
+// advertises the peer with, which is renewed when the local MSP is reloaded
+type gossipSigner struct {
+	signingIdentity atomic.Value // msp.SigningIdentity
+}
+
+// newGossipSigner returns a gossipSigner that signs with the given signing identity
+func newGossipSigner(signingIdentity msp.SigningIdentity) *gossipSigner {
+	s := &gossipSigner{}
+	s.setIdentity(signingIdentity)
+	return s
+}
+
+// identity returns the signing identity the signer signs with
+func (s *gossipSigner) identity() msp.SigningIdentity {
+	return s.signingIdentity.Load().(msp.SigningIdentity)
+}
+
+// setIdentity makes the signer sign with the given signing identity
+func (s *gossipSigner) setIdentity(signingIdentity msp.SigningIdentity) {
+	s.signingIdentity.Store(signingIdentity)
+}
+
+func (s *gossipSigner) signer() crypto.LocalSigner {
+	return localmsp.NewSignerWithIdentity(s.identity())
+}
+
+// NewSignatureHeader creates a SignatureHeader with the signing identity of the signer
+func (s *gossipSigner) NewSignatureHeader() (*cb.SignatureHeader, error) {
+	return s.signer().NewSignatureHeader()
+}
+
+// Sign signs the given message with the signing identity of the signer
+func (s *gossipSigner) Sign(message []byte) ([]byte, error) {
+	return s.signer().Sign(message)
+}
+
+// localSigningIdentity signs with the default signing identity the local MSP
+// has at the time of the invocation, which is replaced when the local MSP is reloaded
+type localSigningIdentity struct{}
+
+// Sign signs the given message with the default signing identity of the local MSP
+func (localSigningIdentity) Sign(msg []byte) ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Sign(msg)
+}
+
+// Serialize serializes the default signing identity of the local MSP
+func (localSigningIdentity) Serialize() ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Serialize()
+}
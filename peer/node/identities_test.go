@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/gossip/api"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityReloader(t *testing.T) {
+	defer viper.Reset()
+
+	err := msptesttools.LoadMSPSetupForTesting()
+	assert.NoError(t, err)
+	mspDir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	oldKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+	newKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "identities")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	assert.NoError(t, ioutil.WriteFile(certFile, newKeyPair.Cert, 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, newKeyPair.Key, 0600))
+
+	viper.Set("peer.mspConfigPath", mspDir)
+	viper.Set("peer.localMspId", "SampleOrg")
+	viper.Set("peer.tls.enabled", true)
+	viper.Set("peer.tls.cert.file", certFile)
+	viper.Set("peer.tls.key.file", filepath.Join(dir, "does_not_exist"))
+
+	srv, err := comm.NewGRPCServer("127.0.0.1:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:      true,
+			Certificate: oldKeyPair.Cert,
+			Key:         oldKeyPair.Key,
+		},
+	})
+	assert.NoError(t, err)
+	defer srv.Listener().Close()
+	oldCert := srv.ServerCertificate()
+	gossipCerts := &gossipcommon.TLSCertificates{}
+	gossipCerts.TLSServerCert.Store(&oldCert)
+	gossipCerts.TLSClientCert.Store(&oldCert)
+	comm.GetCredentialSupport().SetClientCertificate(oldCert)
+
+	identities := &identityReloader{}
+	identities.addServer(srv)
+	identities.setGossipCertificates(gossipCerts)
+
+	// A key pair that cannot be loaded leaves all identities in place
+	localMSP := mgmt.GetLocalMSP()
+	err = identities.reload()
+	assert.Contains(t, err.Error(), "failed loading TLS server key pair")
+	assert.True(t, localMSP == mgmt.GetLocalMSP())
+	assert.Equal(t, oldKeyPair.TLSCert.Raw, srv.ServerCertificate().Certificate[0])
+
+	// A signing identity other than the one gossip has, which gossip
+	// doesn't accept, leaves all identities in place
+	viper.Set("peer.tls.key.file", keyFile)
+	prevSigningIdentity := mgmt.GetLocalSigningIdentityOrPanic()
+	signer := newGossipSigner(prevSigningIdentity)
+	gossip := &mockGossipIdentityUpdater{err: errors.New("identity of another organization")}
+	identities.setGossip(gossip, signer, []byte("previous identity"))
+	err = identities.reload()
+	assert.Contains(t, err.Error(), "failed updating the identity of gossip: identity of another organization")
+	assert.True(t, localMSP == mgmt.GetLocalMSP())
+	assert.True(t, prevSigningIdentity == signer.identity())
+	assert.Equal(t, oldKeyPair.TLSCert.Raw, srv.ServerCertificate().Certificate[0])
+
+	// A signing identity other than the one gossip has is advertised by gossip
+	gossip.err = nil
+	err = identities.reload()
+	assert.NoError(t, err)
+	signingIdentity := mgmt.GetLocalSigningIdentityOrPanic()
+	identity, err := signingIdentity.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, []api.PeerIdentityType{identity, identity}, gossip.identities)
+	assert.True(t, signingIdentity == signer.identity(), "gossip should sign with the reloaded signing identity")
+	sig, err := signer.Sign([]byte("msg"))
+	assert.NoError(t, err)
+	assert.NoError(t, signingIdentity.Verify([]byte("msg"), sig))
+	assert.False(t, localMSP == mgmt.GetLocalMSP(), "the local MSP should have been reloaded")
+	assert.Equal(t, newKeyPair.TLSCert.Raw, srv.ServerCertificate().Certificate[0])
+
+	// The signing identity gossip has is left to gossip
+	localMSP = mgmt.GetLocalMSP()
+	err = identities.reload()
+	assert.NoError(t, err)
+	assert.Len(t, gossip.identities, 2)
+	assert.False(t, localMSP == mgmt.GetLocalMSP(), "the local MSP should have been reloaded")
+	assert.Equal(t, newKeyPair.TLSCert.Raw, srv.ServerCertificate().Certificate[0])
+	assert.Equal(t, newKeyPair.TLSCert.Raw, comm.GetCredentialSupport().GetClientCertificate().Certificate[0])
+	assert.Equal(t, newKeyPair.TLSCert.Raw, gossipCerts.TLSServerCert.Load().(*tls.Certificate).Certificate[0])
+	assert.Equal(t, newKeyPair.TLSCert.Raw, gossipCerts.TLSClientCert.Load().(*tls.Certificate).Certificate[0])
+
+	// The endorser signs with the reloaded signing identity
+	sig, err = localSigningIdentity{}.Sign([]byte("msg"))
+	assert.NoError(t, err)
+	assert.NoError(t, mgmt.GetLocalSigningIdentityOrPanic().Verify([]byte("msg"), sig))
+}
+
+type mockGossipIdentityUpdater struct {
+	identities []api.PeerIdentityType
+	err        error
+}
+
+func (m *mockGossipIdentityUpdater) UpdateIdentity(identity api.PeerIdentityType) error {
+	m.identities = append(m.identities, identity)
+	return m.err
+}
@@ -0,0 +1,62 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func reloadCmd() *cobra.Command {
+	return nodeReloadCmd
+}
+
+var nodeReloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reloads the identities of the node.",
+	Long: `Reloads the local MSP and the TLS server and client key pairs of the running node ` +
+		`from the files they were loaded from at startup.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return reload()
+	},
+}
+
+func reload() error {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return err
+	}
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return errors.Errorf("failed obtaining default signer: %v", err)
+	}
+
+	localSigner := crypto.NewSignatureHeaderCreator(signer)
+	env, err := utils.CreateSignedEnvelope(common2.HeaderType_PEER_ADMIN_OPERATION, "", localSigner, &pb.AdminOperation{}, 0, 0)
+	if err != nil {
+		return errors.Errorf("failed signing: %v", err)
+	}
+
+	if _, err := adminClient.ReloadIdentities(context.Background(), env); err != nil {
+		return errors.Errorf("failed reloading the identities of the peer: %v", err)
+	}
+	fmt.Println("Reloaded the identities of the peer")
+	return nil
+}
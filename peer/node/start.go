@@ -21,7 +21,6 @@ import (
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/flogging"
-	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/aclmgmt"
@@ -231,18 +230,19 @@ func serve(args []string) error {
 
 	logger.Debugf("Running peer")
 
+	// Register the servers and credentials whose TLS certificates are
+	// reloaded along with the local MSP
+	identities := &identityReloader{}
+	identities.addServer(peerServer)
+
 	// Start the Admin server
-	startAdminServer(listenAddr, peerServer.Server())
+	startAdminServer(listenAddr, peerServer.Server(), identities)
 
 	privDataDist := func(channel string, txID string, privateData *transientstore.TxPvtReadWriteSetWithConfigInfo, blkHt uint64) error {
 		return service.GetGossipService().DistributePrivateData(channel, txID, privateData, blkHt)
 	}
 
 	signingIdentity := mgmt.GetLocalSigningIdentityOrPanic()
-	serializedIdentity, err := signingIdentity.Serialize()
-	if err != nil {
-		logger.Panicf("Failed serializing self identity: %v", err)
-	}
 
 	libConf := library.Config{}
 	if err = viperutil.EnhancedExactUnmarshalKey("peer.handlers", &libConf); err != nil {
@@ -252,7 +252,7 @@ func serve(args []string) error {
 
 	authFilters := reg.Lookup(library.Auth).([]authHandler.Filter)
 	endorserSupport := &endorser.SupportImpl{
-		SignerSupport:    signingIdentity,
+		SignerSupport:    localSigningIdentity{},
 		Peer:             peer.Default,
 		PeerSupport:      peer.DefaultSupport,
 		ChaincodeSupport: chaincodeSupport,
@@ -279,7 +279,7 @@ func serve(args []string) error {
 	policyMgr := peer.NewChannelPolicyManagerGetter()
 
 	// Initialize gossip component
-	err = initGossipService(policyMgr, peerServer, serializedIdentity, peerEndpoint.Address)
+	err = initGossipService(policyMgr, peerServer, signingIdentity, peerEndpoint.Address, identities)
 	if err != nil {
 		return err
 	}
@@ -656,7 +656,7 @@ func adminHasSeparateListener(peerListenAddr string, adminListenAddress string)
 	return adminPort != peerPort
 }
 
-func startAdminServer(peerListenAddr string, peerServer *grpc.Server) {
+func startAdminServer(peerListenAddr string, peerServer *grpc.Server, identities *identityReloader) {
 	adminListenAddress := viper.GetString("peer.adminService.listenAddress")
 	separateLsnrForAdmin := adminHasSeparateListener(peerListenAddr, adminListenAddress)
 	mspID := viper.GetString("peer.localMspId")
@@ -674,12 +674,23 @@ func startAdminServer(peerListenAddr string, peerServer *grpc.Server) {
 			logger.Fatalf("Failed to create admin server (%s)", err)
 		}
 		gRPCService = adminServer.Server()
+		identities.addServer(adminServer)
 		defer func() {
 			go adminServer.Start()
 		}()
 	}
 
-	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy))
+	reconciliationStatus := func(channelID string) (*pb.PvtDataReconciliationStatus, error) {
+		// the gossip service is initialized before any channel ledger is opened
+		if peer.GetLedger(channelID) == nil {
+			return nil, errors.Errorf("channel %s not found", channelID)
+		}
+		return service.GetGossipService().PvtDataReconciliationStatus(channelID)
+	}
+	gossipStatus := func(channelID string) (*pb.GossipStatus, error) {
+		return service.GetGossipService().GossipStatus(channelID)
+	}
+	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy, reconciliationStatus, gossipStatus, identities.reload))
 }
 
 // secureDialOpts is the callback function for secure dial options for gossip service
@@ -714,7 +725,13 @@ func secureDialOpts() []grpc.DialOption {
 // 2. Init the message crypto service;
 // 3. Init the security advisor;
 // 4. Init gossip related struct.
-func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, peerServer *comm.GRPCServer, serializedIdentity []byte, peerAddr string) error {
+// Gossip signs with the given signing identity until reloading the local MSP renews it.
+func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, peerServer *comm.GRPCServer, signingIdentity msp.SigningIdentity, peerAddr string, identities *identityReloader) error {
+	serializedIdentity, err := signingIdentity.Serialize()
+	if err != nil {
+		return errors.Wrap(err, "failed serializing self identity")
+	}
+
 	var certs *gossipcommon.TLSCertificates
 	if peerServer.TLSEnabled() {
 		serverCert := peerServer.ServerCertificate()
@@ -725,15 +742,22 @@ func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, peerServer
 		certs = &gossipcommon.TLSCertificates{}
 		certs.TLSServerCert.Store(&serverCert)
 		certs.TLSClientCert.Store(&clientCert)
+		identities.setGossipCertificates(certs)
 	}
 
+	signer := newGossipSigner(signingIdentity)
 	messageCryptoService := peergossip.NewMCS(
 		policyMgr,
-		localmsp.NewSigner(),
+		signer,
 		mgmt.NewDeserializersManager())
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
 	bootstrap := viper.GetStringSlice("peer.gossip.bootstrap")
 
-	return service.InitGossipService(serializedIdentity, peerAddr, peerServer.Server(), certs,
+	err = service.InitGossipService(serializedIdentity, peerAddr, peerServer.Server(), certs,
 		messageCryptoService, secAdv, secureDialOpts, bootstrap...)
+	if err != nil {
+		return err
+	}
+	identities.setGossip(service.GetGossipService(), signer, serializedIdentity)
+	return nil
 }
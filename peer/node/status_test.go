@@ -30,6 +30,7 @@ import (
 	"github.com/hyperledger/fabric/peer/mocks"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,7 +58,7 @@ func TestStatusCmd(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create peer server (%s)", err)
 	} else {
-		pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}))
+		pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, nil, nil, nil))
 		go peerServer.Start()
 		defer peerServer.Stop()
 
@@ -106,7 +107,7 @@ func TestStatus(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to create peer server (%s)", err)
 			} else {
-				pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}))
+				pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, nil, nil, nil))
 				go peerServer.Start()
 				defer peerServer.Stop()
 				if test.shouldSucceed {
@@ -132,3 +133,36 @@ func TestStatusWithGetStatusError(t *testing.T) {
 	defer peerServer.Stop()
 	assert.Error(t, status())
 }
+
+func TestReloadCmd(t *testing.T) {
+	defer viper.Reset()
+
+	signer := &mocks.Signer{}
+	common2.GetDefaultSignerFnc = func() (msp.SigningIdentity, error) {
+		return signer, nil
+	}
+	viper.Set("peer.address", "localhost:7074")
+	viper.Set("peer.client.connTimeout", 10*time.Millisecond)
+	peerServer, err := peer.NewPeerServer("localhost:7074", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	var reloads int
+	var reloadErr error
+	pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, nil, nil, func() error {
+		reloads++
+		return reloadErr
+	}))
+	go peerServer.Start()
+	defer peerServer.Stop()
+
+	cmd := reloadCmd()
+	cmd.SetArgs([]string{})
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, 1, reloads)
+
+	reloadErr = errors.New("failed reloading local MSP")
+	err = reload()
+	assert.EqualError(t, err, "failed reloading the identities of the peer: rpc error: code = Unknown desc = failed reloading local MSP")
+	assert.Equal(t, 2, reloads)
+}
@@ -32,7 +32,7 @@ func (m *CollectionConfigPackage) Reset()         { *m = CollectionConfigPackage
 func (m *CollectionConfigPackage) String() string { return proto.CompactTextString(m) }
 func (*CollectionConfigPackage) ProtoMessage()    {}
 func (*CollectionConfigPackage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_b741380d1d025da1, []int{0}
+	return fileDescriptor_collection_eb29bc614e2f6f1a, []int{0}
 }
 func (m *CollectionConfigPackage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionConfigPackage.Unmarshal(m, b)
@@ -75,7 +75,7 @@ func (m *CollectionConfig) Reset()         { *m = CollectionConfig{} }
 func (m *CollectionConfig) String() string { return proto.CompactTextString(m) }
 func (*CollectionConfig) ProtoMessage()    {}
 func (*CollectionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_b741380d1d025da1, []int{1}
+	return fileDescriptor_collection_eb29bc614e2f6f1a, []int{1}
 }
 func (m *CollectionConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionConfig.Unmarshal(m, b)
@@ -176,8 +176,10 @@ func _CollectionConfig_OneofSizer(msg proto.Message) (n int) {
 
 // StaticCollectionConfig constitutes the configuration parameters of a
 // static collection object. Static collections are collections that are
-// known at chaincode instantiation time, and that cannot be changed.
-// Dynamic collections are deferred.
+// known at chaincode instantiation time. The member orgs policy, the peer
+// counts and the block to live of a static collection can be updated
+// without upgrading the chaincode; the version in force at each block is
+// recorded in the collection config history. Dynamic collections are deferred.
 type StaticCollectionConfig struct {
 	// the name of the collection inside the denoted chaincode
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -193,18 +195,33 @@ type StaticCollectionConfig struct {
 	MaximumPeerCount int32 `protobuf:"varint,4,opt,name=maximum_peer_count,json=maximumPeerCount" json:"maximum_peer_count,omitempty"`
 	// The number of blocks after which the collection data expires.
 	// For instance if the value is set to 10, a key last modified by block number 100
-	// will be purged at block number 111. A zero value is treated same as MaxUint64
-	BlockToLive          uint64   `protobuf:"varint,5,opt,name=block_to_live,json=blockToLive" json:"block_to_live,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// will be purged at block number 111. A zero value is treated same as MaxUint64.
+	// An updated value only applies to the data committed after the update.
+	BlockToLive uint64 `protobuf:"varint,5,opt,name=block_to_live,json=blockToLive" json:"block_to_live,omitempty"`
+	// The member only read access denotes whether only collection member clients
+	// can read the private data (if set to true), or even non members can
+	// read the data (if set to false, for example if you want to implement more granular
+	// access logic in the chaincode)
+	MemberOnlyRead bool `protobuf:"varint,6,opt,name=member_only_read,json=memberOnlyRead" json:"member_only_read,omitempty"`
+	// The member only write access denotes whether only collection member clients
+	// can write the private data (if set to true), or even non members can
+	// write the data (if set to false, for example if you want to implement more granular
+	// access logic in the chaincode)
+	MemberOnlyWrite bool `protobuf:"varint,7,opt,name=member_only_write,json=memberOnlyWrite" json:"member_only_write,omitempty"`
+	// The endorsement policy that writes to this collection must satisfy.
+	// If not set, the writes are validated against the endorsement policy
+	// of the chaincode.
+	EndorsementPolicy    *CollectionPolicyConfig `protobuf:"bytes,8,opt,name=endorsement_policy,json=endorsementPolicy" json:"endorsement_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *StaticCollectionConfig) Reset()         { *m = StaticCollectionConfig{} }
 func (m *StaticCollectionConfig) String() string { return proto.CompactTextString(m) }
 func (*StaticCollectionConfig) ProtoMessage()    {}
 func (*StaticCollectionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_b741380d1d025da1, []int{2}
+	return fileDescriptor_collection_eb29bc614e2f6f1a, []int{2}
 }
 func (m *StaticCollectionConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StaticCollectionConfig.Unmarshal(m, b)
@@ -259,6 +276,27 @@ func (m *StaticCollectionConfig) GetBlockToLive() uint64 {
 	return 0
 }
 
+func (m *StaticCollectionConfig) GetMemberOnlyRead() bool {
+	if m != nil {
+		return m.MemberOnlyRead
+	}
+	return false
+}
+
+func (m *StaticCollectionConfig) GetMemberOnlyWrite() bool {
+	if m != nil {
+		return m.MemberOnlyWrite
+	}
+	return false
+}
+
+func (m *StaticCollectionConfig) GetEndorsementPolicy() *CollectionPolicyConfig {
+	if m != nil {
+		return m.EndorsementPolicy
+	}
+	return nil
+}
+
 // Collection policy configuration. Initially, the configuration can only
 // contain a SignaturePolicy. In the future, the SignaturePolicy may be a
 // more general Policy. Instead of containing the actual policy, the
@@ -276,7 +314,7 @@ func (m *CollectionPolicyConfig) Reset()         { *m = CollectionPolicyConfig{}
 func (m *CollectionPolicyConfig) String() string { return proto.CompactTextString(m) }
 func (*CollectionPolicyConfig) ProtoMessage()    {}
 func (*CollectionPolicyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_b741380d1d025da1, []int{3}
+	return fileDescriptor_collection_eb29bc614e2f6f1a, []int{3}
 }
 func (m *CollectionPolicyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionPolicyConfig.Unmarshal(m, b)
@@ -391,7 +429,7 @@ func (m *CollectionCriteria) Reset()         { *m = CollectionCriteria{} }
 func (m *CollectionCriteria) String() string { return proto.CompactTextString(m) }
 func (*CollectionCriteria) ProtoMessage()    {}
 func (*CollectionCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_b741380d1d025da1, []int{4}
+	return fileDescriptor_collection_eb29bc614e2f6f1a, []int{4}
 }
 func (m *CollectionCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionCriteria.Unmarshal(m, b)
@@ -447,37 +485,41 @@ func init() {
 	proto.RegisterType((*CollectionCriteria)(nil), "common.CollectionCriteria")
 }
 
-func init() { proto.RegisterFile("common/collection.proto", fileDescriptor_collection_b741380d1d025da1) }
-
-var fileDescriptor_collection_b741380d1d025da1 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6b, 0xdb, 0x40,
-	0x10, 0x85, 0xa3, 0xc6, 0x76, 0xd0, 0x98, 0x52, 0x77, 0x43, 0x1d, 0x51, 0x4a, 0x6a, 0x44, 0x0f,
-	0x86, 0x16, 0xa9, 0xa4, 0xff, 0x20, 0xa6, 0x90, 0x52, 0x43, 0x8d, 0xd2, 0x53, 0x2e, 0x62, 0xb5,
-	0x9a, 0xc8, 0x4b, 0x24, 0xad, 0xb2, 0xbb, 0x32, 0xf6, 0xb1, 0xff, 0xbb, 0x87, 0xe0, 0x5d, 0xc9,
-	0x52, 0x8c, 0x6f, 0x9e, 0x79, 0xdf, 0x3c, 0xcf, 0x3c, 0x2d, 0x5c, 0x31, 0x51, 0x14, 0xa2, 0x0c,
-	0x99, 0xc8, 0x73, 0x64, 0x9a, 0x8b, 0x32, 0xa8, 0xa4, 0xd0, 0x82, 0x8c, 0xac, 0xf0, 0xf1, 0x43,
-	0x03, 0x54, 0x22, 0xe7, 0x8c, 0xa3, 0xb2, 0xb2, 0xff, 0x1b, 0xae, 0x16, 0x87, 0x91, 0x85, 0x28,
-	0x1f, 0x79, 0xb6, 0xa2, 0xec, 0x89, 0x66, 0x48, 0xbe, 0xc3, 0x88, 0x99, 0x86, 0xe7, 0xcc, 0xce,
-	0xe7, 0xe3, 0x1b, 0x2f, 0xb0, 0x16, 0xc1, 0xf1, 0x40, 0xd4, 0x70, 0xfe, 0x0e, 0x26, 0xc7, 0x1a,
-	0x79, 0x00, 0x4f, 0x69, 0xaa, 0x39, 0x8b, 0xbb, 0xd5, 0xe2, 0x83, 0xaf, 0x33, 0x1f, 0xdf, 0x5c,
-	0xb7, 0xbe, 0xf7, 0x86, 0x3b, 0x76, 0xb8, 0x3b, 0x8b, 0xa6, 0xea, 0xa4, 0x72, 0xeb, 0xc2, 0x45,
-	0x45, 0x77, 0xb9, 0xa0, 0xa9, 0xff, 0xdf, 0x81, 0xe9, 0xe9, 0x79, 0x42, 0x60, 0x50, 0xd2, 0x02,
-	0xcd, 0xbf, 0xb9, 0x91, 0xf9, 0x4d, 0x96, 0x40, 0x0a, 0x2c, 0x12, 0x94, 0xb1, 0x90, 0x99, 0x8a,
-	0x4d, 0x28, 0x3b, 0xef, 0xcd, 0xeb, 0x7d, 0x3a, 0xa7, 0x95, 0xd1, 0x9b, 0x6b, 0x27, 0x76, 0xf2,
-	0x8f, 0xcc, 0x94, 0xed, 0x93, 0x00, 0x2e, 0x25, 0x3e, 0xd7, 0x5c, 0x62, 0x1a, 0x57, 0x88, 0x32,
-	0x66, 0xa2, 0x2e, 0xb5, 0x77, 0x3e, 0x73, 0xe6, 0xc3, 0xe8, 0x7d, 0x2b, 0xad, 0x10, 0xe5, 0x62,
-	0x2f, 0x90, 0x6f, 0x40, 0x0a, 0xba, 0xe5, 0x45, 0x5d, 0xf4, 0xf1, 0x81, 0xc1, 0x27, 0x8d, 0xd2,
-	0xd1, 0x3e, 0xbc, 0x4d, 0x72, 0xc1, 0x9e, 0x62, 0x2d, 0xe2, 0x9c, 0x6f, 0xd0, 0x1b, 0xce, 0x9c,
-	0xf9, 0x20, 0x1a, 0x9b, 0xe6, 0x5f, 0xb1, 0xe4, 0x1b, 0xf4, 0x9f, 0x61, 0x7a, 0x7a, 0x5b, 0xb2,
-	0x84, 0x89, 0xe2, 0x59, 0x49, 0x75, 0x2d, 0xb1, 0xbd, 0xd3, 0xe6, 0xfe, 0xf9, 0x90, 0x7b, 0xab,
-	0xdb, 0xc1, 0x9f, 0xe5, 0x06, 0x73, 0x51, 0xe1, 0xdd, 0x59, 0xf4, 0x4e, 0xbd, 0x96, 0xfa, 0x89,
-	0xff, 0x73, 0x80, 0xf4, 0xb2, 0x96, 0x5c, 0xa3, 0xe4, 0x94, 0x78, 0x70, 0xc1, 0xd6, 0xb4, 0x2c,
-	0x31, 0x6f, 0x02, 0x6f, 0x4b, 0x72, 0x09, 0x43, 0xbd, 0x8d, 0x79, 0x6a, 0x62, 0x76, 0xa3, 0x81,
-	0xde, 0xfe, 0x4a, 0xc9, 0x35, 0x40, 0xf7, 0x2e, 0x4c, 0x62, 0x6e, 0xd4, 0xeb, 0x90, 0x4f, 0xe0,
-	0xee, 0x3f, 0x98, 0xaa, 0x28, 0x43, 0x93, 0x90, 0x1b, 0x75, 0x8d, 0xdb, 0x7b, 0xf8, 0x22, 0x64,
-	0x16, 0xac, 0x77, 0x15, 0xca, 0x1c, 0xd3, 0x0c, 0x65, 0xf0, 0x48, 0x13, 0xc9, 0x99, 0x7d, 0xdd,
-	0xaa, 0xb9, 0xf0, 0xe1, 0x6b, 0xc6, 0xf5, 0xba, 0x4e, 0xf6, 0x65, 0xd8, 0x83, 0x43, 0x0b, 0x87,
-	0x16, 0x0e, 0x2d, 0x9c, 0x8c, 0x4c, 0xf9, 0xe3, 0x25, 0x00, 0x00, 0xff, 0xff, 0x04, 0x6f, 0x60,
-	0x95, 0x53, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("common/collection.proto", fileDescriptor_collection_eb29bc614e2f6f1a) }
+
+var fileDescriptor_collection_eb29bc614e2f6f1a = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6a, 0xdb, 0x3e,
+	0x14, 0xc6, 0xeb, 0x7f, 0xd3, 0xb4, 0x3e, 0xe1, 0xbf, 0x26, 0x2a, 0x4b, 0xcd, 0x18, 0x5d, 0x08,
+	0xbb, 0x30, 0xdb, 0x70, 0x46, 0xf7, 0x06, 0x0d, 0x83, 0x8e, 0x65, 0x2c, 0xb8, 0x83, 0x41, 0x6f,
+	0x8c, 0x22, 0x9f, 0x3a, 0xa2, 0xb6, 0xe4, 0xca, 0x4a, 0x16, 0x5f, 0xee, 0x51, 0xf6, 0xa6, 0x23,
+	0x92, 0x1d, 0xbb, 0x21, 0x17, 0xbb, 0xb3, 0xce, 0xf7, 0xfb, 0x8e, 0x74, 0xa4, 0xcf, 0x70, 0xc9,
+	0x64, 0x96, 0x49, 0x31, 0x61, 0x32, 0x4d, 0x91, 0x69, 0x2e, 0x45, 0x90, 0x2b, 0xa9, 0x25, 0xe9,
+	0x5a, 0xe1, 0xd5, 0xcb, 0x0a, 0xc8, 0x65, 0xca, 0x19, 0xc7, 0xc2, 0xca, 0xe3, 0xaf, 0x70, 0x39,
+	0xdd, 0x59, 0xa6, 0x52, 0x3c, 0xf0, 0x64, 0x4e, 0xd9, 0x23, 0x4d, 0x90, 0x7c, 0x84, 0x2e, 0x33,
+	0x05, 0xcf, 0x19, 0x1d, 0xfb, 0xbd, 0x6b, 0x2f, 0xb0, 0x2d, 0x82, 0x7d, 0x43, 0x58, 0x71, 0xe3,
+	0x12, 0xfa, 0xfb, 0x1a, 0xb9, 0x07, 0xaf, 0xd0, 0x54, 0x73, 0x16, 0x35, 0x47, 0x8b, 0x76, 0x7d,
+	0x1d, 0xbf, 0x77, 0x7d, 0x55, 0xf7, 0xbd, 0x33, 0xdc, 0x7e, 0x87, 0xdb, 0xa3, 0x70, 0x58, 0x1c,
+	0x54, 0x6e, 0x5c, 0x38, 0xcd, 0x69, 0x99, 0x4a, 0x1a, 0x8f, 0xff, 0x1c, 0xc3, 0xf0, 0xb0, 0x9f,
+	0x10, 0xe8, 0x08, 0x9a, 0xa1, 0xd9, 0xcd, 0x0d, 0xcd, 0x37, 0x99, 0x01, 0xc9, 0x30, 0x5b, 0xa0,
+	0x8a, 0xa4, 0x4a, 0x8a, 0xc8, 0x5c, 0x4a, 0xe9, 0xfd, 0xf7, 0xfc, 0x3c, 0x4d, 0xa7, 0xb9, 0xd1,
+	0xab, 0x69, 0xfb, 0xd6, 0xf9, 0x5d, 0x25, 0x85, 0xad, 0x93, 0x00, 0x2e, 0x14, 0x3e, 0xad, 0xb8,
+	0xc2, 0x38, 0xca, 0x11, 0x55, 0xc4, 0xe4, 0x4a, 0x68, 0xef, 0x78, 0xe4, 0xf8, 0x27, 0xe1, 0xa0,
+	0x96, 0xe6, 0x88, 0x6a, 0xba, 0x15, 0xc8, 0x07, 0x20, 0x19, 0xdd, 0xf0, 0x6c, 0x95, 0xb5, 0xf1,
+	0x8e, 0xc1, 0xfb, 0x95, 0xd2, 0xd0, 0x63, 0xf8, 0x7f, 0x91, 0x4a, 0xf6, 0x18, 0x69, 0x19, 0xa5,
+	0x7c, 0x8d, 0xde, 0xc9, 0xc8, 0xf1, 0x3b, 0x61, 0xcf, 0x14, 0x7f, 0xc8, 0x19, 0x5f, 0x23, 0xf1,
+	0xa1, 0x5f, 0xcf, 0x23, 0xd2, 0x32, 0x52, 0x48, 0x63, 0xaf, 0x3b, 0x72, 0xfc, 0xb3, 0xf0, 0x45,
+	0x75, 0x5a, 0x91, 0x96, 0x21, 0xd2, 0x98, 0xbc, 0x83, 0x41, 0x9b, 0xfc, 0xa5, 0xb8, 0x46, 0xef,
+	0xd4, 0xa0, 0xe7, 0x0d, 0xfa, 0x73, 0x5b, 0x26, 0xdf, 0x80, 0xa0, 0x88, 0xa5, 0x2a, 0x30, 0x43,
+	0xa1, 0xeb, 0x5b, 0x3a, 0xfb, 0xa7, 0x5b, 0x1a, 0xb4, 0x9c, 0x56, 0x18, 0x3f, 0xc1, 0xf0, 0x30,
+	0x4c, 0x66, 0xd0, 0x2f, 0x78, 0x22, 0xa8, 0x5e, 0x29, 0xac, 0xb7, 0xb1, 0xe1, 0x78, 0xb3, 0x0b,
+	0x47, 0xad, 0x5b, 0xe3, 0x67, 0xb1, 0xc6, 0x54, 0xe6, 0x78, 0x7b, 0x14, 0x9e, 0x17, 0xcf, 0xa5,
+	0x76, 0x2c, 0x7e, 0x3b, 0x40, 0x5a, 0x81, 0xd8, 0x4e, 0xa5, 0x38, 0x25, 0x1e, 0x9c, 0xb2, 0x25,
+	0x15, 0x02, 0xd3, 0x2a, 0x15, 0xf5, 0x92, 0x5c, 0xc0, 0x89, 0xde, 0x44, 0x3c, 0x36, 0x59, 0x70,
+	0xc3, 0x8e, 0xde, 0x7c, 0x89, 0xc9, 0x15, 0x40, 0x13, 0x5e, 0xf3, 0xac, 0x6e, 0xd8, 0xaa, 0x90,
+	0xd7, 0xe0, 0x6e, 0x53, 0x55, 0xe4, 0x94, 0xa1, 0x79, 0x46, 0x37, 0x6c, 0x0a, 0x37, 0x77, 0xf0,
+	0x56, 0xaa, 0x24, 0x58, 0x96, 0x39, 0xaa, 0x14, 0xe3, 0x04, 0x55, 0xf0, 0x40, 0x17, 0x8a, 0x33,
+	0xfb, 0x0b, 0x16, 0xd5, 0x84, 0xf7, 0xef, 0x13, 0xae, 0x97, 0xab, 0xc5, 0x76, 0x39, 0x69, 0xc1,
+	0x13, 0x0b, 0x4f, 0x2c, 0x3c, 0xb1, 0xf0, 0xa2, 0x6b, 0x96, 0x9f, 0xfe, 0x0e, 0x00, 0xde, 0xcd,
+	0xec, 0x28, 0xf8, 0x03, 0x00, 0x00,
 }
@@ -22,10 +22,11 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type Policy_PolicyType int32
 
 const (
-	Policy_UNKNOWN       Policy_PolicyType = 0
-	Policy_SIGNATURE     Policy_PolicyType = 1
-	Policy_MSP           Policy_PolicyType = 2
-	Policy_IMPLICIT_META Policy_PolicyType = 3
+	Policy_UNKNOWN             Policy_PolicyType = 0
+	Policy_SIGNATURE           Policy_PolicyType = 1
+	Policy_MSP                 Policy_PolicyType = 2
+	Policy_IMPLICIT_META       Policy_PolicyType = 3
+	Policy_THRESHOLD_SIGNATURE Policy_PolicyType = 4
 )
 
 var Policy_PolicyType_name = map[int32]string{
@@ -33,19 +34,21 @@ var Policy_PolicyType_name = map[int32]string{
 	1: "SIGNATURE",
 	2: "MSP",
 	3: "IMPLICIT_META",
+	4: "THRESHOLD_SIGNATURE",
 }
 var Policy_PolicyType_value = map[string]int32{
-	"UNKNOWN":       0,
-	"SIGNATURE":     1,
-	"MSP":           2,
-	"IMPLICIT_META": 3,
+	"UNKNOWN":             0,
+	"SIGNATURE":           1,
+	"MSP":                 2,
+	"IMPLICIT_META":       3,
+	"THRESHOLD_SIGNATURE": 4,
 }
 
 func (x Policy_PolicyType) String() string {
 	return proto.EnumName(Policy_PolicyType_name, int32(x))
 }
 func (Policy_PolicyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{0, 0}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{0, 0}
 }
 
 type ImplicitMetaPolicy_Rule int32
@@ -71,7 +74,7 @@ func (x ImplicitMetaPolicy_Rule) String() string {
 	return proto.EnumName(ImplicitMetaPolicy_Rule_name, int32(x))
 }
 func (ImplicitMetaPolicy_Rule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{3, 0}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{4, 0}
 }
 
 // Policy expresses a policy which the orderer can evaluate, because there has been some desire expressed to support
@@ -88,7 +91,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{0}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{0}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policy.Unmarshal(m, b)
@@ -136,7 +139,7 @@ func (m *SignaturePolicyEnvelope) Reset()         { *m = SignaturePolicyEnvelope
 func (m *SignaturePolicyEnvelope) String() string { return proto.CompactTextString(m) }
 func (*SignaturePolicyEnvelope) ProtoMessage()    {}
 func (*SignaturePolicyEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{1}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{1}
 }
 func (m *SignaturePolicyEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignaturePolicyEnvelope.Unmarshal(m, b)
@@ -197,7 +200,7 @@ func (m *SignaturePolicy) Reset()         { *m = SignaturePolicy{} }
 func (m *SignaturePolicy) String() string { return proto.CompactTextString(m) }
 func (*SignaturePolicy) ProtoMessage()    {}
 func (*SignaturePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{2}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{2}
 }
 func (m *SignaturePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignaturePolicy.Unmarshal(m, b)
@@ -333,7 +336,7 @@ func (m *SignaturePolicy_NOutOf) Reset()         { *m = SignaturePolicy_NOutOf{}
 func (m *SignaturePolicy_NOutOf) String() string { return proto.CompactTextString(m) }
 func (*SignaturePolicy_NOutOf) ProtoMessage()    {}
 func (*SignaturePolicy_NOutOf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{2, 0}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{2, 0}
 }
 func (m *SignaturePolicy_NOutOf) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignaturePolicy_NOutOf.Unmarshal(m, b)
@@ -367,6 +370,47 @@ func (m *SignaturePolicy_NOutOf) GetRules() []*SignaturePolicy {
 	return nil
 }
 
+// ThresholdSignaturePolicy is satisfied by a threshold signature which verifies against
+// the group public key of a set of key shares, for example one computed by a threshold of
+// the ordering service nodes over a block. The identities of the signers are not considered.
+type ThresholdSignaturePolicy struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThresholdSignaturePolicy) Reset()         { *m = ThresholdSignaturePolicy{} }
+func (m *ThresholdSignaturePolicy) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSignaturePolicy) ProtoMessage()    {}
+func (*ThresholdSignaturePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policies_1ab22ba895c50803, []int{3}
+}
+func (m *ThresholdSignaturePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThresholdSignaturePolicy.Unmarshal(m, b)
+}
+func (m *ThresholdSignaturePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThresholdSignaturePolicy.Marshal(b, m, deterministic)
+}
+func (dst *ThresholdSignaturePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSignaturePolicy.Merge(dst, src)
+}
+func (m *ThresholdSignaturePolicy) XXX_Size() int {
+	return xxx_messageInfo_ThresholdSignaturePolicy.Size(m)
+}
+func (m *ThresholdSignaturePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSignaturePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSignaturePolicy proto.InternalMessageInfo
+
+func (m *ThresholdSignaturePolicy) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 // ImplicitMetaPolicy is a policy type which depends on the hierarchical nature of the configuration
 // It is implicit because the rule is generate implicitly based on the number of sub policies
 // It is meta because it depends only on the result of other policies
@@ -387,7 +431,7 @@ func (m *ImplicitMetaPolicy) Reset()         { *m = ImplicitMetaPolicy{} }
 func (m *ImplicitMetaPolicy) String() string { return proto.CompactTextString(m) }
 func (*ImplicitMetaPolicy) ProtoMessage()    {}
 func (*ImplicitMetaPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policies_0e89ed70e7bf49a8, []int{3}
+	return fileDescriptor_policies_1ab22ba895c50803, []int{4}
 }
 func (m *ImplicitMetaPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImplicitMetaPolicy.Unmarshal(m, b)
@@ -426,43 +470,47 @@ func init() {
 	proto.RegisterType((*SignaturePolicyEnvelope)(nil), "common.SignaturePolicyEnvelope")
 	proto.RegisterType((*SignaturePolicy)(nil), "common.SignaturePolicy")
 	proto.RegisterType((*SignaturePolicy_NOutOf)(nil), "common.SignaturePolicy.NOutOf")
+	proto.RegisterType((*ThresholdSignaturePolicy)(nil), "common.ThresholdSignaturePolicy")
 	proto.RegisterType((*ImplicitMetaPolicy)(nil), "common.ImplicitMetaPolicy")
 	proto.RegisterEnum("common.Policy_PolicyType", Policy_PolicyType_name, Policy_PolicyType_value)
 	proto.RegisterEnum("common.ImplicitMetaPolicy_Rule", ImplicitMetaPolicy_Rule_name, ImplicitMetaPolicy_Rule_value)
 }
 
-func init() { proto.RegisterFile("common/policies.proto", fileDescriptor_policies_0e89ed70e7bf49a8) }
-
-var fileDescriptor_policies_0e89ed70e7bf49a8 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xdf, 0x8b, 0xda, 0x40,
-	0x10, 0x76, 0xfd, 0x11, 0x75, 0xf4, 0xda, 0x74, 0xb9, 0xa2, 0x1c, 0xb4, 0x95, 0x50, 0x8a, 0x70,
-	0x34, 0x01, 0xaf, 0x4f, 0x7d, 0xd3, 0x56, 0x7a, 0x69, 0x4d, 0x94, 0xd5, 0xa3, 0x5c, 0x5f, 0x82,
-	0xd1, 0xd5, 0x5b, 0x88, 0xbb, 0x4b, 0x76, 0x23, 0xf5, 0xbf, 0xe8, 0x53, 0xff, 0x99, 0xfe, 0x73,
-	0x25, 0x59, 0x2d, 0x72, 0xe5, 0xde, 0xe6, 0x9b, 0xfd, 0x66, 0xf6, 0xfb, 0x66, 0x06, 0x5e, 0xae,
-	0xc4, 0x6e, 0x27, 0xb8, 0x27, 0x45, 0xc2, 0x56, 0x8c, 0x2a, 0x57, 0xa6, 0x42, 0x0b, 0x6c, 0x99,
-	0xf4, 0x55, 0x67, 0xa7, 0xa4, 0xb7, 0x53, 0x32, 0x92, 0x29, 0xe3, 0x2b, 0x26, 0x97, 0x89, 0x21,
-	0x38, 0x3f, 0xc1, 0x9a, 0xe5, 0x25, 0x07, 0x8c, 0xa1, 0xaa, 0x0f, 0x92, 0x76, 0x51, 0x0f, 0xf5,
-	0x6b, 0xa4, 0x88, 0xf1, 0x25, 0xd4, 0xf6, 0xcb, 0x24, 0xa3, 0xdd, 0x72, 0x0f, 0xf5, 0xdb, 0xc4,
-	0x00, 0xe7, 0x33, 0x80, 0xa9, 0x59, 0xe4, 0x9c, 0x16, 0xd4, 0xef, 0xc2, 0x6f, 0xe1, 0xf4, 0x7b,
-	0x68, 0x97, 0xf0, 0x05, 0x34, 0xe7, 0xfe, 0x97, 0x70, 0xb8, 0xb8, 0x23, 0x63, 0x1b, 0xe1, 0x3a,
-	0x54, 0x82, 0xf9, 0xcc, 0x2e, 0xe3, 0x17, 0x70, 0xe1, 0x07, 0xb3, 0x89, 0xff, 0xc9, 0x5f, 0x44,
-	0xc1, 0x78, 0x31, 0xb4, 0x2b, 0xce, 0x6f, 0x04, 0x9d, 0x39, 0xdb, 0xf2, 0xa5, 0xce, 0x52, 0x6a,
-	0xfa, 0x8d, 0xf9, 0x9e, 0x26, 0x42, 0x52, 0xdc, 0x85, 0xfa, 0x9e, 0xa6, 0x8a, 0x09, 0x7e, 0x94,
-	0x73, 0x82, 0xf8, 0x1a, 0xaa, 0x69, 0x96, 0x18, 0x41, 0xad, 0x41, 0xc7, 0x35, 0xfe, 0xdc, 0x47,
-	0x8d, 0x48, 0x41, 0xc2, 0x1f, 0x00, 0xd8, 0x9a, 0x72, 0xcd, 0x34, 0xa3, 0xaa, 0x5b, 0xe9, 0x55,
-	0xfa, 0xad, 0xc1, 0xe5, 0xa9, 0x24, 0x98, 0xcf, 0x66, 0xa7, 0x61, 0x90, 0x33, 0x9e, 0xf3, 0x07,
-	0xc1, 0xf3, 0x47, 0xfd, 0xf0, 0x2b, 0x68, 0x2a, 0xb6, 0xe5, 0x74, 0x1d, 0xc5, 0x07, 0x23, 0xe9,
-	0xb6, 0x44, 0x1a, 0x26, 0x35, 0x3a, 0xe0, 0x8f, 0xd0, 0xe0, 0x91, 0xc8, 0x74, 0x24, 0x36, 0x47,
-	0x65, 0xaf, 0x9f, 0x50, 0xe6, 0x86, 0xd3, 0x4c, 0x4f, 0x37, 0xb7, 0x25, 0x62, 0xf1, 0x22, 0xba,
-	0x1a, 0x83, 0x65, 0x72, 0xb8, 0x0d, 0xe8, 0xe4, 0x17, 0x71, 0xfc, 0x1e, 0x6a, 0xb9, 0x09, 0xd5,
-	0x2d, 0x17, 0xba, 0x9f, 0xb4, 0x6a, 0x58, 0x23, 0x0b, 0xaa, 0xf9, 0x3a, 0x9c, 0x5f, 0x08, 0xb0,
-	0xbf, 0x93, 0xf9, 0x15, 0xe8, 0x80, 0xea, 0xe5, 0x3f, 0x03, 0xa0, 0xb2, 0x38, 0x2a, 0xce, 0xc3,
-	0x38, 0x68, 0x92, 0xa6, 0xca, 0xe2, 0xe3, 0xf3, 0xcd, 0xd9, 0x58, 0x9f, 0x0d, 0xde, 0x9c, 0xfe,
-	0xfa, 0xbf, 0x91, 0x4b, 0xb2, 0x84, 0x9a, 0xf1, 0x3a, 0xef, 0xa0, 0x9a, 0xa3, 0x7c, 0xcb, 0xc3,
-	0xf0, 0xde, 0x2e, 0x15, 0xc1, 0x64, 0x62, 0x23, 0xdc, 0x86, 0x46, 0x30, 0xfc, 0x3a, 0x25, 0xfe,
-	0xe2, 0xde, 0x2e, 0x8f, 0xe6, 0xf0, 0x56, 0xa4, 0x5b, 0xf7, 0xe1, 0x20, 0x69, 0x9a, 0xd0, 0xf5,
-	0x96, 0xa6, 0xee, 0x66, 0x19, 0xa7, 0x6c, 0x65, 0x6e, 0x50, 0x1d, 0x7f, 0xfb, 0x71, 0xbd, 0x65,
-	0xfa, 0x21, 0x8b, 0x73, 0xe8, 0x9d, 0x91, 0x3d, 0x43, 0xf6, 0x0c, 0xd9, 0x33, 0xe4, 0xd8, 0x2a,
-	0xe0, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0xab, 0x8a, 0xb5, 0xf9, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("common/policies.proto", fileDescriptor_policies_1ab22ba895c50803) }
+
+var fileDescriptor_policies_1ab22ba895c50803 = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x51, 0x8b, 0xda, 0x4c,
+	0x14, 0x35, 0xea, 0x46, 0xbd, 0xba, 0xdf, 0x97, 0x4e, 0xb7, 0x18, 0x16, 0xda, 0x4a, 0x28, 0x45,
+	0x58, 0x9a, 0x80, 0xdb, 0x97, 0xf6, 0x4d, 0x5b, 0xa9, 0xe9, 0x9a, 0x28, 0x93, 0x2c, 0x65, 0x0b,
+	0x25, 0x18, 0x1d, 0x75, 0x68, 0xcc, 0x0c, 0x99, 0x44, 0xc8, 0xbf, 0x68, 0x5f, 0xfa, 0x67, 0xfa,
+	0xe7, 0x4a, 0x32, 0xca, 0xca, 0x2e, 0xed, 0xdb, 0x3d, 0x37, 0xe7, 0xdc, 0x39, 0x27, 0xf7, 0xc2,
+	0xb3, 0x25, 0xdb, 0xed, 0x58, 0x6c, 0x71, 0x16, 0xd1, 0x25, 0x25, 0xc2, 0xe4, 0x09, 0x4b, 0x19,
+	0x52, 0x65, 0xfb, 0xb2, 0xbb, 0x13, 0xdc, 0xda, 0x09, 0x1e, 0xf0, 0x84, 0xc6, 0x4b, 0xca, 0x17,
+	0x91, 0x24, 0x18, 0x3f, 0x15, 0x50, 0xe7, 0x85, 0x26, 0x47, 0x08, 0xea, 0x69, 0xce, 0x89, 0xae,
+	0xf4, 0x94, 0xfe, 0x19, 0x2e, 0x6b, 0x74, 0x01, 0x67, 0xfb, 0x45, 0x94, 0x11, 0xbd, 0xda, 0x53,
+	0xfa, 0x1d, 0x2c, 0x81, 0xf1, 0x0d, 0x40, 0x6a, 0xfc, 0x82, 0xd3, 0x86, 0xc6, 0xad, 0x7b, 0xe3,
+	0xce, 0xbe, 0xb8, 0x5a, 0x05, 0x9d, 0x43, 0xcb, 0xb3, 0x3f, 0xb9, 0x43, 0xff, 0x16, 0x8f, 0x35,
+	0x05, 0x35, 0xa0, 0xe6, 0x78, 0x73, 0xad, 0x8a, 0x9e, 0xc0, 0xb9, 0xed, 0xcc, 0xa7, 0xf6, 0x07,
+	0xdb, 0x0f, 0x9c, 0xb1, 0x3f, 0xd4, 0x6a, 0xa8, 0x0b, 0x4f, 0xfd, 0x09, 0x1e, 0x7b, 0x93, 0xd9,
+	0xf4, 0x63, 0x70, 0x2f, 0xaa, 0x1b, 0xbf, 0x14, 0xe8, 0x7a, 0x74, 0x13, 0x2f, 0xd2, 0x2c, 0x21,
+	0xf2, 0xa1, 0x71, 0xbc, 0x27, 0x11, 0xe3, 0x04, 0xe9, 0xd0, 0xd8, 0x93, 0x44, 0x50, 0x16, 0x1f,
+	0x7c, 0x1e, 0x21, 0xba, 0x82, 0x7a, 0x92, 0x45, 0xd2, 0x69, 0x7b, 0xd0, 0x35, 0x65, 0x72, 0xf3,
+	0xc1, 0x20, 0x5c, 0x92, 0xd0, 0x5b, 0x00, 0xba, 0x22, 0x71, 0x4a, 0x53, 0x4a, 0x84, 0x5e, 0xeb,
+	0xd5, 0xfa, 0xed, 0xc1, 0xc5, 0x51, 0xe2, 0x78, 0xf3, 0xf9, 0xf1, 0x37, 0xe1, 0x13, 0x9e, 0xf1,
+	0x5b, 0x81, 0xff, 0x1f, 0xcc, 0x43, 0xcf, 0xa1, 0x25, 0xe8, 0x26, 0x26, 0xab, 0x20, 0xcc, 0xa5,
+	0xa5, 0x49, 0x05, 0x37, 0x65, 0x6b, 0x94, 0xa3, 0xf7, 0xd0, 0x8c, 0x03, 0x96, 0xa5, 0x01, 0x5b,
+	0x1f, 0x9c, 0xbd, 0xf8, 0x8b, 0x33, 0xd3, 0x9d, 0x65, 0xe9, 0x6c, 0x3d, 0xa9, 0x60, 0x35, 0x2e,
+	0xab, 0xcb, 0x31, 0xa8, 0xb2, 0x87, 0x3a, 0xa0, 0x1c, 0xf3, 0x2a, 0x31, 0x7a, 0x03, 0x67, 0x45,
+	0x08, 0xa1, 0x57, 0x7b, 0xb5, 0x7f, 0x45, 0x95, 0xac, 0x91, 0x0a, 0xf5, 0x62, 0x4f, 0xc6, 0x3b,
+	0xd0, 0xfd, 0x6d, 0x42, 0xc4, 0x96, 0x45, 0xab, 0xc7, 0x29, 0x80, 0x67, 0x61, 0x44, 0x97, 0xc1,
+	0x77, 0x22, 0x63, 0x74, 0x70, 0x4b, 0x76, 0x6e, 0x48, 0x6e, 0xfc, 0x50, 0x00, 0xd9, 0x3b, 0x5e,
+	0x9c, 0x56, 0xea, 0x90, 0x74, 0x71, 0xaf, 0x12, 0x59, 0x18, 0x94, 0x37, 0x27, 0x55, 0x2d, 0xdc,
+	0x12, 0x59, 0x78, 0xf8, 0x7c, 0x7d, 0xb2, 0x91, 0xff, 0x06, 0x2f, 0x8f, 0x36, 0x1f, 0x0f, 0x32,
+	0x71, 0x16, 0x11, 0xb9, 0x19, 0xe3, 0x35, 0xd4, 0x0b, 0x54, 0x5c, 0xce, 0xd0, 0xbd, 0xd3, 0x2a,
+	0x65, 0x31, 0x9d, 0x6a, 0x0a, 0xea, 0x40, 0xd3, 0x19, 0x7e, 0x9e, 0x61, 0xdb, 0xbf, 0xd3, 0xaa,
+	0x23, 0x0f, 0x5e, 0xb1, 0x64, 0x63, 0x6e, 0x73, 0x4e, 0x92, 0x88, 0xac, 0x36, 0x24, 0x31, 0xd7,
+	0x8b, 0x30, 0xa1, 0x4b, 0x79, 0xd8, 0xe2, 0xf0, 0xda, 0xd7, 0xab, 0x0d, 0x4d, 0xb7, 0x59, 0x58,
+	0x40, 0xeb, 0x84, 0x6c, 0x49, 0xb2, 0x25, 0xc9, 0x96, 0x24, 0x87, 0x6a, 0x09, 0xaf, 0xff, 0x0c,
+	0x00, 0xc2, 0x9e, 0xbd, 0xe6, 0x4e, 0x03, 0x00, 0x00,
 }
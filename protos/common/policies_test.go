@@ -24,6 +24,8 @@ func TestPoliciesEnums(t *testing.T) {
 	assert.Equal(t, "MSP", policy.String())
 	policy = 3
 	assert.Equal(t, "IMPLICIT_META", policy.String())
+	policy = 4
+	assert.Equal(t, "THRESHOLD_SIGNATURE", policy.String())
 
 	_, _ = policy.EnumDescriptor()
 
@@ -228,6 +228,12 @@ func (m *GossipMessage) IsDigestMsg() bool {
 	return m.GetDataDig() != nil
 }
 
+// IsBlockTreeMsg returns whether this GossipMessage is used for maintaining
+// the epidemic broadcast tree blocks are disseminated through
+func (m *GossipMessage) IsBlockTreeMsg() bool {
+	return m.GetTreeIhave() != nil || m.GetTreeGraft() != nil || m.GetTreePrune() != nil
+}
+
 // IsLeadershipMsg returns whether this GossipMessage is a leadership (leader election) message
 func (m *GossipMessage) IsLeadershipMsg() bool {
 	return m.GetLeadershipMsg() != nil
@@ -290,7 +296,7 @@ func (m *GossipMessage) IsTagLegal() error {
 		return nil
 	}
 
-	if m.IsLeadershipMsg() {
+	if m.IsLeadershipMsg() || m.IsBlockTreeMsg() {
 		if m.Tag != GossipMessage_CHAN_AND_ORG {
 			return fmt.Errorf("Tag should be %s", GossipMessage_Tag_name[int32(GossipMessage_CHAN_AND_ORG)])
 		}
@@ -673,6 +673,27 @@ func TestGossipMessageLeadershipMessageTagType(t *testing.T) {
 	assert.Error(t, msg.IsTagLegal())
 }
 
+func TestGossipMessageBlockTreeMessageTagType(t *testing.T) {
+	channelID := "testID1"
+	for _, content := range []isGossipMessage_Content{
+		&GossipMessage_TreeIhave{TreeIhave: &BlockTreeIHave{SeqNums: []uint64{1}}},
+		&GossipMessage_TreeGraft{TreeGraft: &BlockTreeGraft{SeqNums: []uint64{1}}},
+		&GossipMessage_TreePrune{TreePrune: &BlockTreePrune{}},
+	} {
+		msg := signedGossipMessage(channelID, GossipMessage_CHAN_AND_ORG, content)
+		assert.True(t, msg.IsBlockTreeMsg())
+		assert.NoError(t, msg.IsTagLegal())
+
+		msg = signedGossipMessage(channelID, GossipMessage_CHAN_OR_ORG, content)
+		assert.Error(t, msg.IsTagLegal())
+	}
+
+	msg := signedGossipMessage(channelID, GossipMessage_CHAN_AND_ORG, &GossipMessage_DataMsg{
+		DataMsg: &DataMessage{},
+	})
+	assert.False(t, msg.IsBlockTreeMsg())
+}
+
 func TestGossipMessageSign(t *testing.T) {
 	idSigner := func(msg []byte) ([]byte, error) {
 		return msg, nil
@@ -47,7 +47,7 @@ func (x PullMsgType) String() string {
 	return proto.EnumName(PullMsgType_name, int32(x))
 }
 func (PullMsgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_bd280b35749a1360, []int{0}
 }
 
 type GossipMessage_Tag int32
@@ -82,7 +82,7 @@ func (x GossipMessage_Tag) String() string {
 	return proto.EnumName(GossipMessage_Tag_name, int32(x))
 }
 func (GossipMessage_Tag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3, 0}
+	return fileDescriptor_message_bd280b35749a1360, []int{3, 0}
 }
 
 // Envelope contains a marshalled
@@ -102,7 +102,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_bd280b35749a1360, []int{0}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -160,7 +160,7 @@ func (m *SecretEnvelope) Reset()         { *m = SecretEnvelope{} }
 func (m *SecretEnvelope) String() string { return proto.CompactTextString(m) }
 func (*SecretEnvelope) ProtoMessage()    {}
 func (*SecretEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{1}
+	return fileDescriptor_message_bd280b35749a1360, []int{1}
 }
 func (m *SecretEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretEnvelope.Unmarshal(m, b)
@@ -210,7 +210,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{2}
+	return fileDescriptor_message_bd280b35749a1360, []int{2}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Secret.Unmarshal(m, b)
@@ -339,6 +339,9 @@ type GossipMessage struct {
 	//	*GossipMessage_PrivateReq
 	//	*GossipMessage_PrivateRes
 	//	*GossipMessage_PrivateData
+	//	*GossipMessage_TreeIhave
+	//	*GossipMessage_TreeGraft
+	//	*GossipMessage_TreePrune
 	Content              isGossipMessage_Content `protobuf_oneof:"content"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -349,7 +352,7 @@ func (m *GossipMessage) Reset()         { *m = GossipMessage{} }
 func (m *GossipMessage) String() string { return proto.CompactTextString(m) }
 func (*GossipMessage) ProtoMessage()    {}
 func (*GossipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3}
+	return fileDescriptor_message_bd280b35749a1360, []int{3}
 }
 func (m *GossipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipMessage.Unmarshal(m, b)
@@ -436,6 +439,15 @@ type GossipMessage_PrivateRes struct {
 type GossipMessage_PrivateData struct {
 	PrivateData *PrivateDataMessage `protobuf:"bytes,25,opt,name=private_data,json=privateData,oneof"`
 }
+type GossipMessage_TreeIhave struct {
+	TreeIhave *BlockTreeIHave `protobuf:"bytes,26,opt,name=tree_ihave,json=treeIhave,oneof"`
+}
+type GossipMessage_TreeGraft struct {
+	TreeGraft *BlockTreeGraft `protobuf:"bytes,27,opt,name=tree_graft,json=treeGraft,oneof"`
+}
+type GossipMessage_TreePrune struct {
+	TreePrune *BlockTreePrune `protobuf:"bytes,28,opt,name=tree_prune,json=treePrune,oneof"`
+}
 
 func (*GossipMessage_AliveMsg) isGossipMessage_Content()         {}
 func (*GossipMessage_MemReq) isGossipMessage_Content()           {}
@@ -458,6 +470,9 @@ func (*GossipMessage_Ack) isGossipMessage_Content()              {}
 func (*GossipMessage_PrivateReq) isGossipMessage_Content()       {}
 func (*GossipMessage_PrivateRes) isGossipMessage_Content()       {}
 func (*GossipMessage_PrivateData) isGossipMessage_Content()      {}
+func (*GossipMessage_TreeIhave) isGossipMessage_Content()        {}
+func (*GossipMessage_TreeGraft) isGossipMessage_Content()        {}
+func (*GossipMessage_TreePrune) isGossipMessage_Content()        {}
 
 func (m *GossipMessage) GetContent() isGossipMessage_Content {
 	if m != nil {
@@ -634,6 +649,27 @@ func (m *GossipMessage) GetPrivateData() *PrivateDataMessage {
 	return nil
 }
 
+func (m *GossipMessage) GetTreeIhave() *BlockTreeIHave {
+	if x, ok := m.GetContent().(*GossipMessage_TreeIhave); ok {
+		return x.TreeIhave
+	}
+	return nil
+}
+
+func (m *GossipMessage) GetTreeGraft() *BlockTreeGraft {
+	if x, ok := m.GetContent().(*GossipMessage_TreeGraft); ok {
+		return x.TreeGraft
+	}
+	return nil
+}
+
+func (m *GossipMessage) GetTreePrune() *BlockTreePrune {
+	if x, ok := m.GetContent().(*GossipMessage_TreePrune); ok {
+		return x.TreePrune
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*GossipMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _GossipMessage_OneofMarshaler, _GossipMessage_OneofUnmarshaler, _GossipMessage_OneofSizer, []interface{}{
@@ -658,6 +694,9 @@ func (*GossipMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*GossipMessage_PrivateReq)(nil),
 		(*GossipMessage_PrivateRes)(nil),
 		(*GossipMessage_PrivateData)(nil),
+		(*GossipMessage_TreeIhave)(nil),
+		(*GossipMessage_TreeGraft)(nil),
+		(*GossipMessage_TreePrune)(nil),
 	}
 }
 
@@ -770,6 +809,21 @@ func _GossipMessage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.PrivateData); err != nil {
 			return err
 		}
+	case *GossipMessage_TreeIhave:
+		b.EncodeVarint(26<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TreeIhave); err != nil {
+			return err
+		}
+	case *GossipMessage_TreeGraft:
+		b.EncodeVarint(27<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TreeGraft); err != nil {
+			return err
+		}
+	case *GossipMessage_TreePrune:
+		b.EncodeVarint(28<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TreePrune); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("GossipMessage.Content has unexpected type %T", x)
@@ -948,6 +1002,30 @@ func _GossipMessage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		err := b.DecodeMessage(msg)
 		m.Content = &GossipMessage_PrivateData{msg}
 		return true, err
+	case 26: // content.tree_ihave
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockTreeIHave)
+		err := b.DecodeMessage(msg)
+		m.Content = &GossipMessage_TreeIhave{msg}
+		return true, err
+	case 27: // content.tree_graft
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockTreeGraft)
+		err := b.DecodeMessage(msg)
+		m.Content = &GossipMessage_TreeGraft{msg}
+		return true, err
+	case 28: // content.tree_prune
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockTreePrune)
+		err := b.DecodeMessage(msg)
+		m.Content = &GossipMessage_TreePrune{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1062,6 +1140,21 @@ func _GossipMessage_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *GossipMessage_TreeIhave:
+		s := proto.Size(x.TreeIhave)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GossipMessage_TreeGraft:
+		s := proto.Size(x.TreeGraft)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GossipMessage_TreePrune:
+		s := proto.Size(x.TreePrune)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1088,7 +1181,7 @@ func (m *StateInfo) Reset()         { *m = StateInfo{} }
 func (m *StateInfo) String() string { return proto.CompactTextString(m) }
 func (*StateInfo) ProtoMessage()    {}
 func (*StateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{4}
+	return fileDescriptor_message_bd280b35749a1360, []int{4}
 }
 func (m *StateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfo.Unmarshal(m, b)
@@ -1149,7 +1242,7 @@ func (m *Properties) Reset()         { *m = Properties{} }
 func (m *Properties) String() string { return proto.CompactTextString(m) }
 func (*Properties) ProtoMessage()    {}
 func (*Properties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{5}
+	return fileDescriptor_message_bd280b35749a1360, []int{5}
 }
 func (m *Properties) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Properties.Unmarshal(m, b)
@@ -1202,7 +1295,7 @@ func (m *StateInfoSnapshot) Reset()         { *m = StateInfoSnapshot{} }
 func (m *StateInfoSnapshot) String() string { return proto.CompactTextString(m) }
 func (*StateInfoSnapshot) ProtoMessage()    {}
 func (*StateInfoSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{6}
+	return fileDescriptor_message_bd280b35749a1360, []int{6}
 }
 func (m *StateInfoSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoSnapshot.Unmarshal(m, b)
@@ -1245,7 +1338,7 @@ func (m *StateInfoPullRequest) Reset()         { *m = StateInfoPullRequest{} }
 func (m *StateInfoPullRequest) String() string { return proto.CompactTextString(m) }
 func (*StateInfoPullRequest) ProtoMessage()    {}
 func (*StateInfoPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{7}
+	return fileDescriptor_message_bd280b35749a1360, []int{7}
 }
 func (m *StateInfoPullRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoPullRequest.Unmarshal(m, b)
@@ -1288,7 +1381,7 @@ func (m *ConnEstablish) Reset()         { *m = ConnEstablish{} }
 func (m *ConnEstablish) String() string { return proto.CompactTextString(m) }
 func (*ConnEstablish) ProtoMessage()    {}
 func (*ConnEstablish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{8}
+	return fileDescriptor_message_bd280b35749a1360, []int{8}
 }
 func (m *ConnEstablish) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnEstablish.Unmarshal(m, b)
@@ -1345,7 +1438,7 @@ func (m *PeerIdentity) Reset()         { *m = PeerIdentity{} }
 func (m *PeerIdentity) String() string { return proto.CompactTextString(m) }
 func (*PeerIdentity) ProtoMessage()    {}
 func (*PeerIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{9}
+	return fileDescriptor_message_bd280b35749a1360, []int{9}
 }
 func (m *PeerIdentity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerIdentity.Unmarshal(m, b)
@@ -1401,7 +1494,7 @@ func (m *DataRequest) Reset()         { *m = DataRequest{} }
 func (m *DataRequest) String() string { return proto.CompactTextString(m) }
 func (*DataRequest) ProtoMessage()    {}
 func (*DataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{10}
+	return fileDescriptor_message_bd280b35749a1360, []int{10}
 }
 func (m *DataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataRequest.Unmarshal(m, b)
@@ -1457,7 +1550,7 @@ func (m *GossipHello) Reset()         { *m = GossipHello{} }
 func (m *GossipHello) String() string { return proto.CompactTextString(m) }
 func (*GossipHello) ProtoMessage()    {}
 func (*GossipHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{11}
+	return fileDescriptor_message_bd280b35749a1360, []int{11}
 }
 func (m *GossipHello) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipHello.Unmarshal(m, b)
@@ -1513,7 +1606,7 @@ func (m *DataUpdate) Reset()         { *m = DataUpdate{} }
 func (m *DataUpdate) String() string { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()    {}
 func (*DataUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{12}
+	return fileDescriptor_message_bd280b35749a1360, []int{12}
 }
 func (m *DataUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdate.Unmarshal(m, b)
@@ -1569,7 +1662,7 @@ func (m *DataDigest) Reset()         { *m = DataDigest{} }
 func (m *DataDigest) String() string { return proto.CompactTextString(m) }
 func (*DataDigest) ProtoMessage()    {}
 func (*DataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{13}
+	return fileDescriptor_message_bd280b35749a1360, []int{13}
 }
 func (m *DataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataDigest.Unmarshal(m, b)
@@ -1610,6 +1703,118 @@ func (m *DataDigest) GetMsgType() PullMsgType {
 	return PullMsgType_UNDEFINED
 }
 
+// BlockTreeIHave is sent by a peer to the peers it doesn't
+// eagerly push blocks to, and announces the blocks it received
+type BlockTreeIHave struct {
+	SeqNums              []uint64 `protobuf:"varint,1,rep,packed,name=seq_nums,json=seqNums" json:"seq_nums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTreeIHave) Reset()         { *m = BlockTreeIHave{} }
+func (m *BlockTreeIHave) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeIHave) ProtoMessage()    {}
+func (*BlockTreeIHave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_bd280b35749a1360, []int{14}
+}
+func (m *BlockTreeIHave) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeIHave.Unmarshal(m, b)
+}
+func (m *BlockTreeIHave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeIHave.Marshal(b, m, deterministic)
+}
+func (dst *BlockTreeIHave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeIHave.Merge(dst, src)
+}
+func (m *BlockTreeIHave) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeIHave.Size(m)
+}
+func (m *BlockTreeIHave) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeIHave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeIHave proto.InternalMessageInfo
+
+func (m *BlockTreeIHave) GetSeqNums() []uint64 {
+	if m != nil {
+		return m.SeqNums
+	}
+	return nil
+}
+
+// BlockTreeGraft asks a peer to eagerly push blocks to the sender,
+// and to send it the blocks with the given sequences
+type BlockTreeGraft struct {
+	SeqNums              []uint64 `protobuf:"varint,1,rep,packed,name=seq_nums,json=seqNums" json:"seq_nums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTreeGraft) Reset()         { *m = BlockTreeGraft{} }
+func (m *BlockTreeGraft) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeGraft) ProtoMessage()    {}
+func (*BlockTreeGraft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_bd280b35749a1360, []int{15}
+}
+func (m *BlockTreeGraft) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeGraft.Unmarshal(m, b)
+}
+func (m *BlockTreeGraft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeGraft.Marshal(b, m, deterministic)
+}
+func (dst *BlockTreeGraft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeGraft.Merge(dst, src)
+}
+func (m *BlockTreeGraft) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeGraft.Size(m)
+}
+func (m *BlockTreeGraft) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeGraft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeGraft proto.InternalMessageInfo
+
+func (m *BlockTreeGraft) GetSeqNums() []uint64 {
+	if m != nil {
+		return m.SeqNums
+	}
+	return nil
+}
+
+// BlockTreePrune asks a peer to stop eagerly pushing blocks
+// to the sender, and to only announce blocks to it instead
+type BlockTreePrune struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTreePrune) Reset()         { *m = BlockTreePrune{} }
+func (m *BlockTreePrune) String() string { return proto.CompactTextString(m) }
+func (*BlockTreePrune) ProtoMessage()    {}
+func (*BlockTreePrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_bd280b35749a1360, []int{16}
+}
+func (m *BlockTreePrune) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreePrune.Unmarshal(m, b)
+}
+func (m *BlockTreePrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreePrune.Marshal(b, m, deterministic)
+}
+func (dst *BlockTreePrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreePrune.Merge(dst, src)
+}
+func (m *BlockTreePrune) XXX_Size() int {
+	return xxx_messageInfo_BlockTreePrune.Size(m)
+}
+func (m *BlockTreePrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreePrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreePrune proto.InternalMessageInfo
+
 // DataMessage is the message that contains a block
 type DataMessage struct {
 	Payload              *Payload `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
@@ -1622,7 +1827,7 @@ func (m *DataMessage) Reset()         { *m = DataMessage{} }
 func (m *DataMessage) String() string { return proto.CompactTextString(m) }
 func (*DataMessage) ProtoMessage()    {}
 func (*DataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{14}
+	return fileDescriptor_message_bd280b35749a1360, []int{17}
 }
 func (m *DataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataMessage.Unmarshal(m, b)
@@ -1663,7 +1868,7 @@ func (m *PrivateDataMessage) Reset()         { *m = PrivateDataMessage{} }
 func (m *PrivateDataMessage) String() string { return proto.CompactTextString(m) }
 func (*PrivateDataMessage) ProtoMessage()    {}
 func (*PrivateDataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{15}
+	return fileDescriptor_message_bd280b35749a1360, []int{18}
 }
 func (m *PrivateDataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivateDataMessage.Unmarshal(m, b)
@@ -1704,7 +1909,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{16}
+	return fileDescriptor_message_bd280b35749a1360, []int{19}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payload.Unmarshal(m, b)
@@ -1764,7 +1969,7 @@ func (m *PrivatePayload) Reset()         { *m = PrivatePayload{} }
 func (m *PrivatePayload) String() string { return proto.CompactTextString(m) }
 func (*PrivatePayload) ProtoMessage()    {}
 func (*PrivatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{17}
+	return fileDescriptor_message_bd280b35749a1360, []int{20}
 }
 func (m *PrivatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivatePayload.Unmarshal(m, b)
@@ -1841,7 +2046,7 @@ func (m *AliveMessage) Reset()         { *m = AliveMessage{} }
 func (m *AliveMessage) String() string { return proto.CompactTextString(m) }
 func (*AliveMessage) ProtoMessage()    {}
 func (*AliveMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{18}
+	return fileDescriptor_message_bd280b35749a1360, []int{21}
 }
 func (m *AliveMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AliveMessage.Unmarshal(m, b)
@@ -1897,7 +2102,7 @@ func (m *LeadershipMessage) Reset()         { *m = LeadershipMessage{} }
 func (m *LeadershipMessage) String() string { return proto.CompactTextString(m) }
 func (*LeadershipMessage) ProtoMessage()    {}
 func (*LeadershipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{19}
+	return fileDescriptor_message_bd280b35749a1360, []int{22}
 }
 func (m *LeadershipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LeadershipMessage.Unmarshal(m, b)
@@ -1951,7 +2156,7 @@ func (m *PeerTime) Reset()         { *m = PeerTime{} }
 func (m *PeerTime) String() string { return proto.CompactTextString(m) }
 func (*PeerTime) ProtoMessage()    {}
 func (*PeerTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{20}
+	return fileDescriptor_message_bd280b35749a1360, []int{23}
 }
 func (m *PeerTime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerTime.Unmarshal(m, b)
@@ -1999,7 +2204,7 @@ func (m *MembershipRequest) Reset()         { *m = MembershipRequest{} }
 func (m *MembershipRequest) String() string { return proto.CompactTextString(m) }
 func (*MembershipRequest) ProtoMessage()    {}
 func (*MembershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{21}
+	return fileDescriptor_message_bd280b35749a1360, []int{24}
 }
 func (m *MembershipRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipRequest.Unmarshal(m, b)
@@ -2046,7 +2251,7 @@ func (m *MembershipResponse) Reset()         { *m = MembershipResponse{} }
 func (m *MembershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MembershipResponse) ProtoMessage()    {}
 func (*MembershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{22}
+	return fileDescriptor_message_bd280b35749a1360, []int{25}
 }
 func (m *MembershipResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipResponse.Unmarshal(m, b)
@@ -2095,7 +2300,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{23}
+	return fileDescriptor_message_bd280b35749a1360, []int{26}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Member.Unmarshal(m, b)
@@ -2147,7 +2352,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{24}
+	return fileDescriptor_message_bd280b35749a1360, []int{27}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -2181,7 +2386,7 @@ func (m *RemoteStateRequest) Reset()         { *m = RemoteStateRequest{} }
 func (m *RemoteStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateRequest) ProtoMessage()    {}
 func (*RemoteStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{25}
+	return fileDescriptor_message_bd280b35749a1360, []int{28}
 }
 func (m *RemoteStateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateRequest.Unmarshal(m, b)
@@ -2228,7 +2433,7 @@ func (m *RemoteStateResponse) Reset()         { *m = RemoteStateResponse{} }
 func (m *RemoteStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateResponse) ProtoMessage()    {}
 func (*RemoteStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{26}
+	return fileDescriptor_message_bd280b35749a1360, []int{29}
 }
 func (m *RemoteStateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateResponse.Unmarshal(m, b)
@@ -2268,7 +2473,7 @@ func (m *RemotePvtDataRequest) Reset()         { *m = RemotePvtDataRequest{} }
 func (m *RemotePvtDataRequest) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataRequest) ProtoMessage()    {}
 func (*RemotePvtDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{27}
+	return fileDescriptor_message_bd280b35749a1360, []int{30}
 }
 func (m *RemotePvtDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataRequest.Unmarshal(m, b)
@@ -2311,7 +2516,7 @@ func (m *PvtDataDigest) Reset()         { *m = PvtDataDigest{} }
 func (m *PvtDataDigest) String() string { return proto.CompactTextString(m) }
 func (*PvtDataDigest) ProtoMessage()    {}
 func (*PvtDataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{28}
+	return fileDescriptor_message_bd280b35749a1360, []int{31}
 }
 func (m *PvtDataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataDigest.Unmarshal(m, b)
@@ -2379,7 +2584,7 @@ func (m *RemotePvtDataResponse) Reset()         { *m = RemotePvtDataResponse{} }
 func (m *RemotePvtDataResponse) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataResponse) ProtoMessage()    {}
 func (*RemotePvtDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{29}
+	return fileDescriptor_message_bd280b35749a1360, []int{32}
 }
 func (m *RemotePvtDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataResponse.Unmarshal(m, b)
@@ -2419,7 +2624,7 @@ func (m *PvtDataElement) Reset()         { *m = PvtDataElement{} }
 func (m *PvtDataElement) String() string { return proto.CompactTextString(m) }
 func (*PvtDataElement) ProtoMessage()    {}
 func (*PvtDataElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{30}
+	return fileDescriptor_message_bd280b35749a1360, []int{33}
 }
 func (m *PvtDataElement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataElement.Unmarshal(m, b)
@@ -2469,7 +2674,7 @@ func (m *PvtDataPayload) Reset()         { *m = PvtDataPayload{} }
 func (m *PvtDataPayload) String() string { return proto.CompactTextString(m) }
 func (*PvtDataPayload) ProtoMessage()    {}
 func (*PvtDataPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{31}
+	return fileDescriptor_message_bd280b35749a1360, []int{34}
 }
 func (m *PvtDataPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataPayload.Unmarshal(m, b)
@@ -2514,7 +2719,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{32}
+	return fileDescriptor_message_bd280b35749a1360, []int{35}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Acknowledgement.Unmarshal(m, b)
@@ -2556,7 +2761,7 @@ func (m *Chaincode) Reset()         { *m = Chaincode{} }
 func (m *Chaincode) String() string { return proto.CompactTextString(m) }
 func (*Chaincode) ProtoMessage()    {}
 func (*Chaincode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{33}
+	return fileDescriptor_message_bd280b35749a1360, []int{36}
 }
 func (m *Chaincode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chaincode.Unmarshal(m, b)
@@ -2612,6 +2817,9 @@ func init() {
 	proto.RegisterType((*GossipHello)(nil), "gossip.GossipHello")
 	proto.RegisterType((*DataUpdate)(nil), "gossip.DataUpdate")
 	proto.RegisterType((*DataDigest)(nil), "gossip.DataDigest")
+	proto.RegisterType((*BlockTreeIHave)(nil), "gossip.BlockTreeIHave")
+	proto.RegisterType((*BlockTreeGraft)(nil), "gossip.BlockTreeGraft")
+	proto.RegisterType((*BlockTreePrune)(nil), "gossip.BlockTreePrune")
 	proto.RegisterType((*DataMessage)(nil), "gossip.DataMessage")
 	proto.RegisterType((*PrivateDataMessage)(nil), "gossip.PrivateDataMessage")
 	proto.RegisterType((*Payload)(nil), "gossip.Payload")
@@ -2778,126 +2986,131 @@ var _Gossip_serviceDesc = grpc.ServiceDesc{
 	Metadata: "gossip/message.proto",
 }
 
-func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_7c42328ef5ef9997) }
-
-var fileDescriptor_message_7c42328ef5ef9997 = []byte{
-	// 1874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0xe3, 0xc6,
-	0x15, 0x16, 0x6d, 0x5d, 0x8f, 0x2e, 0x96, 0xc7, 0xde, 0x5d, 0xc6, 0x49, 0x13, 0x87, 0xed, 0x26,
-	0xdb, 0x7a, 0x23, 0x6f, 0x9d, 0x16, 0x0d, 0x90, 0xb6, 0x0b, 0x5b, 0x52, 0x2c, 0x21, 0x2b, 0xad,
-	0x4b, 0x7b, 0xd1, 0xba, 0x2f, 0xc4, 0x98, 0x1c, 0x53, 0xac, 0xc9, 0x21, 0xcd, 0x19, 0x3b, 0xf6,
-	0x63, 0xd1, 0x87, 0x00, 0x7d, 0xe9, 0x6f, 0xe8, 0x53, 0xff, 0x66, 0x31, 0x33, 0xbc, 0x4a, 0xf6,
-	0x02, 0x1b, 0x20, 0x6f, 0x3c, 0xf7, 0x99, 0x33, 0x67, 0xbe, 0x73, 0x86, 0xb0, 0xed, 0x86, 0x8c,
-	0x79, 0xd1, 0x7e, 0x40, 0x18, 0xc3, 0x2e, 0x19, 0x44, 0x71, 0xc8, 0x43, 0x54, 0x57, 0xdc, 0x9d,
-	0x67, 0x76, 0x18, 0x04, 0x21, 0xdd, 0xb7, 0x43, 0xdf, 0x27, 0x36, 0xf7, 0x42, 0xaa, 0x14, 0x8c,
-	0x7f, 0x69, 0xd0, 0x1c, 0xd3, 0x5b, 0xe2, 0x87, 0x11, 0x41, 0x3a, 0x34, 0x22, 0x7c, 0xef, 0x87,
-	0xd8, 0xd1, 0xb5, 0x5d, 0xed, 0x45, 0xc7, 0x4c, 0x49, 0xf4, 0x09, 0xb4, 0x98, 0xe7, 0x52, 0xcc,
-	0x6f, 0x62, 0xa2, 0xaf, 0x49, 0x59, 0xce, 0x40, 0xaf, 0x61, 0x83, 0x11, 0x3b, 0x26, 0xdc, 0x22,
-	0x89, 0x2b, 0x7d, 0x7d, 0x57, 0x7b, 0xd1, 0x3e, 0x78, 0x3a, 0x50, 0xf1, 0x07, 0xa7, 0x52, 0x9c,
-	0x06, 0x32, 0x7b, 0xac, 0x44, 0x1b, 0x13, 0xe8, 0x95, 0x35, 0x7e, 0xea, 0x52, 0x8c, 0x43, 0xa8,
-	0x2b, 0x4f, 0xe8, 0x25, 0xf4, 0x3d, 0xca, 0x49, 0x4c, 0xb1, 0x3f, 0xa6, 0x4e, 0x14, 0x7a, 0x94,
-	0x4b, 0x57, 0xad, 0x49, 0xc5, 0x5c, 0x91, 0x1c, 0xb5, 0xa0, 0x61, 0x87, 0x94, 0x13, 0xca, 0x8d,
-	0x1f, 0xdb, 0xd0, 0x3d, 0x96, 0xcb, 0x9e, 0xa9, 0x5c, 0xa2, 0x6d, 0xa8, 0xd1, 0x90, 0xda, 0x44,
-	0xda, 0x57, 0x4d, 0x45, 0x88, 0x25, 0xda, 0x0b, 0x4c, 0x29, 0xf1, 0x93, 0x65, 0xa4, 0x24, 0xda,
-	0x83, 0x75, 0x8e, 0x5d, 0x99, 0x83, 0xde, 0xc1, 0x47, 0x69, 0x0e, 0x4a, 0x3e, 0x07, 0x67, 0xd8,
-	0x35, 0x85, 0x16, 0xfa, 0x1a, 0x5a, 0xd8, 0xf7, 0x6e, 0x89, 0x15, 0x30, 0x57, 0xaf, 0xc9, 0xb4,
-	0x6d, 0xa7, 0x26, 0x87, 0x42, 0x90, 0x58, 0x4c, 0x2a, 0x66, 0x53, 0x2a, 0xce, 0x98, 0x8b, 0x7e,
-	0x07, 0x8d, 0x80, 0x04, 0x56, 0x4c, 0xae, 0xf5, 0xba, 0x34, 0xc9, 0xa2, 0xcc, 0x48, 0x70, 0x41,
-	0x62, 0xb6, 0xf0, 0x22, 0x93, 0x5c, 0xdf, 0x10, 0xc6, 0x27, 0x15, 0xb3, 0x1e, 0x90, 0xc0, 0x24,
-	0xd7, 0xe8, 0xf7, 0xa9, 0x15, 0xd3, 0x1b, 0xd2, 0x6a, 0xe7, 0x21, 0x2b, 0x16, 0x85, 0x94, 0x91,
-	0xcc, 0x8c, 0xa1, 0x57, 0xd0, 0x74, 0x30, 0xc7, 0x72, 0x81, 0x4d, 0x69, 0xb7, 0x95, 0xda, 0x8d,
-	0x30, 0xc7, 0xf9, 0xfa, 0x1a, 0x42, 0x4d, 0x2c, 0x6f, 0x0f, 0x6a, 0x0b, 0xe2, 0xfb, 0xa1, 0xde,
-	0x2a, 0xab, 0xab, 0x14, 0x4c, 0x84, 0x68, 0x52, 0x31, 0x95, 0x0e, 0xda, 0x4f, 0xdc, 0x3b, 0x9e,
-	0xab, 0x83, 0xd4, 0x47, 0x45, 0xf7, 0x23, 0xcf, 0x55, 0xbb, 0x90, 0xde, 0x47, 0x9e, 0x9b, 0xad,
-	0x47, 0xec, 0xbe, 0xbd, 0xba, 0x9e, 0x7c, 0xdf, 0xd2, 0x42, 0x6d, 0xbc, 0x2d, 0x2d, 0x6e, 0x22,
-	0x07, 0x73, 0xa2, 0x77, 0x56, 0xa3, 0xbc, 0x93, 0x92, 0x49, 0xc5, 0x04, 0x27, 0xa3, 0xd0, 0x73,
-	0xa8, 0x91, 0x20, 0xe2, 0xf7, 0x7a, 0x57, 0x1a, 0x74, 0x53, 0x83, 0xb1, 0x60, 0x8a, 0x0d, 0x48,
-	0x29, 0xda, 0x83, 0xaa, 0x1d, 0x52, 0xaa, 0xf7, 0xa4, 0xd6, 0x93, 0x54, 0x6b, 0x18, 0x52, 0x3a,
-	0x66, 0x1c, 0x5f, 0xf8, 0x1e, 0x5b, 0x4c, 0x2a, 0xa6, 0x54, 0x42, 0x07, 0x00, 0x8c, 0x63, 0x4e,
-	0x2c, 0x8f, 0x5e, 0x86, 0xfa, 0x86, 0x34, 0xd9, 0xcc, 0xae, 0x89, 0x90, 0x4c, 0xe9, 0xa5, 0xc8,
-	0x4e, 0x8b, 0xa5, 0x04, 0x3a, 0x82, 0x9e, 0xb2, 0x61, 0x14, 0x47, 0x6c, 0x11, 0x72, 0xbd, 0x5f,
-	0x3e, 0xf4, 0xcc, 0xee, 0x34, 0x51, 0x98, 0x54, 0xcc, 0xae, 0x34, 0x49, 0x19, 0x68, 0x06, 0x5b,
-	0x79, 0x5c, 0x2b, 0xba, 0xf1, 0x7d, 0x99, 0xbf, 0x4d, 0xe9, 0xe8, 0x93, 0x15, 0x47, 0x27, 0x37,
-	0xbe, 0x9f, 0x27, 0xb2, 0xcf, 0x96, 0xf8, 0xe8, 0x10, 0x94, 0x7f, 0xe1, 0x44, 0x28, 0xe9, 0xa8,
-	0x5c, 0x50, 0x26, 0x09, 0x42, 0x4e, 0xa4, 0xbb, 0xdc, 0x4d, 0x87, 0x15, 0x68, 0x34, 0x4a, 0x77,
-	0x15, 0x27, 0x25, 0xa7, 0x6f, 0x49, 0x1f, 0x1f, 0x3f, 0xe8, 0x23, 0xab, 0xca, 0x2e, 0x2b, 0x32,
-	0x44, 0x6e, 0x7c, 0x82, 0x1d, 0x55, 0xbc, 0xb2, 0x44, 0xb7, 0xcb, 0xb9, 0x79, 0x93, 0x49, 0xf3,
-	0x42, 0xed, 0xe6, 0x26, 0xa2, 0x5c, 0xbf, 0x85, 0x6e, 0x44, 0x48, 0x6c, 0x79, 0x0e, 0xa1, 0xdc,
-	0xe3, 0xf7, 0xfa, 0x93, 0xf2, 0x35, 0x3c, 0x21, 0x24, 0x9e, 0x26, 0x32, 0xb1, 0x8d, 0xa8, 0x40,
-	0x8b, 0xcb, 0x8e, 0xed, 0x2b, 0xfd, 0xa9, 0x34, 0x79, 0x96, 0xdd, 0x5c, 0xfb, 0x8a, 0x86, 0x3f,
-	0xf8, 0xc4, 0x71, 0x49, 0x40, 0xa8, 0xd8, 0xbc, 0xd0, 0x42, 0x7f, 0x06, 0x88, 0x62, 0xef, 0x56,
-	0x65, 0x41, 0x7f, 0x56, 0x4e, 0xbe, 0xda, 0xef, 0xc9, 0x2d, 0x2f, 0x57, 0x71, 0xc1, 0x02, 0xbd,
-	0x2e, 0xd8, 0x33, 0x5d, 0x97, 0xf6, 0xbf, 0x78, 0xc4, 0x3e, 0xcb, 0x58, 0xc1, 0x04, 0xbd, 0x86,
-	0x4e, 0x42, 0x59, 0xa2, 0xd0, 0xf5, 0x8f, 0xca, 0xc7, 0x76, 0xa2, 0x64, 0xe5, 0x6b, 0xdd, 0x8e,
-	0x72, 0xae, 0x61, 0xc1, 0xfa, 0x19, 0x76, 0x51, 0x17, 0x5a, 0xef, 0xe6, 0xa3, 0xf1, 0x77, 0xd3,
-	0xf9, 0x78, 0xd4, 0xaf, 0xa0, 0x16, 0xd4, 0xc6, 0xb3, 0x93, 0xb3, 0xf3, 0xbe, 0x86, 0x3a, 0xd0,
-	0x7c, 0x6b, 0x1e, 0x5b, 0x6f, 0xe7, 0x6f, 0xce, 0xfb, 0x6b, 0x42, 0x6f, 0x38, 0x39, 0x9c, 0x2b,
-	0x72, 0x1d, 0xf5, 0xa1, 0x23, 0xc9, 0xc3, 0xf9, 0xc8, 0x7a, 0x6b, 0x1e, 0xf7, 0xab, 0x68, 0x03,
-	0xda, 0x4a, 0xc1, 0x94, 0x8c, 0x5a, 0x11, 0x89, 0xff, 0xa7, 0x41, 0x2b, 0xab, 0x48, 0x34, 0x80,
-	0x16, 0xf7, 0x02, 0xc2, 0x38, 0x0e, 0x22, 0x89, 0xb8, 0xed, 0x83, 0x7e, 0xf1, 0x84, 0xce, 0xbc,
-	0x80, 0x98, 0xb9, 0x0a, 0x7a, 0x02, 0xf5, 0xe8, 0xca, 0xb3, 0x3c, 0x47, 0x02, 0x71, 0xc7, 0xac,
-	0x45, 0x57, 0xde, 0xd4, 0x41, 0x9f, 0x41, 0x3b, 0xc1, 0x69, 0x6b, 0x76, 0x38, 0xd4, 0xab, 0x52,
-	0x06, 0x09, 0x6b, 0x76, 0x38, 0x14, 0x37, 0x34, 0x8a, 0xc3, 0x88, 0xc4, 0xdc, 0x23, 0x2c, 0x41,
-	0x64, 0x94, 0x27, 0x28, 0x95, 0x98, 0x05, 0x2d, 0xe3, 0x47, 0x0d, 0x20, 0x17, 0xa1, 0x5f, 0x42,
-	0x57, 0x1e, 0x7d, 0x6c, 0x2d, 0x88, 0xe7, 0x2e, 0x78, 0xd2, 0x38, 0x3a, 0x8a, 0x39, 0x91, 0x3c,
-	0xf4, 0x39, 0x74, 0x7c, 0x72, 0xc9, 0xad, 0x62, 0x13, 0x69, 0x9a, 0x6d, 0xc1, 0x1b, 0x26, 0x8d,
-	0xe4, 0xb7, 0x20, 0x16, 0xe6, 0x51, 0x3b, 0x74, 0x08, 0xd3, 0xd7, 0x77, 0xd7, 0x8b, 0x60, 0x31,
-	0x4c, 0x25, 0x66, 0x41, 0xc9, 0x38, 0x84, 0xcd, 0x15, 0x34, 0x40, 0x2f, 0xa1, 0x49, 0x7c, 0x59,
-	0x88, 0x4c, 0xd7, 0xa4, 0x97, 0x2c, 0x73, 0x59, 0x4f, 0xce, 0x34, 0x8c, 0x3f, 0xc0, 0xf6, 0x43,
-	0x38, 0xb0, 0x9c, 0x39, 0x6d, 0x39, 0x73, 0xc6, 0x25, 0x74, 0x4b, 0xa0, 0x57, 0x38, 0x02, 0xad,
-	0x78, 0x04, 0x3b, 0xd0, 0xcc, 0xae, 0x9a, 0x6a, 0x9d, 0x19, 0x8d, 0x0c, 0xe8, 0x72, 0x9f, 0x59,
-	0x36, 0x89, 0xb9, 0xb5, 0xc0, 0x6c, 0x91, 0x1c, 0x5e, 0x9b, 0xfb, 0x6c, 0x48, 0x62, 0x3e, 0xc1,
-	0x6c, 0x61, 0xbc, 0x83, 0x4e, 0xf1, 0x4a, 0x3e, 0x16, 0x06, 0x41, 0x55, 0xb8, 0x49, 0x42, 0xc8,
-	0x6f, 0x11, 0x3a, 0x20, 0x1c, 0xcb, 0xda, 0x57, 0x9e, 0x33, 0xda, 0x08, 0xa0, 0x5d, 0xb8, 0x79,
-	0x8f, 0x77, 0x7d, 0x47, 0x76, 0x24, 0xa6, 0xaf, 0xed, 0xae, 0x8b, 0xae, 0x9f, 0x90, 0x68, 0x00,
-	0xcd, 0x80, 0xb9, 0x16, 0xbf, 0x4f, 0xc6, 0x9f, 0x5e, 0xde, 0x96, 0x44, 0x16, 0x67, 0xcc, 0x3d,
-	0xbb, 0x8f, 0x88, 0xd9, 0x08, 0xd4, 0x87, 0x11, 0x42, 0xbb, 0xd0, 0x0f, 0x1f, 0x09, 0x57, 0x5c,
-	0xef, 0x5a, 0x79, 0xbd, 0x1f, 0x1c, 0xf0, 0x0e, 0x20, 0x6f, 0x75, 0x8f, 0xc4, 0xfb, 0x15, 0x54,
-	0x93, 0x58, 0x0f, 0x57, 0x49, 0xf5, 0x27, 0x45, 0xf6, 0x55, 0x64, 0xd5, 0xca, 0x7f, 0xf6, 0xc4,
-	0x7e, 0xa3, 0xce, 0x31, 0x9d, 0xde, 0x7e, 0x5d, 0x1e, 0x25, 0xdb, 0x07, 0x1b, 0x99, 0xb5, 0x62,
-	0x67, 0xb3, 0xa5, 0xf1, 0x1d, 0xa0, 0x55, 0x04, 0x44, 0xaf, 0x96, 0x1d, 0x3c, 0x5d, 0x82, 0xcb,
-	0x15, 0x3f, 0xe7, 0xd0, 0x48, 0x78, 0xe8, 0x19, 0x34, 0x18, 0xb9, 0xb6, 0xe8, 0x4d, 0x90, 0x6c,
-	0xb7, 0xce, 0xc8, 0xf5, 0xfc, 0x26, 0x10, 0xd5, 0x59, 0x38, 0x55, 0x95, 0xd7, 0xcf, 0x97, 0xd0,
-	0x79, 0x5d, 0x26, 0xa2, 0x84, 0xbf, 0xff, 0x59, 0x83, 0x5e, 0x39, 0x2c, 0xfa, 0x12, 0x36, 0xf2,
-	0xb9, 0xde, 0xa2, 0x38, 0x50, 0x99, 0x6d, 0x99, 0xbd, 0x9c, 0x3d, 0xc7, 0x01, 0x11, 0xa3, 0xb3,
-	0x90, 0xb2, 0x08, 0xdb, 0x6a, 0x74, 0x6e, 0x99, 0x39, 0x03, 0x6d, 0x41, 0x8d, 0xdf, 0xa5, 0x70,
-	0xd9, 0x32, 0xab, 0xfc, 0x6e, 0xea, 0x08, 0x24, 0x4b, 0x57, 0x14, 0xff, 0xc0, 0x08, 0x4f, 0xf0,
-	0x32, 0x5d, 0xa6, 0x29, 0x78, 0xe8, 0x25, 0xa0, 0x54, 0x89, 0x79, 0x41, 0x8a, 0x79, 0x35, 0xb9,
-	0xdd, 0x7e, 0x22, 0x39, 0xf5, 0x82, 0x04, 0xf7, 0xe6, 0x80, 0x0a, 0xcb, 0xb5, 0x43, 0x7a, 0xe9,
-	0xb9, 0x2c, 0x19, 0x63, 0x3f, 0x1b, 0xa8, 0x87, 0xca, 0x60, 0x98, 0x69, 0x0c, 0xa5, 0xc2, 0x09,
-	0xb6, 0xaf, 0xb0, 0x4b, 0xcc, 0x4d, 0x7b, 0x49, 0xc0, 0x8c, 0x7f, 0x6b, 0xd0, 0x29, 0x0e, 0xca,
-	0x68, 0x00, 0x10, 0x64, 0xf3, 0x6c, 0x72, 0x64, 0xbd, 0xf2, 0xa4, 0x6b, 0x16, 0x34, 0x3e, 0xb8,
-	0xb1, 0x14, 0xe1, 0xab, 0x5a, 0x86, 0x2f, 0xe3, 0x9f, 0x1a, 0x6c, 0xae, 0x4c, 0x1c, 0x8f, 0x01,
-	0xd4, 0x87, 0x06, 0x7e, 0x0e, 0x3d, 0x8f, 0x59, 0x0e, 0xb1, 0x7d, 0x1c, 0x63, 0x91, 0x02, 0x79,
-	0x54, 0x4d, 0xb3, 0xeb, 0xb1, 0x51, 0xce, 0x34, 0xfe, 0x08, 0xcd, 0xd4, 0x5a, 0x94, 0x9f, 0x47,
-	0xed, 0x62, 0xf9, 0x79, 0xd4, 0x16, 0xe5, 0x57, 0xa8, 0xcb, 0xb5, 0x62, 0x5d, 0x1a, 0x97, 0xb0,
-	0xb9, 0xf2, 0x86, 0x40, 0xdf, 0x42, 0x9f, 0x11, 0xff, 0x52, 0x0e, 0x8f, 0x71, 0xa0, 0x62, 0x6b,
-	0xe5, 0x05, 0x67, 0x10, 0xb1, 0x21, 0x34, 0xa7, 0xb9, 0xa2, 0xb8, 0xef, 0x62, 0x18, 0xa2, 0xc9,
-	0xbd, 0x56, 0x84, 0x71, 0x01, 0x68, 0xf5, 0xd5, 0x81, 0xbe, 0x80, 0x9a, 0x7c, 0xe4, 0x3c, 0xda,
-	0xa6, 0x94, 0x58, 0xe2, 0x14, 0xc1, 0xce, 0x7b, 0x70, 0x8a, 0x60, 0xc7, 0xf8, 0x2b, 0xd4, 0x55,
-	0x0c, 0x71, 0x66, 0xa4, 0xf4, 0x0a, 0x34, 0x33, 0xfa, 0xbd, 0x18, 0xfb, 0xf0, 0x10, 0x61, 0x34,
-	0xa0, 0x26, 0x1f, 0x01, 0xc6, 0xdf, 0x00, 0xad, 0x8e, 0xba, 0xa2, 0x89, 0x31, 0x8e, 0x63, 0x6e,
-	0x95, 0xaf, 0x7e, 0x5b, 0x32, 0x4f, 0xd5, 0xfd, 0xff, 0x14, 0xda, 0x84, 0x3a, 0x56, 0xf9, 0x10,
-	0x5a, 0x84, 0x3a, 0x4a, 0x6e, 0x1c, 0xc1, 0xd6, 0x03, 0x03, 0x30, 0xda, 0x83, 0x66, 0x82, 0x32,
-	0x69, 0x2b, 0x5f, 0x81, 0xb3, 0x4c, 0xc1, 0x38, 0x86, 0xed, 0x87, 0x86, 0x4a, 0xb4, 0x9f, 0x63,
-	0xad, 0xf2, 0x91, 0x3d, 0x5a, 0x12, 0x45, 0x85, 0xd4, 0x19, 0x04, 0x1b, 0xff, 0xd5, 0xa0, 0x5b,
-	0x12, 0xe5, 0x68, 0xa1, 0x15, 0xd0, 0xe2, 0xfd, 0x00, 0xf3, 0x29, 0x40, 0x7e, 0x7b, 0x13, 0x94,
-	0x29, 0x70, 0xd0, 0xc7, 0xd0, 0xba, 0xf0, 0x43, 0xfb, 0x4a, 0xe4, 0x44, 0x5e, 0xac, 0xaa, 0xd9,
-	0x94, 0x8c, 0x53, 0x72, 0x8d, 0x76, 0xa1, 0x23, 0x52, 0xe5, 0x51, 0x4b, 0xb2, 0x12, 0x74, 0x01,
-	0x46, 0xae, 0xa7, 0xf4, 0x48, 0x70, 0x8c, 0xef, 0xe1, 0xc9, 0x83, 0x13, 0x30, 0x3a, 0x58, 0x99,
-	0x7e, 0x9e, 0x2e, 0x6d, 0x77, 0xac, 0xc4, 0x85, 0x19, 0xe8, 0x1c, 0x7a, 0x65, 0x19, 0xfa, 0x0a,
-	0xea, 0x2a, 0x1b, 0x49, 0xe1, 0x3f, 0x92, 0xb2, 0x44, 0xa9, 0xf8, 0x03, 0x23, 0x69, 0x67, 0x69,
-	0x73, 0xf8, 0x4b, 0xe6, 0x3a, 0x05, 0xf0, 0xe7, 0xb0, 0xc1, 0xef, 0xac, 0xd2, 0xf6, 0x92, 0x81,
-	0x91, 0xdf, 0x9d, 0x66, 0x1b, 0x2c, 0xbb, 0x2c, 0xfe, 0x13, 0x31, 0xbe, 0x84, 0x8d, 0xa5, 0x07,
-	0x87, 0xb8, 0x74, 0x24, 0x8e, 0xc3, 0x38, 0x39, 0x1f, 0x45, 0x18, 0xef, 0xa0, 0x95, 0x8d, 0x8d,
-	0xa2, 0x03, 0x15, 0x9a, 0x85, 0xfc, 0x16, 0x31, 0x6e, 0x49, 0xcc, 0xc4, 0x01, 0xa9, 0xf3, 0x4b,
-	0xc9, 0xf7, 0x4d, 0x4e, 0xbf, 0xf9, 0x13, 0xb4, 0x0b, 0x9d, 0x78, 0xf9, 0x71, 0xd0, 0x85, 0xd6,
-	0xd1, 0x9b, 0xb7, 0xc3, 0xef, 0xad, 0xd9, 0xe9, 0x71, 0x5f, 0x13, 0x6f, 0x80, 0xe9, 0x68, 0x3c,
-	0x3f, 0x9b, 0x9e, 0x9d, 0x4b, 0xce, 0xda, 0xc1, 0x3f, 0xa0, 0xae, 0x26, 0x21, 0xf4, 0x0d, 0x74,
-	0xd4, 0xd7, 0x29, 0x8f, 0x09, 0x0e, 0xd0, 0xca, 0xc5, 0xde, 0x59, 0xe1, 0x18, 0x95, 0x17, 0xda,
-	0x2b, 0x0d, 0x7d, 0x01, 0xd5, 0x13, 0x8f, 0xba, 0xa8, 0xfc, 0x48, 0xdf, 0x29, 0x93, 0x46, 0xe5,
-	0xe8, 0xab, 0xbf, 0xef, 0xb9, 0x1e, 0x5f, 0xdc, 0x5c, 0x88, 0x4e, 0xb3, 0xbf, 0xb8, 0x8f, 0x48,
-	0xac, 0xa6, 0xf2, 0xfd, 0x4b, 0x7c, 0x11, 0x7b, 0xf6, 0xbe, 0xfc, 0x2f, 0xc6, 0xf6, 0x95, 0xd9,
-	0x45, 0x5d, 0x92, 0x5f, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x1d, 0xb3, 0x7e, 0x5f, 0x13,
-	0x00, 0x00,
+func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_bd280b35749a1360) }
+
+var fileDescriptor_message_bd280b35749a1360 = []byte{
+	// 1966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x53, 0xdc, 0xc8,
+	0xf5, 0x1f, 0xc1, 0x5c, 0xcf, 0x5c, 0x18, 0x1a, 0x6c, 0xcb, 0xd8, 0xff, 0x5d, 0xfe, 0x4a, 0xbc,
+	0xeb, 0x04, 0x2f, 0x38, 0x6c, 0x52, 0xbb, 0x55, 0x9b, 0xc4, 0x05, 0x03, 0xcb, 0x4c, 0xad, 0xc1,
+	0x44, 0xe0, 0x4a, 0xc8, 0x8b, 0xaa, 0x91, 0x1a, 0x8d, 0x82, 0xd4, 0x12, 0xea, 0x86, 0x85, 0xc7,
+	0x54, 0x1e, 0x52, 0x95, 0x97, 0x7c, 0x86, 0x3c, 0xe5, 0x3d, 0x9f, 0x30, 0xd5, 0xdd, 0xba, 0xb4,
+	0x18, 0x86, 0x2a, 0x6f, 0x55, 0xde, 0x74, 0xae, 0xdd, 0x7d, 0xfa, 0xf4, 0xef, 0x9c, 0x23, 0x58,
+	0xf5, 0x63, 0xc6, 0x82, 0x64, 0x2b, 0x22, 0x8c, 0x61, 0x9f, 0x6c, 0x26, 0x69, 0xcc, 0x63, 0xd4,
+	0x54, 0xdc, 0xb5, 0x67, 0x6e, 0x1c, 0x45, 0x31, 0xdd, 0x72, 0xe3, 0x30, 0x24, 0x2e, 0x0f, 0x62,
+	0xaa, 0x14, 0xac, 0xbf, 0x19, 0xd0, 0xde, 0xa7, 0x37, 0x24, 0x8c, 0x13, 0x82, 0x4c, 0x68, 0x25,
+	0xf8, 0x2e, 0x8c, 0xb1, 0x67, 0x1a, 0xeb, 0xc6, 0xeb, 0x9e, 0x9d, 0x93, 0xe8, 0x25, 0x74, 0x58,
+	0xe0, 0x53, 0xcc, 0xaf, 0x53, 0x62, 0x2e, 0x48, 0x59, 0xc9, 0x40, 0xef, 0x60, 0x89, 0x11, 0x37,
+	0x25, 0xdc, 0x21, 0x99, 0x2b, 0x73, 0x71, 0xdd, 0x78, 0xdd, 0xdd, 0x7e, 0xba, 0xa9, 0xd6, 0xdf,
+	0x3c, 0x91, 0xe2, 0x7c, 0x21, 0x7b, 0xc0, 0x2a, 0xb4, 0x35, 0x86, 0x41, 0x55, 0xe3, 0xa7, 0x6e,
+	0xc5, 0xda, 0x81, 0xa6, 0xf2, 0x84, 0xde, 0xc0, 0x30, 0xa0, 0x9c, 0xa4, 0x14, 0x87, 0xfb, 0xd4,
+	0x4b, 0xe2, 0x80, 0x72, 0xe9, 0xaa, 0x33, 0xae, 0xd9, 0x33, 0x92, 0xdd, 0x0e, 0xb4, 0xdc, 0x98,
+	0x72, 0x42, 0xb9, 0xf5, 0x9f, 0x1e, 0xf4, 0x0f, 0xe4, 0xb6, 0x0f, 0x55, 0x2c, 0xd1, 0x2a, 0x34,
+	0x68, 0x4c, 0x5d, 0x22, 0xed, 0xeb, 0xb6, 0x22, 0xc4, 0x16, 0xdd, 0x29, 0xa6, 0x94, 0x84, 0xd9,
+	0x36, 0x72, 0x12, 0x6d, 0xc0, 0x22, 0xc7, 0xbe, 0x8c, 0xc1, 0x60, 0xfb, 0x79, 0x1e, 0x83, 0x8a,
+	0xcf, 0xcd, 0x53, 0xec, 0xdb, 0x42, 0x0b, 0x7d, 0x0d, 0x1d, 0x1c, 0x06, 0x37, 0xc4, 0x89, 0x98,
+	0x6f, 0x36, 0x64, 0xd8, 0x56, 0x73, 0x93, 0x1d, 0x21, 0xc8, 0x2c, 0xc6, 0x35, 0xbb, 0x2d, 0x15,
+	0x0f, 0x99, 0x8f, 0x7e, 0x0d, 0xad, 0x88, 0x44, 0x4e, 0x4a, 0xae, 0xcc, 0xa6, 0x34, 0x29, 0x56,
+	0x39, 0x24, 0xd1, 0x39, 0x49, 0xd9, 0x34, 0x48, 0x6c, 0x72, 0x75, 0x4d, 0x18, 0x1f, 0xd7, 0xec,
+	0x66, 0x44, 0x22, 0x9b, 0x5c, 0xa1, 0xdf, 0xe4, 0x56, 0xcc, 0x6c, 0x49, 0xab, 0xb5, 0x87, 0xac,
+	0x58, 0x12, 0x53, 0x46, 0x0a, 0x33, 0x86, 0xde, 0x42, 0xdb, 0xc3, 0x1c, 0xcb, 0x0d, 0xb6, 0xa5,
+	0xdd, 0x4a, 0x6e, 0xb7, 0x87, 0x39, 0x2e, 0xf7, 0xd7, 0x12, 0x6a, 0x62, 0x7b, 0x1b, 0xd0, 0x98,
+	0x92, 0x30, 0x8c, 0xcd, 0x4e, 0x55, 0x5d, 0x85, 0x60, 0x2c, 0x44, 0xe3, 0x9a, 0xad, 0x74, 0xd0,
+	0x56, 0xe6, 0xde, 0x0b, 0x7c, 0x13, 0xa4, 0x3e, 0xd2, 0xdd, 0xef, 0x05, 0xbe, 0x3a, 0x85, 0xf4,
+	0xbe, 0x17, 0xf8, 0xc5, 0x7e, 0xc4, 0xe9, 0xbb, 0xb3, 0xfb, 0x29, 0xcf, 0x2d, 0x2d, 0xd4, 0xc1,
+	0xbb, 0xd2, 0xe2, 0x3a, 0xf1, 0x30, 0x27, 0x66, 0x6f, 0x76, 0x95, 0x8f, 0x52, 0x32, 0xae, 0xd9,
+	0xe0, 0x15, 0x14, 0x7a, 0x05, 0x0d, 0x12, 0x25, 0xfc, 0xce, 0xec, 0x4b, 0x83, 0x7e, 0x6e, 0xb0,
+	0x2f, 0x98, 0xe2, 0x00, 0x52, 0x8a, 0x36, 0xa0, 0xee, 0xc6, 0x94, 0x9a, 0x03, 0xa9, 0xf5, 0x24,
+	0xd7, 0x1a, 0xc5, 0x94, 0xee, 0x33, 0x8e, 0xcf, 0xc3, 0x80, 0x4d, 0xc7, 0x35, 0x5b, 0x2a, 0xa1,
+	0x6d, 0x00, 0xc6, 0x31, 0x27, 0x4e, 0x40, 0x2f, 0x62, 0x73, 0x49, 0x9a, 0x2c, 0x17, 0xcf, 0x44,
+	0x48, 0x26, 0xf4, 0x42, 0x44, 0xa7, 0xc3, 0x72, 0x02, 0xed, 0xc2, 0x40, 0xd9, 0x30, 0x8a, 0x13,
+	0x36, 0x8d, 0xb9, 0x39, 0xac, 0x5e, 0x7a, 0x61, 0x77, 0x92, 0x29, 0x8c, 0x6b, 0x76, 0x5f, 0x9a,
+	0xe4, 0x0c, 0x74, 0x08, 0x2b, 0xe5, 0xba, 0x4e, 0x72, 0x1d, 0x86, 0x32, 0x7e, 0xcb, 0xd2, 0xd1,
+	0xcb, 0x19, 0x47, 0xc7, 0xd7, 0x61, 0x58, 0x06, 0x72, 0xc8, 0xee, 0xf1, 0xd1, 0x0e, 0x28, 0xff,
+	0x4e, 0xaa, 0x94, 0x4c, 0x54, 0x4d, 0x28, 0x9b, 0x44, 0x31, 0x27, 0xd2, 0x5d, 0xe9, 0xa6, 0xc7,
+	0x34, 0x1a, 0xed, 0xe5, 0xa7, 0x4a, 0xb3, 0x94, 0x33, 0x57, 0xa4, 0x8f, 0x17, 0x0f, 0xfa, 0x28,
+	0xb2, 0xb2, 0xcf, 0x74, 0x86, 0x88, 0x4d, 0x48, 0xb0, 0xa7, 0x92, 0x57, 0xa6, 0xe8, 0x6a, 0x35,
+	0x36, 0xef, 0x0b, 0x69, 0x99, 0xa8, 0xfd, 0xd2, 0x44, 0xa4, 0xeb, 0x77, 0xd0, 0x4f, 0x08, 0x49,
+	0x9d, 0xc0, 0x23, 0x94, 0x07, 0xfc, 0xce, 0x7c, 0x52, 0x7d, 0x86, 0xc7, 0x84, 0xa4, 0x93, 0x4c,
+	0x26, 0x8e, 0x91, 0x68, 0xb4, 0x78, 0xec, 0xd8, 0xbd, 0x34, 0x9f, 0x4a, 0x93, 0x67, 0xc5, 0xcb,
+	0x75, 0x2f, 0x69, 0xfc, 0x63, 0x48, 0x3c, 0x9f, 0x44, 0x84, 0x8a, 0xc3, 0x0b, 0x2d, 0xf4, 0x7b,
+	0x80, 0x24, 0x0d, 0x6e, 0x54, 0x14, 0xcc, 0x67, 0xd5, 0xe0, 0xab, 0xf3, 0x1e, 0xdf, 0xf0, 0x6a,
+	0x16, 0x6b, 0x16, 0xe8, 0x9d, 0x66, 0xcf, 0x4c, 0x53, 0xda, 0xff, 0xdf, 0x1c, 0xfb, 0x22, 0x62,
+	0x9a, 0x09, 0x7a, 0x07, 0xbd, 0x8c, 0x72, 0x44, 0xa2, 0x9b, 0xcf, 0xab, 0xd7, 0x76, 0xac, 0x64,
+	0xd5, 0x67, 0xdd, 0x4d, 0x4a, 0x2e, 0xfa, 0x06, 0x80, 0xa7, 0x84, 0x38, 0xc1, 0x14, 0xdf, 0x10,
+	0x73, 0xad, 0x0a, 0xf3, 0xbb, 0x61, 0xec, 0x5e, 0x9e, 0xa6, 0x84, 0x4c, 0xc6, 0xf8, 0x46, 0x98,
+	0x76, 0x84, 0xee, 0x44, 0xa8, 0x16, 0x86, 0x7e, 0x8a, 0x2f, 0xb8, 0xf9, 0x62, 0x8e, 0xe1, 0x81,
+	0x90, 0xe6, 0x86, 0x92, 0x28, 0x0c, 0x93, 0xf4, 0x9a, 0x12, 0xf3, 0xe5, 0x1c, 0xc3, 0x63, 0x21,
+	0xcd, 0x0d, 0x25, 0x61, 0x39, 0xb0, 0x78, 0x8a, 0x7d, 0xd4, 0x87, 0xce, 0xc7, 0xa3, 0xbd, 0xfd,
+	0xef, 0x27, 0x47, 0xfb, 0x7b, 0xc3, 0x1a, 0xea, 0x40, 0x63, 0xff, 0xf0, 0xf8, 0xf4, 0x6c, 0x68,
+	0xa0, 0x1e, 0xb4, 0x3f, 0xd8, 0x07, 0xce, 0x87, 0xa3, 0xf7, 0x67, 0xc3, 0x05, 0xa1, 0x37, 0x1a,
+	0xef, 0x1c, 0x29, 0x72, 0x11, 0x0d, 0xa1, 0x27, 0xc9, 0x9d, 0xa3, 0x3d, 0xe7, 0x83, 0x7d, 0x30,
+	0xac, 0xa3, 0x25, 0xe8, 0x2a, 0x05, 0x5b, 0x32, 0x1a, 0x7a, 0xd1, 0xf8, 0xb7, 0x01, 0x9d, 0xe2,
+	0xf1, 0xa0, 0x4d, 0xe8, 0xf0, 0x20, 0x22, 0x8c, 0xe3, 0x28, 0x91, 0xc5, 0xa1, 0xbb, 0x3d, 0xd4,
+	0x93, 0xe9, 0x34, 0x88, 0x88, 0x5d, 0xaa, 0xa0, 0x27, 0xd0, 0x4c, 0x2e, 0x03, 0x27, 0xf0, 0x64,
+	0xcd, 0xe8, 0xd9, 0x8d, 0xe4, 0x32, 0x98, 0x78, 0xe8, 0x73, 0xe8, 0x66, 0x25, 0xc5, 0x39, 0xdc,
+	0x19, 0x99, 0x75, 0x29, 0x83, 0x8c, 0x75, 0xb8, 0x33, 0x12, 0x60, 0x92, 0xa4, 0x71, 0x42, 0x52,
+	0x1e, 0x10, 0x66, 0x36, 0xaa, 0xb0, 0x76, 0x5c, 0x48, 0x6c, 0x4d, 0xcb, 0xfa, 0xbb, 0x01, 0x50,
+	0x8a, 0xd0, 0xcf, 0xa0, 0x2f, 0xb3, 0x34, 0x75, 0xa6, 0x24, 0xf0, 0xa7, 0x3c, 0xab, 0x71, 0x3d,
+	0xc5, 0x1c, 0x4b, 0x1e, 0xfa, 0x7f, 0xe8, 0x85, 0xe4, 0x82, 0x3b, 0x7a, 0xbd, 0x6b, 0xdb, 0x5d,
+	0xc1, 0x1b, 0x29, 0x16, 0xfa, 0x15, 0x88, 0x8d, 0x05, 0xd4, 0x8d, 0x3d, 0xc2, 0xcc, 0xc5, 0xf5,
+	0x45, 0x1d, 0xd7, 0x46, 0xb9, 0xc4, 0xd6, 0x94, 0xac, 0x1d, 0x58, 0x9e, 0x01, 0x2e, 0xf4, 0x06,
+	0xda, 0x24, 0x94, 0x6f, 0x86, 0x99, 0xc6, 0xfa, 0xa2, 0x1e, 0xb9, 0xa2, 0x7d, 0x28, 0x34, 0xac,
+	0x6f, 0x60, 0xf5, 0x21, 0xc8, 0xba, 0x1f, 0x39, 0xe3, 0x7e, 0xe4, 0xac, 0x0b, 0xe8, 0x57, 0xf0,
+	0x59, 0xbb, 0x02, 0x43, 0xbf, 0x82, 0x35, 0x68, 0x17, 0xa8, 0xa0, 0xaa, 0x7c, 0x41, 0x23, 0x0b,
+	0xfa, 0x3c, 0x64, 0x8e, 0x4b, 0x52, 0xee, 0x4c, 0x31, 0x9b, 0x66, 0x97, 0xd7, 0xe5, 0x21, 0x1b,
+	0x91, 0x94, 0x8f, 0x31, 0x9b, 0x5a, 0x1f, 0xa1, 0xa7, 0xa3, 0xc7, 0xbc, 0x65, 0x10, 0xd4, 0x85,
+	0x9b, 0x6c, 0x09, 0xf9, 0x2d, 0x96, 0x8e, 0x08, 0xc7, 0xf2, 0x99, 0x2a, 0xcf, 0x05, 0x6d, 0x45,
+	0xd0, 0xd5, 0x40, 0x62, 0x7e, 0x83, 0xe2, 0xc9, 0xe2, 0xc9, 0xcc, 0x85, 0xf5, 0x45, 0xd1, 0xa0,
+	0x64, 0x24, 0xda, 0x84, 0x76, 0xc4, 0x7c, 0x87, 0xdf, 0x65, 0x9d, 0xda, 0xa0, 0xac, 0xa0, 0x22,
+	0x8a, 0x87, 0xcc, 0x3f, 0xbd, 0x4b, 0x88, 0xdd, 0x8a, 0xd4, 0x87, 0x15, 0x43, 0x57, 0x2b, 0xdd,
+	0x73, 0x96, 0xd3, 0xf7, 0xbb, 0x50, 0xdd, 0xef, 0x27, 0x2f, 0x78, 0x0b, 0x50, 0x56, 0xe5, 0x39,
+	0xeb, 0xfd, 0x1c, 0xea, 0xd9, 0x5a, 0x0f, 0x67, 0x49, 0xfd, 0x27, 0xad, 0x1c, 0x02, 0x94, 0x5d,
+	0xc7, 0xff, 0x3c, 0xb0, 0x1b, 0x30, 0xa8, 0x62, 0x26, 0x7a, 0x0e, 0x6d, 0x46, 0xae, 0x1c, 0x7a,
+	0x1d, 0xa9, 0xfc, 0xaf, 0xdb, 0x2d, 0x46, 0xae, 0x8e, 0xae, 0x23, 0x56, 0x51, 0x56, 0xd0, 0xf8,
+	0x88, 0xf2, 0x50, 0x53, 0x56, 0x70, 0xf8, 0x2d, 0x74, 0x35, 0x5c, 0x47, 0xbf, 0xa8, 0x76, 0xd8,
+	0xdd, 0xed, 0xa5, 0x62, 0xa7, 0x8a, 0x5d, 0xb4, 0xdc, 0xd6, 0xf7, 0x80, 0x66, 0x0b, 0x03, 0x7a,
+	0x7b, 0xdf, 0xc1, 0xd3, 0x7b, 0x55, 0x64, 0xc6, 0xcf, 0x19, 0xb4, 0x32, 0x1e, 0x7a, 0x06, 0xad,
+	0x6c, 0xe7, 0x59, 0x68, 0x9b, 0x6a, 0xe3, 0xe2, 0x25, 0x68, 0x19, 0x24, 0xbf, 0x05, 0xfc, 0x54,
+	0x8a, 0xd6, 0xa2, 0x0c, 0xba, 0x5e, 0x96, 0xac, 0x7f, 0x2e, 0xc0, 0xa0, 0xba, 0x2c, 0xfa, 0x12,
+	0x96, 0xca, 0x71, 0xc7, 0xa1, 0x38, 0x52, 0xb7, 0xd8, 0xb1, 0x07, 0x25, 0xfb, 0x08, 0x47, 0x44,
+	0x4c, 0x14, 0x42, 0xca, 0x12, 0xec, 0xaa, 0x89, 0xa2, 0x63, 0x97, 0x0c, 0xb4, 0x02, 0x0d, 0x7e,
+	0x9b, 0x43, 0x73, 0xc7, 0xae, 0xf3, 0xdb, 0x89, 0x27, 0x50, 0x33, 0xdf, 0x51, 0xfa, 0x23, 0x23,
+	0x3c, 0xc3, 0xe6, 0x7c, 0x9b, 0xb6, 0xe0, 0xa1, 0x37, 0x80, 0x72, 0x25, 0x16, 0x44, 0x39, 0xbe,
+	0x36, 0xe4, 0x71, 0x87, 0x99, 0xe4, 0x24, 0x88, 0x32, 0x8c, 0x3d, 0x02, 0xa4, 0x6d, 0xd7, 0x8d,
+	0xe9, 0x45, 0xe0, 0xb3, 0xac, 0xbb, 0xff, 0x7c, 0x53, 0xcd, 0x6f, 0x9b, 0xa3, 0x42, 0x63, 0x24,
+	0x15, 0x8e, 0xb1, 0x7b, 0x89, 0x7d, 0x62, 0x2f, 0xbb, 0xf7, 0x04, 0xcc, 0xfa, 0x87, 0x01, 0x3d,
+	0x7d, 0x7e, 0x40, 0x9b, 0x00, 0x51, 0xd1, 0xe6, 0x67, 0x57, 0x36, 0xa8, 0x0e, 0x00, 0xb6, 0xa6,
+	0xf1, 0xc9, 0x45, 0x4c, 0x87, 0xca, 0x7a, 0x15, 0x2a, 0xad, 0xbf, 0x1a, 0xb0, 0x3c, 0xd3, 0x88,
+	0xcd, 0x03, 0xc3, 0x4f, 0x5d, 0xf8, 0x15, 0x0c, 0x02, 0xe6, 0x78, 0xc4, 0x0d, 0x71, 0x8a, 0x45,
+	0x08, 0xe4, 0x55, 0xb5, 0xed, 0x7e, 0xc0, 0xf6, 0x4a, 0xa6, 0xf5, 0x5b, 0x68, 0xe7, 0xd6, 0x22,
+	0xfd, 0x02, 0xea, 0xea, 0xe9, 0x17, 0x50, 0x57, 0xa4, 0x9f, 0x96, 0x97, 0x0b, 0x7a, 0x5e, 0x5a,
+	0x17, 0xb0, 0x3c, 0x33, 0x5a, 0xa1, 0xef, 0x60, 0xc8, 0x48, 0x78, 0x21, 0x7b, 0xea, 0x34, 0x52,
+	0x6b, 0x1b, 0xeb, 0xc6, 0x83, 0x70, 0xb4, 0x24, 0x34, 0x27, 0xa5, 0xa2, 0xc0, 0x16, 0xd1, 0x23,
+	0xd2, 0x0c, 0x43, 0x14, 0x61, 0x9d, 0x03, 0x9a, 0x1d, 0xc6, 0xd0, 0x17, 0xd0, 0x90, 0xb3, 0xdf,
+	0xdc, 0x92, 0xa8, 0xc4, 0x12, 0x13, 0x09, 0xf6, 0x1e, 0xc1, 0x44, 0x82, 0x3d, 0xeb, 0x8f, 0xd0,
+	0x54, 0x6b, 0x88, 0x3b, 0x23, 0x95, 0xe1, 0xd8, 0x2e, 0xe8, 0x47, 0xf1, 0xfc, 0xe1, 0x86, 0xc5,
+	0x6a, 0x41, 0x43, 0xce, 0x46, 0xd6, 0x9f, 0x00, 0xcd, 0x4e, 0x00, 0xa2, 0x60, 0x32, 0x8e, 0x53,
+	0xee, 0x54, 0x9f, 0x7e, 0x57, 0x32, 0x4f, 0xd4, 0xfb, 0xff, 0x0c, 0xba, 0x84, 0x7a, 0x4e, 0xf5,
+	0x12, 0x3a, 0x84, 0x7a, 0x4a, 0x6e, 0xed, 0xc2, 0xca, 0x03, 0x73, 0x01, 0xda, 0x80, 0x76, 0x86,
+	0x32, 0x79, 0xdb, 0x30, 0x03, 0x67, 0x85, 0x82, 0x75, 0x00, 0xab, 0x0f, 0xf5, 0xda, 0x68, 0xab,
+	0xc4, 0x75, 0xe5, 0xa3, 0x98, 0xe5, 0x32, 0x45, 0x55, 0x15, 0x0a, 0xb8, 0xb7, 0xfe, 0x65, 0x40,
+	0xbf, 0x22, 0x2a, 0xd1, 0xc2, 0xd0, 0xd0, 0xe2, 0x71, 0x80, 0xf9, 0x0c, 0xa0, 0x7c, 0xbd, 0x19,
+	0xca, 0x68, 0x1c, 0xf4, 0x02, 0x3a, 0xe7, 0x02, 0xc9, 0x45, 0x4c, 0xe4, 0xc3, 0xaa, 0xdb, 0x6d,
+	0xc9, 0x38, 0x21, 0x57, 0x68, 0x1d, 0x7a, 0x22, 0x54, 0x01, 0x75, 0x24, 0x2b, 0x43, 0x17, 0x60,
+	0xe4, 0x6a, 0x42, 0x25, 0xfe, 0x5b, 0x3f, 0xc0, 0x93, 0x07, 0x07, 0x03, 0xb4, 0x3d, 0xd3, 0x69,
+	0x3d, 0xbd, 0x77, 0xdc, 0x7d, 0x25, 0xd6, 0xfa, 0xad, 0x33, 0x18, 0x54, 0x65, 0xe8, 0x2b, 0x68,
+	0xaa, 0x68, 0x64, 0x89, 0x3f, 0x27, 0x64, 0x99, 0x92, 0xfe, 0x5f, 0x27, 0x2b, 0x9d, 0x19, 0x69,
+	0xfd, 0xa1, 0x70, 0x9d, 0x03, 0xf8, 0x2b, 0x58, 0xe2, 0xb7, 0x4e, 0xe5, 0x78, 0x59, 0x73, 0xca,
+	0x6f, 0x4f, 0x8a, 0x03, 0x56, 0x5d, 0xea, 0xbf, 0x8a, 0xac, 0x2f, 0x61, 0xe9, 0xde, 0x1c, 0x26,
+	0x1e, 0x1d, 0x49, 0xd3, 0x38, 0xcd, 0xee, 0x47, 0x11, 0xd6, 0x47, 0xe8, 0x14, 0x2d, 0xaa, 0xa8,
+	0x40, 0x5a, 0xb1, 0x90, 0xdf, 0x62, 0x8d, 0x1b, 0x92, 0x32, 0x71, 0x41, 0xea, 0xfe, 0x72, 0xf2,
+	0xb1, 0x2e, 0xed, 0x97, 0xbf, 0x83, 0xae, 0x56, 0xf5, 0xef, 0x0f, 0x22, 0x7d, 0xe8, 0xec, 0xbe,
+	0xff, 0x30, 0xfa, 0xc1, 0x39, 0x3c, 0x39, 0x18, 0x1a, 0x62, 0xde, 0x98, 0xec, 0xed, 0x1f, 0x9d,
+	0x4e, 0x4e, 0xcf, 0x24, 0x67, 0x61, 0xfb, 0x2f, 0xd0, 0x54, 0x5d, 0x17, 0xfa, 0x16, 0x7a, 0xea,
+	0xeb, 0x84, 0xa7, 0x04, 0x47, 0x68, 0xe6, 0x61, 0xaf, 0xcd, 0x70, 0xac, 0xda, 0x6b, 0xe3, 0xad,
+	0x81, 0xbe, 0x80, 0xfa, 0x71, 0x40, 0x7d, 0x54, 0xfd, 0x77, 0xb1, 0x56, 0x25, 0xad, 0xda, 0xee,
+	0x57, 0x7f, 0xde, 0xf0, 0x03, 0x3e, 0xbd, 0x3e, 0x17, 0x95, 0x66, 0x6b, 0x7a, 0x97, 0x90, 0x54,
+	0x4d, 0x00, 0x5b, 0x17, 0xf8, 0x3c, 0x0d, 0xdc, 0x2d, 0xf9, 0xbb, 0x90, 0x6d, 0x29, 0xb3, 0xf3,
+	0xa6, 0x24, 0xbf, 0xfe, 0xef, 0x00, 0x75, 0x07, 0xab, 0x61, 0x76, 0x14, 0x00, 0x00,
 }
@@ -36,7 +36,7 @@ func (m *MSPConfig) Reset()         { *m = MSPConfig{} }
 func (m *MSPConfig) String() string { return proto.CompactTextString(m) }
 func (*MSPConfig) ProtoMessage()    {}
 func (*MSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{0}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{0}
 }
 func (m *MSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPConfig.Unmarshal(m, b)
@@ -131,7 +131,7 @@ func (m *FabricMSPConfig) Reset()         { *m = FabricMSPConfig{} }
 func (m *FabricMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricMSPConfig) ProtoMessage()    {}
 func (*FabricMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{1}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{1}
 }
 func (m *FabricMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricMSPConfig.Unmarshal(m, b)
@@ -239,17 +239,22 @@ type FabricCryptoConfig struct {
 	// IdentityIdentifierHashFunction is a string representing the hash function
 	// to be used during the computation of the identity identifier of an MSP identity.
 	// Allowed values are "SHA256", "SHA384" and "SHA3_256", "SHA3_384".
-	IdentityIdentifierHashFunction string   `protobuf:"bytes,2,opt,name=identity_identifier_hash_function,json=identityIdentifierHashFunction" json:"identity_identifier_hash_function,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
-	XXX_unrecognized               []byte   `json:"-"`
-	XXX_sizecache                  int32    `json:"-"`
+	IdentityIdentifierHashFunction string `protobuf:"bytes,2,opt,name=identity_identifier_hash_function,json=identityIdentifierHashFunction" json:"identity_identifier_hash_function,omitempty"`
+	// HybridPublicKeys is true if the identities of the MSP may carry
+	// hybrid public keys, pairing a classical key with a post-quantum key.
+	// All the nodes of the network are then required to use a BCCSP that
+	// supports hybrid keys.
+	HybridPublicKeys     bool     `protobuf:"varint,3,opt,name=hybrid_public_keys,json=hybridPublicKeys" json:"hybrid_public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FabricCryptoConfig) Reset()         { *m = FabricCryptoConfig{} }
 func (m *FabricCryptoConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricCryptoConfig) ProtoMessage()    {}
 func (*FabricCryptoConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{2}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{2}
 }
 func (m *FabricCryptoConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricCryptoConfig.Unmarshal(m, b)
@@ -283,6 +288,13 @@ func (m *FabricCryptoConfig) GetIdentityIdentifierHashFunction() string {
 	return ""
 }
 
+func (m *FabricCryptoConfig) GetHybridPublicKeys() bool {
+	if m != nil {
+		return m.HybridPublicKeys
+	}
+	return false
+}
+
 // IdemixMSPConfig collects all the configuration information for
 // an Idemix MSP.
 type IdemixMSPConfig struct {
@@ -305,7 +317,7 @@ func (m *IdemixMSPConfig) Reset()         { *m = IdemixMSPConfig{} }
 func (m *IdemixMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPConfig) ProtoMessage()    {}
 func (*IdemixMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{3}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{3}
 }
 func (m *IdemixMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPConfig.Unmarshal(m, b)
@@ -383,7 +395,7 @@ func (m *IdemixMSPSignerConfig) Reset()         { *m = IdemixMSPSignerConfig{} }
 func (m *IdemixMSPSignerConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPSignerConfig) ProtoMessage()    {}
 func (*IdemixMSPSignerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{4}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{4}
 }
 func (m *IdemixMSPSignerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPSignerConfig.Unmarshal(m, b)
@@ -465,7 +477,7 @@ func (m *SigningIdentityInfo) Reset()         { *m = SigningIdentityInfo{} }
 func (m *SigningIdentityInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningIdentityInfo) ProtoMessage()    {}
 func (*SigningIdentityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{5}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{5}
 }
 func (m *SigningIdentityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SigningIdentityInfo.Unmarshal(m, b)
@@ -520,7 +532,7 @@ func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
 func (m *KeyInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyInfo) ProtoMessage()    {}
 func (*KeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{6}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{6}
 }
 func (m *KeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyInfo.Unmarshal(m, b)
@@ -577,7 +589,7 @@ func (m *FabricOUIdentifier) Reset()         { *m = FabricOUIdentifier{} }
 func (m *FabricOUIdentifier) String() string { return proto.CompactTextString(m) }
 func (*FabricOUIdentifier) ProtoMessage()    {}
 func (*FabricOUIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{7}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{7}
 }
 func (m *FabricOUIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricOUIdentifier.Unmarshal(m, b)
@@ -620,7 +632,13 @@ type FabricNodeOUs struct {
 	// OU Identifier of the clients
 	ClientOuIdentifier *FabricOUIdentifier `protobuf:"bytes,2,opt,name=client_ou_identifier,json=clientOuIdentifier" json:"client_ou_identifier,omitempty"`
 	// OU Identifier of the peers
-	PeerOuIdentifier     *FabricOUIdentifier `protobuf:"bytes,3,opt,name=peer_ou_identifier,json=peerOuIdentifier" json:"peer_ou_identifier,omitempty"`
+	PeerOuIdentifier *FabricOUIdentifier `protobuf:"bytes,3,opt,name=peer_ou_identifier,json=peerOuIdentifier" json:"peer_ou_identifier,omitempty"`
+	// OU Identifier of the admins. Honored starting from MSP v1.4, where
+	// identities with this OU are admins of the MSP, in addition to
+	// those listed in the admins of the MSP configuration
+	AdminOuIdentifier *FabricOUIdentifier `protobuf:"bytes,4,opt,name=admin_ou_identifier,json=adminOuIdentifier" json:"admin_ou_identifier,omitempty"`
+	// OU Identifier of the orderers. Honored starting from MSP v1.4
+	OrdererOuIdentifier  *FabricOUIdentifier `protobuf:"bytes,5,opt,name=orderer_ou_identifier,json=ordererOuIdentifier" json:"orderer_ou_identifier,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -630,7 +648,7 @@ func (m *FabricNodeOUs) Reset()         { *m = FabricNodeOUs{} }
 func (m *FabricNodeOUs) String() string { return proto.CompactTextString(m) }
 func (*FabricNodeOUs) ProtoMessage()    {}
 func (*FabricNodeOUs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{8}
+	return fileDescriptor_msp_config_8cb91a7d8ea02912, []int{8}
 }
 func (m *FabricNodeOUs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricNodeOUs.Unmarshal(m, b)
@@ -671,6 +689,20 @@ func (m *FabricNodeOUs) GetPeerOuIdentifier() *FabricOUIdentifier {
 	return nil
 }
 
+func (m *FabricNodeOUs) GetAdminOuIdentifier() *FabricOUIdentifier {
+	if m != nil {
+		return m.AdminOuIdentifier
+	}
+	return nil
+}
+
+func (m *FabricNodeOUs) GetOrdererOuIdentifier() *FabricOUIdentifier {
+	if m != nil {
+		return m.OrdererOuIdentifier
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MSPConfig)(nil), "msp.MSPConfig")
 	proto.RegisterType((*FabricMSPConfig)(nil), "msp.FabricMSPConfig")
@@ -683,61 +715,65 @@ func init() {
 	proto.RegisterType((*FabricNodeOUs)(nil), "msp.FabricNodeOUs")
 }
 
-func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_e749e5bd1d6d997b) }
-
-var fileDescriptor_msp_config_e749e5bd1d6d997b = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0x57, 0x92, 0x26, 0x77, 0x99, 0x38, 0x49, 0xd9, 0xeb, 0x15, 0x0b, 0x71, 0x77, 0xa9, 0x01,
-	0x91, 0x17, 0x52, 0xa9, 0x87, 0x84, 0x84, 0x78, 0xba, 0xc2, 0x09, 0x03, 0xa5, 0xd5, 0x56, 0x7d,
-	0xe1, 0xc5, 0xda, 0xd8, 0x9b, 0x64, 0x65, 0x7b, 0xd7, 0xda, 0x5d, 0x9f, 0x08, 0xe2, 0x99, 0x2f,
-	0xc0, 0x77, 0xe0, 0x99, 0x57, 0xbe, 0x1d, 0xda, 0x3f, 0x8d, 0x9d, 0x6b, 0x15, 0x78, 0x9b, 0x9d,
-	0xf9, 0xcd, 0xcf, 0xb3, 0xbf, 0x99, 0x59, 0xc3, 0x49, 0xa9, 0xaa, 0xf3, 0x52, 0x55, 0x49, 0x2a,
-	0xf8, 0x8a, 0xad, 0x17, 0x95, 0x14, 0x5a, 0xa0, 0x5e, 0xa9, 0xaa, 0xe8, 0x2b, 0x18, 0x5e, 0xdd,
-	0xde, 0x5c, 0x5a, 0x3f, 0x42, 0x70, 0xa4, 0xb7, 0x15, 0x0d, 0x3b, 0xb3, 0xce, 0xbc, 0x8f, 0xad,
-	0x8d, 0x4e, 0x61, 0xe0, 0xb2, 0xc2, 0xee, 0xac, 0x33, 0x0f, 0xb0, 0x3f, 0x45, 0x7f, 0x1f, 0xc1,
-	0xf4, 0x2d, 0x59, 0x4a, 0x96, 0xee, 0xe5, 0x73, 0x52, 0xba, 0xfc, 0x21, 0xb6, 0x36, 0x7a, 0x01,
-	0x20, 0x85, 0xd0, 0x49, 0x4a, 0xa5, 0x56, 0x61, 0x77, 0xd6, 0x9b, 0x07, 0x78, 0x68, 0x3c, 0x97,
-	0xc6, 0x81, 0xbe, 0x00, 0xc4, 0xb8, 0xa6, 0xb2, 0xa4, 0x19, 0x23, 0x9a, 0x7a, 0x58, 0xcf, 0xc2,
-	0x3e, 0x68, 0x47, 0x1c, 0xfc, 0x14, 0x06, 0x24, 0x2b, 0x19, 0x57, 0xe1, 0x91, 0x85, 0xf8, 0x13,
-	0xfa, 0x1c, 0xa6, 0x92, 0xbe, 0x13, 0x29, 0xd1, 0x4c, 0xf0, 0xa4, 0x60, 0x4a, 0x87, 0x7d, 0x0b,
-	0x98, 0x34, 0xee, 0x9f, 0x98, 0xd2, 0xe8, 0x12, 0x8e, 0x15, 0x5b, 0x73, 0xc6, 0xd7, 0x09, 0xcb,
-	0x28, 0xd7, 0x4c, 0x6f, 0xc3, 0xc1, 0xac, 0x33, 0x1f, 0x5d, 0x84, 0x8b, 0x52, 0x55, 0x8b, 0x5b,
-	0x17, 0x8c, 0x7d, 0x2c, 0xe6, 0x2b, 0x81, 0xa7, 0x6a, 0xdf, 0x89, 0x12, 0x78, 0x25, 0xe4, 0x9a,
-	0x70, 0xf6, 0x9b, 0x25, 0x26, 0x45, 0x52, 0x73, 0xa6, 0x3d, 0xe1, 0x8a, 0x51, 0xa9, 0xc2, 0x27,
-	0xb3, 0xde, 0x7c, 0x74, 0xf1, 0xa1, 0xe5, 0x74, 0x32, 0x5d, 0xdf, 0xc5, 0xbb, 0x38, 0x7e, 0xb1,
-	0x9f, 0x7f, 0xc7, 0x99, 0x6e, 0xa2, 0x0a, 0x7d, 0x03, 0xe3, 0x54, 0x6e, 0x2b, 0x2d, 0x7c, 0xc7,
-	0xc2, 0xa7, 0xb6, 0xc4, 0x36, 0xdd, 0xa5, 0x8d, 0x3b, 0xe1, 0x71, 0x90, 0xb6, 0x4e, 0xe8, 0x53,
-	0x98, 0xe8, 0x42, 0x25, 0x2d, 0xd9, 0x87, 0x56, 0x8b, 0x40, 0x17, 0x0a, 0xef, 0x94, 0xff, 0x12,
-	0x4e, 0x0d, 0xea, 0x11, 0xf5, 0xc1, 0xa2, 0x4f, 0x74, 0xa1, 0xe2, 0x07, 0x0d, 0xf8, 0x1a, 0xa6,
-	0x2b, 0xfb, 0xfd, 0x84, 0x8b, 0x8c, 0x26, 0xa2, 0x56, 0xe1, 0xc8, 0xd6, 0x86, 0x5a, 0xb5, 0xfd,
-	0x2c, 0x32, 0x7a, 0x7d, 0xa7, 0xf0, 0x78, 0xd5, 0x1c, 0x6b, 0x15, 0xfd, 0xd9, 0x01, 0xf4, 0xb0,
-	0x78, 0x74, 0x01, 0xcf, 0x8d, 0xc0, 0x44, 0xd7, 0x92, 0x26, 0x1b, 0xa2, 0x36, 0xc9, 0x8a, 0x94,
-	0xac, 0xd8, 0xfa, 0x31, 0x7a, 0xb6, 0x0b, 0x7e, 0x4f, 0xd4, 0xe6, 0xad, 0x0d, 0xa1, 0x18, 0xce,
-	0xee, 0xdb, 0xd7, 0x92, 0xdd, 0x67, 0xd7, 0x3c, 0x35, 0xb2, 0xda, 0x81, 0x1d, 0xe2, 0x97, 0xf7,
-	0xc0, 0x46, 0x60, 0x4b, 0xe4, 0x51, 0xd1, 0x5f, 0x1d, 0x98, 0xc6, 0x19, 0x2d, 0xd9, 0xaf, 0x87,
-	0x07, 0xf9, 0x18, 0x7a, 0xac, 0xca, 0xfd, 0x16, 0x18, 0x13, 0x5d, 0xc0, 0xc0, 0xd4, 0x46, 0x65,
-	0xd8, 0xb3, 0x12, 0x7c, 0x64, 0x25, 0xd8, 0x71, 0xdd, 0xda, 0x98, 0xef, 0x90, 0x47, 0xa2, 0x4f,
-	0x60, 0xdc, 0x1a, 0xd4, 0x2a, 0x0f, 0x8f, 0x2c, 0x5f, 0xd0, 0x38, 0x6f, 0x72, 0x74, 0x02, 0x7d,
-	0x5a, 0x89, 0x74, 0x13, 0xf6, 0x67, 0x9d, 0x79, 0x0f, 0xbb, 0x43, 0xf4, 0x47, 0x17, 0x9e, 0x3f,
-	0x4a, 0x6e, 0xca, 0x4d, 0x25, 0xcd, 0x6c, 0xb9, 0x01, 0xb6, 0x36, 0x9a, 0x40, 0x57, 0xdd, 0x57,
-	0xdb, 0x55, 0x39, 0xfa, 0x16, 0x5e, 0x1e, 0x9e, 0x59, 0x7b, 0x89, 0x21, 0xfe, 0xf8, 0xd0, 0x64,
-	0x9a, 0x2f, 0x49, 0x51, 0x50, 0x5b, 0x75, 0x1f, 0x5b, 0xdb, 0x5c, 0x89, 0x72, 0x29, 0x8a, 0xa2,
-	0xa4, 0xdc, 0x10, 0xda, 0xaa, 0x87, 0x38, 0x68, 0x9c, 0x71, 0x86, 0x7e, 0x80, 0x33, 0x53, 0x96,
-	0x21, 0x22, 0x45, 0xd2, 0x92, 0x80, 0xf1, 0x95, 0x90, 0xa5, 0xb5, 0xed, 0x22, 0x06, 0xf8, 0x55,
-	0x03, 0xc4, 0x3b, 0x5c, 0xdc, 0xc0, 0x22, 0x01, 0xcf, 0x1e, 0x59, 0x53, 0x53, 0x47, 0x55, 0x2f,
-	0x0b, 0x96, 0x26, 0xbe, 0x2b, 0x4e, 0x8e, 0xc0, 0x39, 0x9d, 0x60, 0xe8, 0x35, 0x4c, 0x2a, 0xc9,
-	0xde, 0x99, 0x61, 0xf7, 0xa8, 0xae, 0xed, 0x5d, 0x60, 0x7b, 0xf7, 0x23, 0x75, 0x1b, 0x3f, 0xf6,
-	0x18, 0x97, 0x14, 0xdd, 0xc2, 0x13, 0x1f, 0x41, 0x9f, 0xc1, 0x24, 0xa7, 0xed, 0x99, 0xf3, 0x33,
-	0x32, 0xce, 0x69, 0x6b, 0xc0, 0xd0, 0x19, 0x04, 0x06, 0x56, 0x12, 0x4d, 0x25, 0x23, 0x85, 0xef,
-	0xc3, 0x28, 0xa7, 0xdb, 0x2b, 0xef, 0x8a, 0x7e, 0xbf, 0x5f, 0x86, 0xf6, 0xc3, 0x80, 0x66, 0x30,
-	0x32, 0x4b, 0xc8, 0x56, 0x2c, 0x25, 0x9a, 0xfa, 0x2b, 0xb4, 0x5d, 0xff, 0xa3, 0x91, 0xdd, 0xff,
-	0x6e, 0x64, 0xf4, 0x4f, 0x07, 0xc6, 0x7b, 0xcb, 0x6a, 0x9e, 0x56, 0xca, 0xc9, 0xb2, 0x70, 0x1f,
-	0x7d, 0x8a, 0xfd, 0x09, 0xc5, 0x70, 0x92, 0x16, 0xcc, 0xb4, 0x56, 0xd4, 0xef, 0x7f, 0xe5, 0xc0,
-	0x0b, 0x87, 0x5c, 0xd2, 0x75, 0xdd, 0xba, 0xdc, 0x77, 0x80, 0x2a, 0x4a, 0xe5, 0x7b, 0x44, 0xbd,
-	0xc3, 0x44, 0xc7, 0x26, 0xa5, 0x4d, 0xf3, 0x26, 0x81, 0x33, 0x21, 0xd7, 0x8b, 0xcd, 0xb6, 0xa2,
-	0xb2, 0xa0, 0xd9, 0x9a, 0xca, 0x85, 0x7b, 0x68, 0xdc, 0x8f, 0x4d, 0x19, 0xa6, 0x37, 0xc7, 0x57,
-	0xaa, 0x72, 0xeb, 0x71, 0x43, 0xd2, 0x9c, 0xac, 0xe9, 0x2f, 0xf3, 0x35, 0xd3, 0x9b, 0x7a, 0xb9,
-	0x48, 0x45, 0x79, 0xde, 0xca, 0x3d, 0x77, 0xb9, 0xe7, 0x2e, 0xd7, 0xfc, 0x26, 0x97, 0x03, 0x6b,
-	0xbf, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x54, 0x67, 0x46, 0xdb, 0x38, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_8cb91a7d8ea02912) }
+
+var fileDescriptor_msp_config_8cb91a7d8ea02912 = []byte{
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0x96, 0x7f, 0x77, 0x5d, 0x1e, 0xff, 0x6c, 0xe7, 0x87, 0x11, 0x62, 0x77, 0x1d, 0x03, 0xc2,
+	0x07, 0x70, 0x24, 0x2f, 0x12, 0x12, 0xe2, 0xb4, 0x81, 0x05, 0x13, 0x42, 0xa2, 0x8e, 0x72, 0xe1,
+	0x32, 0x6a, 0xcf, 0xb4, 0xed, 0x96, 0x67, 0xba, 0x47, 0xdd, 0x3d, 0x2b, 0x06, 0x71, 0xe6, 0x51,
+	0x38, 0xf3, 0x0a, 0x3c, 0x01, 0xaf, 0x84, 0xfa, 0x27, 0xf6, 0x38, 0x89, 0x0c, 0xb7, 0xea, 0xaa,
+	0xaf, 0xbe, 0xa9, 0xfe, 0xaa, 0xaa, 0x07, 0x8e, 0x33, 0x95, 0x9f, 0x67, 0x2a, 0x8f, 0x62, 0xc1,
+	0x97, 0x6c, 0x35, 0xcd, 0xa5, 0xd0, 0x02, 0x35, 0x32, 0x95, 0x8f, 0xbf, 0x82, 0xce, 0xd5, 0xed,
+	0xcd, 0x85, 0xf5, 0x23, 0x04, 0x4d, 0x5d, 0xe6, 0x34, 0xac, 0x8d, 0x6a, 0x93, 0x16, 0xb6, 0x36,
+	0x3a, 0x85, 0xb6, 0xcb, 0x0a, 0xeb, 0xa3, 0xda, 0x24, 0xc0, 0xfe, 0x34, 0xfe, 0xab, 0x09, 0x83,
+	0x77, 0x64, 0x21, 0x59, 0xbc, 0x97, 0xcf, 0x49, 0xe6, 0xf2, 0x3b, 0xd8, 0xda, 0xe8, 0x25, 0x80,
+	0x14, 0x42, 0x47, 0x31, 0x95, 0x5a, 0x85, 0xf5, 0x51, 0x63, 0x12, 0xe0, 0x8e, 0xf1, 0x5c, 0x18,
+	0x07, 0xfa, 0x02, 0x10, 0xe3, 0x9a, 0xca, 0x8c, 0x26, 0x8c, 0x68, 0xea, 0x61, 0x0d, 0x0b, 0x7b,
+	0x51, 0x8d, 0x38, 0xf8, 0x29, 0xb4, 0x49, 0x92, 0x31, 0xae, 0xc2, 0xa6, 0x85, 0xf8, 0x13, 0xfa,
+	0x0c, 0x06, 0x92, 0xbe, 0x17, 0x31, 0xd1, 0x4c, 0xf0, 0x28, 0x65, 0x4a, 0x87, 0x2d, 0x0b, 0xe8,
+	0xef, 0xdc, 0x3f, 0x31, 0xa5, 0xd1, 0x05, 0x0c, 0x15, 0x5b, 0x71, 0xc6, 0x57, 0x11, 0x4b, 0x28,
+	0xd7, 0x4c, 0x97, 0x61, 0x7b, 0x54, 0x9b, 0x74, 0x67, 0xe1, 0x34, 0x53, 0xf9, 0xf4, 0xd6, 0x05,
+	0xe7, 0x3e, 0x36, 0xe7, 0x4b, 0x81, 0x07, 0x6a, 0xdf, 0x89, 0x22, 0x78, 0x2d, 0xe4, 0x8a, 0x70,
+	0xf6, 0x9b, 0x25, 0x26, 0x69, 0x54, 0x70, 0xa6, 0x3d, 0xe1, 0x92, 0x51, 0xa9, 0xc2, 0x67, 0xa3,
+	0xc6, 0xa4, 0x3b, 0xfb, 0xc0, 0x72, 0x3a, 0x99, 0xae, 0xef, 0xe6, 0xdb, 0x38, 0x7e, 0xb9, 0x9f,
+	0x7f, 0xc7, 0x99, 0xde, 0x45, 0x15, 0xfa, 0x06, 0x7a, 0xb1, 0x2c, 0x73, 0x2d, 0x7c, 0xc7, 0xc2,
+	0xe7, 0xa3, 0xda, 0x03, 0xba, 0x0b, 0x1b, 0x77, 0xc2, 0xe3, 0x20, 0xae, 0x9c, 0xd0, 0x27, 0xd0,
+	0xd7, 0xa9, 0x8a, 0x2a, 0xb2, 0x77, 0xac, 0x16, 0x81, 0x4e, 0x15, 0xde, 0x2a, 0xff, 0x25, 0x9c,
+	0x1a, 0xd4, 0x13, 0xea, 0x83, 0x45, 0x1f, 0xeb, 0x54, 0xcd, 0x1f, 0x35, 0xe0, 0x6b, 0x18, 0x2c,
+	0xed, 0xf7, 0x23, 0x2e, 0x12, 0x1a, 0x89, 0x42, 0x85, 0x5d, 0x5b, 0x1b, 0xaa, 0xd4, 0xf6, 0xb3,
+	0x48, 0xe8, 0xf5, 0x9d, 0xc2, 0xbd, 0xe5, 0xee, 0x58, 0xa8, 0xf1, 0xdf, 0x35, 0x40, 0x8f, 0x8b,
+	0x47, 0x33, 0x38, 0x31, 0x02, 0x13, 0x5d, 0x48, 0x1a, 0xad, 0x89, 0x5a, 0x47, 0x4b, 0x92, 0xb1,
+	0xb4, 0xf4, 0x63, 0x74, 0xb4, 0x0d, 0xfe, 0x40, 0xd4, 0xfa, 0x9d, 0x0d, 0xa1, 0x39, 0x9c, 0xdd,
+	0xb7, 0xaf, 0x22, 0xbb, 0xcf, 0x2e, 0x78, 0x6c, 0x64, 0xb5, 0x03, 0xdb, 0xc1, 0xaf, 0xee, 0x81,
+	0x3b, 0x81, 0x2d, 0x91, 0x47, 0xa1, 0xcf, 0x01, 0xad, 0xcb, 0x85, 0x64, 0x49, 0x94, 0x17, 0x8b,
+	0x94, 0xc5, 0xd1, 0x86, 0x96, 0x66, 0x02, 0x6b, 0x93, 0xe7, 0x78, 0xe8, 0x22, 0x37, 0x36, 0x70,
+	0x49, 0x4b, 0x35, 0xfe, 0xb3, 0x06, 0x83, 0x79, 0x42, 0x33, 0xf6, 0xeb, 0xe1, 0xb1, 0x1f, 0x42,
+	0x83, 0xe5, 0x1b, 0xbf, 0x33, 0xc6, 0x44, 0x33, 0x68, 0x9b, 0x9b, 0x50, 0x69, 0xb9, 0xbb, 0xb3,
+	0x0f, 0xad, 0x60, 0x5b, 0xae, 0x5b, 0x1b, 0xf3, 0xfd, 0xf4, 0x48, 0xf4, 0x31, 0xf4, 0x2a, 0x63,
+	0x9d, 0x6f, 0xc2, 0xa6, 0xe5, 0x0b, 0x76, 0xce, 0x9b, 0x0d, 0x3a, 0x86, 0x16, 0xcd, 0x45, 0xbc,
+	0x0e, 0x5b, 0xa3, 0xda, 0xa4, 0x81, 0xdd, 0x61, 0xfc, 0x47, 0x1d, 0x4e, 0x9e, 0x24, 0x37, 0xe5,
+	0xc6, 0x92, 0x26, 0xb6, 0xdc, 0x00, 0x5b, 0x1b, 0xf5, 0xa1, 0xae, 0xee, 0xab, 0xad, 0xab, 0x0d,
+	0xfa, 0x16, 0x5e, 0x1d, 0x9e, 0x70, 0x7b, 0x89, 0x0e, 0xfe, 0xe8, 0xd0, 0x1c, 0x9b, 0x2f, 0x49,
+	0x91, 0x52, 0x5b, 0x75, 0x0b, 0x5b, 0xdb, 0x5c, 0x89, 0x72, 0x29, 0xd2, 0x34, 0xa3, 0xdc, 0x10,
+	0xda, 0xaa, 0x3b, 0x38, 0xd8, 0x39, 0xe7, 0x09, 0xfa, 0x11, 0xce, 0x4c, 0x59, 0x86, 0x88, 0xa4,
+	0x51, 0x45, 0x02, 0xc6, 0x97, 0x42, 0x66, 0xd6, 0xb6, 0x6b, 0x1b, 0xe0, 0xd7, 0x3b, 0x20, 0xde,
+	0xe2, 0xe6, 0x3b, 0xd8, 0x58, 0xc0, 0xd1, 0x13, 0x4b, 0x6d, 0xea, 0xf0, 0xfd, 0xf6, 0x5d, 0x71,
+	0x72, 0x04, 0xce, 0xe9, 0x04, 0x43, 0x6f, 0xa0, 0x9f, 0x4b, 0xf6, 0xde, 0xac, 0x86, 0x47, 0xd5,
+	0x6d, 0xef, 0x02, 0xdb, 0xbb, 0x4b, 0xea, 0xde, 0x87, 0x9e, 0xc7, 0xb8, 0xa4, 0xf1, 0x2d, 0x3c,
+	0xf3, 0x11, 0xf4, 0x29, 0xf4, 0x37, 0xb4, 0x3a, 0xa1, 0x7e, 0x46, 0x7a, 0x1b, 0x5a, 0x19, 0x47,
+	0x74, 0x06, 0x81, 0x81, 0x65, 0x44, 0x53, 0xc9, 0x48, 0xea, 0xfb, 0xd0, 0xdd, 0xd0, 0xf2, 0xca,
+	0xbb, 0xc6, 0xbf, 0x03, 0x7a, 0xfc, 0x8c, 0xa0, 0x11, 0x74, 0xcd, 0xca, 0xb2, 0x25, 0x8b, 0x89,
+	0xa6, 0xfe, 0x0a, 0x55, 0xd7, 0xff, 0x68, 0x64, 0xfd, 0xbf, 0x1b, 0x39, 0xfe, 0xa7, 0x0e, 0xbd,
+	0xbd, 0xd5, 0x36, 0x0f, 0x31, 0xe5, 0x64, 0x91, 0xba, 0x8f, 0x3e, 0xc7, 0xfe, 0x84, 0xe6, 0x70,
+	0x1c, 0xa7, 0xcc, 0xb4, 0x56, 0x14, 0x0f, 0xbf, 0x72, 0xe0, 0x3d, 0x44, 0x2e, 0xe9, 0xba, 0xa8,
+	0x5c, 0xee, 0x3b, 0x40, 0x39, 0xa5, 0xf2, 0x01, 0x51, 0xe3, 0x30, 0xd1, 0xd0, 0xa4, 0xec, 0xd1,
+	0x7c, 0x0f, 0x47, 0xf6, 0x27, 0xf1, 0x80, 0xa7, 0x79, 0x98, 0xe7, 0x85, 0xcd, 0xd9, 0x23, 0xba,
+	0x84, 0x13, 0x21, 0x13, 0x2a, 0x1f, 0x95, 0xd4, 0x3a, 0x4c, 0x75, 0xe4, 0xb3, 0xaa, 0x64, 0x6f,
+	0x23, 0x38, 0x13, 0x72, 0x35, 0x5d, 0x97, 0x39, 0x95, 0x29, 0x4d, 0x56, 0x54, 0x4e, 0xdd, 0x63,
+	0xe9, 0x7e, 0xce, 0xca, 0x90, 0xbd, 0x1d, 0x5e, 0xa9, 0xdc, 0x2d, 0xed, 0x0d, 0x89, 0x37, 0x64,
+	0x45, 0x7f, 0x99, 0xac, 0x98, 0x5e, 0x17, 0x8b, 0x69, 0x2c, 0xb2, 0xf3, 0x4a, 0xee, 0xb9, 0xcb,
+	0x3d, 0x77, 0xb9, 0xe6, 0x57, 0xbf, 0x68, 0x5b, 0xfb, 0xcd, 0xbf, 0x03, 0x00, 0x8a, 0xef, 0x94,
+	0x54, 0xfc, 0x07, 0x00, 0x00,
 }
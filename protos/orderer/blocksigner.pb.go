@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: orderer/blocksigner.proto
+
+package orderer // import "github.com/hyperledger/fabric/protos/orderer"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import common "github.com/hyperledger/fabric/protos/common"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// PartialSignRequest asks for a partial signature of the concatenation
+// of the signature header and of the block header
+type PartialSignRequest struct {
+	Channel              string              `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	SignatureHeader      []byte              `protobuf:"bytes,2,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	Header               *common.BlockHeader `protobuf:"bytes,3,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PartialSignRequest) Reset()         { *m = PartialSignRequest{} }
+func (m *PartialSignRequest) String() string { return proto.CompactTextString(m) }
+func (*PartialSignRequest) ProtoMessage()    {}
+func (*PartialSignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blocksigner_0a441c4b273b1eb0, []int{0}
+}
+func (m *PartialSignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialSignRequest.Unmarshal(m, b)
+}
+func (m *PartialSignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialSignRequest.Marshal(b, m, deterministic)
+}
+func (dst *PartialSignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSignRequest.Merge(dst, src)
+}
+func (m *PartialSignRequest) XXX_Size() int {
+	return xxx_messageInfo_PartialSignRequest.Size(m)
+}
+func (m *PartialSignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSignRequest proto.InternalMessageInfo
+
+func (m *PartialSignRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PartialSignRequest) GetSignatureHeader() []byte {
+	if m != nil {
+		return m.SignatureHeader
+	}
+	return nil
+}
+
+func (m *PartialSignRequest) GetHeader() *common.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+// PartialSignResponse holds the partial signature computed
+// with the key share of the given index
+type PartialSignResponse struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartialSignResponse) Reset()         { *m = PartialSignResponse{} }
+func (m *PartialSignResponse) String() string { return proto.CompactTextString(m) }
+func (*PartialSignResponse) ProtoMessage()    {}
+func (*PartialSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blocksigner_0a441c4b273b1eb0, []int{1}
+}
+func (m *PartialSignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialSignResponse.Unmarshal(m, b)
+}
+func (m *PartialSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialSignResponse.Marshal(b, m, deterministic)
+}
+func (dst *PartialSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSignResponse.Merge(dst, src)
+}
+func (m *PartialSignResponse) XXX_Size() int {
+	return xxx_messageInfo_PartialSignResponse.Size(m)
+}
+func (m *PartialSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSignResponse proto.InternalMessageInfo
+
+func (m *PartialSignResponse) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PartialSignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PartialSignRequest)(nil), "orderer.PartialSignRequest")
+	proto.RegisterType((*PartialSignResponse)(nil), "orderer.PartialSignResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BlockSigner service
+
+type BlockSignerClient interface {
+	// PartialSign returns a partial signature of a block produced by the node
+	PartialSign(ctx context.Context, in *PartialSignRequest, opts ...grpc.CallOption) (*PartialSignResponse, error)
+}
+
+type blockSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockSignerClient(cc *grpc.ClientConn) BlockSignerClient {
+	return &blockSignerClient{cc}
+}
+
+func (c *blockSignerClient) PartialSign(ctx context.Context, in *PartialSignRequest, opts ...grpc.CallOption) (*PartialSignResponse, error) {
+	out := new(PartialSignResponse)
+	err := grpc.Invoke(ctx, "/orderer.BlockSigner/PartialSign", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BlockSigner service
+
+type BlockSignerServer interface {
+	// PartialSign returns a partial signature of a block produced by the node
+	PartialSign(context.Context, *PartialSignRequest) (*PartialSignResponse, error)
+}
+
+func RegisterBlockSignerServer(s *grpc.Server, srv BlockSignerServer) {
+	s.RegisterService(&_BlockSigner_serviceDesc, srv)
+}
+
+func _BlockSigner_PartialSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartialSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServer).PartialSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderer.BlockSigner/PartialSign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServer).PartialSign(ctx, req.(*PartialSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "orderer.BlockSigner",
+	HandlerType: (*BlockSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PartialSign",
+			Handler:    _BlockSigner_PartialSign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orderer/blocksigner.proto",
+}
+
+func init() {
+	proto.RegisterFile("orderer/blocksigner.proto", fileDescriptor_blocksigner_0a441c4b273b1eb0)
+}
+
+var fileDescriptor_blocksigner_0a441c4b273b1eb0 = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x89, 0xd2, 0x96, 0x4e, 0x04, 0x65, 0xe3, 0x21, 0xd6, 0x1e, 0x42, 0x41, 0x88, 0x28,
+	0xbb, 0x50, 0xdf, 0xa0, 0xa7, 0x7a, 0x93, 0x14, 0x11, 0xbc, 0xc8, 0x26, 0x19, 0x93, 0xc5, 0x74,
+	0x37, 0x4e, 0x12, 0xd0, 0x17, 0xf0, 0xb9, 0x25, 0xd9, 0x6d, 0xad, 0xe8, 0x69, 0x99, 0x99, 0x6f,
+	0xe7, 0xff, 0xe7, 0x87, 0x0b, 0x43, 0x39, 0x12, 0x92, 0x48, 0x2b, 0x93, 0xbd, 0x35, 0xaa, 0xd0,
+	0x48, 0xbc, 0x26, 0xd3, 0x1a, 0x36, 0x71, 0xa3, 0x59, 0x90, 0x99, 0xed, 0xd6, 0x68, 0x61, 0x1f,
+	0x3b, 0x5d, 0x7c, 0x79, 0xc0, 0x1e, 0x24, 0xb5, 0x4a, 0x56, 0x1b, 0x55, 0xe8, 0x04, 0xdf, 0x3b,
+	0x6c, 0x5a, 0x16, 0xc2, 0x24, 0x2b, 0xa5, 0xd6, 0x58, 0x85, 0x5e, 0xe4, 0xc5, 0xd3, 0x64, 0x57,
+	0xb2, 0x6b, 0x38, 0xeb, 0xd7, 0xcb, 0xb6, 0x23, 0x7c, 0x29, 0x51, 0xe6, 0x48, 0xe1, 0x51, 0xe4,
+	0xc5, 0x27, 0xc9, 0xe9, 0xbe, 0xbf, 0x1e, 0xda, 0xec, 0x06, 0xc6, 0x0e, 0x38, 0x8e, 0xbc, 0xd8,
+	0x5f, 0x06, 0xdc, 0x49, 0xaf, 0x7a, 0x93, 0x16, 0x4a, 0x1c, 0xb2, 0xb8, 0x87, 0xe0, 0x97, 0x8f,
+	0xa6, 0x36, 0xba, 0x41, 0x76, 0x0e, 0x23, 0xa5, 0x73, 0xfc, 0x18, 0x6c, 0x8c, 0x12, 0x5b, 0xb0,
+	0x39, 0x4c, 0xf7, 0x62, 0x4e, 0xfd, 0xa7, 0xb1, 0x7c, 0x02, 0x7f, 0x50, 0xd8, 0x0c, 0x31, 0xb0,
+	0x35, 0xf8, 0x07, 0x9b, 0xd9, 0x25, 0x77, 0x81, 0xf0, 0xbf, 0x77, 0xcf, 0xe6, 0xff, 0x0f, 0xad,
+	0x99, 0xd5, 0x23, 0x5c, 0x19, 0x2a, 0x78, 0xf9, 0x59, 0x23, 0x55, 0x98, 0x17, 0x48, 0xfc, 0x55,
+	0xa6, 0xa4, 0x32, 0x1b, 0x66, 0xb3, 0xfb, 0xfc, 0x7c, 0x5b, 0xa8, 0xb6, 0xec, 0xd2, 0xfe, 0x5e,
+	0x71, 0x40, 0x0b, 0x4b, 0x0b, 0x4b, 0x0b, 0x47, 0xa7, 0xe3, 0xa1, 0xbe, 0xfb, 0x1e, 0x00, 0x5f,
+	0x5f, 0x5b, 0x35, 0xc5, 0x01, 0x00, 0x00,
+}
@@ -57,7 +57,7 @@ func (x ServerStatus_StatusCode) String() string {
 	return proto.EnumName(ServerStatus_StatusCode_name, int32(x))
 }
 func (ServerStatus_StatusCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_admin_6c8e47987088ed1f, []int{0, 0}
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{0, 0}
 }
 
 type ServerStatus struct {
@@ -71,7 +71,7 @@ func (m *ServerStatus) Reset()         { *m = ServerStatus{} }
 func (m *ServerStatus) String() string { return proto.CompactTextString(m) }
 func (*ServerStatus) ProtoMessage()    {}
 func (*ServerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_6c8e47987088ed1f, []int{0}
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{0}
 }
 func (m *ServerStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerStatus.Unmarshal(m, b)
@@ -110,7 +110,7 @@ func (m *LogLevelRequest) Reset()         { *m = LogLevelRequest{} }
 func (m *LogLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*LogLevelRequest) ProtoMessage()    {}
 func (*LogLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_6c8e47987088ed1f, []int{1}
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{1}
 }
 func (m *LogLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevelRequest.Unmarshal(m, b)
@@ -156,7 +156,7 @@ func (m *LogLevelResponse) Reset()         { *m = LogLevelResponse{} }
 func (m *LogLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*LogLevelResponse) ProtoMessage()    {}
 func (*LogLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_6c8e47987088ed1f, []int{2}
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{2}
 }
 func (m *LogLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevelResponse.Unmarshal(m, b)
@@ -190,9 +190,475 @@ func (m *LogLevelResponse) GetLogLevel() string {
 	return ""
 }
 
+// PvtDataReconciliationStatusRequest asks for the status of the reconciliation
+// of the missing private data of a channel
+type PvtDataReconciliationStatusRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PvtDataReconciliationStatusRequest) Reset()         { *m = PvtDataReconciliationStatusRequest{} }
+func (m *PvtDataReconciliationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*PvtDataReconciliationStatusRequest) ProtoMessage()    {}
+func (*PvtDataReconciliationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{3}
+}
+func (m *PvtDataReconciliationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PvtDataReconciliationStatusRequest.Unmarshal(m, b)
+}
+func (m *PvtDataReconciliationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PvtDataReconciliationStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *PvtDataReconciliationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PvtDataReconciliationStatusRequest.Merge(dst, src)
+}
+func (m *PvtDataReconciliationStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_PvtDataReconciliationStatusRequest.Size(m)
+}
+func (m *PvtDataReconciliationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PvtDataReconciliationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PvtDataReconciliationStatusRequest proto.InternalMessageInfo
+
+func (m *PvtDataReconciliationStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// PvtDataReconciliationStatus reports the progress of the reconciliation
+// of the missing private data of a channel
+type PvtDataReconciliationStatus struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// reconciliation_enabled indicates whether the peer pulls missing private data from other peers
+	ReconciliationEnabled bool `protobuf:"varint,2,opt,name=reconciliation_enabled,json=reconciliationEnabled" json:"reconciliation_enabled,omitempty"`
+	// missing is the number of private write sets the peer is eligible to, that are still missing
+	Missing uint64 `protobuf:"varint,3,opt,name=missing" json:"missing,omitempty"`
+	// ineligible is the number of missing private write sets of collections the peer isn't a member of
+	Ineligible uint64 `protobuf:"varint,4,opt,name=ineligible" json:"ineligible,omitempty"`
+	// reconciled is the number of private write sets reconciled since the peer started
+	Reconciled           uint64   `protobuf:"varint,5,opt,name=reconciled" json:"reconciled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PvtDataReconciliationStatus) Reset()         { *m = PvtDataReconciliationStatus{} }
+func (m *PvtDataReconciliationStatus) String() string { return proto.CompactTextString(m) }
+func (*PvtDataReconciliationStatus) ProtoMessage()    {}
+func (*PvtDataReconciliationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{4}
+}
+func (m *PvtDataReconciliationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PvtDataReconciliationStatus.Unmarshal(m, b)
+}
+func (m *PvtDataReconciliationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PvtDataReconciliationStatus.Marshal(b, m, deterministic)
+}
+func (dst *PvtDataReconciliationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PvtDataReconciliationStatus.Merge(dst, src)
+}
+func (m *PvtDataReconciliationStatus) XXX_Size() int {
+	return xxx_messageInfo_PvtDataReconciliationStatus.Size(m)
+}
+func (m *PvtDataReconciliationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PvtDataReconciliationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PvtDataReconciliationStatus proto.InternalMessageInfo
+
+func (m *PvtDataReconciliationStatus) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PvtDataReconciliationStatus) GetReconciliationEnabled() bool {
+	if m != nil {
+		return m.ReconciliationEnabled
+	}
+	return false
+}
+
+func (m *PvtDataReconciliationStatus) GetMissing() uint64 {
+	if m != nil {
+		return m.Missing
+	}
+	return 0
+}
+
+func (m *PvtDataReconciliationStatus) GetIneligible() uint64 {
+	if m != nil {
+		return m.Ineligible
+	}
+	return 0
+}
+
+func (m *PvtDataReconciliationStatus) GetReconciled() uint64 {
+	if m != nil {
+		return m.Reconciled
+	}
+	return 0
+}
+
+// GossipStatusRequest asks for the gossip membership a peer sees,
+// and the state of the channels it has joined
+type GossipStatusRequest struct {
+	// channel_id restricts the reported channels to the given channel, if not empty
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipStatusRequest) Reset()         { *m = GossipStatusRequest{} }
+func (m *GossipStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GossipStatusRequest) ProtoMessage()    {}
+func (*GossipStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{5}
+}
+func (m *GossipStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipStatusRequest.Unmarshal(m, b)
+}
+func (m *GossipStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *GossipStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipStatusRequest.Merge(dst, src)
+}
+func (m *GossipStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GossipStatusRequest.Size(m)
+}
+func (m *GossipStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipStatusRequest proto.InternalMessageInfo
+
+func (m *GossipStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// GossipStatus reports the gossip membership a peer sees,
+// and the state of the channels it has joined
+type GossipStatus struct {
+	Self *GossipMember `protobuf:"bytes,1,opt,name=self" json:"self,omitempty"`
+	// alive are the peers the peer considers alive
+	Alive []*GossipMember `protobuf:"bytes,2,rep,name=alive" json:"alive,omitempty"`
+	// dead are the peers the peer considers dead
+	Dead                 []*GossipMember        `protobuf:"bytes,3,rep,name=dead" json:"dead,omitempty"`
+	Channels             []*GossipChannelStatus `protobuf:"bytes,4,rep,name=channels" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GossipStatus) Reset()         { *m = GossipStatus{} }
+func (m *GossipStatus) String() string { return proto.CompactTextString(m) }
+func (*GossipStatus) ProtoMessage()    {}
+func (*GossipStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{6}
+}
+func (m *GossipStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipStatus.Unmarshal(m, b)
+}
+func (m *GossipStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipStatus.Marshal(b, m, deterministic)
+}
+func (dst *GossipStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipStatus.Merge(dst, src)
+}
+func (m *GossipStatus) XXX_Size() int {
+	return xxx_messageInfo_GossipStatus.Size(m)
+}
+func (m *GossipStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipStatus proto.InternalMessageInfo
+
+func (m *GossipStatus) GetSelf() *GossipMember {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+func (m *GossipStatus) GetAlive() []*GossipMember {
+	if m != nil {
+		return m.Alive
+	}
+	return nil
+}
+
+func (m *GossipStatus) GetDead() []*GossipMember {
+	if m != nil {
+		return m.Dead
+	}
+	return nil
+}
+
+func (m *GossipStatus) GetChannels() []*GossipChannelStatus {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// GossipMember describes a peer in the gossip membership
+type GossipMember struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint" json:"endpoint,omitempty"`
+	InternalEndpoint     string   `protobuf:"bytes,2,opt,name=internal_endpoint,json=internalEndpoint" json:"internal_endpoint,omitempty"`
+	PkiId                []byte   `protobuf:"bytes,3,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipMember) Reset()         { *m = GossipMember{} }
+func (m *GossipMember) String() string { return proto.CompactTextString(m) }
+func (*GossipMember) ProtoMessage()    {}
+func (*GossipMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{7}
+}
+func (m *GossipMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipMember.Unmarshal(m, b)
+}
+func (m *GossipMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipMember.Marshal(b, m, deterministic)
+}
+func (dst *GossipMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipMember.Merge(dst, src)
+}
+func (m *GossipMember) XXX_Size() int {
+	return xxx_messageInfo_GossipMember.Size(m)
+}
+func (m *GossipMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipMember proto.InternalMessageInfo
+
+func (m *GossipMember) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GossipMember) GetInternalEndpoint() string {
+	if m != nil {
+		return m.InternalEndpoint
+	}
+	return ""
+}
+
+func (m *GossipMember) GetPkiId() []byte {
+	if m != nil {
+		return m.PkiId
+	}
+	return nil
+}
+
+// GossipChannelStatus reports the state of a channel, as advertised
+// by the peers of the channel in their StateInfo messages
+type GossipChannelStatus struct {
+	ChannelId string               `protobuf:"bytes,1,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	Self      *GossipChannelMember `protobuf:"bytes,2,opt,name=self" json:"self,omitempty"`
+	// peers are the alive peers of the channel other than the peer itself
+	Peers []*GossipChannelMember `protobuf:"bytes,3,rep,name=peers" json:"peers,omitempty"`
+	// leader_count is the number of leaders the leader election aims for
+	LeaderCount          uint32   `protobuf:"varint,4,opt,name=leader_count,json=leaderCount" json:"leader_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipChannelStatus) Reset()         { *m = GossipChannelStatus{} }
+func (m *GossipChannelStatus) String() string { return proto.CompactTextString(m) }
+func (*GossipChannelStatus) ProtoMessage()    {}
+func (*GossipChannelStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{8}
+}
+func (m *GossipChannelStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipChannelStatus.Unmarshal(m, b)
+}
+func (m *GossipChannelStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipChannelStatus.Marshal(b, m, deterministic)
+}
+func (dst *GossipChannelStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipChannelStatus.Merge(dst, src)
+}
+func (m *GossipChannelStatus) XXX_Size() int {
+	return xxx_messageInfo_GossipChannelStatus.Size(m)
+}
+func (m *GossipChannelStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipChannelStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipChannelStatus proto.InternalMessageInfo
+
+func (m *GossipChannelStatus) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *GossipChannelStatus) GetSelf() *GossipChannelMember {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+func (m *GossipChannelStatus) GetPeers() []*GossipChannelMember {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *GossipChannelStatus) GetLeaderCount() uint32 {
+	if m != nil {
+		return m.LeaderCount
+	}
+	return 0
+}
+
+// GossipChannelMember describes a peer of a channel
+type GossipChannelMember struct {
+	Endpoint     string             `protobuf:"bytes,1,opt,name=endpoint" json:"endpoint,omitempty"`
+	PkiId        []byte             `protobuf:"bytes,2,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	LedgerHeight uint64             `protobuf:"varint,3,opt,name=ledger_height,json=ledgerHeight" json:"ledger_height,omitempty"`
+	Chaincodes   []*GossipChaincode `protobuf:"bytes,4,rep,name=chaincodes" json:"chaincodes,omitempty"`
+	// leader indicates whether the peer is known to be a leader
+	// that pulls blocks of the channel from the ordering service
+	Leader               bool     `protobuf:"varint,5,opt,name=leader" json:"leader,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipChannelMember) Reset()         { *m = GossipChannelMember{} }
+func (m *GossipChannelMember) String() string { return proto.CompactTextString(m) }
+func (*GossipChannelMember) ProtoMessage()    {}
+func (*GossipChannelMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{9}
+}
+func (m *GossipChannelMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipChannelMember.Unmarshal(m, b)
+}
+func (m *GossipChannelMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipChannelMember.Marshal(b, m, deterministic)
+}
+func (dst *GossipChannelMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipChannelMember.Merge(dst, src)
+}
+func (m *GossipChannelMember) XXX_Size() int {
+	return xxx_messageInfo_GossipChannelMember.Size(m)
+}
+func (m *GossipChannelMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipChannelMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipChannelMember proto.InternalMessageInfo
+
+func (m *GossipChannelMember) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GossipChannelMember) GetPkiId() []byte {
+	if m != nil {
+		return m.PkiId
+	}
+	return nil
+}
+
+func (m *GossipChannelMember) GetLedgerHeight() uint64 {
+	if m != nil {
+		return m.LedgerHeight
+	}
+	return 0
+}
+
+func (m *GossipChannelMember) GetChaincodes() []*GossipChaincode {
+	if m != nil {
+		return m.Chaincodes
+	}
+	return nil
+}
+
+func (m *GossipChannelMember) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+// GossipChaincode describes a chaincode installed on a peer
+type GossipChaincode struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipChaincode) Reset()         { *m = GossipChaincode{} }
+func (m *GossipChaincode) String() string { return proto.CompactTextString(m) }
+func (*GossipChaincode) ProtoMessage()    {}
+func (*GossipChaincode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{10}
+}
+func (m *GossipChaincode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipChaincode.Unmarshal(m, b)
+}
+func (m *GossipChaincode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipChaincode.Marshal(b, m, deterministic)
+}
+func (dst *GossipChaincode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipChaincode.Merge(dst, src)
+}
+func (m *GossipChaincode) XXX_Size() int {
+	return xxx_messageInfo_GossipChaincode.Size(m)
+}
+func (m *GossipChaincode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipChaincode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipChaincode proto.InternalMessageInfo
+
+func (m *GossipChaincode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GossipChaincode) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 type AdminOperation struct {
 	// Types that are valid to be assigned to Content:
 	//	*AdminOperation_LogReq
+	//	*AdminOperation_ReconciliationStatusReq
+	//	*AdminOperation_GossipStatusReq
 	Content              isAdminOperation_Content `protobuf_oneof:"content"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -203,7 +669,7 @@ func (m *AdminOperation) Reset()         { *m = AdminOperation{} }
 func (m *AdminOperation) String() string { return proto.CompactTextString(m) }
 func (*AdminOperation) ProtoMessage()    {}
 func (*AdminOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_6c8e47987088ed1f, []int{3}
+	return fileDescriptor_admin_0a3c0aa282d80189, []int{11}
 }
 func (m *AdminOperation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AdminOperation.Unmarshal(m, b)
@@ -230,8 +696,16 @@ type isAdminOperation_Content interface {
 type AdminOperation_LogReq struct {
 	LogReq *LogLevelRequest `protobuf:"bytes,1,opt,name=logReq,oneof"`
 }
+type AdminOperation_ReconciliationStatusReq struct {
+	ReconciliationStatusReq *PvtDataReconciliationStatusRequest `protobuf:"bytes,2,opt,name=reconciliationStatusReq,oneof"`
+}
+type AdminOperation_GossipStatusReq struct {
+	GossipStatusReq *GossipStatusRequest `protobuf:"bytes,3,opt,name=gossipStatusReq,oneof"`
+}
 
-func (*AdminOperation_LogReq) isAdminOperation_Content() {}
+func (*AdminOperation_LogReq) isAdminOperation_Content()                  {}
+func (*AdminOperation_ReconciliationStatusReq) isAdminOperation_Content() {}
+func (*AdminOperation_GossipStatusReq) isAdminOperation_Content()         {}
 
 func (m *AdminOperation) GetContent() isAdminOperation_Content {
 	if m != nil {
@@ -247,10 +721,26 @@ func (m *AdminOperation) GetLogReq() *LogLevelRequest {
 	return nil
 }
 
+func (m *AdminOperation) GetReconciliationStatusReq() *PvtDataReconciliationStatusRequest {
+	if x, ok := m.GetContent().(*AdminOperation_ReconciliationStatusReq); ok {
+		return x.ReconciliationStatusReq
+	}
+	return nil
+}
+
+func (m *AdminOperation) GetGossipStatusReq() *GossipStatusRequest {
+	if x, ok := m.GetContent().(*AdminOperation_GossipStatusReq); ok {
+		return x.GossipStatusReq
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*AdminOperation) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _AdminOperation_OneofMarshaler, _AdminOperation_OneofUnmarshaler, _AdminOperation_OneofSizer, []interface{}{
 		(*AdminOperation_LogReq)(nil),
+		(*AdminOperation_ReconciliationStatusReq)(nil),
+		(*AdminOperation_GossipStatusReq)(nil),
 	}
 }
 
@@ -263,6 +753,16 @@ func _AdminOperation_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LogReq); err != nil {
 			return err
 		}
+	case *AdminOperation_ReconciliationStatusReq:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ReconciliationStatusReq); err != nil {
+			return err
+		}
+	case *AdminOperation_GossipStatusReq:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GossipStatusReq); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("AdminOperation.Content has unexpected type %T", x)
@@ -281,6 +781,22 @@ func _AdminOperation_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Content = &AdminOperation_LogReq{msg}
 		return true, err
+	case 2: // content.reconciliationStatusReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PvtDataReconciliationStatusRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_ReconciliationStatusReq{msg}
+		return true, err
+	case 3: // content.gossipStatusReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GossipStatusRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_GossipStatusReq{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -295,6 +811,16 @@ func _AdminOperation_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *AdminOperation_ReconciliationStatusReq:
+		s := proto.Size(x.ReconciliationStatusReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AdminOperation_GossipStatusReq:
+		s := proto.Size(x.GossipStatusReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -306,6 +832,14 @@ func init() {
 	proto.RegisterType((*ServerStatus)(nil), "protos.ServerStatus")
 	proto.RegisterType((*LogLevelRequest)(nil), "protos.LogLevelRequest")
 	proto.RegisterType((*LogLevelResponse)(nil), "protos.LogLevelResponse")
+	proto.RegisterType((*PvtDataReconciliationStatusRequest)(nil), "protos.PvtDataReconciliationStatusRequest")
+	proto.RegisterType((*PvtDataReconciliationStatus)(nil), "protos.PvtDataReconciliationStatus")
+	proto.RegisterType((*GossipStatusRequest)(nil), "protos.GossipStatusRequest")
+	proto.RegisterType((*GossipStatus)(nil), "protos.GossipStatus")
+	proto.RegisterType((*GossipMember)(nil), "protos.GossipMember")
+	proto.RegisterType((*GossipChannelStatus)(nil), "protos.GossipChannelStatus")
+	proto.RegisterType((*GossipChannelMember)(nil), "protos.GossipChannelMember")
+	proto.RegisterType((*GossipChaincode)(nil), "protos.GossipChaincode")
 	proto.RegisterType((*AdminOperation)(nil), "protos.AdminOperation")
 	proto.RegisterEnum("protos.ServerStatus_StatusCode", ServerStatus_StatusCode_name, ServerStatus_StatusCode_value)
 }
@@ -326,6 +860,9 @@ type AdminClient interface {
 	GetModuleLogLevel(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogLevelResponse, error)
 	SetModuleLogLevel(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogLevelResponse, error)
 	RevertLogLevels(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetPvtDataReconciliationStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataReconciliationStatus, error)
+	GetGossipStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*GossipStatus, error)
+	ReloadIdentities(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type adminClient struct {
@@ -381,6 +918,33 @@ func (c *adminClient) RevertLogLevels(ctx context.Context, in *common.Envelope,
 	return out, nil
 }
 
+func (c *adminClient) GetPvtDataReconciliationStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataReconciliationStatus, error) {
+	out := new(PvtDataReconciliationStatus)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetPvtDataReconciliationStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetGossipStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*GossipStatus, error) {
+	out := new(GossipStatus)
+	err := grpc.Invoke(ctx, "/protos.Admin/GetGossipStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReloadIdentities(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := grpc.Invoke(ctx, "/protos.Admin/ReloadIdentities", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Admin service
 
 type AdminServer interface {
@@ -389,6 +953,9 @@ type AdminServer interface {
 	GetModuleLogLevel(context.Context, *common.Envelope) (*LogLevelResponse, error)
 	SetModuleLogLevel(context.Context, *common.Envelope) (*LogLevelResponse, error)
 	RevertLogLevels(context.Context, *common.Envelope) (*empty.Empty, error)
+	GetPvtDataReconciliationStatus(context.Context, *common.Envelope) (*PvtDataReconciliationStatus, error)
+	GetGossipStatus(context.Context, *common.Envelope) (*GossipStatus, error)
+	ReloadIdentities(context.Context, *common.Envelope) (*empty.Empty, error)
 }
 
 func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
@@ -485,6 +1052,60 @@ func _Admin_RevertLogLevels_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetPvtDataReconciliationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetPvtDataReconciliationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetPvtDataReconciliationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetPvtDataReconciliationStatus(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetGossipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetGossipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetGossipStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetGossipStatus(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReloadIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReloadIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/ReloadIdentities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReloadIdentities(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -509,43 +1130,84 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevertLogLevels",
 			Handler:    _Admin_RevertLogLevels_Handler,
 		},
+		{
+			MethodName: "GetPvtDataReconciliationStatus",
+			Handler:    _Admin_GetPvtDataReconciliationStatus_Handler,
+		},
+		{
+			MethodName: "GetGossipStatus",
+			Handler:    _Admin_GetGossipStatus_Handler,
+		},
+		{
+			MethodName: "ReloadIdentities",
+			Handler:    _Admin_ReloadIdentities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/admin.proto",
 }
 
-func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor_admin_6c8e47987088ed1f) }
-
-var fileDescriptor_admin_6c8e47987088ed1f = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0x0b, 0xed, 0xc8, 0xe9, 0xd8, 0x82, 0x41, 0x50, 0x75, 0x42, 0xa0, 0x5c, 0xc1, 0x8d,
-	0x23, 0x8a, 0xd0, 0xae, 0xb8, 0x68, 0x49, 0x18, 0x88, 0x2d, 0xad, 0x9c, 0x55, 0x08, 0x24, 0x34,
-	0xa5, 0xed, 0x99, 0x57, 0xe1, 0xc4, 0x99, 0xe3, 0x54, 0xda, 0xeb, 0xf0, 0x1c, 0x3c, 0x1c, 0x8a,
-	0x9d, 0x68, 0x13, 0xf4, 0x06, 0xf5, 0xea, 0xf8, 0x1c, 0x7f, 0xdf, 0x67, 0x9f, 0x3f, 0x70, 0x73,
-	0x44, 0xe5, 0x27, 0xab, 0x74, 0x9d, 0xd1, 0x5c, 0x49, 0x2d, 0x49, 0xcf, 0x98, 0x62, 0x78, 0xc4,
-	0xa5, 0xe4, 0x02, 0x7d, 0xe3, 0x2e, 0xca, 0x4b, 0x1f, 0xd3, 0x5c, 0xdf, 0x58, 0xd0, 0xf0, 0xf1,
-	0x52, 0xa6, 0xa9, 0xcc, 0x7c, 0x6b, 0x6c, 0xd0, 0xfb, 0xd5, 0x86, 0xfd, 0x18, 0xd5, 0x06, 0x55,
-	0xac, 0x13, 0x5d, 0x16, 0xe4, 0x18, 0x7a, 0x85, 0x39, 0x0d, 0xda, 0x2f, 0xdb, 0xaf, 0x0e, 0x46,
-	0x2f, 0x2c, 0xb0, 0xa0, 0x77, 0x51, 0xd4, 0x9a, 0x0f, 0x72, 0x85, 0xac, 0x86, 0x7b, 0xdf, 0x00,
-	0x6e, 0xa3, 0xe4, 0x21, 0x38, 0xf3, 0x28, 0x08, 0x3f, 0x7e, 0x8e, 0xc2, 0xc0, 0x6d, 0x91, 0x3e,
-	0xec, 0xc5, 0xe7, 0x63, 0x76, 0x1e, 0x06, 0x6e, 0xdb, 0x3a, 0xd3, 0xd9, 0x2c, 0x0c, 0xdc, 0x0e,
-	0x01, 0xe8, 0xcd, 0xc6, 0xf3, 0x38, 0x0c, 0xdc, 0x7b, 0xc4, 0x81, 0x6e, 0xc8, 0xd8, 0x94, 0xb9,
-	0xf7, 0x2b, 0xcc, 0x3c, 0xfa, 0x12, 0x4d, 0xbf, 0x46, 0x6e, 0xd7, 0x3b, 0x83, 0xc3, 0x53, 0xc9,
-	0x4f, 0x71, 0x83, 0x82, 0xe1, 0x75, 0x89, 0x85, 0x26, 0xcf, 0x01, 0x84, 0xe4, 0x17, 0xa9, 0x5c,
-	0x95, 0x02, 0xcd, 0x57, 0x1d, 0xe6, 0x08, 0xc9, 0xcf, 0x4c, 0x80, 0x1c, 0x41, 0xe5, 0x5c, 0x88,
-	0x8a, 0x32, 0xe8, 0x98, 0xdb, 0x07, 0xa2, 0x96, 0xf0, 0x22, 0x70, 0x6f, 0xe5, 0x8a, 0x5c, 0x66,
-	0x05, 0xee, 0xa8, 0x77, 0x30, 0xae, 0x9a, 0x31, 0xcd, 0x51, 0x25, 0x7a, 0x2d, 0x33, 0xf2, 0x06,
-	0x7a, 0x42, 0x72, 0x86, 0xd7, 0x46, 0xa9, 0x3f, 0x7a, 0xd6, 0x14, 0xf1, 0xaf, 0x34, 0x3e, 0xb5,
-	0x58, 0x0d, 0x9c, 0x38, 0xb0, 0xb7, 0x94, 0x99, 0xc6, 0x4c, 0x8f, 0x7e, 0x77, 0xa0, 0x6b, 0x04,
-	0xc9, 0x3b, 0x70, 0x4e, 0x50, 0xd7, 0x9d, 0x71, 0x69, 0xdd, 0xb9, 0x30, 0xdb, 0xa0, 0x90, 0x39,
-	0x0e, 0x9f, 0x6c, 0xeb, 0x8d, 0xd7, 0x22, 0xc7, 0xd0, 0x8f, 0x75, 0xa2, 0xb4, 0x0d, 0xff, 0x07,
-	0x71, 0x0c, 0x8f, 0x4e, 0x50, 0xdb, 0x9c, 0x9b, 0xaf, 0x6e, 0xa1, 0x0f, 0xfe, 0x4d, 0xc7, 0x96,
-	0xd1, 0x4a, 0xc4, 0x3b, 0x4a, 0xbc, 0x87, 0x43, 0x86, 0x1b, 0x54, 0xba, 0xb9, 0xdb, 0x96, 0xfb,
-	0x53, 0x6a, 0x67, 0x9d, 0x36, 0xb3, 0x4e, 0xc3, 0x6a, 0xd6, 0xbd, 0xd6, 0xe4, 0x07, 0x78, 0x52,
-	0x71, 0x7a, 0x75, 0x93, 0xa3, 0x12, 0xb8, 0xe2, 0xa8, 0xe8, 0x65, 0xb2, 0x50, 0xeb, 0x65, 0xf3,
-	0x64, 0xb5, 0x3e, 0x93, 0x7d, 0x53, 0xe1, 0x59, 0xb2, 0xfc, 0x99, 0x70, 0xfc, 0xfe, 0x9a, 0xaf,
-	0xf5, 0x55, 0xb9, 0xa8, 0x5e, 0xf1, 0xef, 0x10, 0x7d, 0x4b, 0xb4, 0xfb, 0x54, 0xf8, 0x15, 0x71,
-	0x61, 0x77, 0xed, 0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x20, 0x26, 0x0b, 0x86, 0x03,
-	0x00, 0x00,
+func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor_admin_0a3c0aa282d80189) }
+
+var fileDescriptor_admin_0a3c0aa282d80189 = []byte{
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+	0x10, 0xb6, 0x12, 0xdb, 0x8d, 0x2f, 0x4e, 0xad, 0x32, 0x6d, 0x2a, 0x24, 0x58, 0x97, 0xa9, 0x2f,
+	0x59, 0x07, 0xd8, 0x68, 0xb6, 0x22, 0x0f, 0xc3, 0x36, 0x24, 0xb1, 0xe6, 0x04, 0x6b, 0x1c, 0x83,
+	0x6e, 0x30, 0x74, 0xc0, 0x60, 0xc8, 0xd6, 0x45, 0x26, 0x42, 0x93, 0xaa, 0x44, 0x1b, 0xe8, 0xff,
+	0xd8, 0x2f, 0xd8, 0xdf, 0x18, 0xb0, 0xd7, 0x3d, 0xed, 0x37, 0x6d, 0x10, 0x29, 0x25, 0xb6, 0xe3,
+	0x38, 0x0d, 0xfa, 0x24, 0xf1, 0xee, 0xbb, 0x4f, 0xc7, 0x8f, 0xc7, 0x3b, 0x81, 0x1d, 0x21, 0xc6,
+	0x0d, 0x3f, 0x18, 0x31, 0x51, 0x8f, 0x62, 0xa9, 0x24, 0x29, 0xeb, 0x47, 0xb2, 0xbd, 0x13, 0x4a,
+	0x19, 0x72, 0x6c, 0xe8, 0x65, 0x7f, 0x7c, 0xd9, 0xc0, 0x51, 0xa4, 0x3e, 0x1a, 0xd0, 0xf6, 0xe6,
+	0x40, 0x8e, 0x46, 0x52, 0x34, 0xcc, 0xc3, 0x18, 0xdd, 0x3f, 0x2d, 0xa8, 0x76, 0x31, 0x9e, 0x60,
+	0xdc, 0x55, 0xbe, 0x1a, 0x27, 0xe4, 0x00, 0xca, 0x89, 0x7e, 0x73, 0xac, 0x5d, 0x6b, 0xef, 0xf1,
+	0xfe, 0x97, 0x06, 0x98, 0xd4, 0xa7, 0x51, 0x75, 0xf3, 0x38, 0x96, 0x01, 0xd2, 0x0c, 0xee, 0xbe,
+	0x07, 0xb8, 0xb1, 0x92, 0x0d, 0xa8, 0x5c, 0xb4, 0x9b, 0xde, 0xcf, 0xa7, 0x6d, 0xaf, 0x69, 0x17,
+	0xc8, 0x3a, 0x3c, 0xea, 0xbe, 0x3b, 0xa4, 0xef, 0xbc, 0xa6, 0x6d, 0x99, 0xc5, 0x79, 0xa7, 0xe3,
+	0x35, 0xed, 0x15, 0x02, 0x50, 0xee, 0x1c, 0x5e, 0x74, 0xbd, 0xa6, 0xbd, 0x4a, 0x2a, 0x50, 0xf2,
+	0x28, 0x3d, 0xa7, 0x76, 0x31, 0xc5, 0x5c, 0xb4, 0x7f, 0x69, 0x9f, 0xff, 0xda, 0xb6, 0x4b, 0xee,
+	0x19, 0xd4, 0xde, 0xca, 0xf0, 0x2d, 0x4e, 0x90, 0x53, 0xfc, 0x30, 0xc6, 0x44, 0x91, 0x2f, 0x00,
+	0xb8, 0x0c, 0x7b, 0x23, 0x19, 0x8c, 0x39, 0xea, 0x54, 0x2b, 0xb4, 0xc2, 0x65, 0x78, 0xa6, 0x0d,
+	0x64, 0x07, 0xd2, 0x45, 0x8f, 0xa7, 0x21, 0xce, 0x8a, 0xf6, 0xae, 0xf1, 0x8c, 0xc2, 0x6d, 0x83,
+	0x7d, 0x43, 0x97, 0x44, 0x52, 0x24, 0xf8, 0x59, 0x7c, 0xc7, 0xe0, 0x76, 0x26, 0xaa, 0xe9, 0x2b,
+	0x9f, 0xe2, 0x40, 0x8a, 0x01, 0xe3, 0xcc, 0x57, 0x4c, 0x0a, 0x23, 0xc7, 0x54, 0xc6, 0x83, 0xa1,
+	0x2f, 0x04, 0xf2, 0x1e, 0x0b, 0xf2, 0x2f, 0x64, 0x96, 0xd3, 0xc0, 0xfd, 0xd7, 0x82, 0x9d, 0x25,
+	0x2c, 0xf7, 0x84, 0x93, 0x37, 0xb0, 0x15, 0xcf, 0x84, 0xf5, 0x50, 0xf8, 0x7d, 0x8e, 0x81, 0xce,
+	0x76, 0x8d, 0x3e, 0x9b, 0xf5, 0x7a, 0xc6, 0x49, 0x1c, 0x78, 0x34, 0x62, 0x49, 0xc2, 0x44, 0xe8,
+	0xac, 0xee, 0x5a, 0x7b, 0x45, 0x9a, 0x2f, 0xc9, 0x0b, 0x00, 0x26, 0x90, 0xb3, 0x90, 0xf5, 0x39,
+	0x3a, 0x45, 0xed, 0x9c, 0xb2, 0xa4, 0xfe, 0x9c, 0x12, 0x03, 0xa7, 0x64, 0xfc, 0x37, 0x16, 0xf7,
+	0x3b, 0xd8, 0x6c, 0xc9, 0x24, 0x61, 0xd1, 0x83, 0x54, 0xf8, 0xc7, 0x82, 0xea, 0x74, 0x18, 0xd9,
+	0x83, 0x62, 0x82, 0xfc, 0x52, 0x23, 0xd7, 0xf7, 0x9f, 0xe6, 0xc5, 0x68, 0x30, 0x67, 0x38, 0xea,
+	0x63, 0x4c, 0x35, 0x82, 0xbc, 0x82, 0x92, 0xcf, 0xd9, 0x04, 0x9d, 0x95, 0xdd, 0xd5, 0x3b, 0xa1,
+	0x06, 0x92, 0xb2, 0x06, 0xe8, 0x07, 0xce, 0xea, 0x12, 0xa8, 0x46, 0x90, 0x03, 0x58, 0xcb, 0xb2,
+	0x4b, 0x9c, 0xa2, 0x46, 0xef, 0xcc, 0xa2, 0x8f, 0x8d, 0x37, 0xdb, 0xe5, 0x35, 0xd8, 0x15, 0x50,
+	0x9d, 0xa6, 0x23, 0xdb, 0xb0, 0x86, 0x22, 0x88, 0x24, 0x13, 0x2a, 0xdb, 0xf6, 0xf5, 0x9a, 0x7c,
+	0x03, 0x4f, 0x98, 0x50, 0x18, 0x0b, 0x9f, 0xf7, 0xae, 0x41, 0xa6, 0xca, 0xec, 0xdc, 0xe1, 0xe5,
+	0xe0, 0x67, 0x50, 0x8e, 0xae, 0x58, 0xaa, 0x5e, 0x7a, 0x62, 0x55, 0x5a, 0x8a, 0xae, 0xd8, 0x69,
+	0xe0, 0xfe, 0x65, 0xc1, 0xe6, 0x82, 0x8c, 0xee, 0xab, 0x9b, 0x46, 0xa6, 0xef, 0xca, 0xae, 0x75,
+	0xe7, 0xde, 0x66, 0x64, 0x7e, 0x0d, 0xa5, 0xb4, 0xfd, 0x24, 0x99, 0x76, 0x4b, 0x23, 0x0c, 0x92,
+	0x7c, 0x05, 0x55, 0x8e, 0x7e, 0x80, 0x71, 0x6f, 0x20, 0xc7, 0x42, 0xe9, 0x62, 0xda, 0xa0, 0xeb,
+	0xc6, 0x76, 0x9c, 0x9a, 0xdc, 0xbf, 0xe7, 0xb3, 0xff, 0x04, 0xd5, 0x6e, 0x84, 0x58, 0x99, 0x12,
+	0x82, 0xbc, 0x84, 0x0d, 0x8e, 0x41, 0x88, 0x71, 0x6f, 0x88, 0x2c, 0x1c, 0xaa, 0xac, 0xb0, 0xab,
+	0xc6, 0x78, 0xa2, 0x6d, 0xe4, 0x40, 0xab, 0xc2, 0xc4, 0x40, 0x06, 0x98, 0x1f, 0xec, 0xf3, 0x5b,
+	0x5b, 0x31, 0x7e, 0x3a, 0x05, 0x25, 0x5b, 0x50, 0x36, 0x79, 0xeb, 0x92, 0x5f, 0xa3, 0xd9, 0xca,
+	0xfd, 0x09, 0x6a, 0x73, 0x61, 0x84, 0x40, 0x51, 0xf8, 0xa3, 0xbc, 0x99, 0xe8, 0xf7, 0xf4, 0xbe,
+	0x4d, 0x30, 0x4e, 0x98, 0x14, 0xd9, 0xf9, 0xe6, 0x4b, 0xf7, 0x3f, 0x0b, 0x1e, 0x1f, 0xa6, 0x2d,
+	0xfd, 0x3c, 0xc2, 0x58, 0xdf, 0x51, 0xf2, 0x1a, 0xca, 0x5c, 0x86, 0x14, 0x3f, 0x64, 0xd5, 0x7f,
+	0x9d, 0xe0, 0x5c, 0x33, 0x3c, 0x29, 0xd0, 0x0c, 0x48, 0x2e, 0xe1, 0x79, 0xbc, 0xb8, 0x07, 0x65,
+	0x27, 0xfc, 0x2a, 0xe7, 0xb8, 0xbf, 0x63, 0x9d, 0x14, 0xe8, 0x5d, 0x64, 0xa4, 0x05, 0xb5, 0x70,
+	0xf6, 0x76, 0x6b, 0x99, 0x6f, 0xd5, 0xc3, 0x3c, 0xe1, 0x7c, 0xd4, 0x51, 0x05, 0x1e, 0x0d, 0xa4,
+	0x50, 0x28, 0xd4, 0xfe, 0x1f, 0x45, 0x28, 0x69, 0x05, 0xc8, 0x1b, 0xa8, 0xb4, 0x50, 0x65, 0x05,
+	0x6c, 0xd7, 0xb3, 0x81, 0xe5, 0x89, 0x09, 0x72, 0x19, 0xe1, 0xf6, 0xd3, 0x45, 0x23, 0xc9, 0x2d,
+	0x90, 0x03, 0x58, 0xef, 0x2a, 0x3f, 0x56, 0xc6, 0xfc, 0x80, 0xc0, 0x43, 0x78, 0xd2, 0x42, 0x65,
+	0x5a, 0x7d, 0xae, 0xed, 0x82, 0x70, 0xe7, 0xb6, 0xfe, 0x66, 0x7a, 0x18, 0x8a, 0xee, 0x67, 0x52,
+	0xfc, 0x00, 0x35, 0x8a, 0x13, 0x8c, 0x55, 0xee, 0x5b, 0xb4, 0xf7, 0xad, 0xba, 0x19, 0xf1, 0xf5,
+	0x7c, 0xc4, 0xd7, 0xbd, 0x74, 0xc4, 0xbb, 0x05, 0xf2, 0x1e, 0x5e, 0xb4, 0x50, 0x2d, 0x1b, 0x21,
+	0xb7, 0xd9, 0x5e, 0x7e, 0x42, 0x35, 0xb8, 0x05, 0xf2, 0x3d, 0xd4, 0x5a, 0xa8, 0x66, 0xfa, 0xf2,
+	0x12, 0x71, 0xa7, 0x71, 0x6e, 0x81, 0xfc, 0x08, 0x36, 0x45, 0x2e, 0xfd, 0xe0, 0x34, 0x40, 0xa1,
+	0x98, 0x62, 0xf8, 0xa0, 0x7d, 0x1d, 0xfd, 0x0e, 0xae, 0x8c, 0xc3, 0xfa, 0xf0, 0x63, 0x84, 0xb1,
+	0xb9, 0xc3, 0xf5, 0x4b, 0xbf, 0x1f, 0xb3, 0x41, 0xfe, 0xbd, 0xb4, 0xc9, 0x1c, 0x55, 0x75, 0xe5,
+	0x74, 0xfc, 0xc1, 0x95, 0x1f, 0xe2, 0x6f, 0x5f, 0x87, 0x4c, 0x0d, 0xc7, 0xfd, 0xf4, 0x2b, 0x8d,
+	0xa9, 0xc0, 0x86, 0x09, 0x34, 0xbf, 0x47, 0x49, 0x23, 0x0d, 0xec, 0x9b, 0x5f, 0xa7, 0x6f, 0xff,
+	0x1f, 0x00, 0x71, 0xf6, 0xf6, 0xc9, 0x55, 0x09, 0x00, 0x00,
 }
@@ -48,6 +48,7 @@ const (
 	ChaincodeMessage_GET_HISTORY_FOR_KEY ChaincodeMessage_Type = 19
 	ChaincodeMessage_GET_STATE_METADATA  ChaincodeMessage_Type = 20
 	ChaincodeMessage_PUT_STATE_METADATA  ChaincodeMessage_Type = 21
+	ChaincodeMessage_PURGE_PRIVATE_DATA  ChaincodeMessage_Type = 22
 )
 
 var ChaincodeMessage_Type_name = map[int32]string{
@@ -72,6 +73,7 @@ var ChaincodeMessage_Type_name = map[int32]string{
 	19: "GET_HISTORY_FOR_KEY",
 	20: "GET_STATE_METADATA",
 	21: "PUT_STATE_METADATA",
+	22: "PURGE_PRIVATE_DATA",
 }
 var ChaincodeMessage_Type_value = map[string]int32{
 	"UNDEFINED":           0,
@@ -95,13 +97,14 @@ var ChaincodeMessage_Type_value = map[string]int32{
 	"GET_HISTORY_FOR_KEY": 19,
 	"GET_STATE_METADATA":  20,
 	"PUT_STATE_METADATA":  21,
+	"PURGE_PRIVATE_DATA":  22,
 }
 
 func (x ChaincodeMessage_Type) String() string {
 	return proto.EnumName(ChaincodeMessage_Type_name, int32(x))
 }
 func (ChaincodeMessage_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{0, 0}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{0, 0}
 }
 
 type ChaincodeMessage struct {
@@ -115,17 +118,21 @@ type ChaincodeMessage struct {
 	// with Block.NonHashData.TransactionResult
 	ChaincodeEvent *ChaincodeEvent `protobuf:"bytes,6,opt,name=chaincode_event,json=chaincodeEvent" json:"chaincode_event,omitempty"`
 	// channel id
-	ChannelId            string   `protobuf:"bytes,7,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId" json:"channel_id,omitempty"`
+	// all the events emitted by chaincode, in the order they were set.
+	// chaincode_event carries the first of them for peers that are not
+	// aware of this field. Used only with Init or Invoke.
+	ChaincodeEvents      []*ChaincodeEvent `protobuf:"bytes,8,rep,name=chaincode_events,json=chaincodeEvents" json:"chaincode_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ChaincodeMessage) Reset()         { *m = ChaincodeMessage{} }
 func (m *ChaincodeMessage) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeMessage) ProtoMessage()    {}
 func (*ChaincodeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{0}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{0}
 }
 func (m *ChaincodeMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeMessage.Unmarshal(m, b)
@@ -194,6 +201,13 @@ func (m *ChaincodeMessage) GetChannelId() string {
 	return ""
 }
 
+func (m *ChaincodeMessage) GetChaincodeEvents() []*ChaincodeEvent {
+	if m != nil {
+		return m.ChaincodeEvents
+	}
+	return nil
+}
+
 // GetState is the payload of a ChaincodeMessage. It contains a key which
 // is to be fetched from the ledger. If the collection is specified, the key
 // would be fetched from the collection (i.e., private state)
@@ -209,7 +223,7 @@ func (m *GetState) Reset()         { *m = GetState{} }
 func (m *GetState) String() string { return proto.CompactTextString(m) }
 func (*GetState) ProtoMessage()    {}
 func (*GetState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{1}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{1}
 }
 func (m *GetState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetState.Unmarshal(m, b)
@@ -255,7 +269,7 @@ func (m *GetStateMetadata) Reset()         { *m = GetStateMetadata{} }
 func (m *GetStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*GetStateMetadata) ProtoMessage()    {}
 func (*GetStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{2}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{2}
 }
 func (m *GetStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateMetadata.Unmarshal(m, b)
@@ -306,7 +320,7 @@ func (m *PutState) Reset()         { *m = PutState{} }
 func (m *PutState) String() string { return proto.CompactTextString(m) }
 func (*PutState) ProtoMessage()    {}
 func (*PutState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{3}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{3}
 }
 func (m *PutState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutState.Unmarshal(m, b)
@@ -360,7 +374,7 @@ func (m *PutStateMetadata) Reset()         { *m = PutStateMetadata{} }
 func (m *PutStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*PutStateMetadata) ProtoMessage()    {}
 func (*PutStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{4}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{4}
 }
 func (m *PutStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutStateMetadata.Unmarshal(m, b)
@@ -405,6 +419,9 @@ func (m *PutStateMetadata) GetMetadata() *StateMetadata {
 // needs to be recorded in the transaction's write set as a delete operation.
 // If the collection is specified, the key needs to be recorded in the
 // transaction's private write set as a delete operation.
+// DelState is also the payload of a PURGE_PRIVATE_DATA ChaincodeMessage, in
+// which case the collection is required and all the versions of the key are
+// purged from the private data of the peers.
 type DelState struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Collection           string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -417,7 +434,7 @@ func (m *DelState) Reset()         { *m = DelState{} }
 func (m *DelState) String() string { return proto.CompactTextString(m) }
 func (*DelState) ProtoMessage()    {}
 func (*DelState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{5}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{5}
 }
 func (m *DelState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelState.Unmarshal(m, b)
@@ -469,7 +486,7 @@ func (m *GetStateByRange) Reset()         { *m = GetStateByRange{} }
 func (m *GetStateByRange) String() string { return proto.CompactTextString(m) }
 func (*GetStateByRange) ProtoMessage()    {}
 func (*GetStateByRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{6}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{6}
 }
 func (m *GetStateByRange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateByRange.Unmarshal(m, b)
@@ -534,7 +551,7 @@ func (m *GetQueryResult) Reset()         { *m = GetQueryResult{} }
 func (m *GetQueryResult) String() string { return proto.CompactTextString(m) }
 func (*GetQueryResult) ProtoMessage()    {}
 func (*GetQueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{7}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{7}
 }
 func (m *GetQueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetQueryResult.Unmarshal(m, b)
@@ -590,7 +607,7 @@ func (m *QueryMetadata) Reset()         { *m = QueryMetadata{} }
 func (m *QueryMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryMetadata) ProtoMessage()    {}
 func (*QueryMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{8}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{8}
 }
 func (m *QueryMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryMetadata.Unmarshal(m, b)
@@ -637,7 +654,7 @@ func (m *GetHistoryForKey) Reset()         { *m = GetHistoryForKey{} }
 func (m *GetHistoryForKey) String() string { return proto.CompactTextString(m) }
 func (*GetHistoryForKey) ProtoMessage()    {}
 func (*GetHistoryForKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{9}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{9}
 }
 func (m *GetHistoryForKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHistoryForKey.Unmarshal(m, b)
@@ -675,7 +692,7 @@ func (m *QueryStateNext) Reset()         { *m = QueryStateNext{} }
 func (m *QueryStateNext) String() string { return proto.CompactTextString(m) }
 func (*QueryStateNext) ProtoMessage()    {}
 func (*QueryStateNext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{10}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{10}
 }
 func (m *QueryStateNext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateNext.Unmarshal(m, b)
@@ -713,7 +730,7 @@ func (m *QueryStateClose) Reset()         { *m = QueryStateClose{} }
 func (m *QueryStateClose) String() string { return proto.CompactTextString(m) }
 func (*QueryStateClose) ProtoMessage()    {}
 func (*QueryStateClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{11}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{11}
 }
 func (m *QueryStateClose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateClose.Unmarshal(m, b)
@@ -752,7 +769,7 @@ func (m *QueryResultBytes) Reset()         { *m = QueryResultBytes{} }
 func (m *QueryResultBytes) String() string { return proto.CompactTextString(m) }
 func (*QueryResultBytes) ProtoMessage()    {}
 func (*QueryResultBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{12}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{12}
 }
 func (m *QueryResultBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResultBytes.Unmarshal(m, b)
@@ -798,7 +815,7 @@ func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{13}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{13}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -860,7 +877,7 @@ func (m *QueryResponseMetadata) Reset()         { *m = QueryResponseMetadata{} }
 func (m *QueryResponseMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryResponseMetadata) ProtoMessage()    {}
 func (*QueryResponseMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{14}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{14}
 }
 func (m *QueryResponseMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponseMetadata.Unmarshal(m, b)
@@ -906,7 +923,7 @@ func (m *StateMetadata) Reset()         { *m = StateMetadata{} }
 func (m *StateMetadata) String() string { return proto.CompactTextString(m) }
 func (*StateMetadata) ProtoMessage()    {}
 func (*StateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{15}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{15}
 }
 func (m *StateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadata.Unmarshal(m, b)
@@ -951,7 +968,7 @@ func (m *StateMetadataResult) Reset()         { *m = StateMetadataResult{} }
 func (m *StateMetadataResult) String() string { return proto.CompactTextString(m) }
 func (*StateMetadataResult) ProtoMessage()    {}
 func (*StateMetadataResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{16}
+	return fileDescriptor_chaincode_shim_8f99abe0f3cf206e, []int{16}
 }
 func (m *StateMetadataResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadataResult.Unmarshal(m, b)
@@ -1104,73 +1121,74 @@ var _ChaincodeSupport_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_3c6cdf1ba834f673)
+	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_8f99abe0f3cf206e)
 }
 
-var fileDescriptor_chaincode_shim_3c6cdf1ba834f673 = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_chaincode_shim_8f99abe0f3cf206e = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x73, 0xda, 0x46,
-	0x14, 0x0e, 0x06, 0x8c, 0x78, 0xd8, 0x78, 0xb3, 0x8e, 0x5d, 0xc2, 0x4c, 0x5a, 0xca, 0xf4, 0x40,
-	0x2f, 0xd0, 0xd0, 0x1e, 0x7a, 0xe8, 0x4c, 0x06, 0xc3, 0x1a, 0x33, 0xb6, 0x05, 0x59, 0xc9, 0x99,
-	0xb8, 0x17, 0x8d, 0x90, 0xd6, 0x42, 0x63, 0xa1, 0x55, 0xa5, 0x25, 0x0d, 0xbd, 0xf5, 0xda, 0x7f,
-	0xa9, 0x7f, 0x58, 0xaf, 0x9d, 0xd5, 0x2f, 0x03, 0xae, 0x93, 0x4e, 0x4e, 0xe8, 0x7b, 0xef, 0xdb,
-	0xef, 0xfd, 0xda, 0x87, 0x04, 0x2f, 0x03, 0xc6, 0xc2, 0x9e, 0xb5, 0x30, 0x5d, 0xdf, 0xe2, 0x36,
-	0x33, 0xa2, 0x85, 0xbb, 0xec, 0x06, 0x21, 0x17, 0x1c, 0xef, 0xc7, 0x3f, 0x51, 0xb3, 0xb9, 0x43,
-	0x61, 0x1f, 0x98, 0x2f, 0x12, 0x4e, 0xf3, 0x38, 0xf6, 0x05, 0x21, 0x0f, 0x78, 0x64, 0x7a, 0xa9,
-	0xf1, 0x1b, 0x87, 0x73, 0xc7, 0x63, 0xbd, 0x18, 0xcd, 0x57, 0x77, 0x3d, 0xe1, 0x2e, 0x59, 0x24,
-	0xcc, 0x65, 0x90, 0x10, 0xda, 0x7f, 0x97, 0x01, 0x0d, 0x33, 0xbd, 0x6b, 0x16, 0x45, 0xa6, 0xc3,
-	0xf0, 0x6b, 0x28, 0x89, 0x75, 0xc0, 0x1a, 0x85, 0x56, 0xa1, 0x53, 0xef, 0xbf, 0x4a, 0xa8, 0x51,
-	0x77, 0x97, 0xd7, 0xd5, 0xd7, 0x01, 0xa3, 0x31, 0x15, 0xff, 0x0c, 0xd5, 0x5c, 0xba, 0xb1, 0xd7,
-	0x2a, 0x74, 0x6a, 0xfd, 0x66, 0x37, 0x09, 0xde, 0xcd, 0x82, 0x77, 0xf5, 0x8c, 0x41, 0x1f, 0xc8,
-	0xb8, 0x01, 0x95, 0xc0, 0x5c, 0x7b, 0xdc, 0xb4, 0x1b, 0xc5, 0x56, 0xa1, 0x73, 0x40, 0x33, 0x88,
-	0x31, 0x94, 0xc4, 0x47, 0xd7, 0x6e, 0x94, 0x5a, 0x85, 0x4e, 0x95, 0xc6, 0xcf, 0xb8, 0x0f, 0x4a,
-	0x56, 0x62, 0xa3, 0x1c, 0x87, 0x39, 0xcd, 0xd2, 0xd3, 0x5c, 0xc7, 0x67, 0xf6, 0x2c, 0xf5, 0xd2,
-	0x9c, 0x87, 0xdf, 0xc0, 0xd1, 0x4e, 0xcb, 0x1a, 0xfb, 0xdb, 0x47, 0xf3, 0xca, 0x88, 0xf4, 0xd2,
-	0xba, 0xb5, 0x85, 0xf1, 0x2b, 0x00, 0x6b, 0x61, 0xfa, 0x3e, 0xf3, 0x0c, 0xd7, 0x6e, 0x54, 0xe2,
-	0x74, 0xaa, 0xa9, 0x65, 0x62, 0xb7, 0xff, 0xd9, 0x83, 0x92, 0x6c, 0x05, 0x3e, 0x84, 0xea, 0x8d,
-	0x3a, 0x22, 0xe7, 0x13, 0x95, 0x8c, 0xd0, 0x33, 0x7c, 0x00, 0x0a, 0x25, 0xe3, 0x89, 0xa6, 0x13,
-	0x8a, 0x0a, 0xb8, 0x0e, 0x90, 0x21, 0x32, 0x42, 0x7b, 0x58, 0x81, 0xd2, 0x44, 0x9d, 0xe8, 0xa8,
-	0x88, 0xab, 0x50, 0xa6, 0x64, 0x30, 0xba, 0x45, 0x25, 0x7c, 0x04, 0x35, 0x9d, 0x0e, 0x54, 0x6d,
-	0x30, 0xd4, 0x27, 0x53, 0x15, 0x95, 0xa5, 0xe4, 0x70, 0x7a, 0x3d, 0xbb, 0x22, 0x3a, 0x19, 0xa1,
-	0x7d, 0x49, 0x25, 0x94, 0x4e, 0x29, 0xaa, 0x48, 0xcf, 0x98, 0xe8, 0x86, 0xa6, 0x0f, 0x74, 0x82,
-	0x14, 0x09, 0x67, 0x37, 0x19, 0xac, 0x4a, 0x38, 0x22, 0x57, 0x29, 0x04, 0xfc, 0x02, 0xd0, 0x44,
-	0x7d, 0x37, 0xbd, 0x24, 0xc6, 0xf0, 0x62, 0x30, 0x51, 0x87, 0xd3, 0x11, 0x41, 0xb5, 0x24, 0x41,
-	0x6d, 0x36, 0x55, 0x35, 0x82, 0x0e, 0xf1, 0x29, 0xe0, 0x5c, 0xd0, 0x38, 0xbb, 0x35, 0xe8, 0x40,
-	0x1d, 0x13, 0x54, 0x97, 0x67, 0xa5, 0xfd, 0xed, 0x0d, 0xa1, 0xb7, 0x06, 0x25, 0xda, 0xcd, 0x95,
-	0x8e, 0x8e, 0xa4, 0x35, 0xb1, 0x24, 0x7c, 0x95, 0xbc, 0xd7, 0x11, 0xc2, 0x27, 0xf0, 0x7c, 0xd3,
-	0x3a, 0xbc, 0x9a, 0x6a, 0x04, 0x3d, 0x97, 0xd9, 0x5c, 0x12, 0x32, 0x1b, 0x5c, 0x4d, 0xde, 0x11,
-	0x84, 0xf1, 0x57, 0x70, 0x2c, 0x15, 0x2f, 0x26, 0x9a, 0x3e, 0xa5, 0xb7, 0xc6, 0xf9, 0x94, 0x1a,
-	0x97, 0xe4, 0x16, 0x1d, 0x6f, 0xa7, 0x70, 0x4d, 0xf4, 0xc1, 0x68, 0xa0, 0x0f, 0xd0, 0x0b, 0x69,
-	0xcf, 0x8b, 0x7b, 0xb0, 0x9f, 0xb4, 0x7f, 0x01, 0x65, 0xcc, 0x84, 0x26, 0x4c, 0xc1, 0x30, 0x82,
-	0xe2, 0x3d, 0x5b, 0xc7, 0x77, 0xb6, 0x4a, 0xe5, 0x23, 0xfe, 0x1a, 0xc0, 0xe2, 0x9e, 0xc7, 0x2c,
-	0xe1, 0x72, 0x3f, 0xbe, 0x94, 0x55, 0xba, 0x61, 0x69, 0x8f, 0x00, 0x65, 0xa7, 0xaf, 0x99, 0x30,
-	0x6d, 0x53, 0x98, 0x5f, 0xa0, 0x42, 0x41, 0x99, 0xad, 0x9e, 0xcc, 0xe1, 0x05, 0x94, 0x3f, 0x98,
-	0xde, 0x8a, 0xc5, 0x07, 0x0f, 0x68, 0x02, 0x76, 0x34, 0x8b, 0x8f, 0x34, 0x7f, 0x07, 0x94, 0x69,
-	0xfe, 0xef, 0xcc, 0x1e, 0xa9, 0xe0, 0xd7, 0xa0, 0x2c, 0xd3, 0xd3, 0xf1, 0x0e, 0xd5, 0xfa, 0x27,
-	0xf9, 0xae, 0x6c, 0x4a, 0xd3, 0x9c, 0x26, 0x1b, 0x3a, 0x62, 0xde, 0x97, 0x36, 0xf4, 0xcf, 0x02,
-	0x1c, 0x65, 0x1d, 0x3d, 0x5b, 0x53, 0xd3, 0x77, 0x18, 0x6e, 0x82, 0x12, 0x09, 0x33, 0x14, 0x97,
-	0xb9, 0x54, 0x8e, 0xf1, 0x29, 0xec, 0x33, 0xdf, 0x96, 0x9e, 0x44, 0x2b, 0x45, 0x9f, 0x2d, 0xac,
-	0xb9, 0x53, 0xd8, 0xc1, 0x46, 0x05, 0x73, 0xa8, 0x8f, 0x99, 0x78, 0xbb, 0x62, 0xe1, 0x9a, 0xb2,
-	0x68, 0xe5, 0x09, 0x39, 0x82, 0xdf, 0x24, 0x4c, 0xc3, 0x27, 0xe0, 0x73, 0xb5, 0x6c, 0xc5, 0x28,
-	0xee, 0xc4, 0x18, 0xc3, 0x61, 0x1c, 0x20, 0x9f, 0x4d, 0x13, 0x94, 0xc0, 0x74, 0x98, 0xe6, 0xfe,
-	0x91, 0xfc, 0x69, 0x96, 0x69, 0x8e, 0xa5, 0x6f, 0xce, 0xf9, 0xfd, 0xd2, 0x0c, 0xef, 0xd3, 0x30,
-	0x39, 0x6e, 0x7f, 0x17, 0xdf, 0xc0, 0x0b, 0x37, 0x12, 0x3c, 0x5c, 0x9f, 0xf3, 0x50, 0x16, 0xff,
-	0xa8, 0xed, 0xed, 0x16, 0xd4, 0xe3, 0x70, 0x71, 0x5f, 0x55, 0xf6, 0x51, 0xe0, 0x3a, 0xec, 0xb9,
-	0x76, 0x4a, 0xd9, 0x73, 0xed, 0xf6, 0xb7, 0x70, 0xf4, 0xc0, 0x18, 0x7a, 0x3c, 0x62, 0x8f, 0x28,
-	0x3f, 0x01, 0xda, 0x68, 0xca, 0xd9, 0x5a, 0xb0, 0x08, 0xb7, 0xa0, 0x16, 0x3e, 0xc0, 0x98, 0x7c,
-	0x40, 0x37, 0x4d, 0xed, 0xbf, 0x0a, 0x69, 0xa9, 0x94, 0x45, 0x01, 0xf7, 0x23, 0x86, 0xfb, 0x50,
-	0x49, 0x08, 0x92, 0x5f, 0xec, 0xd4, 0xfa, 0x8d, 0xec, 0x4e, 0xed, 0xca, 0xd3, 0x8c, 0x88, 0x5f,
-	0x82, 0xb2, 0x30, 0x23, 0x63, 0xc9, 0xc3, 0x64, 0x0f, 0x14, 0x5a, 0x59, 0x98, 0xd1, 0x35, 0x0f,
-	0xb3, 0x34, 0x8b, 0x59, 0x9a, 0x9f, 0x1c, 0xad, 0x03, 0x27, 0x5b, 0xb9, 0xe4, 0xed, 0xef, 0xc3,
-	0xc9, 0x1d, 0x13, 0xd6, 0x82, 0xd9, 0x46, 0xc8, 0x2c, 0x1e, 0xda, 0x91, 0x61, 0xf1, 0x95, 0x2f,
-	0xd2, 0x59, 0x1c, 0xa7, 0x4e, 0x9a, 0xf8, 0x86, 0xd2, 0xf5, 0xc9, 0xb1, 0xbc, 0x81, 0xc3, 0xed,
-	0xdd, 0x6b, 0x40, 0x45, 0x66, 0xf1, 0x30, 0x97, 0x0c, 0xfe, 0xf7, 0x7e, 0xb7, 0xcf, 0xe1, 0x78,
-	0x7b, 0xc3, 0x92, 0x9b, 0xd8, 0x83, 0x0a, 0xf3, 0x45, 0xe8, 0xb2, 0xac, 0x77, 0x4f, 0xec, 0x63,
-	0xc6, 0xea, 0xbf, 0xdf, 0x78, 0x39, 0x6b, 0xab, 0x20, 0xe0, 0xa1, 0xc0, 0x23, 0x50, 0x28, 0x73,
-	0xdc, 0x48, 0xb0, 0x10, 0x37, 0x9e, 0x7a, 0x35, 0x37, 0x9f, 0xf4, 0xb4, 0x9f, 0x75, 0x0a, 0x3f,
-	0x14, 0xce, 0xa6, 0xd0, 0xe6, 0xa1, 0xd3, 0x5d, 0xac, 0x03, 0x16, 0x7a, 0xcc, 0x76, 0x58, 0xd8,
-	0xbd, 0x33, 0xe7, 0xa1, 0x6b, 0x65, 0xe7, 0xe4, 0xd7, 0xc4, 0xaf, 0xdf, 0x3b, 0xae, 0x58, 0xac,
-	0xe6, 0x5d, 0x8b, 0x2f, 0x7b, 0x1b, 0xd4, 0x5e, 0x42, 0x4d, 0xbe, 0x2a, 0xa2, 0x9e, 0xa4, 0xce,
-	0x93, 0x4f, 0x94, 0x1f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x41, 0xbd, 0x1a, 0x01, 0xc6, 0x08,
-	0x00, 0x00,
+	0x14, 0x0e, 0x06, 0x1b, 0xf1, 0x6c, 0xc3, 0x66, 0x1d, 0xbb, 0x0a, 0x33, 0x69, 0x29, 0xd3, 0x83,
+	0x7b, 0x81, 0x86, 0xf6, 0xd0, 0x43, 0x67, 0x32, 0x18, 0xd6, 0x84, 0xb1, 0x2d, 0xc8, 0x4a, 0xce,
+	0xc4, 0xbd, 0x68, 0x84, 0xb4, 0x16, 0x1a, 0x0b, 0xad, 0x2a, 0x2d, 0x69, 0xe8, 0xad, 0xd7, 0x5e,
+	0x7a, 0xeb, 0xdf, 0xdb, 0x59, 0xfd, 0x32, 0xe0, 0xda, 0x99, 0xe6, 0x04, 0xdf, 0x7b, 0xdf, 0x7e,
+	0xef, 0xd7, 0x3e, 0x49, 0xf0, 0x32, 0x64, 0x2c, 0xea, 0xda, 0x73, 0xcb, 0x0b, 0x6c, 0xee, 0x30,
+	0x33, 0x9e, 0x7b, 0x8b, 0x4e, 0x18, 0x71, 0xc1, 0xf1, 0x5e, 0xf2, 0x13, 0x37, 0x9b, 0x5b, 0x14,
+	0xf6, 0x91, 0x05, 0x22, 0xe5, 0x34, 0x8f, 0x12, 0x5f, 0x18, 0xf1, 0x90, 0xc7, 0x96, 0x9f, 0x19,
+	0xbf, 0x71, 0x39, 0x77, 0x7d, 0xd6, 0x4d, 0xd0, 0x6c, 0x79, 0xdb, 0x15, 0xde, 0x82, 0xc5, 0xc2,
+	0x5a, 0x84, 0x29, 0xa1, 0xfd, 0xcf, 0x1e, 0xa0, 0x41, 0xae, 0x77, 0xc5, 0xe2, 0xd8, 0x72, 0x19,
+	0x7e, 0x0d, 0x15, 0xb1, 0x0a, 0x99, 0x5a, 0x6a, 0x95, 0x4e, 0xeb, 0xbd, 0x57, 0x29, 0x35, 0xee,
+	0x6c, 0xf3, 0x3a, 0xc6, 0x2a, 0x64, 0x34, 0xa1, 0xe2, 0x9f, 0xa1, 0x56, 0x48, 0xab, 0x3b, 0xad,
+	0xd2, 0xe9, 0x7e, 0xaf, 0xd9, 0x49, 0x83, 0x77, 0xf2, 0xe0, 0x1d, 0x23, 0x67, 0xd0, 0x7b, 0x32,
+	0x56, 0xa1, 0x1a, 0x5a, 0x2b, 0x9f, 0x5b, 0x8e, 0x5a, 0x6e, 0x95, 0x4e, 0x0f, 0x68, 0x0e, 0x31,
+	0x86, 0x8a, 0xf8, 0xe4, 0x39, 0x6a, 0xa5, 0x55, 0x3a, 0xad, 0xd1, 0xe4, 0x3f, 0xee, 0x81, 0x92,
+	0x97, 0xa8, 0xee, 0x26, 0x61, 0x4e, 0xf2, 0xf4, 0x74, 0xcf, 0x0d, 0x98, 0x33, 0xcd, 0xbc, 0xb4,
+	0xe0, 0xe1, 0x37, 0xd0, 0xd8, 0x6a, 0x99, 0xba, 0xb7, 0x79, 0xb4, 0xa8, 0x8c, 0x48, 0x2f, 0xad,
+	0xdb, 0x1b, 0x18, 0xbf, 0x02, 0xb0, 0xe7, 0x56, 0x10, 0x30, 0xdf, 0xf4, 0x1c, 0xb5, 0x9a, 0xa4,
+	0x53, 0xcb, 0x2c, 0x63, 0x07, 0xf7, 0x01, 0x6d, 0xe9, 0xc7, 0xaa, 0xd2, 0x2a, 0x3f, 0x11, 0xa0,
+	0xb1, 0x19, 0x20, 0x6e, 0xff, 0x5d, 0x86, 0x8a, 0xec, 0x26, 0x3e, 0x84, 0xda, 0xb5, 0x36, 0x24,
+	0xe7, 0x63, 0x8d, 0x0c, 0xd1, 0x33, 0x7c, 0x00, 0x0a, 0x25, 0xa3, 0xb1, 0x6e, 0x10, 0x8a, 0x4a,
+	0xb8, 0x0e, 0x90, 0x23, 0x32, 0x44, 0x3b, 0x58, 0x81, 0xca, 0x58, 0x1b, 0x1b, 0xa8, 0x8c, 0x6b,
+	0xb0, 0x4b, 0x49, 0x7f, 0x78, 0x83, 0x2a, 0xb8, 0x01, 0xfb, 0x06, 0xed, 0x6b, 0x7a, 0x7f, 0x60,
+	0x8c, 0x27, 0x1a, 0xda, 0x95, 0x92, 0x83, 0xc9, 0xd5, 0xf4, 0x92, 0x18, 0x64, 0x88, 0xf6, 0x24,
+	0x95, 0x50, 0x3a, 0xa1, 0xa8, 0x2a, 0x3d, 0x23, 0x62, 0x98, 0xba, 0xd1, 0x37, 0x08, 0x52, 0x24,
+	0x9c, 0x5e, 0xe7, 0xb0, 0x26, 0xe1, 0x90, 0x5c, 0x66, 0x10, 0xf0, 0x0b, 0x40, 0x63, 0xed, 0xfd,
+	0xe4, 0x82, 0x98, 0x83, 0xb7, 0xfd, 0xb1, 0x36, 0x98, 0x0c, 0x09, 0xda, 0x4f, 0x13, 0xd4, 0xa7,
+	0x13, 0x4d, 0x27, 0xe8, 0x10, 0x9f, 0x00, 0x2e, 0x04, 0xcd, 0xb3, 0x1b, 0x93, 0xf6, 0xb5, 0x11,
+	0x41, 0x75, 0x79, 0x56, 0xda, 0xdf, 0x5d, 0x13, 0x7a, 0x63, 0x52, 0xa2, 0x5f, 0x5f, 0x1a, 0xa8,
+	0x21, 0xad, 0xa9, 0x25, 0xe5, 0x6b, 0xe4, 0x83, 0x81, 0x10, 0x3e, 0x86, 0xe7, 0xeb, 0xd6, 0xc1,
+	0xe5, 0x44, 0x27, 0xe8, 0xb9, 0xcc, 0xe6, 0x82, 0x90, 0x69, 0xff, 0x72, 0xfc, 0x9e, 0x20, 0x8c,
+	0xbf, 0x82, 0x23, 0xa9, 0xf8, 0x76, 0xac, 0x1b, 0x13, 0x7a, 0x63, 0x9e, 0x4f, 0xa8, 0x79, 0x41,
+	0x6e, 0xd0, 0xd1, 0x66, 0x0a, 0x57, 0xc4, 0xe8, 0x0f, 0xfb, 0x46, 0x1f, 0xbd, 0x90, 0xf6, 0xe9,
+	0xf5, 0x03, 0xfb, 0x71, 0x6a, 0xa7, 0x23, 0x62, 0x4e, 0xe9, 0xf8, 0xbd, 0xf4, 0x25, 0xf6, 0x93,
+	0xf6, 0x2f, 0xa0, 0x8c, 0x98, 0xd0, 0x85, 0x25, 0x18, 0x46, 0x50, 0xbe, 0x63, 0xab, 0x64, 0x1d,
+	0x6a, 0x54, 0xfe, 0xc5, 0x5f, 0x03, 0xd8, 0xdc, 0xf7, 0x99, 0x2d, 0x3c, 0x1e, 0x24, 0xf7, 0xbd,
+	0x46, 0xd7, 0x2c, 0xed, 0x21, 0xa0, 0xfc, 0xf4, 0x15, 0x13, 0x96, 0x63, 0x09, 0xeb, 0x0b, 0x54,
+	0x28, 0x28, 0xd3, 0xe5, 0xa3, 0x39, 0xbc, 0x80, 0xdd, 0x8f, 0x96, 0xbf, 0x64, 0xc9, 0xc1, 0x03,
+	0x9a, 0x82, 0x2d, 0xcd, 0xf2, 0x03, 0xcd, 0xdf, 0x01, 0x4d, 0x97, 0xff, 0x33, 0xb3, 0x07, 0x2a,
+	0xf8, 0x35, 0x28, 0x8b, 0xec, 0x74, 0xb2, 0x9e, 0xfb, 0xbd, 0xe3, 0x62, 0x0d, 0xd7, 0xa5, 0x69,
+	0x41, 0x93, 0x0d, 0x1d, 0x32, 0xff, 0x4b, 0x1b, 0xfa, 0x67, 0x09, 0x1a, 0x79, 0x47, 0xcf, 0x56,
+	0xd4, 0x0a, 0x5c, 0x86, 0x9b, 0xa0, 0xc4, 0xc2, 0x8a, 0xc4, 0x45, 0x21, 0x55, 0x60, 0x7c, 0x02,
+	0x7b, 0x2c, 0x70, 0xa4, 0x27, 0xd5, 0xca, 0xd0, 0x67, 0x0b, 0x6b, 0x6e, 0x15, 0x76, 0xb0, 0x56,
+	0xc1, 0x0c, 0xea, 0x23, 0x26, 0xde, 0x2d, 0x59, 0xb4, 0xa2, 0x2c, 0x5e, 0xfa, 0x42, 0x8e, 0xe0,
+	0x37, 0x09, 0xb3, 0xf0, 0x29, 0xf8, 0x5c, 0x2d, 0x1b, 0x31, 0xca, 0x5b, 0x31, 0x46, 0x70, 0x98,
+	0x04, 0x28, 0x66, 0xd3, 0x04, 0x25, 0xb4, 0x5c, 0xa6, 0x7b, 0x7f, 0xa4, 0xcf, 0xe3, 0x5d, 0x5a,
+	0x60, 0xe9, 0x9b, 0x71, 0x7e, 0xb7, 0xb0, 0xa2, 0xbb, 0x2c, 0x4c, 0x81, 0xdb, 0xdf, 0x25, 0x37,
+	0xf0, 0xad, 0x17, 0x0b, 0x1e, 0xad, 0xce, 0x79, 0x24, 0x8b, 0x7f, 0xd0, 0xf6, 0x76, 0x0b, 0xea,
+	0x49, 0xb8, 0xa4, 0xaf, 0x1a, 0xfb, 0x24, 0x70, 0x1d, 0x76, 0x3c, 0x27, 0xa3, 0xec, 0x78, 0x4e,
+	0xfb, 0x5b, 0x68, 0xdc, 0x33, 0x06, 0x3e, 0x8f, 0xd9, 0x03, 0xca, 0x4f, 0x80, 0xd6, 0x9a, 0x72,
+	0xb6, 0x12, 0x2c, 0xc6, 0x2d, 0xd8, 0x8f, 0xee, 0x61, 0x42, 0x3e, 0xa0, 0xeb, 0xa6, 0xf6, 0x5f,
+	0xa5, 0xac, 0x54, 0xca, 0xe2, 0x90, 0x07, 0x31, 0xc3, 0x3d, 0xa8, 0xa6, 0x04, 0xc9, 0x97, 0x8f,
+	0x4f, 0x35, 0xbf, 0x53, 0xdb, 0xf2, 0x34, 0x27, 0xe2, 0x97, 0xa0, 0xcc, 0xad, 0xd8, 0x5c, 0xf0,
+	0x28, 0xdd, 0x03, 0x85, 0x56, 0xe7, 0x56, 0x7c, 0xc5, 0xa3, 0x3c, 0xcd, 0x72, 0x9e, 0xe6, 0x93,
+	0xa3, 0x75, 0xe1, 0x78, 0x23, 0x97, 0xa2, 0xfd, 0x3d, 0x38, 0xbe, 0x65, 0xc2, 0x9e, 0x33, 0xc7,
+	0x8c, 0x98, 0xcd, 0x23, 0x27, 0x36, 0x6d, 0xbe, 0x0c, 0x44, 0x36, 0x8b, 0xa3, 0xcc, 0x49, 0x53,
+	0xdf, 0x40, 0xba, 0x9e, 0x1c, 0xcb, 0x1b, 0x38, 0xdc, 0xdc, 0x3d, 0x15, 0xaa, 0x32, 0x8b, 0xfb,
+	0xb9, 0xe4, 0xf0, 0xbf, 0xf7, 0xbb, 0x7d, 0x0e, 0x47, 0x9b, 0x1b, 0x96, 0xde, 0xc4, 0x2e, 0x54,
+	0x59, 0x20, 0x22, 0x8f, 0xe5, 0xbd, 0x7b, 0x64, 0x1f, 0x73, 0x56, 0xef, 0xc3, 0xda, 0x7b, 0x5f,
+	0x5f, 0x86, 0x21, 0x8f, 0x04, 0x1e, 0x82, 0x42, 0x99, 0xeb, 0xc5, 0x82, 0x45, 0x58, 0x7d, 0xec,
+	0xad, 0xdf, 0x7c, 0xd4, 0xd3, 0x7e, 0x76, 0x5a, 0xfa, 0xa1, 0x74, 0x36, 0x81, 0x36, 0x8f, 0xdc,
+	0xce, 0x7c, 0x15, 0xb2, 0xc8, 0x67, 0x8e, 0xcb, 0xa2, 0xce, 0xad, 0x35, 0x8b, 0x3c, 0x3b, 0x3f,
+	0x27, 0x3f, 0x54, 0x7e, 0xfd, 0xde, 0xf5, 0xc4, 0x7c, 0x39, 0xeb, 0xd8, 0x7c, 0xd1, 0x5d, 0xa3,
+	0x76, 0x53, 0x6a, 0xfa, 0xc1, 0x12, 0x77, 0x25, 0x75, 0x96, 0x7e, 0xfd, 0xfc, 0xf8, 0xef, 0x00,
+	0x97, 0xe1, 0x02, 0x7e, 0x21, 0x09, 0x00, 0x00,
 }
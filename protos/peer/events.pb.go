@@ -39,7 +39,7 @@ func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
 func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
 func (*FilteredBlock) ProtoMessage()    {}
 func (*FilteredBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{0}
+	return fileDescriptor_events_ec513087560defca, []int{0}
 }
 func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredBlock.Unmarshal(m, b)
@@ -98,7 +98,7 @@ func (m *FilteredTransaction) Reset()         { *m = FilteredTransaction{} }
 func (m *FilteredTransaction) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransaction) ProtoMessage()    {}
 func (*FilteredTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{1}
+	return fileDescriptor_events_ec513087560defca, []int{1}
 }
 func (m *FilteredTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransaction.Unmarshal(m, b)
@@ -231,7 +231,7 @@ func (m *FilteredTransactionActions) Reset()         { *m = FilteredTransactionA
 func (m *FilteredTransactionActions) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransactionActions) ProtoMessage()    {}
 func (*FilteredTransactionActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{2}
+	return fileDescriptor_events_ec513087560defca, []int{2}
 }
 func (m *FilteredTransactionActions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransactionActions.Unmarshal(m, b)
@@ -271,7 +271,7 @@ func (m *FilteredChaincodeAction) Reset()         { *m = FilteredChaincodeAction
 func (m *FilteredChaincodeAction) String() string { return proto.CompactTextString(m) }
 func (*FilteredChaincodeAction) ProtoMessage()    {}
 func (*FilteredChaincodeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{3}
+	return fileDescriptor_events_ec513087560defca, []int{3}
 }
 func (m *FilteredChaincodeAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredChaincodeAction.Unmarshal(m, b)
@@ -298,6 +298,59 @@ func (m *FilteredChaincodeAction) GetChaincodeEvent() *ChaincodeEvent {
 	return nil
 }
 
+// ChaincodeEventFilter restricts the chaincode events included in the
+// filtered blocks sent by DeliverFiltered. It is passed as the extension of
+// the channel header of the seek envelope. Transactions are still reported
+// when none of their events match the filter.
+type ChaincodeEventFilter struct {
+	// if set, only events emitted by this chaincode are delivered
+	ChaincodeName string `protobuf:"bytes,1,opt,name=chaincode_name,json=chaincodeName" json:"chaincode_name,omitempty"`
+	// if set, only events whose name matches this regular expression are
+	// delivered
+	EventNameRegex       string   `protobuf:"bytes,2,opt,name=event_name_regex,json=eventNameRegex" json:"event_name_regex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChaincodeEventFilter) Reset()         { *m = ChaincodeEventFilter{} }
+func (m *ChaincodeEventFilter) String() string { return proto.CompactTextString(m) }
+func (*ChaincodeEventFilter) ProtoMessage()    {}
+func (*ChaincodeEventFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_ec513087560defca, []int{4}
+}
+func (m *ChaincodeEventFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChaincodeEventFilter.Unmarshal(m, b)
+}
+func (m *ChaincodeEventFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChaincodeEventFilter.Marshal(b, m, deterministic)
+}
+func (dst *ChaincodeEventFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChaincodeEventFilter.Merge(dst, src)
+}
+func (m *ChaincodeEventFilter) XXX_Size() int {
+	return xxx_messageInfo_ChaincodeEventFilter.Size(m)
+}
+func (m *ChaincodeEventFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChaincodeEventFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChaincodeEventFilter proto.InternalMessageInfo
+
+func (m *ChaincodeEventFilter) GetChaincodeName() string {
+	if m != nil {
+		return m.ChaincodeName
+	}
+	return ""
+}
+
+func (m *ChaincodeEventFilter) GetEventNameRegex() string {
+	if m != nil {
+		return m.EventNameRegex
+	}
+	return ""
+}
+
 // DeliverResponse
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
@@ -314,7 +367,7 @@ func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{4}
+	return fileDescriptor_events_ec513087560defca, []int{5}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -473,6 +526,7 @@ func init() {
 	proto.RegisterType((*FilteredTransaction)(nil), "protos.FilteredTransaction")
 	proto.RegisterType((*FilteredTransactionActions)(nil), "protos.FilteredTransactionActions")
 	proto.RegisterType((*FilteredChaincodeAction)(nil), "protos.FilteredChaincodeAction")
+	proto.RegisterType((*ChaincodeEventFilter)(nil), "protos.ChaincodeEventFilter")
 	proto.RegisterType((*DeliverResponse)(nil), "protos.DeliverResponse")
 }
 
@@ -657,43 +711,46 @@ var _Deliver_serviceDesc = grpc.ServiceDesc{
 	Metadata: "peer/events.proto",
 }
 
-func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_8af932975aef5a3c) }
-
-var fileDescriptor_events_8af932975aef5a3c = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x08, 0xea, 0x44, 0x49, 0xdb, 0x2d, 0x4d, 0xa3, 0x20, 0xd4, 0xc8, 0x12, 0xc8,
-	0x5c, 0x62, 0x64, 0x6e, 0x1c, 0x40, 0xa4, 0x3f, 0x0a, 0x12, 0x87, 0x6a, 0x09, 0x1c, 0x7a, 0xc0,
-	0x5a, 0xdb, 0x13, 0xc7, 0xd4, 0xf1, 0x5a, 0xbb, 0x9b, 0x28, 0x79, 0x04, 0xde, 0x80, 0x67, 0xe0,
-	0x09, 0x39, 0x22, 0xaf, 0xbd, 0x49, 0x9a, 0x52, 0x24, 0x4e, 0xf6, 0xce, 0x7c, 0x3f, 0x33, 0xb3,
-	0x63, 0xc3, 0x51, 0x8e, 0x28, 0x5c, 0x5c, 0x60, 0xa6, 0xe4, 0x20, 0x17, 0x5c, 0x71, 0xd2, 0xd0,
-	0x0f, 0xd9, 0x3b, 0x0e, 0xf9, 0x6c, 0xc6, 0x33, 0xb7, 0x7c, 0x94, 0xc9, 0xde, 0x59, 0xcc, 0x79,
-	0x9c, 0xa2, 0xab, 0x4f, 0xc1, 0x7c, 0xe2, 0xaa, 0x64, 0x86, 0x52, 0xb1, 0x59, 0x5e, 0x01, 0x7a,
-	0x5a, 0x30, 0x9c, 0xb2, 0x24, 0x0b, 0x79, 0x84, 0xbe, 0x96, 0xae, 0x72, 0x1d, 0x9d, 0x53, 0x82,
-	0x65, 0x92, 0x85, 0x2a, 0x31, 0xa2, 0xf6, 0x4f, 0x0b, 0x5a, 0x57, 0x49, 0xaa, 0x50, 0x60, 0x34,
-	0x4c, 0x79, 0x78, 0x4b, 0x9e, 0x03, 0x84, 0x53, 0x96, 0x65, 0x98, 0xfa, 0x49, 0xd4, 0xb5, 0xfa,
-	0x96, 0xb3, 0x4f, 0xf7, 0xab, 0xc8, 0xc7, 0x88, 0x74, 0xa0, 0x91, 0xcd, 0x67, 0x01, 0x8a, 0xee,
-	0xa3, 0xbe, 0xe5, 0xd4, 0x69, 0x75, 0x22, 0xd7, 0x70, 0x32, 0xa9, 0x74, 0xfc, 0x2d, 0x1b, 0xd9,
-	0xad, 0xf7, 0xf7, 0x9c, 0xa6, 0xf7, 0xac, 0xf4, 0x93, 0x03, 0x63, 0x36, 0xde, 0x60, 0xe8, 0xd3,
-	0xc9, 0xfd, 0xa0, 0xb4, 0x7f, 0x5b, 0x70, 0xfc, 0x17, 0x34, 0x21, 0x50, 0x57, 0xcb, 0x75, 0x69,
-	0xfa, 0x9d, 0xbc, 0x84, 0xba, 0x5a, 0xe5, 0xa8, 0x6b, 0x6a, 0x7b, 0x64, 0x50, 0x0d, 0x6e, 0x84,
-	0x2c, 0x42, 0x31, 0x5e, 0xe5, 0x48, 0x75, 0x9e, 0x5c, 0x01, 0x51, 0x4b, 0x7f, 0xc1, 0xd2, 0x24,
-	0x62, 0x85, 0x98, 0x5f, 0x0c, 0xaa, 0xbb, 0xa7, 0x59, 0x5d, 0x53, 0xe2, 0x78, 0xf9, 0x75, 0x0d,
-	0x38, 0xe7, 0x11, 0xd2, 0x43, 0xb5, 0x13, 0x21, 0x5f, 0xe0, 0x78, 0xab, 0x49, 0x7f, 0xd3, 0xab,
-	0xe5, 0x34, 0x3d, 0xfb, 0x1f, 0xbd, 0x7e, 0x28, 0x91, 0xa3, 0x1a, 0x25, 0xea, 0x5e, 0x74, 0xd8,
-	0x80, 0xfa, 0x05, 0x53, 0xcc, 0xfe, 0x0e, 0xbd, 0x87, 0xb9, 0xe4, 0x13, 0x1c, 0x6d, 0x2e, 0xd9,
-	0x58, 0x5b, 0x7a, 0xcc, 0x67, 0xbb, 0xd6, 0xe7, 0x06, 0x58, 0x92, 0xe9, 0x61, 0x78, 0x37, 0x20,
-	0xed, 0x1b, 0x38, 0x7d, 0x00, 0x4c, 0xde, 0xc3, 0xc1, 0xce, 0x36, 0xe9, 0xa1, 0x37, 0xbd, 0x8e,
-	0xb1, 0x59, 0x33, 0x2e, 0x8b, 0x2c, 0x6d, 0x87, 0x77, 0xce, 0xf6, 0x2f, 0x0b, 0x0e, 0x2e, 0x30,
-	0x4d, 0x16, 0x28, 0x28, 0xca, 0x9c, 0x67, 0x12, 0x89, 0x03, 0x0d, 0xa9, 0x98, 0x9a, 0x4b, 0xad,
-	0xd5, 0xf6, 0xda, 0xe6, 0xb2, 0x3e, 0xeb, 0xe8, 0xa8, 0x46, 0xab, 0x3c, 0x79, 0x01, 0x8f, 0x83,
-	0x62, 0x25, 0xf5, 0xad, 0x36, 0xbd, 0x96, 0x01, 0xea, 0x3d, 0x1d, 0xd5, 0x68, 0x99, 0x25, 0xef,
-	0xa0, 0xbd, 0xde, 0xbc, 0x12, 0xbf, 0xa7, 0xf1, 0x27, 0xbb, 0xb3, 0x30, 0xbc, 0xd6, 0x64, 0x3b,
-	0x50, 0x0c, 0xbd, 0xd8, 0x10, 0xef, 0x87, 0x05, 0x4f, 0xaa, 0x62, 0xc9, 0xdb, 0xcd, 0xeb, 0xa1,
-	0xb1, 0xbd, 0xcc, 0x16, 0x98, 0xf2, 0x1c, 0x7b, 0xa7, 0x46, 0x78, 0xa7, 0x35, 0xbb, 0xe6, 0x58,
-	0xaf, 0x2d, 0x32, 0x5c, 0xf7, 0x6c, 0x8c, 0xff, 0x5b, 0x63, 0xf8, 0x0d, 0x6c, 0x2e, 0xe2, 0xc1,
-	0x74, 0x95, 0xa3, 0x48, 0x31, 0x8a, 0x51, 0x0c, 0x26, 0x2c, 0x10, 0x49, 0x68, 0x68, 0xc5, 0xe7,
-	0x3c, 0x6c, 0xe9, 0x29, 0xcb, 0x6b, 0x16, 0xde, 0xb2, 0x18, 0x6f, 0x5e, 0xc5, 0x89, 0x9a, 0xce,
-	0x83, 0xc2, 0xcb, 0xdd, 0x62, 0xba, 0x25, 0xb3, 0xfc, 0x6f, 0x48, 0xb7, 0x60, 0x06, 0xe5, 0x8f,
-	0xe6, 0xcd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0xd1, 0xa9, 0x84, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_ec513087560defca) }
+
+var fileDescriptor_events_ec513087560defca = []byte{
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xbf, 0xe6, 0x0b, 0xea, 0x8d, 0x92, 0xa6, 0xd3, 0xbf, 0x28, 0x08, 0xb5, 0xb2, 0x54,
+	0x14, 0x36, 0x31, 0x32, 0x3b, 0x16, 0x20, 0xd2, 0x1f, 0x05, 0x09, 0xa1, 0x6a, 0x28, 0x2c, 0xba,
+	0xc0, 0x9a, 0xd8, 0x37, 0x8e, 0xa9, 0xed, 0xb1, 0x66, 0x26, 0x51, 0xfa, 0x08, 0xbc, 0x01, 0xcf,
+	0xc0, 0x13, 0xb2, 0x44, 0x33, 0xf6, 0x24, 0x69, 0x4a, 0x91, 0x58, 0xc5, 0x73, 0xee, 0x39, 0xf7,
+	0xcc, 0xfd, 0xc9, 0xc0, 0x6e, 0x81, 0x28, 0x3c, 0x9c, 0x63, 0xae, 0xe4, 0xa0, 0x10, 0x5c, 0x71,
+	0xd2, 0x30, 0x3f, 0xb2, 0xb7, 0x17, 0xf2, 0x2c, 0xe3, 0xb9, 0x57, 0xfe, 0x94, 0xc1, 0xde, 0x71,
+	0xcc, 0x79, 0x9c, 0xa2, 0x67, 0x4e, 0xe3, 0xd9, 0xc4, 0x53, 0x49, 0x86, 0x52, 0xb1, 0xac, 0xa8,
+	0x08, 0x3d, 0x93, 0x30, 0x9c, 0xb2, 0x24, 0x0f, 0x79, 0x84, 0x81, 0x49, 0x5d, 0xc5, 0x0e, 0x4d,
+	0x4c, 0x09, 0x96, 0x4b, 0x16, 0xaa, 0xc4, 0x26, 0x75, 0x7f, 0x38, 0xd0, 0xba, 0x4c, 0x52, 0x85,
+	0x02, 0xa3, 0x61, 0xca, 0xc3, 0x5b, 0xf2, 0x0c, 0x20, 0x9c, 0xb2, 0x3c, 0xc7, 0x34, 0x48, 0xa2,
+	0xae, 0x73, 0xe2, 0xf4, 0xb7, 0xe9, 0x76, 0x85, 0xbc, 0x8f, 0xc8, 0x21, 0x34, 0xf2, 0x59, 0x36,
+	0x46, 0xd1, 0xfd, 0xef, 0xc4, 0xe9, 0xd7, 0x69, 0x75, 0x22, 0x57, 0x70, 0x30, 0xa9, 0xf2, 0x04,
+	0x6b, 0x36, 0xb2, 0x5b, 0x3f, 0xd9, 0xea, 0x37, 0xfd, 0xa7, 0xa5, 0x9f, 0x1c, 0x58, 0xb3, 0xeb,
+	0x15, 0x87, 0xee, 0x4f, 0x1e, 0x82, 0xd2, 0xfd, 0xe5, 0xc0, 0xde, 0x1f, 0xd8, 0x84, 0x40, 0x5d,
+	0x2d, 0x96, 0x57, 0x33, 0xdf, 0xe4, 0x39, 0xd4, 0xd5, 0x5d, 0x81, 0xe6, 0x4e, 0x6d, 0x9f, 0x0c,
+	0xaa, 0xc6, 0x8d, 0x90, 0x45, 0x28, 0xae, 0xef, 0x0a, 0xa4, 0x26, 0x4e, 0x2e, 0x81, 0xa8, 0x45,
+	0x30, 0x67, 0x69, 0x12, 0x31, 0x9d, 0x2c, 0xd0, 0x8d, 0xea, 0x6e, 0x19, 0x55, 0xd7, 0x5e, 0xf1,
+	0x7a, 0xf1, 0x65, 0x49, 0x38, 0xe3, 0x11, 0xd2, 0x8e, 0xda, 0x40, 0xc8, 0x67, 0xd8, 0x5b, 0x2b,
+	0x32, 0x58, 0xd5, 0xea, 0xf4, 0x9b, 0xbe, 0xfb, 0x97, 0x5a, 0xdf, 0x95, 0xcc, 0x51, 0x8d, 0x12,
+	0xf5, 0x00, 0x1d, 0x36, 0xa0, 0x7e, 0xce, 0x14, 0x73, 0xbf, 0x41, 0xef, 0x71, 0x2d, 0xf9, 0x00,
+	0xbb, 0xab, 0x21, 0x5b, 0x6b, 0xc7, 0xb4, 0xf9, 0x78, 0xd3, 0xfa, 0xcc, 0x12, 0x4b, 0x31, 0xed,
+	0x84, 0xf7, 0x01, 0xe9, 0xde, 0xc0, 0xd1, 0x23, 0x64, 0xf2, 0x16, 0x76, 0x36, 0xb6, 0xc9, 0x34,
+	0xbd, 0xe9, 0x1f, 0x5a, 0x9b, 0xa5, 0xe2, 0x42, 0x47, 0x69, 0x3b, 0xbc, 0x77, 0x76, 0x63, 0xd8,
+	0xbf, 0xcf, 0x28, 0x9d, 0xc8, 0x29, 0xac, 0x98, 0x41, 0xce, 0x32, 0xac, 0x86, 0xd9, 0x5a, 0xa2,
+	0x1f, 0x59, 0x86, 0xa4, 0x0f, 0x1d, 0xe3, 0x6a, 0x28, 0x81, 0xc0, 0x18, 0x17, 0x66, 0xc2, 0xdb,
+	0xb4, 0x6d, 0x70, 0x4d, 0xa2, 0x1a, 0x75, 0x7f, 0x3a, 0xb0, 0x73, 0x8e, 0x69, 0x32, 0x47, 0x41,
+	0x51, 0x16, 0x3c, 0x97, 0x5a, 0xdd, 0x90, 0x8a, 0xa9, 0x99, 0x34, 0xc9, 0xdb, 0x7e, 0xdb, 0x6e,
+	0xc5, 0x27, 0x83, 0x8e, 0x6a, 0xb4, 0x8a, 0x93, 0x53, 0xf8, 0x7f, 0xac, 0x77, 0xdf, 0x24, 0x6f,
+	0xfa, 0x2d, 0x4b, 0x34, 0x7f, 0x88, 0x51, 0x8d, 0x96, 0x51, 0xf2, 0x06, 0xda, 0xcb, 0x15, 0x2f,
+	0xf9, 0x5b, 0x86, 0x7f, 0xb0, 0xd9, 0x74, 0xab, 0x6b, 0x4d, 0xd6, 0x01, 0x3d, 0x5d, 0xbd, 0x8a,
+	0xfe, 0x77, 0x07, 0x9e, 0x54, 0x97, 0x25, 0xaf, 0x57, 0x9f, 0x1d, 0x6b, 0x7b, 0x91, 0xcf, 0x31,
+	0xe5, 0x05, 0xf6, 0x8e, 0x6c, 0xe2, 0x8d, 0xd2, 0xdc, 0x5a, 0xdf, 0x79, 0xe9, 0x90, 0xe1, 0xb2,
+	0x66, 0x6b, 0xfc, 0xcf, 0x39, 0x86, 0x5f, 0xc1, 0xe5, 0x22, 0x1e, 0x4c, 0xef, 0x0a, 0x14, 0x29,
+	0x46, 0x31, 0x8a, 0xc1, 0x84, 0x8d, 0x45, 0x12, 0x5a, 0x99, 0x7e, 0x37, 0x86, 0x2d, 0x33, 0x3c,
+	0x79, 0xc5, 0xc2, 0x5b, 0x16, 0xe3, 0xcd, 0x8b, 0x38, 0x51, 0xd3, 0xd9, 0x58, 0x7b, 0x79, 0x6b,
+	0x4a, 0xaf, 0x54, 0x96, 0x0f, 0x94, 0xf4, 0xb4, 0x72, 0x5c, 0xbe, 0x68, 0xaf, 0x7e, 0x0f, 0x00,
+	0x83, 0x7e, 0x47, 0x29, 0xed, 0x04, 0x00, 0x00,
 }
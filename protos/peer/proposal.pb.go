@@ -58,7 +58,7 @@ func (m *SignedProposal) Reset()         { *m = SignedProposal{} }
 func (m *SignedProposal) String() string { return proto.CompactTextString(m) }
 func (*SignedProposal) ProtoMessage()    {}
 func (*SignedProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_proposal_8e93dae9c9555ac0, []int{0}
+	return fileDescriptor_proposal_6cdf2b497f5d2819, []int{0}
 }
 func (m *SignedProposal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedProposal.Unmarshal(m, b)
@@ -130,7 +130,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_proposal_8e93dae9c9555ac0, []int{1}
+	return fileDescriptor_proposal_6cdf2b497f5d2819, []int{1}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Proposal.Unmarshal(m, b)
@@ -198,7 +198,7 @@ func (m *ChaincodeHeaderExtension) Reset()         { *m = ChaincodeHeaderExtensi
 func (m *ChaincodeHeaderExtension) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeHeaderExtension) ProtoMessage()    {}
 func (*ChaincodeHeaderExtension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_proposal_8e93dae9c9555ac0, []int{2}
+	return fileDescriptor_proposal_6cdf2b497f5d2819, []int{2}
 }
 func (m *ChaincodeHeaderExtension) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeHeaderExtension.Unmarshal(m, b)
@@ -254,7 +254,7 @@ func (m *ChaincodeProposalPayload) Reset()         { *m = ChaincodeProposalPaylo
 func (m *ChaincodeProposalPayload) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeProposalPayload) ProtoMessage()    {}
 func (*ChaincodeProposalPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_proposal_8e93dae9c9555ac0, []int{3}
+	return fileDescriptor_proposal_6cdf2b497f5d2819, []int{3}
 }
 func (m *ChaincodeProposalPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeProposalPayload.Unmarshal(m, b)
@@ -304,17 +304,23 @@ type ChaincodeAction struct {
 	// Committer will validate the version matching with latest chaincode version.
 	// Adding ChaincodeID to keep version opens up the possibility of multiple
 	// ChaincodeAction per transaction.
-	ChaincodeId          *ChaincodeID `protobuf:"bytes,4,opt,name=chaincode_id,json=chaincodeId" json:"chaincode_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	ChaincodeId *ChaincodeID `protobuf:"bytes,4,opt,name=chaincode_id,json=chaincodeId" json:"chaincode_id,omitempty"`
+	// This field contains all the events generated by the chaincode executing
+	// this invocation, in the order they were emitted. It is only set when
+	// more than one event was emitted; the events field always carries the
+	// first event so that consumers that only understand a single event per
+	// invocation keep working.
+	ChaincodeEvents      []*ChaincodeEvent `protobuf:"bytes,5,rep,name=chaincode_events,json=chaincodeEvents" json:"chaincode_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ChaincodeAction) Reset()         { *m = ChaincodeAction{} }
 func (m *ChaincodeAction) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeAction) ProtoMessage()    {}
 func (*ChaincodeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_proposal_8e93dae9c9555ac0, []int{4}
+	return fileDescriptor_proposal_6cdf2b497f5d2819, []int{4}
 }
 func (m *ChaincodeAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeAction.Unmarshal(m, b)
@@ -362,6 +368,13 @@ func (m *ChaincodeAction) GetChaincodeId() *ChaincodeID {
 	return nil
 }
 
+func (m *ChaincodeAction) GetChaincodeEvents() []*ChaincodeEvent {
+	if m != nil {
+		return m.ChaincodeEvents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignedProposal)(nil), "protos.SignedProposal")
 	proto.RegisterType((*Proposal)(nil), "protos.Proposal")
@@ -371,37 +384,38 @@ func init() {
 	proto.RegisterType((*ChaincodeAction)(nil), "protos.ChaincodeAction")
 }
 
-func init() { proto.RegisterFile("peer/proposal.proto", fileDescriptor_proposal_8e93dae9c9555ac0) }
-
-var fileDescriptor_proposal_8e93dae9c9555ac0 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0x96, 0x93, 0xf7, 0xed, 0xc7, 0x24, 0xf4, 0x63, 0x5b, 0x21, 0x2b, 0xea, 0xa1, 0xb2, 0x84,
-	0x54, 0x24, 0xb0, 0xa5, 0x20, 0x21, 0xc4, 0x05, 0x11, 0xa8, 0x44, 0x0f, 0x48, 0x95, 0x81, 0x1e,
-	0x7a, 0x09, 0x6b, 0x7b, 0x70, 0x56, 0x35, 0xbb, 0xab, 0xdd, 0x75, 0x84, 0x8f, 0xfc, 0x1c, 0x7e,
-	0x0a, 0xff, 0x0a, 0xd9, 0xfb, 0xd1, 0x94, 0x5c, 0x38, 0x25, 0x33, 0xf3, 0x3c, 0xcf, 0xcc, 0x3c,
-	0xb3, 0x86, 0x13, 0x89, 0xa8, 0x32, 0xa9, 0x84, 0x14, 0x9a, 0x36, 0xa9, 0x54, 0xc2, 0x08, 0xb2,
-	0x33, 0xfc, 0xe8, 0xd9, 0xe9, 0x50, 0x2c, 0x57, 0x94, 0xf1, 0x52, 0x54, 0x68, 0xab, 0xb3, 0xb3,
-	0x07, 0x94, 0xa5, 0x42, 0x2d, 0x05, 0xd7, 0xae, 0x9a, 0x7c, 0x81, 0x83, 0x4f, 0xac, 0xe6, 0x58,
-	0x5d, 0x3b, 0x00, 0x79, 0x02, 0x07, 0x01, 0x5c, 0x74, 0x06, 0x75, 0x1c, 0x9d, 0x47, 0x17, 0xd3,
-	0xfc, 0x91, 0xcf, 0x2e, 0xfa, 0x24, 0x39, 0x83, 0x7d, 0xcd, 0x6a, 0x4e, 0x4d, 0xab, 0x30, 0x1e,
-	0x0d, 0x88, 0xfb, 0x44, 0x72, 0x0b, 0x7b, 0x41, 0xf0, 0x31, 0xec, 0xac, 0x90, 0x56, 0xa8, 0x9c,
-	0x90, 0x8b, 0x48, 0x0c, 0xbb, 0x92, 0x76, 0x8d, 0xa0, 0x95, 0xe3, 0xfb, 0xb0, 0xd7, 0xc6, 0x1f,
-	0x06, 0xb9, 0x66, 0x82, 0xc7, 0x63, 0xab, 0x1d, 0x12, 0xc9, 0xcf, 0x08, 0xe2, 0x77, 0x7e, 0xc9,
-	0x0f, 0x83, 0xd6, 0xa5, 0x2f, 0x92, 0xe7, 0x40, 0x9c, 0xca, 0x72, 0xcd, 0x34, 0x2b, 0x58, 0xc3,
-	0x4c, 0xe7, 0x1a, 0x1f, 0xbb, 0xca, 0x4d, 0x28, 0x90, 0x97, 0x30, 0x0d, 0x7e, 0x2d, 0x99, 0x1d,
-	0x64, 0x32, 0x3f, 0xb1, 0xe6, 0xe8, 0x34, 0xb4, 0xb9, 0x7a, 0x9f, 0x4f, 0x02, 0xf0, 0xaa, 0x4a,
-	0x7e, 0x6f, 0xce, 0xe0, 0x37, 0xbd, 0x76, 0xe3, 0x9f, 0xc2, 0xff, 0x8c, 0xcb, 0xd6, 0xb8, 0xb6,
-	0x36, 0x20, 0x37, 0x30, 0xfd, 0xac, 0x28, 0xd7, 0x0c, 0xb9, 0xf9, 0x48, 0x65, 0x3c, 0x3a, 0x1f,
-	0x5f, 0x4c, 0xe6, 0xf3, 0xad, 0x56, 0x7f, 0xa9, 0xa5, 0x9b, 0xa4, 0x4b, 0x6e, 0x54, 0x97, 0x3f,
-	0xd0, 0x99, 0xbd, 0x81, 0xe3, 0x2d, 0x08, 0x39, 0x82, 0xf1, 0x1d, 0xda, 0xbd, 0xf7, 0xf3, 0xfe,
-	0x6f, 0x3f, 0xd4, 0x9a, 0x36, 0xad, 0xbf, 0x95, 0x0d, 0x5e, 0x8f, 0x5e, 0x45, 0xc9, 0xaf, 0x08,
-	0x0e, 0x43, 0xf7, 0xb7, 0xa5, 0xe9, 0x6d, 0x8c, 0x61, 0x57, 0xa1, 0x6e, 0x1b, 0xe3, 0xaf, 0xef,
-	0xc3, 0xfe, 0x9a, 0xb8, 0x46, 0x6e, 0xb4, 0x13, 0x72, 0x11, 0x79, 0x06, 0x7b, 0xfe, 0x69, 0x0d,
-	0x27, 0x9b, 0xcc, 0x8f, 0xfc, 0x6a, 0xb9, 0xcb, 0xe7, 0x01, 0xb1, 0xe5, 0xfb, 0x7f, 0xff, 0xe6,
-	0xfb, 0xe2, 0x2b, 0x24, 0x42, 0xd5, 0xe9, 0xaa, 0x93, 0xa8, 0x1a, 0xac, 0x6a, 0x54, 0xe9, 0x37,
-	0x5a, 0x28, 0x56, 0x7a, 0x66, 0xff, 0xd8, 0x17, 0x87, 0xf7, 0x1e, 0x96, 0x77, 0xb4, 0xc6, 0xdb,
-	0xa7, 0x35, 0x33, 0xab, 0xb6, 0x48, 0x4b, 0xf1, 0x3d, 0xdb, 0xe0, 0x66, 0x96, 0x9b, 0x59, 0x6e,
-	0xd6, 0x73, 0x0b, 0xfb, 0x31, 0xbd, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x12, 0x75, 0xb6, 0xaf,
-	0x6a, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/proposal.proto", fileDescriptor_proposal_6cdf2b497f5d2819) }
+
+var fileDescriptor_proposal_6cdf2b497f5d2819 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0x45, 0x76, 0xf3, 0x35, 0x76, 0x63, 0x67, 0x13, 0x82, 0x30, 0x39, 0x04, 0x41, 0x21, 0x85,
+	0x56, 0x02, 0x17, 0x4a, 0xe9, 0xa5, 0xc4, 0xad, 0xa1, 0x39, 0x14, 0x82, 0xda, 0xe6, 0x90, 0x8b,
+	0xbb, 0x92, 0xa6, 0xf2, 0x12, 0x75, 0x57, 0xec, 0xae, 0x4c, 0x75, 0xec, 0xcf, 0xeb, 0x7f, 0xe9,
+	0x8f, 0x28, 0xd2, 0xee, 0xca, 0x76, 0x7c, 0xc9, 0xc9, 0x7e, 0xf3, 0xe6, 0xbd, 0xf9, 0x5a, 0xc1,
+	0x69, 0x89, 0x28, 0xa3, 0x52, 0x8a, 0x52, 0x28, 0x5a, 0x84, 0xa5, 0x14, 0x5a, 0x90, 0xfd, 0xf6,
+	0x47, 0x4d, 0xce, 0x5a, 0x32, 0x5d, 0x52, 0xc6, 0x53, 0x91, 0xa1, 0x61, 0x27, 0x93, 0xed, 0xe8,
+	0x02, 0x57, 0xc8, 0xb5, 0xe5, 0x2e, 0xb6, 0xec, 0x16, 0x12, 0x55, 0x29, 0xb8, 0xb2, 0xca, 0xe0,
+	0x3b, 0x1c, 0x7f, 0x65, 0x39, 0xc7, 0xec, 0xd6, 0x26, 0x90, 0x17, 0x70, 0xdc, 0x25, 0x27, 0xb5,
+	0x46, 0xe5, 0x7b, 0x97, 0xde, 0xd5, 0x30, 0x7e, 0xee, 0xa2, 0xb3, 0x26, 0x48, 0x2e, 0xe0, 0x48,
+	0xb1, 0x9c, 0x53, 0x5d, 0x49, 0xf4, 0x7b, 0x6d, 0xc6, 0x3a, 0x10, 0xdc, 0xc3, 0x61, 0x67, 0x78,
+	0x0e, 0xfb, 0x4b, 0xa4, 0x19, 0x4a, 0x6b, 0x64, 0x11, 0xf1, 0xe1, 0xa0, 0xa4, 0x75, 0x21, 0x68,
+	0x66, 0xf5, 0x0e, 0x36, 0xde, 0xf8, 0x5b, 0x23, 0x57, 0x4c, 0x70, 0xbf, 0x6f, 0xbc, 0xbb, 0x40,
+	0xf0, 0xc7, 0x03, 0xff, 0xa3, 0x1b, 0xf5, 0x73, 0xeb, 0x35, 0x77, 0x24, 0x79, 0x0d, 0xc4, 0xba,
+	0x2c, 0x56, 0x4c, 0xb1, 0x84, 0x15, 0x4c, 0xd7, 0xb6, 0xf0, 0x89, 0x65, 0xee, 0x3a, 0x82, 0xbc,
+	0x85, 0xe1, 0x7a, 0x6b, 0xcc, 0x34, 0x32, 0x98, 0x9e, 0x9a, 0xe5, 0xa8, 0xb0, 0x2b, 0x73, 0xf3,
+	0x29, 0x1e, 0x74, 0x89, 0x37, 0x59, 0xf0, 0x77, 0xb3, 0x07, 0x37, 0xe9, 0xad, 0x6d, 0xff, 0x0c,
+	0xf6, 0x18, 0x2f, 0x2b, 0x6d, 0xcb, 0x1a, 0x40, 0xee, 0x60, 0xf8, 0x4d, 0x52, 0xae, 0x18, 0x72,
+	0xfd, 0x85, 0x96, 0x7e, 0xef, 0xb2, 0x7f, 0x35, 0x98, 0x4e, 0x77, 0x4a, 0x3d, 0x72, 0x0b, 0x37,
+	0x45, 0x73, 0xae, 0x65, 0x1d, 0x6f, 0xf9, 0x4c, 0x3e, 0xc0, 0xc9, 0x4e, 0x0a, 0x19, 0x43, 0xff,
+	0x01, 0xcd, 0xdc, 0x47, 0x71, 0xf3, 0xb7, 0x69, 0x6a, 0x45, 0x8b, 0xca, 0xdd, 0xca, 0x80, 0xf7,
+	0xbd, 0x77, 0x5e, 0xf0, 0xcf, 0x83, 0x51, 0x57, 0xfd, 0x3a, 0xd5, 0xcd, 0x1a, 0x7d, 0x38, 0x90,
+	0xa8, 0xaa, 0x42, 0xbb, 0xeb, 0x3b, 0xd8, 0x5c, 0xb3, 0x7d, 0x5d, 0xca, 0x1a, 0x59, 0x44, 0x5e,
+	0xc1, 0xa1, 0x7b, 0x5a, 0xed, 0xc9, 0x06, 0xd3, 0xb1, 0x1b, 0x2d, 0xb6, 0xf1, 0xb8, 0xcb, 0xd8,
+	0xd9, 0xfb, 0xb3, 0xa7, 0xed, 0x9d, 0x5c, 0xc3, 0xf8, 0xd1, 0x2b, 0x57, 0xfe, 0x5e, 0xbb, 0xc8,
+	0xf3, 0x1d, 0xed, 0xbc, 0xa1, 0xe3, 0x51, 0xba, 0x85, 0xd5, 0xec, 0x07, 0x04, 0x42, 0xe6, 0xe1,
+	0xb2, 0x2e, 0x51, 0x16, 0x98, 0xe5, 0x28, 0xc3, 0x9f, 0x34, 0x91, 0x2c, 0x75, 0x06, 0xcd, 0xf7,
+	0x32, 0x1b, 0xad, 0xcf, 0x90, 0x3e, 0xd0, 0x1c, 0xef, 0x5f, 0xe6, 0x4c, 0x2f, 0xab, 0x24, 0x4c,
+	0xc5, 0xaf, 0x68, 0x43, 0x1b, 0x19, 0x6d, 0x64, 0xb4, 0x51, 0xa3, 0x4d, 0xcc, 0xb7, 0xfa, 0xe6,
+	0xff, 0x00, 0x8b, 0xfe, 0x9a, 0xdf, 0xc9, 0x03, 0x00, 0x00,
 }
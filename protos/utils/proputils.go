@@ -183,6 +183,22 @@ func GetChaincodeEvents(eBytes []byte) (*peer.ChaincodeEvent, error) {
 	return chaincodeEvent, errors.Wrap(err, "error unmarshaling ChaicnodeEvent")
 }
 
+// GetChaincodeActionEvents returns all the events emitted by the chaincode
+// invocation recorded in the given chaincode action, in emission order
+func GetChaincodeActionEvents(cAct *peer.ChaincodeAction) ([]*peer.ChaincodeEvent, error) {
+	if len(cAct.ChaincodeEvents) > 0 {
+		return cAct.ChaincodeEvents, nil
+	}
+	if len(cAct.Events) == 0 {
+		return nil, nil
+	}
+	event, err := GetChaincodeEvents(cAct.Events)
+	if err != nil {
+		return nil, err
+	}
+	return []*peer.ChaincodeEvent{event}, nil
+}
+
 // GetProposalResponsePayload gets the proposal response payload
 func GetProposalResponsePayload(prpBytes []byte) (*peer.ProposalResponsePayload, error) {
 	prp := &peer.ProposalResponsePayload{}
@@ -355,6 +371,40 @@ func GetBytesProposalResponsePayload(hash []byte, response *peer.Response, resul
 	return prpBytes, errors.Wrap(err, "error marshaling ProposalResponsePayload")
 }
 
+// GetBytesProposalResponsePayloadWithEvents gets proposal response payload
+// carrying all the events emitted by the chaincode. The first event is set as
+// the single event of the chaincode action; the whole list is only included
+// when more than one event was emitted, so that the payload of a transaction
+// with a single event is unchanged.
+func GetBytesProposalResponsePayloadWithEvents(hash []byte, response *peer.Response, result []byte, events []*peer.ChaincodeEvent, ccid *peer.ChaincodeID) ([]byte, error) {
+	cAct := &peer.ChaincodeAction{
+		Results:     result,
+		Response:    response,
+		ChaincodeId: ccid,
+	}
+	if len(events) > 0 {
+		eventBytes, err := GetBytesChaincodeEvent(events[0])
+		if err != nil {
+			return nil, err
+		}
+		cAct.Events = eventBytes
+	}
+	if len(events) > 1 {
+		cAct.ChaincodeEvents = events
+	}
+	cActBytes, err := proto.Marshal(cAct)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling ChaincodeAction")
+	}
+
+	prp := &peer.ProposalResponsePayload{
+		Extension:    cActBytes,
+		ProposalHash: hash,
+	}
+	prpBytes, err := proto.Marshal(prp)
+	return prpBytes, errors.Wrap(err, "error marshaling ProposalResponsePayload")
+}
+
 // GetBytesChaincodeProposalPayload gets the chaincode proposal payload
 func GetBytesChaincodeProposalPayload(cpp *peer.ChaincodeProposalPayload) ([]byte, error) {
 	cppBytes, err := proto.Marshal(cpp)
@@ -530,6 +580,22 @@ func CreateUpgradeProposalFromCDS(
 	return createProposalFromCDS(chainID, cds, creator, "upgrade", policy, escc, vscc, collectionConfig)
 }
 
+// CreateUpdateCollectionsProposal returns a proposal to update the collection
+// configuration of an instantiated chaincode without upgrading it
+func CreateUpdateCollectionsProposal(chainID string, chaincodeName string, creator []byte, collectionConfig []byte) (*peer.Proposal, string, error) {
+	lsccSpec := &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			Type:        peer.ChaincodeSpec_GOLANG,
+			ChaincodeId: &peer.ChaincodeID{Name: "lscc"},
+			Input: &peer.ChaincodeInput{
+				Args: [][]byte{[]byte("updatecollections"), []byte(chainID), []byte(chaincodeName), collectionConfig},
+			},
+		},
+	}
+
+	return CreateProposalFromCIS(common.HeaderType_ENDORSER_TRANSACTION, chainID, lsccSpec, creator)
+}
+
 // createProposalFromCDS returns a deploy or upgrade proposal given a
 // serialized identity and a ChaincodeDeploymentSpec
 func createProposalFromCDS(chainID string, msg proto.Message, creator []byte, propType string, args ...[]byte) (*peer.Proposal, string, error) {
@@ -489,6 +489,60 @@ func TestProposalResponse(t *testing.T) {
 	}
 }
 
+func TestProposalResponseWithEvents(t *testing.T) {
+	ccid := &pb.ChaincodeID{Name: "ccid", Version: "v1"}
+	first := &pb.ChaincodeEvent{ChaincodeId: "ccid", TxId: "TxID", EventName: "first", Payload: []byte("p1")}
+	second := &pb.ChaincodeEvent{ChaincodeId: "ccid", TxId: "TxID", EventName: "second", Payload: []byte("p2")}
+
+	getAction := func(events []*pb.ChaincodeEvent) *pb.ChaincodeAction {
+		prpBytes, err := utils.GetBytesProposalResponsePayloadWithEvents([]byte("hash"), &pb.Response{Status: 200}, []byte("results"), events, ccid)
+		assert.NoError(t, err)
+		prp, err := utils.GetProposalResponsePayload(prpBytes)
+		assert.NoError(t, err)
+		act, err := utils.GetChaincodeAction(prp.Extension)
+		assert.NoError(t, err)
+		return act
+	}
+
+	// no events
+	act := getAction(nil)
+	assert.Empty(t, act.Events)
+	assert.Empty(t, act.ChaincodeEvents)
+	events, err := utils.GetChaincodeActionEvents(act)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	// a single event is encoded as before
+	act = getAction([]*pb.ChaincodeEvent{first})
+	eventBytes, err := utils.GetBytesChaincodeEvent(first)
+	assert.NoError(t, err)
+	assert.Equal(t, eventBytes, act.Events)
+	assert.Empty(t, act.ChaincodeEvents)
+	legacyPrpBytes, err := utils.GetBytesProposalResponsePayload([]byte("hash"), &pb.Response{Status: 200}, []byte("results"), eventBytes, ccid)
+	assert.NoError(t, err)
+	prpBytes, err := utils.GetBytesProposalResponsePayloadWithEvents([]byte("hash"), &pb.Response{Status: 200}, []byte("results"), []*pb.ChaincodeEvent{first}, ccid)
+	assert.NoError(t, err)
+	assert.Equal(t, legacyPrpBytes, prpBytes)
+	events, err = utils.GetChaincodeActionEvents(act)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "first", events[0].EventName)
+
+	// multiple events
+	act = getAction([]*pb.ChaincodeEvent{first, second})
+	assert.Equal(t, eventBytes, act.Events)
+	events, err = utils.GetChaincodeActionEvents(act)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "first", events[0].EventName)
+	assert.Equal(t, "second", events[1].EventName)
+	assert.Equal(t, []byte("p2"), events[1].Payload)
+
+	// malformed legacy event
+	_, err = utils.GetChaincodeActionEvents(&pb.ChaincodeAction{Events: []byte("garbage")})
+	assert.Error(t, err)
+}
+
 func TestEnvelope(t *testing.T) {
 	// create a proposal from a ChaincodeInvocationSpec
 	prop, _, err := utils.CreateChaincodeProposal(common.HeaderType_ENDORSER_TRANSACTION, util.GetTestChainID(), createCIS(), signerSerialized)